@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of ACM certificate ARNs
+func getAllACMCertificates(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := acm.New(session)
+
+	// Only RSA 2048 certificates are listed unless other key types are requested explicitly
+	input := &acm.ListCertificatesInput{
+		Includes: &acm.Filters{KeyTypes: aws.StringSlice(acm.KeyAlgorithm_Values())},
+	}
+
+	var certificateArns []*string
+	err := svc.ListCertificatesPages(input, func(page *acm.ListCertificatesOutput, lastPage bool) bool {
+		for _, certificate := range page.CertificateSummaryList {
+			if shouldIncludeACMCertificate(certificate, time.Now(), excludeAfter, configObj) {
+				certificateArns = append(certificateArns, certificate.CertificateArn)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return certificateArns, nil
+}
+
+// shouldIncludeACMCertificate only includes certificates that aren't associated with any resource or that have
+// expired. The issue date is used for the excludeAfter filter, falling back to the import date for imported
+// certificates and to the creation date for certificates that were never issued.
+func shouldIncludeACMCertificate(certificate *acm.CertificateSummary, now time.Time, excludeAfter time.Time, configObj config.Config) bool {
+	if certificate == nil {
+		return false
+	}
+
+	expired := aws.StringValue(certificate.Status) == acm.CertificateStatusExpired ||
+		(certificate.NotAfter != nil && certificate.NotAfter.Before(now))
+	if aws.BoolValue(certificate.InUse) && !expired {
+		return false
+	}
+
+	referenceTime := certificate.IssuedAt
+	if referenceTime == nil {
+		referenceTime = certificate.ImportedAt
+	}
+	if referenceTime == nil {
+		referenceTime = certificate.CreatedAt
+	}
+	if referenceTime != nil && excludeAfter.Before(*referenceTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(certificate.DomainName),
+		configObj.ACM.IncludeRule.NamesRegExp,
+		configObj.ACM.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Deletes all ACM certificates. Expired certificates that are still associated with a resource fail to delete until
+// they are disassociated.
+func nukeAllACMCertificates(session *session.Session, certificateArns []*string) error {
+	svc := acm.New(session)
+
+	if len(certificateArns) == 0 {
+		logging.Logger.Debugf("No ACM certificates to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all ACM certificates in region %s", *session.Config.Region)
+	var deletedArns []*string
+	var allErrs *multierror.Error
+
+	for _, certificateArn := range certificateArns {
+		_, err := svc.DeleteCertificate(&acm.DeleteCertificateInput{CertificateArn: certificateArn})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(certificateArn),
+			ResourceType: "ACM Certificate",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking ACM Certificate",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedArns = append(deletedArns, certificateArn)
+			logging.Logger.Debugf("Deleted ACM certificate: %s", aws.StringValue(certificateArn))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d ACM certificate(s) deleted in %s", len(deletedArns), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldIncludeACMCertificate(t *testing.T) {
+	now := time.Now()
+	excludeAfter := now.Add(1 * time.Hour)
+	issuedAt := now.Add(-24 * time.Hour)
+
+	cases := []struct {
+		Name        string
+		Certificate *acm.CertificateSummary
+		Config      config.Config
+		Expected    bool
+	}{
+		{
+			Name:        "Unused",
+			Certificate: &acm.CertificateSummary{DomainName: aws.String("example.com"), InUse: aws.Bool(false), IssuedAt: aws.Time(issuedAt)},
+			Expected:    true,
+		},
+		{
+			Name:        "InUse",
+			Certificate: &acm.CertificateSummary{DomainName: aws.String("example.com"), InUse: aws.Bool(true), IssuedAt: aws.Time(issuedAt), NotAfter: aws.Time(now.Add(24 * time.Hour))},
+			Expected:    false,
+		},
+		{
+			Name:        "InUseButExpired",
+			Certificate: &acm.CertificateSummary{DomainName: aws.String("example.com"), InUse: aws.Bool(true), IssuedAt: aws.Time(issuedAt), NotAfter: aws.Time(now.Add(-1 * time.Hour))},
+			Expected:    true,
+		},
+		{
+			Name:        "IssuedAfterExcludeAfter",
+			Certificate: &acm.CertificateSummary{DomainName: aws.String("example.com"), InUse: aws.Bool(false), IssuedAt: aws.Time(now.Add(2 * time.Hour))},
+			Expected:    false,
+		},
+		{
+			Name:        "DomainNameExcluded",
+			Certificate: &acm.CertificateSummary{DomainName: aws.String("prod.example.com"), InUse: aws.Bool(false), IssuedAt: aws.Time(issuedAt)},
+			Config: config.Config{
+				ACM: config.ResourceType{
+					ExcludeRule: config.FilterRule{
+						NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^prod\\.")}},
+					},
+				},
+			},
+			Expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludeACMCertificate(c.Certificate, now, excludeAfter, c.Config))
+		})
+	}
+}
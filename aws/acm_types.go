@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// ACMCertificates - represents all unused or expired ACM certificates
+type ACMCertificates struct {
+	CertificateArns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (certificates ACMCertificates) ResourceName() string {
+	return "acm"
+}
+
+// ResourceIdentifiers - The ARNs of the ACM certificates
+func (certificates ACMCertificates) ResourceIdentifiers() []string {
+	return certificates.CertificateArns
+}
+
+func (certificates ACMCertificates) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (certificates ACMCertificates) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllACMCertificates(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -11,13 +11,14 @@ import (
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
 	"github.com/gruntwork-io/go-commons/errors"
 )
 
 // Returns a formatted string of AMI Image ids
-func getAllAMIs(session *session.Session, region string, excludeAfter time.Time) ([]*string, error) {
+func getAllAMIs(session *session.Session, region string, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
 	svc := ec2.New(session)
 
 	params := &ec2.DescribeImagesInput{
@@ -31,14 +32,11 @@ func getAllAMIs(session *session.Session, region string, excludeAfter time.Time)
 
 	var imageIds []*string
 	for _, image := range output.Images {
-		layout := "2006-01-02T15:04:05.000Z"
-		createdTime, err := time.Parse(layout, *image.CreationDate)
+		shouldInclude, err := shouldIncludeAMI(image, excludeAfter, configObj)
 		if err != nil {
 			return nil, err
 		}
-
-		// Test for time exclusion and check if resource is managed by AWS Backup (see note in README)
-		if excludeAfter.After(createdTime) && !util.HasAWSBackupTag(image.Tags) {
+		if shouldInclude {
 			imageIds = append(imageIds, image.ImageId)
 		}
 	}
@@ -46,6 +44,64 @@ func getAllAMIs(session *session.Session, region string, excludeAfter time.Time)
 	return imageIds, nil
 }
 
+func shouldIncludeAMI(image *ec2.Image, excludeAfter time.Time, configObj config.Config) (bool, error) {
+	if image == nil {
+		return false, nil
+	}
+
+	layout := "2006-01-02T15:04:05.000Z"
+	createdTime, err := time.Parse(layout, awsgo.StringValue(image.CreationDate))
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+
+	// Test for time exclusion and check if resource is managed by AWS Backup (see note in README)
+	if !excludeAfter.After(createdTime) || util.HasAWSBackupTag(image.Tags) {
+		return false, nil
+	}
+
+	return config.ShouldInclude(
+		awsgo.StringValue(image.Name),
+		configObj.AMI.IncludeRule.NamesRegExp,
+		configObj.AMI.ExcludeRule.NamesRegExp,
+	), nil
+}
+
+// getAMISnapshotIds returns the ids of the EBS snapshots backing the given AMI
+func getAMISnapshotIds(svc *ec2.EC2, imageID *string) ([]*string, error) {
+	output, err := svc.DescribeImages(&ec2.DescribeImagesInput{
+		ImageIds: []*string{imageID},
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var snapshotIds []*string
+	for _, image := range output.Images {
+		for _, mapping := range image.BlockDeviceMappings {
+			if mapping.Ebs != nil && mapping.Ebs.SnapshotId != nil {
+				snapshotIds = append(snapshotIds, mapping.Ebs.SnapshotId)
+			}
+		}
+	}
+	return snapshotIds, nil
+}
+
+// deleteAMISnapshots deletes the snapshots that backed a deregistered AMI. Failures are logged rather than returned, as
+// the AMI itself has already been deregistered and is what the run report tracks.
+func deleteAMISnapshots(svc *ec2.EC2, imageID *string, snapshotIds []*string) {
+	for _, snapshotID := range snapshotIds {
+		_, err := svc.DeleteSnapshot(&ec2.DeleteSnapshotInput{
+			SnapshotId: snapshotID,
+		})
+		if err != nil {
+			logging.Logger.Errorf("[Failed] Deleting snapshot %s of AMI %s: %s", aws.StringValue(snapshotID), aws.StringValue(imageID), err)
+			continue
+		}
+		logging.Logger.Debugf("Deleted snapshot %s of AMI %s", aws.StringValue(snapshotID), aws.StringValue(imageID))
+	}
+}
+
 // Deletes all AMIs
 func nukeAllAMIs(session *session.Session, imageIds []*string) error {
 	svc := ec2.New(session)
@@ -59,11 +115,17 @@ func nukeAllAMIs(session *session.Session, imageIds []*string) error {
 
 	deletedCount := 0
 	for _, imageID := range imageIds {
+		// The snapshots have to be looked up before deregistering, as the AMI can't be described afterwards
+		snapshotIds, err := getAMISnapshotIds(svc, imageID)
+		if err != nil {
+			logging.Logger.Debugf("[Failed] Looking up snapshots of AMI %s: %s", aws.StringValue(imageID), err)
+		}
+
 		params := &ec2.DeregisterImageInput{
 			ImageId: imageID,
 		}
 
-		_, err := svc.DeregisterImage(params)
+		_, err = svc.DeregisterImage(params)
 
 		// Record status of this resource
 		e := report.Entry{
@@ -83,6 +145,7 @@ func nukeAllAMIs(session *session.Session, imageIds []*string) error {
 		} else {
 			deletedCount++
 			logging.Logger.Debugf("Deleted AMI: %s", *imageID)
+			deleteAMISnapshots(svc, imageID, snapshotIds)
 		}
 	}
 
@@ -2,16 +2,19 @@ package aws
 
 import (
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	"regexp"
 	"testing"
 	"time"
 
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/gruntwork-io/go-commons/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func waitUntilImageAvailable(svc *ec2.EC2, input *ec2.DescribeImagesInput) error {
@@ -108,14 +111,14 @@ func TestListAMIs(t *testing.T) {
 	defer nukeAllAMIs(session, []*string{image.ImageId})
 	defer nukeAllEc2Instances(session, findEC2InstancesByNameTag(t, session, uniqueTestID))
 
-	amis, err := getAllAMIs(session, region, time.Now().Add(1*time.Hour*-1))
+	amis, err := getAllAMIs(session, region, time.Now().Add(1*time.Hour*-1), config.Config{})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of AMIs")
 	}
 
 	assert.NotContains(t, awsgo.StringValueSlice(amis), *image.ImageId)
 
-	amis, err = getAllAMIs(session, region, time.Now().Add(1*time.Hour))
+	amis, err = getAllAMIs(session, region, time.Now().Add(1*time.Hour), config.Config{})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of AMIs")
 	}
@@ -169,10 +172,58 @@ func TestNukeAMIs(t *testing.T) {
 		assert.Fail(t, errors.WithStackTrace(err).Error())
 	}
 
-	amis, err := getAllAMIs(session, region, time.Now().Add(1*time.Hour))
+	amis, err := getAllAMIs(session, region, time.Now().Add(1*time.Hour), config.Config{})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of AMIs")
 	}
 
 	assert.NotContains(t, awsgo.StringValueSlice(amis), *image.ImageId)
 }
+
+func TestShouldIncludeAMI(t *testing.T) {
+	telemetry.InitTelemetry("cloud-nuke", "", "")
+	t.Parallel()
+
+	image := &ec2.Image{
+		ImageId:      awsgo.String("ami-test"),
+		Name:         awsgo.String("cloud-nuke-test-image"),
+		CreationDate: awsgo.String("2022-11-02T10:04:05.000Z"),
+	}
+	createdAt, err := time.Parse("2006-01-02T15:04:05.000Z", *image.CreationDate)
+	require.NoError(t, err)
+
+	includeConfig := config.Config{
+		AMI: config.ResourceType{
+			IncludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^cloud-nuke-test-.*")}},
+			},
+		},
+	}
+	excludeConfig := config.Config{
+		AMI: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^cloud-nuke-test-.*")}},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name         string
+		Config       config.Config
+		ExcludeAfter time.Time
+		Expected     bool
+	}{
+		{"OlderThan", config.Config{}, createdAt.Add(time.Hour), true},
+		{"NotOlderThan", config.Config{}, createdAt.Add(-1 * time.Hour), false},
+		{"ConfigInclude", includeConfig, createdAt.Add(time.Hour), true},
+		{"ConfigExclude", excludeConfig, createdAt.Add(time.Hour), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			result, err := shouldIncludeAMI(image, c.ExcludeAfter, c.Config)
+			require.NoError(t, err)
+			assert.Equal(t, c.Expected, result)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/aws/aws-sdk-go/service/amplify/amplifyiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of Amplify app IDs
+func getAllAmplifyApps(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := amplify.New(session)
+
+	var appIds []*string
+	input := &amplify.ListAppsInput{}
+	for {
+		output, err := svc.ListApps(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, app := range output.Apps {
+			if shouldIncludeAmplifyApp(app, excludeAfter, configObj) {
+				appIds = append(appIds, app.AppId)
+			}
+		}
+
+		if output.NextToken == nil {
+			return appIds, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+func shouldIncludeAmplifyApp(app *amplify.App, excludeAfter time.Time, configObj config.Config) bool {
+	if app == nil {
+		return false
+	}
+
+	if app.CreateTime != nil && excludeAfter.Before(*app.CreateTime) {
+		return false
+	}
+
+	if aws.StringValue(app.Tags[AwsResourceExclusionTagKey]) == "true" {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(app.Name),
+		configObj.AmplifyApp.IncludeRule.NamesRegExp,
+		configObj.AmplifyApp.ExcludeRule.NamesRegExp,
+	)
+}
+
+func listAmplifyBranches(svc amplifyiface.AmplifyAPI, appId *string) ([]*amplify.Branch, error) {
+	var branches []*amplify.Branch
+	input := &amplify.ListBranchesInput{AppId: appId}
+	for {
+		output, err := svc.ListBranches(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		branches = append(branches, output.Branches...)
+
+		if output.NextToken == nil {
+			return branches, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+func listAmplifyBackendEnvironments(svc amplifyiface.AmplifyAPI, appId *string) ([]*amplify.BackendEnvironment, error) {
+	var environments []*amplify.BackendEnvironment
+	input := &amplify.ListBackendEnvironmentsInput{AppId: appId}
+	for {
+		output, err := svc.ListBackendEnvironments(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		environments = append(environments, output.BackendEnvironments...)
+
+		if output.NextToken == nil {
+			return environments, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// deleteAmplifyApp deletes the branches of the app and its backend environments before deleting the app itself, as
+// deleting the app doesn't remove the environments' CloudFormation stacks.
+func deleteAmplifyApp(svc amplifyiface.AmplifyAPI, appId *string) error {
+	branches, err := listAmplifyBranches(svc, appId)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, branch := range branches {
+		_, err := svc.DeleteBranch(&amplify.DeleteBranchInput{AppId: appId, BranchName: branch.BranchName})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted branch %s of Amplify app %s", aws.StringValue(branch.BranchName), aws.StringValue(appId))
+	}
+
+	environments, err := listAmplifyBackendEnvironments(svc, appId)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, environment := range environments {
+		_, err := svc.DeleteBackendEnvironment(&amplify.DeleteBackendEnvironmentInput{
+			AppId:           appId,
+			EnvironmentName: environment.EnvironmentName,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted backend environment %s of Amplify app %s", aws.StringValue(environment.EnvironmentName), aws.StringValue(appId))
+	}
+
+	_, err = svc.DeleteApp(&amplify.DeleteAppInput{AppId: appId})
+	return errors.WithStackTrace(err)
+}
+
+// Deletes all Amplify apps, along with their branches and backend environments
+func nukeAllAmplifyApps(session *session.Session, appIds []*string) error {
+	svc := amplify.New(session)
+
+	if len(appIds) == 0 {
+		logging.Logger.Debugf("No Amplify apps to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Amplify apps in region %s", *session.Config.Region)
+	var deletedAppIds []*string
+	var allErrs *multierror.Error
+
+	for _, appId := range appIds {
+		err := deleteAmplifyApp(svc, appId)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(appId),
+			ResourceType: "Amplify App",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Amplify App",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedAppIds = append(deletedAppIds, appId)
+			logging.Logger.Debugf("Deleted Amplify app: %s", aws.StringValue(appId))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Amplify app(s) deleted in %s", len(deletedAppIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/aws/aws-sdk-go/service/amplify/amplifyiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedAmplify struct {
+	amplifyiface.AmplifyAPI
+	Calls []string
+}
+
+func (m *mockedAmplify) ListBranches(input *amplify.ListBranchesInput) (*amplify.ListBranchesOutput, error) {
+	return &amplify.ListBranchesOutput{Branches: []*amplify.Branch{{BranchName: aws.String("main")}}}, nil
+}
+
+func (m *mockedAmplify) ListBackendEnvironments(input *amplify.ListBackendEnvironmentsInput) (*amplify.ListBackendEnvironmentsOutput, error) {
+	return &amplify.ListBackendEnvironmentsOutput{BackendEnvironments: []*amplify.BackendEnvironment{{EnvironmentName: aws.String("dev")}}}, nil
+}
+
+func (m *mockedAmplify) DeleteBranch(input *amplify.DeleteBranchInput) (*amplify.DeleteBranchOutput, error) {
+	m.Calls = append(m.Calls, "DeleteBranch:"+aws.StringValue(input.BranchName))
+	return &amplify.DeleteBranchOutput{}, nil
+}
+
+func (m *mockedAmplify) DeleteBackendEnvironment(input *amplify.DeleteBackendEnvironmentInput) (*amplify.DeleteBackendEnvironmentOutput, error) {
+	m.Calls = append(m.Calls, "DeleteBackendEnvironment:"+aws.StringValue(input.EnvironmentName))
+	return &amplify.DeleteBackendEnvironmentOutput{}, nil
+}
+
+func (m *mockedAmplify) DeleteApp(input *amplify.DeleteAppInput) (*amplify.DeleteAppOutput, error) {
+	m.Calls = append(m.Calls, "DeleteApp:"+aws.StringValue(input.AppId))
+	return &amplify.DeleteAppOutput{}, nil
+}
+
+func TestDeleteAmplifyAppDeletesBranchesAndEnvironmentsFirst(t *testing.T) {
+	mock := &mockedAmplify{}
+	require.NoError(t, deleteAmplifyApp(mock, aws.String("app")))
+	assert.Equal(t, []string{"DeleteBranch:main", "DeleteBackendEnvironment:dev", "DeleteApp:app"}, mock.Calls)
+}
+
+func TestShouldIncludeAmplifyApp(t *testing.T) {
+	app := &amplify.App{
+		Name:       aws.String("cloud-nuke-test"),
+		CreateTime: aws.Time(time.Now()),
+	}
+	excludedApp := &amplify.App{
+		Name:       aws.String("cloud-nuke-test"),
+		CreateTime: aws.Time(time.Now()),
+		Tags:       map[string]*string{AwsResourceExclusionTagKey: aws.String("true")},
+	}
+
+	excludeConfig := config.Config{
+		AmplifyApp: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^cloud-nuke-")}},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name         string
+		App          *amplify.App
+		Config       config.Config
+		ExcludeAfter time.Time
+		Expected     bool
+	}{
+		{"Include", app, config.Config{}, time.Now().Add(1 * time.Hour), true},
+		{"NotOlderThan", app, config.Config{}, time.Now().Add(1 * time.Hour * -1), false},
+		{"ConfigExclude", app, excludeConfig, time.Now().Add(1 * time.Hour), false},
+		{"ExcludeTag", excludedApp, config.Config{}, time.Now().Add(1 * time.Hour), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludeAmplifyApp(c.App, c.ExcludeAfter, c.Config))
+		})
+	}
+}
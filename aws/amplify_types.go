@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// AmplifyApps - represents all Amplify apps
+type AmplifyApps struct {
+	AppIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (apps AmplifyApps) ResourceName() string {
+	return "amplify-app"
+}
+
+// ResourceIdentifiers - The IDs of the Amplify apps
+func (apps AmplifyApps) ResourceIdentifiers() []string {
+	return apps.AppIds
+}
+
+func (apps AmplifyApps) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (apps AmplifyApps) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllAmplifyApps(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
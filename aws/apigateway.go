@@ -1,33 +1,38 @@
 package aws
 
 import (
+	"fmt"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
-	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
 	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/gruntwork-io/go-commons/retry"
 	"github.com/hashicorp/go-multierror"
 )
 
 func getAllAPIGateways(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
 	svc := apigateway.New(session)
 
-	result, err := svc.GetRestApis(&apigateway.GetRestApisInput{})
-	if err != nil {
-		return []*string{}, errors.WithStackTrace(err)
-	}
 	Ids := []*string{}
-	for _, apigateway := range result.Items {
-		if shouldIncludeAPIGateway(apigateway, excludeAfter, configObj) {
-			Ids = append(Ids, apigateway.Id)
+	err := svc.GetRestApisPages(&apigateway.GetRestApisInput{}, func(page *apigateway.GetRestApisOutput, lastPage bool) bool {
+		for _, apigateway := range page.Items {
+			if shouldIncludeAPIGateway(apigateway, excludeAfter, configObj) {
+				Ids = append(Ids, apigateway.Id)
+			}
 		}
+		return !lastPage
+	})
+	if err != nil {
+		return []*string{}, errors.WithStackTrace(err)
 	}
 
 	return Ids, nil
@@ -65,22 +70,26 @@ func nukeAllAPIGateways(session *session.Session, identifiers []*string) error {
 		return TooManyApiGatewayErr{}
 	}
 
-	// There is no bulk delete Api Gateway API, so we delete the batch of gateways concurrently using goroutines
+	// There is no bulk delete Api Gateway API, and DeleteRestApi is throttled to one call every 30 seconds per account,
+	// so deleting the gateways concurrently would only get most of the calls throttled. Delete them one by one instead.
 	logging.Logger.Debugf("Deleting Api Gateways (v1) in region %s", region)
-	wg := new(sync.WaitGroup)
-	wg.Add(len(identifiers))
-	errChans := make([]chan error, len(identifiers))
-	for i, apigwID := range identifiers {
-		errChans[i] = make(chan error, 1)
-		go deleteApiGatewayAsync(wg, errChans[i], svc, apigwID, region)
-	}
-	wg.Wait()
-
 	var allErrs *multierror.Error
-	for _, errChan := range errChans {
-		if err := <-errChan; err != nil {
+	for _, apigwID := range identifiers {
+		err := deleteApiGatewayWithBackoff(svc, apigwID)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   *apigwID,
+			ResourceType: "APIGateway (v1)",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err == nil {
+			logging.Logger.Debugf("[OK] API Gateway (v1) %s deleted in %s", aws.StringValue(apigwID), region)
+		} else {
 			allErrs = multierror.Append(allErrs, err)
-			logging.Logger.Debugf("[Failed] %s", err)
+			logging.Logger.Debugf("[Failed] Error deleting API Gateway (v1) %s in %s: %s", aws.StringValue(apigwID), region, err)
 			telemetry.TrackEvent(commonTelemetry.EventContext{
 				EventName: "Error Nuking API Gateway",
 			}, map[string]interface{}{
@@ -95,24 +104,23 @@ func nukeAllAPIGateways(session *session.Session, identifiers []*string) error {
 	return nil
 }
 
-func deleteApiGatewayAsync(wg *sync.WaitGroup, errChan chan error, svc *apigateway.APIGateway, apigwID *string, region string) {
-	defer wg.Done()
-
-	input := &apigateway.DeleteRestApiInput{RestApiId: apigwID}
-	_, err := svc.DeleteRestApi(input)
-	errChan <- err
-
-	// Record status of this resource
-	e := report.Entry{
-		Identifier:   *apigwID,
-		ResourceType: "APIGateway (v1)",
-		Error:        err,
-	}
-	report.Record(e)
-
-	if err == nil {
-		logging.Logger.Debugf("[OK] API Gateway (v1) %s deleted in %s", aws.StringValue(apigwID), region)
-	} else {
-		logging.Logger.Debugf("[Failed] Error deleting API Gateway (v1) %s in %s", aws.StringValue(apigwID), region)
-	}
+// deleteApiGatewayWithBackoff deletes the REST API, waiting out the account level throttle whenever the call is
+// rejected with TooManyRequests.
+func deleteApiGatewayWithBackoff(svc apigatewayiface.APIGatewayAPI, apigwID *string) error {
+	return retry.DoWithRetry(
+		logging.Logger,
+		fmt.Sprintf("Delete API Gateway (v1) %s", aws.StringValue(apigwID)),
+		// DeleteRestApi allows one call every 30 seconds, so wait a bit longer than that between attempts
+		10, 35*time.Second,
+		func() error {
+			_, err := svc.DeleteRestApi(&apigateway.DeleteRestApiInput{RestApiId: apigwID})
+			if err == nil {
+				return nil
+			}
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == apigateway.ErrCodeTooManyRequestsException {
+				return err
+			}
+			return retry.FatalError{Underlying: err}
+		},
+	)
 }
@@ -7,8 +7,10 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/gruntwork-io/go-commons/errors"
@@ -151,3 +153,27 @@ func TestNukeAPIGatewayMoreThanOne(t *testing.T) {
 	assert.NotContains(t, aws.StringValueSlice(apigwIds), aws.StringValue(testGw.ID))
 	assert.NotContains(t, aws.StringValueSlice(apigwIds), aws.StringValue(testGw2.ID))
 }
+
+// mockedAPIGatewayDelete fails every DeleteRestApi call with the given error
+type mockedAPIGatewayDelete struct {
+	apigatewayiface.APIGatewayAPI
+	Err   error
+	Calls int
+}
+
+func (m *mockedAPIGatewayDelete) DeleteRestApi(input *apigateway.DeleteRestApiInput) (*apigateway.DeleteRestApiOutput, error) {
+	m.Calls++
+	return &apigateway.DeleteRestApiOutput{}, m.Err
+}
+
+func TestDeleteApiGatewayWithBackoffOnlyRetriesThrottling(t *testing.T) {
+	t.Parallel()
+
+	deleted := &mockedAPIGatewayDelete{}
+	require.NoError(t, deleteApiGatewayWithBackoff(deleted, awsgo.String("abcdef1234")))
+	assert.Equal(t, 1, deleted.Calls)
+
+	notFound := &mockedAPIGatewayDelete{Err: awserr.New(apigateway.ErrCodeNotFoundException, "not found", nil)}
+	assert.Error(t, deleteApiGatewayWithBackoff(notFound, awsgo.String("abcdef1234")))
+	assert.Equal(t, 1, notFound.Calls)
+}
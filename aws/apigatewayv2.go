@@ -19,15 +19,25 @@ import (
 func getAllAPIGatewaysV2(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
 	svc := apigatewayv2.New(session)
 
-	output, err := svc.GetApis(&apigatewayv2.GetApisInput{})
-	if err != nil {
-		return []*string{}, errors.WithStackTrace(err)
-	}
+	// GetApis is paginated, but the SDK doesn't provide a pages helper for it
 	Ids := []*string{}
-	for _, restapi := range output.Items {
-		if shouldIncludeAPIGatewayV2(restapi, excludeAfter, configObj) {
-			Ids = append(Ids, restapi.ApiId)
+	input := &apigatewayv2.GetApisInput{}
+	for {
+		output, err := svc.GetApis(input)
+		if err != nil {
+			return []*string{}, errors.WithStackTrace(err)
+		}
+
+		for _, restapi := range output.Items {
+			if shouldIncludeAPIGatewayV2(restapi, excludeAfter, configObj) {
+				Ids = append(Ids, restapi.ApiId)
+			}
+		}
+
+		if output.NextToken == nil {
+			break
 		}
+		input.NextToken = output.NextToken
 	}
 
 	return Ids, nil
@@ -46,8 +56,8 @@ func shouldIncludeAPIGatewayV2(api *apigatewayv2.Api, excludeAfter time.Time, co
 
 	return config.ShouldInclude(
 		aws.StringValue(api.Name),
-		configObj.APIGateway.IncludeRule.NamesRegExp,
-		configObj.APIGateway.ExcludeRule.NamesRegExp,
+		configObj.APIGatewayV2.IncludeRule.NamesRegExp,
+		configObj.APIGatewayV2.ExcludeRule.NamesRegExp,
 	)
 }
 
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	"regexp"
 	"testing"
 	"time"
 
@@ -148,3 +149,16 @@ func TestNukeAPIGatewayV2MoreThanOne(t *testing.T) {
 	assert.NotContains(t, aws.StringValueSlice(apigwIds), aws.StringValue(testGw.ID))
 	assert.NotContains(t, aws.StringValueSlice(apigwIds), aws.StringValue(testGw2.ID))
 }
+
+func TestShouldIncludeAPIGatewayV2UsesItsOwnConfig(t *testing.T) {
+	now := time.Now()
+	api := &apigatewayv2.Api{Name: awsgo.String("cloud-nuke-test"), CreatedDate: awsgo.Time(now)}
+	excludeRule := config.FilterRule{
+		NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^cloud-nuke-")}},
+	}
+
+	assert.True(t, shouldIncludeAPIGatewayV2(api, now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeAPIGatewayV2(api, now.Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeAPIGatewayV2(api, now.Add(1*time.Hour), config.Config{APIGatewayV2: config.ResourceType{ExcludeRule: excludeRule}}))
+	assert.True(t, shouldIncludeAPIGatewayV2(api, now.Add(1*time.Hour), config.Config{APIGateway: config.ResourceType{ExcludeRule: excludeRule}}))
+}
@@ -0,0 +1,296 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/aws/aws-sdk-go/service/apprunner/apprunneriface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// The auto scaling configuration App Runner provides in every account, which can't be deleted
+const defaultAppRunnerAutoScalingConfigurationName = "DefaultConfiguration"
+
+// Returns a formatted string of App Runner service ARNs
+func getAllAppRunnerServices(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := apprunner.New(session)
+
+	var services []*apprunner.ServiceSummary
+	err := svc.ListServicesPages(&apprunner.ListServicesInput{}, func(page *apprunner.ListServicesOutput, lastPage bool) bool {
+		for _, service := range page.ServiceSummaryList {
+			if shouldIncludeAppRunnerService(service, excludeAfter, configObj) {
+				services = append(services, service)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var arns []*string
+	for _, service := range services {
+		exclude, err := hasAppRunnerExcludeTag(svc, service.ServiceArn)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !exclude {
+			arns = append(arns, service.ServiceArn)
+		}
+	}
+	return arns, nil
+}
+
+func shouldIncludeAppRunnerService(service *apprunner.ServiceSummary, excludeAfter time.Time, configObj config.Config) bool {
+	if service == nil || aws.StringValue(service.Status) == apprunner.ServiceStatusDeleted {
+		return false
+	}
+
+	if service.CreatedAt != nil && excludeAfter.Before(*service.CreatedAt) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(service.ServiceName),
+		configObj.AppRunnerService.IncludeRule.NamesRegExp,
+		configObj.AppRunnerService.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Returns a formatted string of the ARNs of the revisions of custom App Runner auto scaling configurations
+func getAllAppRunnerAutoScalingConfigurations(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := apprunner.New(session)
+
+	var summaries []*apprunner.AutoScalingConfigurationSummary
+	err := svc.ListAutoScalingConfigurationsPages(&apprunner.ListAutoScalingConfigurationsInput{}, func(page *apprunner.ListAutoScalingConfigurationsOutput, lastPage bool) bool {
+		summaries = append(summaries, page.AutoScalingConfigurationSummaryList...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var arns []*string
+	for _, summary := range summaries {
+		if aws.StringValue(summary.AutoScalingConfigurationName) == defaultAppRunnerAutoScalingConfigurationName {
+			continue
+		}
+
+		// The creation time and status are only returned when describing the auto scaling configuration
+		output, err := svc.DescribeAutoScalingConfiguration(&apprunner.DescribeAutoScalingConfigurationInput{
+			AutoScalingConfigurationArn: summary.AutoScalingConfigurationArn,
+		})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !shouldIncludeAppRunnerAutoScalingConfiguration(output.AutoScalingConfiguration, excludeAfter, configObj) {
+			continue
+		}
+
+		exclude, err := hasAppRunnerExcludeTag(svc, summary.AutoScalingConfigurationArn)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !exclude {
+			arns = append(arns, summary.AutoScalingConfigurationArn)
+		}
+	}
+	return arns, nil
+}
+
+func shouldIncludeAppRunnerAutoScalingConfiguration(configuration *apprunner.AutoScalingConfiguration, excludeAfter time.Time, configObj config.Config) bool {
+	if configuration == nil || aws.StringValue(configuration.Status) == apprunner.AutoScalingConfigurationStatusInactive {
+		return false
+	}
+
+	if configuration.CreatedAt != nil && excludeAfter.Before(*configuration.CreatedAt) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(configuration.AutoScalingConfigurationName),
+		configObj.AppRunnerAutoScalingConfiguration.IncludeRule.NamesRegExp,
+		configObj.AppRunnerAutoScalingConfiguration.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Returns a formatted string of App Runner VPC connector ARNs
+func getAllAppRunnerVpcConnectors(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := apprunner.New(session)
+
+	var connectors []*apprunner.VpcConnector
+	err := svc.ListVpcConnectorsPages(&apprunner.ListVpcConnectorsInput{}, func(page *apprunner.ListVpcConnectorsOutput, lastPage bool) bool {
+		for _, connector := range page.VpcConnectors {
+			if shouldIncludeAppRunnerVpcConnector(connector, excludeAfter, configObj) {
+				connectors = append(connectors, connector)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var arns []*string
+	for _, connector := range connectors {
+		exclude, err := hasAppRunnerExcludeTag(svc, connector.VpcConnectorArn)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !exclude {
+			arns = append(arns, connector.VpcConnectorArn)
+		}
+	}
+	return arns, nil
+}
+
+func shouldIncludeAppRunnerVpcConnector(connector *apprunner.VpcConnector, excludeAfter time.Time, configObj config.Config) bool {
+	if connector == nil || aws.StringValue(connector.Status) == apprunner.VpcConnectorStatusInactive {
+		return false
+	}
+
+	if connector.CreatedAt != nil && excludeAfter.Before(*connector.CreatedAt) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(connector.VpcConnectorName),
+		configObj.AppRunnerVpcConnector.IncludeRule.NamesRegExp,
+		configObj.AppRunnerVpcConnector.ExcludeRule.NamesRegExp,
+	)
+}
+
+func hasAppRunnerExcludeTag(svc apprunneriface.AppRunnerAPI, resourceArn *string) (bool, error) {
+	output, err := svc.ListTagsForResource(&apprunner.ListTagsForResourceInput{ResourceArn: resourceArn})
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+
+	for _, tag := range output.Tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// waitForAppRunnerOperation waits until the given operation on the service is finished, returning an error if it
+// didn't succeed.
+func waitForAppRunnerOperation(svc apprunneriface.AppRunnerAPI, serviceArn *string, operationId *string) error {
+	for i := 0; i < 60; i++ {
+		var operation *apprunner.OperationSummary
+		err := svc.ListOperationsPages(&apprunner.ListOperationsInput{ServiceArn: serviceArn}, func(page *apprunner.ListOperationsOutput, lastPage bool) bool {
+			for _, summary := range page.OperationSummaryList {
+				if aws.StringValue(summary.Id) == aws.StringValue(operationId) {
+					operation = summary
+					return false
+				}
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		if operation != nil {
+			switch aws.StringValue(operation.Status) {
+			case apprunner.OperationStatusSucceeded:
+				return nil
+			case apprunner.OperationStatusFailed, apprunner.OperationStatusRollbackFailed, apprunner.OperationStatusRollbackSucceeded:
+				return AppRunnerOperationFailedError{serviceArn: aws.StringValue(serviceArn), status: aws.StringValue(operation.Status)}
+			}
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for App Runner service %s to be deleted", aws.StringValue(serviceArn))
+	}
+
+	return AppRunnerOperationTimeoutError{serviceArn: aws.StringValue(serviceArn)}
+}
+
+// nukeAppRunnerService deletes the service and waits for the deletion to finish, as it's only then that we know
+// whether it succeeded.
+func nukeAppRunnerService(svc apprunneriface.AppRunnerAPI, serviceArn *string) error {
+	output, err := svc.DeleteService(&apprunner.DeleteServiceInput{ServiceArn: serviceArn})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return waitForAppRunnerOperation(svc, serviceArn, output.OperationId)
+}
+
+func nukeAppRunnerAutoScalingConfiguration(svc apprunneriface.AppRunnerAPI, arn *string) error {
+	_, err := svc.DeleteAutoScalingConfiguration(&apprunner.DeleteAutoScalingConfigurationInput{AutoScalingConfigurationArn: arn})
+	return errors.WithStackTrace(err)
+}
+
+func nukeAppRunnerVpcConnector(svc apprunneriface.AppRunnerAPI, arn *string) error {
+	_, err := svc.DeleteVpcConnector(&apprunner.DeleteVpcConnectorInput{VpcConnectorArn: arn})
+	return errors.WithStackTrace(err)
+}
+
+// nukeAppRunnerResources deletes the given App Runner services, auto scaling configurations or VPC connectors using
+// deleteFn, recording the status of each of them.
+func nukeAppRunnerResources(session *session.Session, resourceType string, identifiers []*string, deleteFn func(svc apprunneriface.AppRunnerAPI, identifier *string) error) error {
+	svc := apprunner.New(session)
+
+	if len(identifiers) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedIdentifiers []*string
+	var allErrs *multierror.Error
+
+	for _, identifier := range identifiers {
+		err := deleteFn(svc, identifier)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(identifier),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIdentifiers = append(deletedIdentifiers, identifier)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(identifier))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedIdentifiers), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// Deletes all App Runner services, waiting for each deletion to finish
+func nukeAllAppRunnerServices(session *session.Session, arns []*string) error {
+	return nukeAppRunnerResources(session, "App Runner Service", arns, nukeAppRunnerService)
+}
+
+// Deletes all custom App Runner auto scaling configurations
+func nukeAllAppRunnerAutoScalingConfigurations(session *session.Session, arns []*string) error {
+	return nukeAppRunnerResources(session, "App Runner Auto Scaling Configuration", arns, nukeAppRunnerAutoScalingConfiguration)
+}
+
+// Deletes all App Runner VPC connectors
+func nukeAllAppRunnerVpcConnectors(session *session.Session, arns []*string) error {
+	return nukeAppRunnerResources(session, "App Runner VPC Connector", arns, nukeAppRunnerVpcConnector)
+}
@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/aws/aws-sdk-go/service/apprunner/apprunneriface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedAppRunnerService struct {
+	apprunneriface.AppRunnerAPI
+	OperationStatus string
+}
+
+func (m *mockedAppRunnerService) DeleteService(input *apprunner.DeleteServiceInput) (*apprunner.DeleteServiceOutput, error) {
+	return &apprunner.DeleteServiceOutput{OperationId: aws.String("delete-operation")}, nil
+}
+
+func (m *mockedAppRunnerService) ListOperationsPages(input *apprunner.ListOperationsInput, fn func(*apprunner.ListOperationsOutput, bool) bool) error {
+	fn(&apprunner.ListOperationsOutput{OperationSummaryList: []*apprunner.OperationSummary{
+		{Id: aws.String("create-operation"), Status: aws.String(apprunner.OperationStatusSucceeded)},
+		{Id: aws.String("delete-operation"), Status: aws.String(m.OperationStatus)},
+	}}, true)
+	return nil
+}
+
+func TestNukeAppRunnerServiceWaitsForTheDeleteOperation(t *testing.T) {
+	require.NoError(t, nukeAppRunnerService(&mockedAppRunnerService{OperationStatus: apprunner.OperationStatusSucceeded}, aws.String("service")))
+
+	err := nukeAppRunnerService(&mockedAppRunnerService{OperationStatus: apprunner.OperationStatusFailed}, aws.String("service"))
+	assert.Error(t, err)
+}
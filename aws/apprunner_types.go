@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"fmt"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// AppRunnerServices - represents all App Runner services
+type AppRunnerServices struct {
+	Arns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (services AppRunnerServices) ResourceName() string {
+	return "apprunner-service"
+}
+
+// ResourceIdentifiers - The ARNs of the App Runner services
+func (services AppRunnerServices) ResourceIdentifiers() []string {
+	return services.Arns
+}
+
+func (services AppRunnerServices) MaxBatchSize() int {
+	// Deleting a service takes a few minutes, so we keep the batches small
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (services AppRunnerServices) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllAppRunnerServices(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// AppRunnerAutoScalingConfigurations - represents all revisions of custom App Runner auto scaling configurations
+type AppRunnerAutoScalingConfigurations struct {
+	Arns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (configurations AppRunnerAutoScalingConfigurations) ResourceName() string {
+	return "apprunner-auto-scaling-configuration"
+}
+
+// ResourceIdentifiers - The ARNs of the App Runner auto scaling configuration revisions
+func (configurations AppRunnerAutoScalingConfigurations) ResourceIdentifiers() []string {
+	return configurations.Arns
+}
+
+func (configurations AppRunnerAutoScalingConfigurations) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (configurations AppRunnerAutoScalingConfigurations) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllAppRunnerAutoScalingConfigurations(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// AppRunnerVpcConnectors - represents all App Runner VPC connectors
+type AppRunnerVpcConnectors struct {
+	Arns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (connectors AppRunnerVpcConnectors) ResourceName() string {
+	return "apprunner-vpc-connector"
+}
+
+// ResourceIdentifiers - The ARNs of the App Runner VPC connectors
+func (connectors AppRunnerVpcConnectors) ResourceIdentifiers() []string {
+	return connectors.Arns
+}
+
+func (connectors AppRunnerVpcConnectors) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (connectors AppRunnerVpcConnectors) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllAppRunnerVpcConnectors(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type AppRunnerOperationFailedError struct {
+	serviceArn string
+	status     string
+}
+
+func (e AppRunnerOperationFailedError) Error() string {
+	return fmt.Sprintf("Deleting App Runner service %s finished with status %s", e.serviceArn, e.status)
+}
+
+type AppRunnerOperationTimeoutError struct {
+	serviceArn string
+}
+
+func (e AppRunnerOperationTimeoutError) Error() string {
+	return "Timed out waiting for App Runner service " + e.serviceArn + " to be deleted"
+}
@@ -0,0 +1,308 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/aws/aws-sdk-go/service/appstream/appstreamiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of AppStream fleet names
+func getAllAppStreamFleets(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := appstream.New(session)
+
+	var names []*string
+	input := &appstream.DescribeFleetsInput{}
+	for {
+		output, err := svc.DescribeFleets(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, fleet := range output.Fleets {
+			if !shouldIncludeAppStreamResource(fleet.Name, fleet.CreatedTime, excludeAfter, configObj.AppStreamFleet) {
+				continue
+			}
+			exclude, err := hasAppStreamExcludeTag(svc, fleet.Arn)
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			if !exclude {
+				names = append(names, fleet.Name)
+			}
+		}
+
+		if output.NextToken == nil {
+			return names, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// Returns a formatted string of AppStream stack names
+func getAllAppStreamStacks(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := appstream.New(session)
+
+	var names []*string
+	input := &appstream.DescribeStacksInput{}
+	for {
+		output, err := svc.DescribeStacks(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, stack := range output.Stacks {
+			if !shouldIncludeAppStreamResource(stack.Name, stack.CreatedTime, excludeAfter, configObj.AppStreamStack) {
+				continue
+			}
+			exclude, err := hasAppStreamExcludeTag(svc, stack.Arn)
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			if !exclude {
+				names = append(names, stack.Name)
+			}
+		}
+
+		if output.NextToken == nil {
+			return names, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// Returns a formatted string of AppStream image builder names
+func getAllAppStreamImageBuilders(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := appstream.New(session)
+
+	var names []*string
+	input := &appstream.DescribeImageBuildersInput{}
+	for {
+		output, err := svc.DescribeImageBuilders(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, builder := range output.ImageBuilders {
+			// Image builders being deleted will be gone shortly
+			if aws.StringValue(builder.State) == appstream.ImageBuilderStateDeleting {
+				continue
+			}
+			if !shouldIncludeAppStreamResource(builder.Name, builder.CreatedTime, excludeAfter, configObj.AppStreamImageBuilder) {
+				continue
+			}
+			exclude, err := hasAppStreamExcludeTag(svc, builder.Arn)
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			if !exclude {
+				names = append(names, builder.Name)
+			}
+		}
+
+		if output.NextToken == nil {
+			return names, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+func shouldIncludeAppStreamResource(name *string, createdTime *time.Time, excludeAfter time.Time, resourceType config.ResourceType) bool {
+	if createdTime != nil && excludeAfter.Before(*createdTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(name),
+		resourceType.IncludeRule.NamesRegExp,
+		resourceType.ExcludeRule.NamesRegExp,
+	)
+}
+
+func hasAppStreamExcludeTag(svc appstreamiface.AppStreamAPI, resourceArn *string) (bool, error) {
+	output, err := svc.ListTagsForResource(&appstream.ListTagsForResourceInput{ResourceArn: resourceArn})
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+	return aws.StringValue(output.Tags[AwsResourceExclusionTagKey]) == "true", nil
+}
+
+func listAppStreamAssociatedStacks(svc appstreamiface.AppStreamAPI, fleetName *string) ([]*string, error) {
+	var names []*string
+	input := &appstream.ListAssociatedStacksInput{FleetName: fleetName}
+	for {
+		output, err := svc.ListAssociatedStacks(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		names = append(names, output.Names...)
+
+		if output.NextToken == nil {
+			return names, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+func listAppStreamAssociatedFleets(svc appstreamiface.AppStreamAPI, stackName *string) ([]*string, error) {
+	var names []*string
+	input := &appstream.ListAssociatedFleetsInput{StackName: stackName}
+	for {
+		output, err := svc.ListAssociatedFleets(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		names = append(names, output.Names...)
+
+		if output.NextToken == nil {
+			return names, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// waitForAppStreamFleetToStop waits until the fleet is stopped, as only stopped fleets can be deleted
+func waitForAppStreamFleetToStop(svc appstreamiface.AppStreamAPI, fleetName *string) error {
+	for i := 0; i < 60; i++ {
+		output, err := svc.DescribeFleets(&appstream.DescribeFleetsInput{Names: []*string{fleetName}})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		if len(output.Fleets) == 0 || aws.StringValue(output.Fleets[0].State) == appstream.FleetStateStopped {
+			return nil
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for AppStream fleet %s to stop", aws.StringValue(fleetName))
+	}
+
+	return AppStreamFleetStopTimeoutError{name: aws.StringValue(fleetName)}
+}
+
+// deleteAppStreamFleet disassociates the fleet from its stacks and stops it before deleting it
+func deleteAppStreamFleet(svc appstreamiface.AppStreamAPI, fleetName *string) error {
+	stackNames, err := listAppStreamAssociatedStacks(svc, fleetName)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, stackName := range stackNames {
+		_, err := svc.DisassociateFleet(&appstream.DisassociateFleetInput{FleetName: fleetName, StackName: stackName})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Disassociated AppStream fleet %s from stack %s", aws.StringValue(fleetName), aws.StringValue(stackName))
+	}
+
+	output, err := svc.DescribeFleets(&appstream.DescribeFleetsInput{Names: []*string{fleetName}})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(output.Fleets) > 0 {
+		state := aws.StringValue(output.Fleets[0].State)
+		if state == appstream.FleetStateRunning || state == appstream.FleetStateStarting {
+			if _, err := svc.StopFleet(&appstream.StopFleetInput{Name: fleetName}); err != nil {
+				return errors.WithStackTrace(err)
+			}
+			logging.Logger.Debugf("Stopping AppStream fleet %s", aws.StringValue(fleetName))
+		}
+		if state != appstream.FleetStateStopped {
+			if err := waitForAppStreamFleetToStop(svc, fleetName); err != nil {
+				return errors.WithStackTrace(err)
+			}
+		}
+	}
+
+	_, err = svc.DeleteFleet(&appstream.DeleteFleetInput{Name: fleetName})
+	return errors.WithStackTrace(err)
+}
+
+// deleteAppStreamStack disassociates the fleets that are still associated with the stack before deleting it
+func deleteAppStreamStack(svc appstreamiface.AppStreamAPI, stackName *string) error {
+	fleetNames, err := listAppStreamAssociatedFleets(svc, stackName)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, fleetName := range fleetNames {
+		_, err := svc.DisassociateFleet(&appstream.DisassociateFleetInput{FleetName: fleetName, StackName: stackName})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Disassociated AppStream fleet %s from stack %s", aws.StringValue(fleetName), aws.StringValue(stackName))
+	}
+
+	_, err = svc.DeleteStack(&appstream.DeleteStackInput{Name: stackName})
+	return errors.WithStackTrace(err)
+}
+
+func deleteAppStreamImageBuilder(svc appstreamiface.AppStreamAPI, name *string) error {
+	_, err := svc.DeleteImageBuilder(&appstream.DeleteImageBuilderInput{Name: name})
+	return errors.WithStackTrace(err)
+}
+
+// nukeAppStreamResources deletes the given AppStream fleets, stacks or image builders using deleteFn, recording the
+// status of each of them.
+func nukeAppStreamResources(session *session.Session, resourceType string, names []*string, deleteFn func(svc appstreamiface.AppStreamAPI, name *string) error) error {
+	svc := appstream.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		err := deleteFn(svc, name)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedNames), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// Deletes all AppStream fleets, stopping them first
+func nukeAllAppStreamFleets(session *session.Session, names []*string) error {
+	return nukeAppStreamResources(session, "AppStream Fleet", names, deleteAppStreamFleet)
+}
+
+// Deletes all AppStream stacks
+func nukeAllAppStreamStacks(session *session.Session, names []*string) error {
+	return nukeAppStreamResources(session, "AppStream Stack", names, deleteAppStreamStack)
+}
+
+// Deletes all AppStream image builders
+func nukeAllAppStreamImageBuilders(session *session.Session, names []*string) error {
+	return nukeAppStreamResources(session, "AppStream Image Builder", names, deleteAppStreamImageBuilder)
+}
@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/aws/aws-sdk-go/service/appstream/appstreamiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedAppStream struct {
+	appstreamiface.AppStreamAPI
+	FleetState string
+	Calls      []string
+}
+
+func (m *mockedAppStream) ListAssociatedStacks(input *appstream.ListAssociatedStacksInput) (*appstream.ListAssociatedStacksOutput, error) {
+	return &appstream.ListAssociatedStacksOutput{Names: []*string{aws.String("stack")}}, nil
+}
+
+func (m *mockedAppStream) ListAssociatedFleets(input *appstream.ListAssociatedFleetsInput) (*appstream.ListAssociatedFleetsOutput, error) {
+	return &appstream.ListAssociatedFleetsOutput{Names: []*string{aws.String("fleet")}}, nil
+}
+
+func (m *mockedAppStream) DisassociateFleet(input *appstream.DisassociateFleetInput) (*appstream.DisassociateFleetOutput, error) {
+	m.Calls = append(m.Calls, "DisassociateFleet:"+aws.StringValue(input.FleetName)+"/"+aws.StringValue(input.StackName))
+	return &appstream.DisassociateFleetOutput{}, nil
+}
+
+func (m *mockedAppStream) DescribeFleets(input *appstream.DescribeFleetsInput) (*appstream.DescribeFleetsOutput, error) {
+	fleet := &appstream.Fleet{Name: input.Names[0], State: aws.String(m.FleetState)}
+	return &appstream.DescribeFleetsOutput{Fleets: []*appstream.Fleet{fleet}}, nil
+}
+
+func (m *mockedAppStream) StopFleet(input *appstream.StopFleetInput) (*appstream.StopFleetOutput, error) {
+	m.Calls = append(m.Calls, "StopFleet:"+aws.StringValue(input.Name))
+	m.FleetState = appstream.FleetStateStopped
+	return &appstream.StopFleetOutput{}, nil
+}
+
+func (m *mockedAppStream) DeleteFleet(input *appstream.DeleteFleetInput) (*appstream.DeleteFleetOutput, error) {
+	m.Calls = append(m.Calls, "DeleteFleet:"+aws.StringValue(input.Name))
+	return &appstream.DeleteFleetOutput{}, nil
+}
+
+func (m *mockedAppStream) DeleteStack(input *appstream.DeleteStackInput) (*appstream.DeleteStackOutput, error) {
+	m.Calls = append(m.Calls, "DeleteStack:"+aws.StringValue(input.Name))
+	return &appstream.DeleteStackOutput{}, nil
+}
+
+func TestDeleteAppStreamFleetDisassociatesAndStopsFirst(t *testing.T) {
+	mock := &mockedAppStream{FleetState: appstream.FleetStateRunning}
+	require.NoError(t, deleteAppStreamFleet(mock, aws.String("fleet")))
+	assert.Equal(t, []string{"DisassociateFleet:fleet/stack", "StopFleet:fleet", "DeleteFleet:fleet"}, mock.Calls)
+}
+
+func TestDeleteAppStreamStackDisassociatesFleetsFirst(t *testing.T) {
+	mock := &mockedAppStream{}
+	require.NoError(t, deleteAppStreamStack(mock, aws.String("stack")))
+	assert.Equal(t, []string{"DisassociateFleet:fleet/stack", "DeleteStack:stack"}, mock.Calls)
+}
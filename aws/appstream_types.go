@@ -0,0 +1,102 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// AppStreamFleets - represents all AppStream fleets
+type AppStreamFleets struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (fleets AppStreamFleets) ResourceName() string {
+	return "appstream-fleet"
+}
+
+// ResourceIdentifiers - The names of the AppStream fleets
+func (fleets AppStreamFleets) ResourceIdentifiers() []string {
+	return fleets.Names
+}
+
+func (fleets AppStreamFleets) MaxBatchSize() int {
+	// Fleets have to be stopped before they can be deleted, which takes a few minutes, so we keep the batches small
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (fleets AppStreamFleets) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllAppStreamFleets(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// AppStreamStacks - represents all AppStream stacks
+type AppStreamStacks struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (stacks AppStreamStacks) ResourceName() string {
+	return "appstream-stack"
+}
+
+// ResourceIdentifiers - The names of the AppStream stacks
+func (stacks AppStreamStacks) ResourceIdentifiers() []string {
+	return stacks.Names
+}
+
+func (stacks AppStreamStacks) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (stacks AppStreamStacks) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllAppStreamStacks(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// AppStreamImageBuilders - represents all AppStream image builders
+type AppStreamImageBuilders struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (builders AppStreamImageBuilders) ResourceName() string {
+	return "appstream-image-builder"
+}
+
+// ResourceIdentifiers - The names of the AppStream image builders
+func (builders AppStreamImageBuilders) ResourceIdentifiers() []string {
+	return builders.Names
+}
+
+func (builders AppStreamImageBuilders) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (builders AppStreamImageBuilders) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllAppStreamImageBuilders(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type AppStreamFleetStopTimeoutError struct {
+	name string
+}
+
+func (e AppStreamFleetStopTimeoutError) Error() string {
+	return "Timed out waiting for AppStream fleet " + e.name + " to stop"
+}
@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/appsync/appsynciface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of AppSync GraphQL API IDs. GraphQL APIs don't expose a creation time, so the first seen
+// tag is used for the excludeAfter filter instead.
+func getAllAppSyncGraphqlApis(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := appsync.New(session)
+
+	var apiIds []*string
+	input := &appsync.ListGraphqlApisInput{}
+	for {
+		output, err := svc.ListGraphqlApis(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, api := range output.GraphqlApis {
+			if !shouldIncludeAppSyncGraphqlApi(api, configObj) {
+				continue
+			}
+
+			firstSeenTime, err := getOrSetFirstSeenAppSyncTag(svc, api)
+			if err != nil {
+				logging.Logger.Errorf("Unable to retrieve tags for AppSync GraphQL API %s: %s", aws.StringValue(api.ApiId), err)
+				return nil, err
+			}
+			if excludeAfter.After(firstSeenTime) {
+				apiIds = append(apiIds, api.ApiId)
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return apiIds, nil
+}
+
+func shouldIncludeAppSyncGraphqlApi(api *appsync.GraphqlApi, configObj config.Config) bool {
+	if api == nil {
+		return false
+	}
+
+	if aws.StringValue(api.Tags[AwsResourceExclusionTagKey]) == "true" {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(api.Name),
+		configObj.AppSync.IncludeRule.NamesRegExp,
+		configObj.AppSync.ExcludeRule.NamesRegExp,
+	)
+}
+
+// getOrSetFirstSeenAppSyncTag returns the time cloud-nuke first saw the GraphQL API, tagging it with the current time
+// the first time it is seen.
+func getOrSetFirstSeenAppSyncTag(svc appsynciface.AppSyncAPI, api *appsync.GraphqlApi) (time.Time, error) {
+	if value, ok := api.Tags[firstSeenTagKey]; ok {
+		firstSeenTime, err := time.Parse(time.RFC3339, aws.StringValue(value))
+		if err != nil {
+			return time.Time{}, errors.WithStackTrace(err)
+		}
+		return firstSeenTime, nil
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.TagResource(&appsync.TagResourceInput{
+		ResourceArn: api.Arn,
+		Tags:        map[string]*string{firstSeenTagKey: aws.String(now.Format(time.RFC3339))},
+	})
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return now, nil
+}
+
+// Deletes all AppSync GraphQL APIs. Deleting an API also deletes its schema, resolvers, data sources and API keys.
+func nukeAllAppSyncGraphqlApis(session *session.Session, apiIds []*string) error {
+	svc := appsync.New(session)
+
+	if len(apiIds) == 0 {
+		logging.Logger.Debugf("No AppSync GraphQL APIs to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all AppSync GraphQL APIs in region %s", *session.Config.Region)
+	var deletedIds []*string
+	var allErrs *multierror.Error
+
+	for _, apiId := range apiIds {
+		_, err := svc.DeleteGraphqlApi(&appsync.DeleteGraphqlApiInput{ApiId: apiId})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(apiId),
+			ResourceType: "AppSync GraphQL API",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking AppSync GraphQL API",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, apiId)
+			logging.Logger.Debugf("Deleted AppSync GraphQL API: %s", aws.StringValue(apiId))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d AppSync GraphQL API(s) deleted in %s", len(deletedIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/appsync/appsynciface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedAppSyncTagging struct {
+	appsynciface.AppSyncAPI
+	Tagged map[string]*string
+}
+
+func (m *mockedAppSyncTagging) TagResource(input *appsync.TagResourceInput) (*appsync.TagResourceOutput, error) {
+	m.Tagged = input.Tags
+	return &appsync.TagResourceOutput{}, nil
+}
+
+func TestGetOrSetFirstSeenAppSyncTag(t *testing.T) {
+	t.Parallel()
+
+	firstSeen := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	tagged := &mockedAppSyncTagging{}
+	seen, err := getOrSetFirstSeenAppSyncTag(tagged, &appsync.GraphqlApi{
+		Arn:  aws.String("arn:aws:appsync:us-east-1:123456789012:apis/abc"),
+		Tags: map[string]*string{firstSeenTagKey: aws.String(firstSeen.Format(time.RFC3339))},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, firstSeen, seen)
+	assert.Nil(t, tagged.Tagged)
+
+	untagged := &mockedAppSyncTagging{}
+	seen, err = getOrSetFirstSeenAppSyncTag(untagged, &appsync.GraphqlApi{Arn: aws.String("arn:aws:appsync:us-east-1:123456789012:apis/abc")})
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), seen, time.Minute)
+	assert.Contains(t, untagged.Tagged, firstSeenTagKey)
+}
+
+func TestShouldIncludeAppSyncGraphqlApi(t *testing.T) {
+	api := func(tags map[string]*string) *appsync.GraphqlApi {
+		return &appsync.GraphqlApi{Name: aws.String("cloud-nuke-test"), Tags: tags}
+	}
+	excludeConfig := config.Config{
+		AppSync: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^cloud-nuke-")}},
+			},
+		},
+	}
+
+	assert.True(t, shouldIncludeAppSyncGraphqlApi(api(nil), config.Config{}))
+	assert.False(t, shouldIncludeAppSyncGraphqlApi(api(map[string]*string{AwsResourceExclusionTagKey: aws.String("true")}), config.Config{}))
+	assert.False(t, shouldIncludeAppSyncGraphqlApi(api(nil), excludeConfig))
+	assert.False(t, shouldIncludeAppSyncGraphqlApi(nil, config.Config{}))
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// AppSyncGraphqlApis - represents all AppSync GraphQL APIs
+type AppSyncGraphqlApis struct {
+	ApiIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (apis AppSyncGraphqlApis) ResourceName() string {
+	return "appsync"
+}
+
+// ResourceIdentifiers - The IDs of the AppSync GraphQL APIs
+func (apis AppSyncGraphqlApis) ResourceIdentifiers() []string {
+	return apis.ApiIds
+}
+
+func (apis AppSyncGraphqlApis) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (apis AppSyncGraphqlApis) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllAppSyncGraphqlApis(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
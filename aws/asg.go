@@ -8,6 +8,7 @@ import (
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
@@ -32,6 +33,61 @@ func getAllAutoScalingGroups(session *session.Session, region string, excludeAft
 	return groupNames, nil
 }
 
+// autoScalingGroupLaunchReferences holds the launch configurations and launch templates that auto scaling groups still
+// refer to. Launch templates are referred to by either their ID or their name.
+type autoScalingGroupLaunchReferences struct {
+	launchConfigurationNames map[string]bool
+	launchTemplates          map[string]bool
+}
+
+func (references autoScalingGroupLaunchReferences) addLaunchTemplate(spec *autoscaling.LaunchTemplateSpecification) {
+	if spec == nil {
+		return
+	}
+	if spec.LaunchTemplateId != nil {
+		references.launchTemplates[awsgo.StringValue(spec.LaunchTemplateId)] = true
+	}
+	if spec.LaunchTemplateName != nil {
+		references.launchTemplates[awsgo.StringValue(spec.LaunchTemplateName)] = true
+	}
+}
+
+// getAutoScalingGroupLaunchReferences collects the launch configurations and launch templates of the auto scaling
+// groups in the region, including those of their mixed instances policies. The groups among the given groups being
+// nuked are skipped, since they are deleted before their launch configurations and launch templates.
+func getAutoScalingGroupLaunchReferences(svc autoscalingiface.AutoScalingAPI, nukedGroupNames []string) (autoScalingGroupLaunchReferences, error) {
+	references := autoScalingGroupLaunchReferences{
+		launchConfigurationNames: map[string]bool{},
+		launchTemplates:          map[string]bool{},
+	}
+
+	nukedGroups := map[string]bool{}
+	for _, name := range nukedGroupNames {
+		nukedGroups[name] = true
+	}
+
+	err := svc.DescribeAutoScalingGroupsPages(&autoscaling.DescribeAutoScalingGroupsInput{}, func(page *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+		for _, group := range page.AutoScalingGroups {
+			if nukedGroups[awsgo.StringValue(group.AutoScalingGroupName)] {
+				continue
+			}
+			if group.LaunchConfigurationName != nil {
+				references.launchConfigurationNames[awsgo.StringValue(group.LaunchConfigurationName)] = true
+			}
+			references.addLaunchTemplate(group.LaunchTemplate)
+
+			if group.MixedInstancesPolicy != nil && group.MixedInstancesPolicy.LaunchTemplate != nil {
+				references.addLaunchTemplate(group.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification)
+				for _, override := range group.MixedInstancesPolicy.LaunchTemplate.Overrides {
+					references.addLaunchTemplate(override.LaunchTemplateSpecification)
+				}
+			}
+		}
+		return !lastPage
+	})
+	return references, errors.WithStackTrace(err)
+}
+
 // hasASGExcludeTag checks whether the exlude tag is set for a resource to skip deleting it.
 func hasASGExcludeTag(group *autoscaling.Group) bool {
 	// Exclude deletion of any buckets with cloud-nuke-excluded tags
@@ -9,11 +9,13 @@ import (
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/gruntwork-io/go-commons/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createTestAutoScalingGroup(t *testing.T, session *session.Session, name string) {
@@ -195,3 +197,33 @@ func TestShouldIncludeAutoScalingGroup(t *testing.T) {
 		})
 	}
 }
+
+type mockedAutoScalingLaunchReferences struct {
+	autoscalingiface.AutoScalingAPI
+}
+
+func (m *mockedAutoScalingLaunchReferences) DescribeAutoScalingGroupsPages(input *autoscaling.DescribeAutoScalingGroupsInput, fn func(*autoscaling.DescribeAutoScalingGroupsOutput, bool) bool) error {
+	fn(&autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: []*autoscaling.Group{
+		{LaunchConfigurationName: awsgo.String("lc-in-use")},
+		// Nuked along with its launch configuration
+		{AutoScalingGroupName: awsgo.String("nuked"), LaunchConfigurationName: awsgo.String("lc-of-nuked")},
+		{LaunchTemplate: &autoscaling.LaunchTemplateSpecification{LaunchTemplateId: awsgo.String("lt-0123456789")}},
+	}}, false)
+	fn(&autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: []*autoscaling.Group{
+		{MixedInstancesPolicy: &autoscaling.MixedInstancesPolicy{LaunchTemplate: &autoscaling.LaunchTemplate{
+			LaunchTemplateSpecification: &autoscaling.LaunchTemplateSpecification{LaunchTemplateName: awsgo.String("mixed")},
+			Overrides: []*autoscaling.LaunchTemplateOverrides{
+				{LaunchTemplateSpecification: &autoscaling.LaunchTemplateSpecification{LaunchTemplateName: awsgo.String("override")}},
+			},
+		}}},
+	}}, true)
+	return nil
+}
+
+func TestGetAutoScalingGroupLaunchReferences(t *testing.T) {
+	references, err := getAutoScalingGroupLaunchReferences(&mockedAutoScalingLaunchReferences{}, []string{"nuked"})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]bool{"lc-in-use": true}, references.launchConfigurationNames)
+	assert.Equal(t, map[string]bool{"lt-0123456789": true, "mixed": true, "override": true}, references.launchTemplates)
+}
@@ -0,0 +1,259 @@
+package aws
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/athena/athenaiface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// The primary workgroup exists in every region and can't be deleted
+const primaryAthenaWorkgroupName = "primary"
+
+// Returns a formatted string of Athena workgroup names
+func getAllAthenaWorkgroups(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := athena.New(session)
+
+	var names []*string
+	err := svc.ListWorkGroupsPages(&athena.ListWorkGroupsInput{}, func(page *athena.ListWorkGroupsOutput, lastPage bool) bool {
+		for _, workgroup := range page.WorkGroups {
+			if shouldIncludeAthenaWorkgroup(workgroup, excludeAfter, configObj) {
+				names = append(names, workgroup.Name)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return names, nil
+}
+
+func shouldIncludeAthenaWorkgroup(workgroup *athena.WorkGroupSummary, excludeAfter time.Time, configObj config.Config) bool {
+	if workgroup == nil || aws.StringValue(workgroup.Name) == primaryAthenaWorkgroupName {
+		return false
+	}
+
+	if workgroup.CreationTime != nil && excludeAfter.Before(*workgroup.CreationTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(workgroup.Name),
+		configObj.AthenaWorkgroup.IncludeRule.NamesRegExp,
+		configObj.AthenaWorkgroup.ExcludeRule.NamesRegExp,
+	)
+}
+
+// deleteAthenaPreparedStatements deletes the prepared statements of the given workgroup. Named queries and query
+// executions are removed by the recursive workgroup delete, but prepared statements are not.
+func deleteAthenaPreparedStatements(svc athenaiface.AthenaAPI, workgroupName *string) error {
+	var statementNames []*string
+	err := svc.ListPreparedStatementsPages(&athena.ListPreparedStatementsInput{WorkGroup: workgroupName}, func(page *athena.ListPreparedStatementsOutput, lastPage bool) bool {
+		for _, statement := range page.PreparedStatements {
+			statementNames = append(statementNames, statement.StatementName)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, statementName := range statementNames {
+		_, err := svc.DeletePreparedStatement(&athena.DeletePreparedStatementInput{
+			StatementName: statementName,
+			WorkGroup:     workgroupName,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted prepared statement %s of Athena workgroup %s", aws.StringValue(statementName), aws.StringValue(workgroupName))
+	}
+	return nil
+}
+
+// getAthenaWorkgroupResultLocation returns the S3 location query results of the workgroup are written to, or an empty
+// string if the workgroup doesn't configure one.
+func getAthenaWorkgroupResultLocation(svc athenaiface.AthenaAPI, workgroupName *string) (string, error) {
+	result, err := svc.GetWorkGroup(&athena.GetWorkGroupInput{WorkGroup: workgroupName})
+	if err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+
+	if result.WorkGroup == nil || result.WorkGroup.Configuration == nil || result.WorkGroup.Configuration.ResultConfiguration == nil {
+		return "", nil
+	}
+	return aws.StringValue(result.WorkGroup.Configuration.ResultConfiguration.OutputLocation), nil
+}
+
+// parseAthenaResultLocation splits an s3://bucket/prefix/ result location into its bucket and key prefix. The prefix
+// always ends in a slash, so that a location such as s3://bucket/athena doesn't also match the keys of athena-prod/.
+func parseAthenaResultLocation(location string) (string, string, error) {
+	parsed, err := url.Parse(location)
+	if err != nil {
+		return "", "", errors.WithStackTrace(err)
+	}
+	if parsed.Scheme != "s3" || parsed.Host == "" {
+		return "", "", InvalidAthenaResultLocationError{location: location}
+	}
+
+	prefix := strings.TrimPrefix(parsed.Path, "/")
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return parsed.Host, prefix, nil
+}
+
+// cleanAthenaQueryResults deletes all the objects under the given result location, leaving the bucket itself in place.
+// Locations at the root of a bucket are refused, as are buckets tagged for exclusion.
+func cleanAthenaQueryResults(session *session.Session, location string) error {
+	bucket, prefix, err := parseAthenaResultLocation(location)
+	if err != nil {
+		return err
+	}
+
+	// Query results written to the root of a bucket can't be told apart from whatever else the bucket holds
+	if prefix == "" {
+		return AthenaResultLocationIsBucketRootError{location: location}
+	}
+
+	// The bucket may live in a different region than the workgroup
+	bucketRegion, err := getS3BucketRegion(s3.New(session), bucket)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	svc := s3.New(session, aws.NewConfig().WithRegion(bucketRegion))
+
+	bucketTags, err := getS3BucketTags(svc, bucket)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if !hasValidTags(bucketTags) {
+		logging.Logger.Debugf("Skipping cleaning query results in %s, the bucket is tagged for exclusion", location)
+		return nil
+	}
+
+	var deleteErr error
+	err = svc.ListObjectsV2Pages(&s3.ListObjectsV2Input{Bucket: aws.String(bucket), Prefix: aws.String(prefix)}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if len(page.Contents) == 0 {
+			return !lastPage
+		}
+
+		var objects []*s3.ObjectIdentifier
+		for _, object := range page.Contents {
+			objects = append(objects, &s3.ObjectIdentifier{Key: object.Key})
+		}
+
+		// Each page holds at most 1000 keys, which is also the DeleteObjects limit
+		var output *s3.DeleteObjectsOutput
+		output, deleteErr = svc.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{Objects: objects, Quiet: aws.Bool(true)},
+		})
+		if deleteErr == nil {
+			deleteErr = getAthenaQueryResultsDeleteError(location, output)
+		}
+		return deleteErr == nil && !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return errors.WithStackTrace(deleteErr)
+}
+
+// getAthenaQueryResultsDeleteError turns the keys DeleteObjects failed to delete into an error, since the request
+// itself succeeds even when none of the keys could be deleted.
+func getAthenaQueryResultsDeleteError(location string, output *s3.DeleteObjectsOutput) error {
+	if output == nil || len(output.Errors) == 0 {
+		return nil
+	}
+	first := output.Errors[0]
+	return AthenaQueryResultsDeleteError{
+		location:     location,
+		failedCount:  len(output.Errors),
+		firstKey:     aws.StringValue(first.Key),
+		firstMessage: aws.StringValue(first.Message),
+	}
+}
+
+// nukeAthenaWorkgroup deletes the workgroup along with its prepared statements and named queries, optionally emptying
+// its query result location first.
+func nukeAthenaWorkgroup(session *session.Session, svc athenaiface.AthenaAPI, workgroupName *string, cleanQueryResults bool) error {
+	if cleanQueryResults {
+		location, err := getAthenaWorkgroupResultLocation(svc, workgroupName)
+		if err != nil {
+			return err
+		}
+		if location != "" {
+			logging.Logger.Debugf("Cleaning query results of Athena workgroup %s in %s", aws.StringValue(workgroupName), location)
+			if err := cleanAthenaQueryResults(session, location); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := deleteAthenaPreparedStatements(svc, workgroupName); err != nil {
+		return err
+	}
+
+	_, err := svc.DeleteWorkGroup(&athena.DeleteWorkGroupInput{
+		WorkGroup:             workgroupName,
+		RecursiveDeleteOption: aws.Bool(true),
+	})
+	return errors.WithStackTrace(err)
+}
+
+// Deletes all Athena workgroups
+func nukeAllAthenaWorkgroups(session *session.Session, names []*string, cleanQueryResults bool) error {
+	svc := athena.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No Athena workgroups to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Athena workgroups in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		err := nukeAthenaWorkgroup(session, svc, name, cleanQueryResults)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: "Athena Workgroup",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Athena Workgroup",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted Athena workgroup: %s", aws.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Athena workgroup(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/athena/athenaiface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedAthenaPreparedStatements struct {
+	athenaiface.AthenaAPI
+	Statements []*athena.PreparedStatementSummary
+	Deleted    []string
+}
+
+func (m *mockedAthenaPreparedStatements) ListPreparedStatementsPages(input *athena.ListPreparedStatementsInput, fn func(*athena.ListPreparedStatementsOutput, bool) bool) error {
+	fn(&athena.ListPreparedStatementsOutput{PreparedStatements: m.Statements}, true)
+	return nil
+}
+
+func (m *mockedAthenaPreparedStatements) DeletePreparedStatement(input *athena.DeletePreparedStatementInput) (*athena.DeletePreparedStatementOutput, error) {
+	m.Deleted = append(m.Deleted, aws.StringValue(input.WorkGroup)+"/"+aws.StringValue(input.StatementName))
+	return &athena.DeletePreparedStatementOutput{}, nil
+}
+
+func TestDeleteAthenaPreparedStatements(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedAthenaPreparedStatements{
+		Statements: []*athena.PreparedStatementSummary{
+			{StatementName: aws.String("statement-1")},
+			{StatementName: aws.String("statement-2")},
+		},
+	}
+	require.NoError(t, deleteAthenaPreparedStatements(mock, aws.String("cloud-nuke-test")))
+	assert.Equal(t, []string{"cloud-nuke-test/statement-1", "cloud-nuke-test/statement-2"}, mock.Deleted)
+}
+
+func TestParseAthenaResultLocation(t *testing.T) {
+	t.Parallel()
+
+	bucket, prefix, err := parseAthenaResultLocation("s3://cloud-nuke-test/athena/results/")
+	require.NoError(t, err)
+	assert.Equal(t, "cloud-nuke-test", bucket)
+	assert.Equal(t, "athena/results/", prefix)
+
+	// Without a trailing slash, the prefix would also match the keys of sibling folders such as athena-prod/
+	bucket, prefix, err = parseAthenaResultLocation("s3://cloud-nuke-test/athena")
+	require.NoError(t, err)
+	assert.Equal(t, "cloud-nuke-test", bucket)
+	assert.Equal(t, "athena/", prefix)
+
+	bucket, prefix, err = parseAthenaResultLocation("s3://cloud-nuke-test")
+	require.NoError(t, err)
+	assert.Equal(t, "cloud-nuke-test", bucket)
+	assert.Equal(t, "", prefix)
+
+	_, _, err = parseAthenaResultLocation("https://cloud-nuke-test.s3.amazonaws.com/results/")
+	assert.Error(t, err)
+}
+
+func TestCleanAthenaQueryResultsRefusesBucketRoot(t *testing.T) {
+	t.Parallel()
+
+	// The location is rejected before any request is made
+	err := cleanAthenaQueryResults(nil, "s3://cloud-nuke-test/")
+	assert.Equal(t, AthenaResultLocationIsBucketRootError{location: "s3://cloud-nuke-test/"}, err)
+}
+
+func TestGetAthenaQueryResultsDeleteError(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, getAthenaQueryResultsDeleteError("s3://cloud-nuke-test/results/", &s3.DeleteObjectsOutput{}))
+
+	err := getAthenaQueryResultsDeleteError("s3://cloud-nuke-test/results/", &s3.DeleteObjectsOutput{
+		Errors: []*s3.Error{
+			{Key: aws.String("results/one.csv"), Message: aws.String("Access Denied")},
+			{Key: aws.String("results/two.csv"), Message: aws.String("Access Denied")},
+		},
+	})
+	assert.EqualError(t, err, "Failed to delete 2 Athena query result object(s) in s3://cloud-nuke-test/results/, first results/one.csv: Access Denied")
+}
+
+func TestShouldIncludeAthenaWorkgroup(t *testing.T) {
+	now := time.Now()
+	workgroup := func(name string) *athena.WorkGroupSummary {
+		return &athena.WorkGroupSummary{Name: aws.String(name), CreationTime: aws.Time(now)}
+	}
+
+	assert.True(t, shouldIncludeAthenaWorkgroup(workgroup("cloud-nuke-test"), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeAthenaWorkgroup(workgroup(primaryAthenaWorkgroupName), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeAthenaWorkgroup(workgroup("cloud-nuke-test"), now.Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeAthenaWorkgroup(nil, now, config.Config{}))
+}
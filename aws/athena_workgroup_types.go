@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"fmt"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// AthenaWorkgroups - represents all non-primary Athena workgroups
+type AthenaWorkgroups struct {
+	WorkgroupNames []string
+	// CleanQueryResults also empties the S3 result location of each workgroup before deleting it
+	CleanQueryResults bool
+}
+
+// ResourceName - the simple name of the aws resource
+func (workgroups AthenaWorkgroups) ResourceName() string {
+	return "athena-workgroup"
+}
+
+// ResourceIdentifiers - The names of the Athena workgroups
+func (workgroups AthenaWorkgroups) ResourceIdentifiers() []string {
+	return workgroups.WorkgroupNames
+}
+
+func (workgroups AthenaWorkgroups) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (workgroups AthenaWorkgroups) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllAthenaWorkgroups(session, awsgo.StringSlice(identifiers), workgroups.CleanQueryResults); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type InvalidAthenaResultLocationError struct {
+	location string
+}
+
+func (e InvalidAthenaResultLocationError) Error() string {
+	return "Invalid Athena query result location " + e.location + ", expected s3://bucket/prefix"
+}
+
+type AthenaResultLocationIsBucketRootError struct {
+	location string
+}
+
+func (e AthenaResultLocationIsBucketRootError) Error() string {
+	return "Refusing to clean Athena query results in " + e.location + ", the location is the root of the bucket"
+}
+
+type AthenaQueryResultsDeleteError struct {
+	location     string
+	failedCount  int
+	firstKey     string
+	firstMessage string
+}
+
+func (e AthenaQueryResultsDeleteError) Error() string {
+	return fmt.Sprintf("Failed to delete %d Athena query result object(s) in %s, first %s: %s", e.failedCount, e.location, e.firstKey, e.firstMessage)
+}
@@ -302,7 +302,7 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 			}, map[string]interface{}{
 				"region": region,
 			})
-			configNames, err := getAllLaunchConfigurations(cloudNukeSession, region, excludeAfter, configObj)
+			configNames, err := getAllLaunchConfigurations(cloudNukeSession, region, excludeAfter, configObj, asGroups.GroupNames)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
@@ -332,7 +332,7 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 			}, map[string]interface{}{
 				"region": region,
 			})
-			templateNames, err := getAllLaunchTemplates(cloudNukeSession, excludeAfter, configObj)
+			templateNames, err := getAllLaunchTemplates(cloudNukeSession, excludeAfter, configObj, asGroups.GroupNames)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
@@ -423,7 +423,7 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 			}, map[string]interface{}{
 				"region": region,
 			})
-			queueUrls, err := getAllSqsQueue(cloudNukeSession, region, excludeAfter)
+			queueUrls, err := getAllSqsQueue(cloudNukeSession, region, excludeAfter, configObj)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
@@ -462,7 +462,7 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 			}, map[string]interface{}{
 				"region": region,
 			})
-			transitGatewayVpcAttachmentIds, err := getAllTransitGatewayVpcAttachments(cloudNukeSession, region, excludeAfter)
+			transitGatewayVpcAttachmentIds, err := getAllTransitGatewayAttachments(cloudNukeSession, region, excludeAfter)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
@@ -733,7 +733,7 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 			}, map[string]interface{}{
 				"region": region,
 			})
-			imageIds, err := getAllAMIs(cloudNukeSession, region, excludeAfter)
+			imageIds, err := getAllAMIs(cloudNukeSession, region, excludeAfter, configObj)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
@@ -763,7 +763,7 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 			}, map[string]interface{}{
 				"region": region,
 			})
-			snapshotIds, err := getAllSnapshots(cloudNukeSession, region, excludeAfter)
+			snapshotIds, err := getAllSnapshots(cloudNukeSession, region, excludeAfter, configObj)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
@@ -848,6 +848,39 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 		}
 		// End ECS resources
 
+		// ECS Task Definitions
+		ecsTaskDefinitions := ECSTaskDefinitions{}
+		if IsNukeable(ecsTaskDefinitions.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing ECS Task Definitions",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			ecsTaskDefinitionArns, inactiveEcsTaskDefinitionArns, err := getAllEcsTaskDefinitions(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve ECS Task Definitions",
+					ResourceType: ecsTaskDefinitions.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing ECS Task Definitions",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(ecsTaskDefinitionArns) + len(inactiveEcsTaskDefinitionArns),
+			})
+			if len(ecsTaskDefinitionArns) > 0 || len(inactiveEcsTaskDefinitionArns) > 0 {
+				ecsTaskDefinitions.TaskDefinitionArns = awsgo.StringValueSlice(append(ecsTaskDefinitionArns, inactiveEcsTaskDefinitionArns...))
+				ecsTaskDefinitions.InactiveTaskDefinitionArns = awsgo.StringValueSlice(inactiveEcsTaskDefinitionArns)
+				ecsTaskDefinitions.DeleteRevisions = configObj.ECSTaskDefinition.DeleteRevisions
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, ecsTaskDefinitions)
+			}
+		}
+		// End ECS Task Definitions
+
 		// EKS resources
 		eksClusters := EKSClusters{}
 		if IsNukeable(eksClusters.ResourceName(), resourceTypes) {
@@ -878,6 +911,37 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 		}
 		// End EKS resources
 
+		// RDS Proxies
+		rdsProxies := RdsProxies{}
+		if IsNukeable(rdsProxies.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing RDS Proxies",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			rdsProxyNames, err := getAllRdsProxies(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve RDS Proxies",
+					ResourceType: rdsProxies.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing RDS Proxies",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(rdsProxyNames),
+			})
+			if len(rdsProxyNames) > 0 {
+				rdsProxies.ProxyNames = awsgo.StringValueSlice(rdsProxyNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, rdsProxies)
+			}
+		}
+		// End RDS Proxies
+
 		// RDS DB Instances
 		dbInstances := DBInstances{}
 		if IsNukeable(dbInstances.ResourceName(), resourceTypes) {
@@ -942,6 +1006,161 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 		}
 		// End RDS DB Clusters
 
+		// RDS Snapshots
+		rdsSnapshots := RdsSnapshots{}
+		if IsNukeable(rdsSnapshots.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing RDS Snapshots",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			rdsSnapshotIds, err := getAllRdsSnapshots(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve RDS Snapshots",
+					ResourceType: rdsSnapshots.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing RDS Snapshots",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(rdsSnapshotIds),
+			})
+			if len(rdsSnapshotIds) > 0 {
+				rdsSnapshots.SnapshotIdentifiers = awsgo.StringValueSlice(rdsSnapshotIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, rdsSnapshots)
+			}
+		}
+		// End RDS Snapshots
+
+		// RDS Cluster Snapshots
+		rdsClusterSnapshots := RdsClusterSnapshots{}
+		if IsNukeable(rdsClusterSnapshots.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing RDS Cluster Snapshots",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			rdsClusterSnapshotIds, err := getAllRdsClusterSnapshots(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve RDS Cluster Snapshots",
+					ResourceType: rdsClusterSnapshots.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing RDS Cluster Snapshots",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(rdsClusterSnapshotIds),
+			})
+			if len(rdsClusterSnapshotIds) > 0 {
+				rdsClusterSnapshots.SnapshotIdentifiers = awsgo.StringValueSlice(rdsClusterSnapshotIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, rdsClusterSnapshots)
+			}
+		}
+		// End RDS Cluster Snapshots
+
+		// RDS Parameter Groups
+		rdsParameterGroups := RdsParameterGroups{}
+		if IsNukeable(rdsParameterGroups.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing RDS Parameter Groups",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			rdsParameterGroupNames, err := getAllRdsParameterGroups(cloudNukeSession, excludeAfter, configObj, dbInstances.InstanceNames, dbClusters.InstanceNames)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve RDS Parameter Groups",
+					ResourceType: rdsParameterGroups.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing RDS Parameter Groups",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(rdsParameterGroupNames),
+			})
+			if len(rdsParameterGroupNames) > 0 {
+				rdsParameterGroups.GroupNames = awsgo.StringValueSlice(rdsParameterGroupNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, rdsParameterGroups)
+			}
+		}
+		// End RDS Parameter Groups
+
+		// RDS Option Groups
+		rdsOptionGroups := RdsOptionGroups{}
+		if IsNukeable(rdsOptionGroups.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing RDS Option Groups",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			rdsOptionGroupNames, err := getAllRdsOptionGroups(cloudNukeSession, excludeAfter, configObj, dbInstances.InstanceNames, dbClusters.InstanceNames)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve RDS Option Groups",
+					ResourceType: rdsOptionGroups.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing RDS Option Groups",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(rdsOptionGroupNames),
+			})
+			if len(rdsOptionGroupNames) > 0 {
+				rdsOptionGroups.GroupNames = awsgo.StringValueSlice(rdsOptionGroupNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, rdsOptionGroups)
+			}
+		}
+		// End RDS Option Groups
+
+		// RDS Subnet Groups
+		rdsSubnetGroups := RdsSubnetGroups{}
+		if IsNukeable(rdsSubnetGroups.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing RDS Subnet Groups",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			rdsSubnetGroupNames, err := getAllRdsSubnetGroups(cloudNukeSession, excludeAfter, configObj, dbInstances.InstanceNames, dbClusters.InstanceNames)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve RDS Subnet Groups",
+					ResourceType: rdsSubnetGroups.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing RDS Subnet Groups",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(rdsSubnetGroupNames),
+			})
+			if len(rdsSubnetGroupNames) > 0 {
+				rdsSubnetGroups.GroupNames = awsgo.StringValueSlice(rdsSubnetGroupNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, rdsSubnetGroups)
+			}
+		}
+		// End RDS Subnet Groups
+
 		// Lambda Functions
 		lambdaFunctions := LambdaFunctions{}
 		if IsNukeable(lambdaFunctions.ResourceName(), resourceTypes) {
@@ -973,6 +1192,68 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 		}
 		// End Lambda Functions
 
+		// Lambda Layer Versions
+		lambdaLayerVersions := LambdaLayerVersions{}
+		if IsNukeable(lambdaLayerVersions.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Lambda Layer Versions",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			lambdaLayerVersionArns, err := getAllLambdaLayerVersions(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Lambda Layer Versions",
+					ResourceType: lambdaLayerVersions.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Lambda Layer Versions",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(lambdaLayerVersionArns),
+			})
+			if len(lambdaLayerVersionArns) > 0 {
+				lambdaLayerVersions.LayerVersionArns = awsgo.StringValueSlice(lambdaLayerVersionArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, lambdaLayerVersions)
+			}
+		}
+		// End Lambda Layer Versions
+
+		// Step Functions State Machines
+		sfnStateMachines := SfnStateMachines{}
+		if IsNukeable(sfnStateMachines.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Step Functions State Machines",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			sfnStateMachineArns, err := getAllSfnStateMachines(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Step Functions State Machines",
+					ResourceType: sfnStateMachines.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Step Functions State Machines",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(sfnStateMachineArns),
+			})
+			if len(sfnStateMachineArns) > 0 {
+				sfnStateMachines.StateMachineArns = awsgo.StringValueSlice(sfnStateMachineArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, sfnStateMachines)
+			}
+		}
+		// End Step Functions State Machines
+
 		// Secrets Manager Secrets
 		secretsManagerSecrets := SecretsManagerSecrets{}
 		if IsNukeable(secretsManagerSecrets.ResourceName(), resourceTypes) {
@@ -1000,6 +1281,7 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 
 			if len(secrets) > 0 {
 				secretsManagerSecrets.SecretIDs = awsgo.StringValueSlice(secrets)
+				secretsManagerSecrets.RecoveryWindowInDays = configObj.SecretsManagerSecrets.RecoveryWindowInDays
 				resourcesInRegion.Resources = append(resourcesInRegion.Resources, secretsManagerSecrets)
 			}
 		}
@@ -1167,7 +1449,7 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 			}, map[string]interface{}{
 				"region": region,
 			})
-			tablenames, err := getAllDynamoTables(cloudNukeSession, excludeAfter, configObj, DynamoDB)
+			tablenames, err := getAllDynamoTables(cloudNukeSession, excludeAfter, configObj)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
@@ -1185,11 +1467,43 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 			})
 			if len(tablenames) > 0 {
 				DynamoDB.DynamoTableNames = awsgo.StringValueSlice(tablenames)
+				DynamoDB.DisableDeletionProtection = configObj.DynamoDB.DisableDeletionProtection
 				resourcesInRegion.Resources = append(resourcesInRegion.Resources, DynamoDB)
 			}
 		}
 		// End Dynamo DB tables
 
+		// DynamoDB Backups
+		dynamoDBBackups := DynamoDBBackups{}
+		if IsNukeable(dynamoDBBackups.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing DynamoDB Backups",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			backupArns, err := getAllDynamoDBBackups(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve DynamoDB Backups",
+					ResourceType: dynamoDBBackups.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing DynamoDB Backups",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(backupArns),
+			})
+			if len(backupArns) > 0 {
+				dynamoDBBackups.BackupArns = awsgo.StringValueSlice(backupArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, dynamoDBBackups)
+			}
+		}
+		// End DynamoDB Backups
+
 		// EC2 VPCS
 		ec2Vpcs := EC2VPCs{}
 		if IsNukeable(ec2Vpcs.ResourceName(), resourceTypes) {
@@ -1222,306 +1536,2854 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 		}
 		// End EC2 VPCS
 
-		// Start EC2 KeyPairs
-		KeyPairs := EC2KeyPairs{}
-		if IsNukeable(KeyPairs.ResourceName(), resourceTypes) {
+		// VPC Endpoints
+		vpcEndpoints := VPCEndpoints{}
+		if IsNukeable(vpcEndpoints.ResourceName(), resourceTypes) {
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Listing EC2 Keypairs",
+				EventName: "Listing VPC Endpoints",
 			}, map[string]interface{}{
 				"region": region,
 			})
-			keyPairIds, err := getAllEc2KeyPairs(cloudNukeSession, excludeAfter, configObj)
+			vpcEndpointIds, err := getAllVpcEndpoints(cloudNukeSession, excludeAfter, configObj)
 			if err != nil {
-				return nil, errors.WithStackTrace(err)
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve VPC endpoints",
+					ResourceType: vpcEndpoints.ResourceName(),
+				}
+				report.RecordError(ge)
 			}
 
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Done Listing EC2 Keypairs",
+				EventName: "Done Listing VPC Endpoints",
 			}, map[string]interface{}{
 				"region":      region,
-				"recordCount": len(keyPairIds),
+				"recordCount": len(vpcEndpointIds),
 			})
-			if len(keyPairIds) > 0 {
-				KeyPairs.KeyPairIds = awsgo.StringValueSlice(keyPairIds)
-				resourcesInRegion.Resources = append(resourcesInRegion.Resources, KeyPairs)
+			if len(vpcEndpointIds) > 0 {
+				vpcEndpoints.VpcEndpointIds = awsgo.StringValueSlice(vpcEndpointIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, vpcEndpoints)
 			}
 		}
-		// End EC2 KeyPairs
+		// End VPC Endpoints
 
-		// Elasticaches
-		elasticaches := Elasticaches{}
-		if IsNukeable(elasticaches.ResourceName(), resourceTypes) {
+		// Internet Gateways
+		internetGateways := InternetGateways{}
+		if IsNukeable(internetGateways.ResourceName(), resourceTypes) {
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Listing Elasticache Clusters",
+				EventName: "Listing Internet Gateways",
 			}, map[string]interface{}{
 				"region": region,
 			})
-			clusterIds, err := getAllElasticacheClusters(cloudNukeSession, region, excludeAfter, configObj)
+			internetGatewayIds, err := getAllOrphanedInternetGateways(cloudNukeSession, excludeAfter, configObj)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
-					Description:  "Unable to retrieve Elasticaches",
-					ResourceType: elasticaches.ResourceName(),
+					Description:  "Unable to retrieve Internet Gateways",
+					ResourceType: internetGateways.ResourceName(),
 				}
 				report.RecordError(ge)
 			}
 
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Done Listing Elasticache Clusters",
+				EventName: "Done Listing Internet Gateways",
 			}, map[string]interface{}{
 				"region":      region,
-				"recordCount": len(clusterIds),
+				"recordCount": len(internetGatewayIds),
 			})
-			if len(clusterIds) > 0 {
-				elasticaches.ClusterIds = awsgo.StringValueSlice(clusterIds)
-				resourcesInRegion.Resources = append(resourcesInRegion.Resources, elasticaches)
+			if len(internetGatewayIds) > 0 {
+				internetGateways.InternetGatewayIds = awsgo.StringValueSlice(internetGatewayIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, internetGateways)
 			}
 		}
-		// End Elasticaches
+		// End Internet Gateways
 
-		// KMS Customer managed keys
-		customerKeys := KmsCustomerKeys{}
-		if IsNukeable(customerKeys.ResourceName(), resourceTypes) {
+		// Placement Groups
+		placementGroups := PlacementGroups{}
+		if IsNukeable(placementGroups.ResourceName(), resourceTypes) {
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Listing KMS Keys",
+				EventName: "Listing Placement Groups",
 			}, map[string]interface{}{
 				"region": region,
 			})
-			keys, aliases, err := getAllKmsUserKeys(cloudNukeSession, customerKeys.MaxBatchSize(), excludeAfter, configObj)
+			placementGroupNames, err := getAllEmptyPlacementGroups(cloudNukeSession, excludeAfter, configObj, ec2Instances.InstanceIds)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
-					Description:  "Unable to retrieve KMS customer keys",
-					ResourceType: customerKeys.ResourceName(),
+					Description:  "Unable to retrieve Placement Groups",
+					ResourceType: placementGroups.ResourceName(),
 				}
 				report.RecordError(ge)
 			}
+
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Done Listing KMS Keys",
+				EventName: "Done Listing Placement Groups",
 			}, map[string]interface{}{
 				"region":      region,
-				"recordCount": len(keys),
+				"recordCount": len(placementGroupNames),
 			})
-			if len(keys) > 0 {
-				customerKeys.KeyAliases = aliases
-				customerKeys.KeyIds = awsgo.StringValueSlice(keys)
-				resourcesInRegion.Resources = append(resourcesInRegion.Resources, customerKeys)
+			if len(placementGroupNames) > 0 {
+				placementGroups.GroupNames = awsgo.StringValueSlice(placementGroupNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, placementGroups)
+			}
+		}
+		// End Placement Groups
+
+		// Network Interfaces
+		networkInterfaces := NetworkInterfaces{}
+		if IsNukeable(networkInterfaces.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Network Interfaces",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			networkInterfaceIds, err := getAllAvailableNetworkInterfaces(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Network Interfaces",
+					ResourceType: networkInterfaces.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Network Interfaces",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(networkInterfaceIds),
+			})
+			if len(networkInterfaceIds) > 0 {
+				networkInterfaces.NetworkInterfaceIds = awsgo.StringValueSlice(networkInterfaceIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, networkInterfaces)
+			}
+		}
+		// End Network Interfaces
+
+		// Security Groups
+		securityGroups := SecurityGroups{}
+		if IsNukeable(securityGroups.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Security Groups",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			securityGroupIds, err := getAllUnusedSecurityGroups(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Security Groups",
+					ResourceType: securityGroups.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Security Groups",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(securityGroupIds),
+			})
+			if len(securityGroupIds) > 0 {
+				securityGroups.SecurityGroupIds = awsgo.StringValueSlice(securityGroupIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, securityGroups)
+			}
+		}
+		// End Security Groups
+
+		// Start EC2 KeyPairs
+		KeyPairs := EC2KeyPairs{}
+		if IsNukeable(KeyPairs.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing EC2 Keypairs",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			keyPairIds, err := getAllEc2KeyPairs(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing EC2 Keypairs",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(keyPairIds),
+			})
+			if len(keyPairIds) > 0 {
+				KeyPairs.KeyPairIds = awsgo.StringValueSlice(keyPairIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, KeyPairs)
+			}
+		}
+		// End EC2 KeyPairs
+
+		// Elasticaches
+		elasticaches := Elasticaches{}
+		if IsNukeable(elasticaches.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Elasticache Clusters",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			clusterIds, err := getAllElasticacheClusters(cloudNukeSession, region, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Elasticaches",
+					ResourceType: elasticaches.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Elasticache Clusters",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(clusterIds),
+			})
+			if len(clusterIds) > 0 {
+				elasticaches.ClusterIds = awsgo.StringValueSlice(clusterIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, elasticaches)
+			}
+		}
+		// End Elasticaches
+
+		// Elasticache Serverless Caches
+		elasticacheServerlessCaches := ElasticacheServerlessCaches{}
+		if IsNukeable(elasticacheServerlessCaches.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Elasticache Serverless Caches",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			elasticacheServerlessCacheNames, err := getAllElasticacheServerlessCaches(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Elasticache Serverless Caches",
+					ResourceType: elasticacheServerlessCaches.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Elasticache Serverless Caches",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(elasticacheServerlessCacheNames),
+			})
+			if len(elasticacheServerlessCacheNames) > 0 {
+				elasticacheServerlessCaches.CacheNames = awsgo.StringValueSlice(elasticacheServerlessCacheNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, elasticacheServerlessCaches)
+			}
+		}
+		// End Elasticache Serverless Caches
+
+		// Elasticache User Groups
+		elasticacheUserGroups := ElasticacheUserGroups{}
+		if IsNukeable(elasticacheUserGroups.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Elasticache User Groups",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			elasticacheUserGroupIds, err := getAllElasticacheUserGroups(cloudNukeSession, excludeAfter, configObj, elasticacheServerlessCaches.CacheNames)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Elasticache User Groups",
+					ResourceType: elasticacheUserGroups.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Elasticache User Groups",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(elasticacheUserGroupIds),
+			})
+			if len(elasticacheUserGroupIds) > 0 {
+				elasticacheUserGroups.UserGroupIds = awsgo.StringValueSlice(elasticacheUserGroupIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, elasticacheUserGroups)
+			}
+		}
+		// End Elasticache User Groups
+
+		// Elasticache Users
+		elasticacheUsers := ElasticacheUsers{}
+		if IsNukeable(elasticacheUsers.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Elasticache Users",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			elasticacheUserIds, err := getAllElasticacheUsers(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Elasticache Users",
+					ResourceType: elasticacheUsers.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Elasticache Users",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(elasticacheUserIds),
+			})
+			if len(elasticacheUserIds) > 0 {
+				elasticacheUsers.UserIds = awsgo.StringValueSlice(elasticacheUserIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, elasticacheUsers)
+			}
+		}
+		// End Elasticache Users
+
+		// KMS Customer managed keys
+		customerKeys := KmsCustomerKeys{}
+		if IsNukeable(customerKeys.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing KMS Keys",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			keys, aliases, err := getAllKmsUserKeys(cloudNukeSession, customerKeys.MaxBatchSize(), excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve KMS customer keys",
+					ResourceType: customerKeys.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing KMS Keys",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(keys),
+			})
+			if len(keys) > 0 {
+				customerKeys.KeyAliases = aliases
+				customerKeys.KeyIds = awsgo.StringValueSlice(keys)
+				customerKeys.PendingWindowInDays = configObj.KMSCustomerKeys.RecoveryWindowInDays
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, customerKeys)
+			}
+
+		}
+		// End KMS Customer managed keys
+
+		// GuardDuty detectors
+		guardDutyDetectors := GuardDuty{}
+		if IsNukeable(guardDutyDetectors.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Guard Duty Detectors",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			detectors, err := getAllGuardDutyDetectors(cloudNukeSession, excludeAfter, configObj, guardDutyDetectors.MaxBatchSize())
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve GuardDuty detectors",
+					ResourceType: guardDutyDetectors.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Guard Duty Detectors",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(detectors),
+			})
+			if len(detectors) > 0 {
+				guardDutyDetectors.detectorIds = detectors
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, guardDutyDetectors)
+			}
+		}
+		// End GuardDuty detectors
+
+		// Macie member accounts
+		macieAccounts := MacieMember{}
+		if IsNukeable(macieAccounts.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing MACIE Member Accounts",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			// Unfortunately, the Macie API doesn't provide the metadata information we'd need to implement the excludeAfter or configObj patterns
+			accountIds, err := getAllMacieMemberAccounts(cloudNukeSession)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Macie member accounts",
+					ResourceType: macieAccounts.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing MACIE Member Accounts",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(accountIds),
+			})
+			if len(accountIds) > 0 {
+				macieAccounts.AccountIds = accountIds
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, macieAccounts)
+			}
+
+		}
+		// End Macie member accounts
+
+		// Macie Classification Jobs
+		macieClassificationJobs := MacieClassificationJobs{}
+		if IsNukeable(macieClassificationJobs.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Macie Classification Jobs",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			macieJobIds, err := getAllMacieClassificationJobs(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Macie Classification Jobs",
+					ResourceType: macieClassificationJobs.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Macie Classification Jobs",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(macieJobIds),
+			})
+			if len(macieJobIds) > 0 {
+				macieClassificationJobs.JobIds = awsgo.StringValueSlice(macieJobIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, macieClassificationJobs)
+			}
+		}
+		// End Macie Classification Jobs
+
+		// Macie Session
+		macieSession := MacieSession{}
+		if IsNukeable(macieSession.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Macie Session",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			macieSessionAccountIds, err := getMacieSession(cloudNukeSession, excludeAfter)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Macie Session",
+					ResourceType: macieSession.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Macie Session",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(macieSessionAccountIds),
+			})
+			if len(macieSessionAccountIds) > 0 {
+				macieSession.AccountIds = macieSessionAccountIds
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, macieSession)
+			}
+		}
+		// End Macie Session
+
+		// Security Hub
+		securityHub := SecurityHub{}
+		if IsNukeable(securityHub.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Security Hub",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			hubArns, err := getAllSecurityHubs(cloudNukeSession, excludeAfter)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Security Hub",
+					ResourceType: securityHub.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Security Hub",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(hubArns),
+			})
+			if len(hubArns) > 0 {
+				securityHub.HubArns = hubArns
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, securityHub)
+			}
+		}
+		// End Security Hub
+
+		// Inspector2
+		inspector2Accounts := Inspector2{}
+		if IsNukeable(inspector2Accounts.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Inspector2",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			// Unfortunately, the Inspector2 API doesn't provide the metadata information we'd need to implement the excludeAfter or configObj patterns
+			accountIds, err := getAllInspector2Accounts(cloudNukeSession)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Inspector2 accounts",
+					ResourceType: inspector2Accounts.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Inspector2",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(accountIds),
+			})
+			if len(accountIds) > 0 {
+				inspector2Accounts.AccountIds = accountIds
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, inspector2Accounts)
+			}
+		}
+		// End Inspector2
+
+		// Start SageMaker Notebook Instances
+		notebookInstances := SageMakerNotebookInstances{}
+		if IsNukeable(notebookInstances.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Sagemaker Notebook Instances",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			instances, err := getAllNotebookInstances(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve sagemaker notebook instances",
+					ResourceType: notebookInstances.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Sagemaker Notebook Instances",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(instances),
+			})
+			if len(instances) > 0 {
+				notebookInstances.InstanceNames = awsgo.StringValueSlice(instances)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, notebookInstances)
+			}
+		}
+		// End SageMaker Notebook Instances
+
+		// SageMaker Endpoints
+		sageMakerEndpoints := SageMakerEndpoints{}
+		if IsNukeable(sageMakerEndpoints.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing SageMaker Endpoints",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			sageMakerEndpointNames, err := getAllSageMakerEndpoints(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve SageMaker Endpoints",
+					ResourceType: sageMakerEndpoints.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing SageMaker Endpoints",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(sageMakerEndpointNames),
+			})
+			if len(sageMakerEndpointNames) > 0 {
+				sageMakerEndpoints.EndpointNames = awsgo.StringValueSlice(sageMakerEndpointNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, sageMakerEndpoints)
+			}
+		}
+		// End SageMaker Endpoints
+
+		// SageMaker Models
+		sageMakerModels := SageMakerModels{}
+		if IsNukeable(sageMakerModels.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing SageMaker Models",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			sageMakerModelNames, err := getAllSageMakerModels(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve SageMaker Models",
+					ResourceType: sageMakerModels.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing SageMaker Models",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(sageMakerModelNames),
+			})
+			if len(sageMakerModelNames) > 0 {
+				sageMakerModels.ModelNames = awsgo.StringValueSlice(sageMakerModelNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, sageMakerModels)
+			}
+		}
+		// End SageMaker Models
+
+		// SageMaker Studio Domains
+		sageMakerStudioDomains := SageMakerStudioDomains{}
+		if IsNukeable(sageMakerStudioDomains.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing SageMaker Studio Domains",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			sageMakerStudioDomainIds, err := getAllSageMakerStudioDomains(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve SageMaker Studio Domains",
+					ResourceType: sageMakerStudioDomains.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing SageMaker Studio Domains",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(sageMakerStudioDomainIds),
+			})
+			if len(sageMakerStudioDomainIds) > 0 {
+				sageMakerStudioDomains.DomainIds = awsgo.StringValueSlice(sageMakerStudioDomainIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, sageMakerStudioDomains)
+			}
+		}
+		// End SageMaker Studio Domains
+
+		// Kinesis Streams
+		kinesisStreams := KinesisStreams{}
+		if IsNukeable(kinesisStreams.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Kinesis Streams",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			streams, err := getAllKinesisStreams(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve kinesis streams",
+					ResourceType: kinesisStreams.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Kinesis Streams",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(streams),
+			})
+			if len(streams) > 0 {
+				kinesisStreams.Names = awsgo.StringValueSlice(streams)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, kinesisStreams)
+			}
+		}
+		// End Kinesis Streams
+
+		// Kinesis Firehoses
+		kinesisFirehoses := KinesisFirehoses{}
+		if IsNukeable(kinesisFirehoses.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Kinesis Firehoses",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			kinesisFirehoseNames, err := getAllKinesisFirehoses(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Kinesis Firehoses",
+					ResourceType: kinesisFirehoses.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Kinesis Firehoses",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(kinesisFirehoseNames),
+			})
+			if len(kinesisFirehoseNames) > 0 {
+				kinesisFirehoses.Names = awsgo.StringValueSlice(kinesisFirehoseNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, kinesisFirehoses)
+			}
+		}
+		// End Kinesis Firehoses
+
+		// MSK Clusters
+		mskClusters := MSKClusters{}
+		if IsNukeable(mskClusters.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing MSK Clusters",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			mskClusterArns, err := getAllMSKClusters(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve MSK Clusters",
+					ResourceType: mskClusters.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing MSK Clusters",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(mskClusterArns),
+			})
+			if len(mskClusterArns) > 0 {
+				mskClusters.ClusterArns = awsgo.StringValueSlice(mskClusterArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, mskClusters)
+			}
+		}
+		// End MSK Clusters
+
+		// Redshift Clusters
+		redshiftClusters := RedshiftClusters{}
+		if IsNukeable(redshiftClusters.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Redshift Clusters",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			redshiftClusterIds, err := getAllRedshiftClusters(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Redshift Clusters",
+					ResourceType: redshiftClusters.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Redshift Clusters",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(redshiftClusterIds),
+			})
+			if len(redshiftClusterIds) > 0 {
+				redshiftClusters.ClusterIdentifiers = awsgo.StringValueSlice(redshiftClusterIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, redshiftClusters)
+			}
+		}
+		// End Redshift Clusters
+
+		// Redshift Snapshots
+		redshiftSnapshots := RedshiftSnapshots{}
+		if IsNukeable(redshiftSnapshots.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Redshift Snapshots",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			redshiftSnapshotIds, err := getAllRedshiftSnapshots(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Redshift Snapshots",
+					ResourceType: redshiftSnapshots.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Redshift Snapshots",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(redshiftSnapshotIds),
+			})
+			if len(redshiftSnapshotIds) > 0 {
+				redshiftSnapshots.SnapshotIdentifiers = awsgo.StringValueSlice(redshiftSnapshotIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, redshiftSnapshots)
+			}
+		}
+		// End Redshift Snapshots
+
+		// Redshift Serverless Namespaces
+		redshiftServerlessNamespaces := RedshiftServerlessNamespaces{}
+		if IsNukeable(redshiftServerlessNamespaces.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Redshift Serverless Namespaces",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			redshiftServerlessNamespaceNames, err := getAllRedshiftServerlessNamespaces(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Redshift Serverless Namespaces",
+					ResourceType: redshiftServerlessNamespaces.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Redshift Serverless Namespaces",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(redshiftServerlessNamespaceNames),
+			})
+			if len(redshiftServerlessNamespaceNames) > 0 {
+				redshiftServerlessNamespaces.NamespaceNames = awsgo.StringValueSlice(redshiftServerlessNamespaceNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, redshiftServerlessNamespaces)
+			}
+		}
+		// End Redshift Serverless Namespaces
+
+		// Glue Jobs
+		glueJobs := GlueJobs{}
+		if IsNukeable(glueJobs.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Glue Jobs",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			glueJobNames, err := getAllGlueJobs(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Glue Jobs",
+					ResourceType: glueJobs.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Glue Jobs",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(glueJobNames),
+			})
+			if len(glueJobNames) > 0 {
+				glueJobs.Names = awsgo.StringValueSlice(glueJobNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, glueJobs)
+			}
+		}
+		// End Glue Jobs
+
+		// Glue Crawlers
+		glueCrawlers := GlueCrawlers{}
+		if IsNukeable(glueCrawlers.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Glue Crawlers",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			glueCrawlerNames, err := getAllGlueCrawlers(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Glue Crawlers",
+					ResourceType: glueCrawlers.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Glue Crawlers",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(glueCrawlerNames),
+			})
+			if len(glueCrawlerNames) > 0 {
+				glueCrawlers.Names = awsgo.StringValueSlice(glueCrawlerNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, glueCrawlers)
+			}
+		}
+		// End Glue Crawlers
+
+		// Glue Databases
+		glueDatabases := GlueDatabases{}
+		if IsNukeable(glueDatabases.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Glue Databases",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			glueDatabaseNames, err := getAllGlueDatabases(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Glue Databases",
+					ResourceType: glueDatabases.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Glue Databases",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(glueDatabaseNames),
+			})
+			if len(glueDatabaseNames) > 0 {
+				glueDatabases.Names = awsgo.StringValueSlice(glueDatabaseNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, glueDatabases)
+			}
+		}
+		// End Glue Databases
+
+		// Glue Dev Endpoints
+		glueDevEndpoints := GlueDevEndpoints{}
+		if IsNukeable(glueDevEndpoints.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Glue Dev Endpoints",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			glueDevEndpointNames, err := getAllGlueDevEndpoints(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Glue Dev Endpoints",
+					ResourceType: glueDevEndpoints.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Glue Dev Endpoints",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(glueDevEndpointNames),
+			})
+			if len(glueDevEndpointNames) > 0 {
+				glueDevEndpoints.Names = awsgo.StringValueSlice(glueDevEndpointNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, glueDevEndpoints)
+			}
+		}
+		// End Glue Dev Endpoints
+
+		// Athena Workgroups
+		athenaWorkgroups := AthenaWorkgroups{}
+		if IsNukeable(athenaWorkgroups.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Athena Workgroups",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			athenaWorkgroupNames, err := getAllAthenaWorkgroups(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Athena Workgroups",
+					ResourceType: athenaWorkgroups.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Athena Workgroups",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(athenaWorkgroupNames),
+			})
+			if len(athenaWorkgroupNames) > 0 {
+				athenaWorkgroups.WorkgroupNames = awsgo.StringValueSlice(athenaWorkgroupNames)
+				athenaWorkgroups.CleanQueryResults = configObj.AthenaWorkgroup.CleanQueryResults
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, athenaWorkgroups)
+			}
+		}
+		// End Athena Workgroups
+
+		// API Gateways (v1)
+		apiGateways := ApiGateway{}
+		if IsNukeable(apiGateways.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing API Gateways",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			gatewayIds, err := getAllAPIGateways(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve API gateways v1",
+					ResourceType: apiGateways.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing API Gateways",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(gatewayIds),
+			})
+			if len(gatewayIds) > 0 {
+				apiGateways.Ids = awsgo.StringValueSlice(gatewayIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, apiGateways)
+			}
+		}
+		// End API Gateways (v1)
+
+		// API Gateways (v2)
+		apiGatewaysV2 := ApiGatewayV2{}
+		if IsNukeable(apiGatewaysV2.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing API Gateway V2s",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			gatewayV2Ids, err := getAllAPIGatewaysV2(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve API gateways v2",
+					ResourceType: apiGatewaysV2.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing API Gateway V2s",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(gatewayV2Ids),
+			})
+			if len(gatewayV2Ids) > 0 {
+				apiGatewaysV2.Ids = awsgo.StringValueSlice(gatewayV2Ids)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, apiGatewaysV2)
+			}
+		}
+		// End API Gateways (v2)
+
+		// AppSync GraphQL APIs
+		appSyncGraphqlApis := AppSyncGraphqlApis{}
+		if IsNukeable(appSyncGraphqlApis.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing AppSync GraphQL APIs",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			appSyncGraphqlApiIds, err := getAllAppSyncGraphqlApis(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve AppSync GraphQL APIs",
+					ResourceType: appSyncGraphqlApis.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing AppSync GraphQL APIs",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(appSyncGraphqlApiIds),
+			})
+			if len(appSyncGraphqlApiIds) > 0 {
+				appSyncGraphqlApis.ApiIds = awsgo.StringValueSlice(appSyncGraphqlApiIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, appSyncGraphqlApis)
+			}
+		}
+		// End AppSync GraphQL APIs
+
+		// Cognito User Pools
+		cognitoUserPools := CognitoUserPools{}
+		if IsNukeable(cognitoUserPools.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Cognito User Pools",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			cognitoUserPoolIds, err := getAllCognitoUserPools(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Cognito User Pools",
+					ResourceType: cognitoUserPools.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Cognito User Pools",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(cognitoUserPoolIds),
+			})
+			if len(cognitoUserPoolIds) > 0 {
+				cognitoUserPools.UserPoolIds = awsgo.StringValueSlice(cognitoUserPoolIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, cognitoUserPools)
+			}
+		}
+		// End Cognito User Pools
+
+		// Cognito Identity Pools
+		cognitoIdentityPools := CognitoIdentityPools{}
+		if IsNukeable(cognitoIdentityPools.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Cognito Identity Pools",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			cognitoIdentityPoolIds, err := getAllCognitoIdentityPools(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Cognito Identity Pools",
+					ResourceType: cognitoIdentityPools.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Cognito Identity Pools",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(cognitoIdentityPoolIds),
+			})
+			if len(cognitoIdentityPoolIds) > 0 {
+				cognitoIdentityPools.IdentityPoolIds = awsgo.StringValueSlice(cognitoIdentityPoolIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, cognitoIdentityPools)
+			}
+		}
+		// End Cognito Identity Pools
+
+		// ACM Certificates
+		acmCertificates := ACMCertificates{}
+		if IsNukeable(acmCertificates.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing ACM Certificates",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			acmCertificateArns, err := getAllACMCertificates(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve ACM Certificates",
+					ResourceType: acmCertificates.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing ACM Certificates",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(acmCertificateArns),
+			})
+			if len(acmCertificateArns) > 0 {
+				acmCertificates.CertificateArns = awsgo.StringValueSlice(acmCertificateArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, acmCertificates)
+			}
+		}
+		// End ACM Certificates
+
+		// WAFv2 Web ACLs
+		wafv2WebACLs := WAFv2WebACLs{}
+		if IsNukeable(wafv2WebACLs.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing WAFv2 Web ACLs",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			wafv2WebACLArns, err := getAllWAFv2WebACLs(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve WAFv2 Web ACLs",
+					ResourceType: wafv2WebACLs.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing WAFv2 Web ACLs",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(wafv2WebACLArns),
+			})
+			if len(wafv2WebACLArns) > 0 {
+				wafv2WebACLs.ARNs = awsgo.StringValueSlice(wafv2WebACLArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, wafv2WebACLs)
+			}
+		}
+		// End WAFv2 Web ACLs
+
+		// WAFv2 Rule Groups
+		wafv2RuleGroups := WAFv2RuleGroups{}
+		if IsNukeable(wafv2RuleGroups.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing WAFv2 Rule Groups",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			wafv2RuleGroupArns, err := getAllWAFv2RuleGroups(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve WAFv2 Rule Groups",
+					ResourceType: wafv2RuleGroups.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing WAFv2 Rule Groups",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(wafv2RuleGroupArns),
+			})
+			if len(wafv2RuleGroupArns) > 0 {
+				wafv2RuleGroups.ARNs = awsgo.StringValueSlice(wafv2RuleGroupArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, wafv2RuleGroups)
+			}
+		}
+		// End WAFv2 Rule Groups
+
+		// WAFv2 IP Sets
+		wafv2IPSets := WAFv2IPSets{}
+		if IsNukeable(wafv2IPSets.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing WAFv2 IP Sets",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			wafv2IPSetArns, err := getAllWAFv2IPSets(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve WAFv2 IP Sets",
+					ResourceType: wafv2IPSets.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing WAFv2 IP Sets",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(wafv2IPSetArns),
+			})
+			if len(wafv2IPSetArns) > 0 {
+				wafv2IPSets.ARNs = awsgo.StringValueSlice(wafv2IPSetArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, wafv2IPSets)
+			}
+		}
+		// End WAFv2 IP Sets
+
+		// Lightsail Load Balancers
+		lightsailLoadBalancers := LightsailLoadBalancers{}
+		if IsNukeable(lightsailLoadBalancers.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Lightsail Load Balancers",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			lightsailLoadBalancerNames, err := getAllLightsailLoadBalancers(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Lightsail Load Balancers",
+					ResourceType: lightsailLoadBalancers.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Lightsail Load Balancers",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(lightsailLoadBalancerNames),
+			})
+			if len(lightsailLoadBalancerNames) > 0 {
+				lightsailLoadBalancers.Names = awsgo.StringValueSlice(lightsailLoadBalancerNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, lightsailLoadBalancers)
+			}
+		}
+		// End Lightsail Load Balancers
+
+		// Lightsail Instances
+		lightsailInstances := LightsailInstances{}
+		if IsNukeable(lightsailInstances.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Lightsail Instances",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			lightsailInstanceNames, err := getAllLightsailInstances(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Lightsail Instances",
+					ResourceType: lightsailInstances.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Lightsail Instances",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(lightsailInstanceNames),
+			})
+			if len(lightsailInstanceNames) > 0 {
+				lightsailInstances.Names = awsgo.StringValueSlice(lightsailInstanceNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, lightsailInstances)
+			}
+		}
+		// End Lightsail Instances
+
+		// Lightsail Static IPs
+		lightsailStaticIps := LightsailStaticIps{}
+		if IsNukeable(lightsailStaticIps.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Lightsail Static IPs",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			lightsailStaticIpNames, err := getAllLightsailStaticIps(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Lightsail Static IPs",
+					ResourceType: lightsailStaticIps.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Lightsail Static IPs",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(lightsailStaticIpNames),
+			})
+			if len(lightsailStaticIpNames) > 0 {
+				lightsailStaticIps.Names = awsgo.StringValueSlice(lightsailStaticIpNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, lightsailStaticIps)
+			}
+		}
+		// End Lightsail Static IPs
+
+		// Lightsail Databases
+		lightsailDatabases := LightsailDatabases{}
+		if IsNukeable(lightsailDatabases.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Lightsail Databases",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			lightsailDatabaseNames, err := getAllLightsailDatabases(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Lightsail Databases",
+					ResourceType: lightsailDatabases.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Lightsail Databases",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(lightsailDatabaseNames),
+			})
+			if len(lightsailDatabaseNames) > 0 {
+				lightsailDatabases.Names = awsgo.StringValueSlice(lightsailDatabaseNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, lightsailDatabases)
+			}
+		}
+		// End Lightsail Databases
+
+		// Batch Job Queues
+		batchJobQueues := BatchJobQueues{}
+		if IsNukeable(batchJobQueues.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Batch Job Queues",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			batchJobQueueNames, err := getAllBatchJobQueues(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Batch Job Queues",
+					ResourceType: batchJobQueues.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Batch Job Queues",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(batchJobQueueNames),
+			})
+			if len(batchJobQueueNames) > 0 {
+				batchJobQueues.Names = awsgo.StringValueSlice(batchJobQueueNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, batchJobQueues)
+			}
+		}
+		// End Batch Job Queues
+
+		// Batch Compute Environments
+		batchComputeEnvironments := BatchComputeEnvironments{}
+		if IsNukeable(batchComputeEnvironments.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Batch Compute Environments",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			batchComputeEnvironmentNames, err := getAllBatchComputeEnvironments(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Batch Compute Environments",
+					ResourceType: batchComputeEnvironments.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Batch Compute Environments",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(batchComputeEnvironmentNames),
+			})
+			if len(batchComputeEnvironmentNames) > 0 {
+				batchComputeEnvironments.Names = awsgo.StringValueSlice(batchComputeEnvironmentNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, batchComputeEnvironments)
+			}
+		}
+		// End Batch Compute Environments
+
+		// EMR Clusters
+		emrClusters := EMRClusters{}
+		if IsNukeable(emrClusters.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing EMR Clusters",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			emrClusterIds, err := getAllEMRClusters(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve EMR Clusters",
+					ResourceType: emrClusters.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing EMR Clusters",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(emrClusterIds),
+			})
+			if len(emrClusterIds) > 0 {
+				emrClusters.ClusterIds = awsgo.StringValueSlice(emrClusterIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, emrClusters)
+			}
+		}
+		// End EMR Clusters
+
+		// OpenSearch Serverless Collections
+		openSearchServerlessCollections := OpenSearchServerlessCollections{}
+		if IsNukeable(openSearchServerlessCollections.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing OpenSearch Serverless Collections",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			collectionNames, err := getAllOpenSearchServerlessCollections(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve OpenSearch Serverless Collections",
+					ResourceType: openSearchServerlessCollections.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing OpenSearch Serverless Collections",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(collectionNames),
+			})
+			if len(collectionNames) > 0 {
+				openSearchServerlessCollections.CollectionNames = awsgo.StringValueSlice(collectionNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, openSearchServerlessCollections)
+			}
+		}
+		// End OpenSearch Serverless Collections
+
+		// Neptune Clusters
+		neptuneClusters := NeptuneClusters{}
+		if IsNukeable(neptuneClusters.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Neptune Clusters",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			clusterIdentifiers, err := getAllNeptuneClusters(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Neptune Clusters",
+					ResourceType: neptuneClusters.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Neptune Clusters",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(clusterIdentifiers),
+			})
+			if len(clusterIdentifiers) > 0 {
+				neptuneClusters.ClusterIdentifiers = awsgo.StringValueSlice(clusterIdentifiers)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, neptuneClusters)
+			}
+		}
+		// End Neptune Clusters
+
+		// DocumentDB Clusters
+		docDBClusters := DocDBClusters{}
+		if IsNukeable(docDBClusters.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing DocumentDB Clusters",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			clusterIdentifiers, err := getAllDocDBClusters(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve DocumentDB Clusters",
+					ResourceType: docDBClusters.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing DocumentDB Clusters",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(clusterIdentifiers),
+			})
+			if len(clusterIdentifiers) > 0 {
+				docDBClusters.ClusterIdentifiers = awsgo.StringValueSlice(clusterIdentifiers)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, docDBClusters)
+			}
+		}
+		// End DocumentDB Clusters
+
+		// Timestream Databases
+		timestreamDatabases := TimestreamDatabases{}
+		if IsNukeable(timestreamDatabases.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Timestream Databases",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			databaseNames, err := getAllTimestreamDatabases(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Timestream Databases",
+					ResourceType: timestreamDatabases.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Timestream Databases",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(databaseNames),
+			})
+			if len(databaseNames) > 0 {
+				timestreamDatabases.DatabaseNames = awsgo.StringValueSlice(databaseNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, timestreamDatabases)
+			}
+		}
+		// End Timestream Databases
+
+		// QLDB Ledgers
+		qldbLedgers := QLDBLedgers{}
+		if IsNukeable(qldbLedgers.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing QLDB Ledgers",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			ledgerNames, err := getAllQLDBLedgers(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve QLDB Ledgers",
+					ResourceType: qldbLedgers.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing QLDB Ledgers",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(ledgerNames),
+			})
+			if len(ledgerNames) > 0 {
+				qldbLedgers.Names = awsgo.StringValueSlice(ledgerNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, qldbLedgers)
+			}
+		}
+		// End QLDB Ledgers
+
+		// Amazon MQ Brokers
+		mqBrokers := MQBrokers{}
+		if IsNukeable(mqBrokers.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Amazon MQ Brokers",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			brokerIds, err := getAllMQBrokers(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Amazon MQ Brokers",
+					ResourceType: mqBrokers.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Amazon MQ Brokers",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(brokerIds),
+			})
+			if len(brokerIds) > 0 {
+				mqBrokers.BrokerIds = awsgo.StringValueSlice(brokerIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, mqBrokers)
+			}
+		}
+		// End Amazon MQ Brokers
+
+		// CodeBuild Projects
+		codeBuildProjects := CodeBuildProjects{}
+		if IsNukeable(codeBuildProjects.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing CodeBuild Projects",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			projectNames, err := getAllCodeBuildProjects(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve CodeBuild Projects",
+					ResourceType: codeBuildProjects.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing CodeBuild Projects",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(projectNames),
+			})
+			if len(projectNames) > 0 {
+				codeBuildProjects.ProjectNames = awsgo.StringValueSlice(projectNames)
+				codeBuildProjects.DeleteReportGroups = configObj.CodeBuildProject.DeleteReportGroups
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, codeBuildProjects)
+			}
+		}
+		// End CodeBuild Projects
+
+		// CodePipeline Pipelines
+		codePipelinePipelines := CodePipelinePipelines{}
+		if IsNukeable(codePipelinePipelines.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing CodePipeline Pipelines",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			pipelineNames, err := getAllCodePipelinePipelines(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve CodePipeline Pipelines",
+					ResourceType: codePipelinePipelines.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing CodePipeline Pipelines",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(pipelineNames),
+			})
+			if len(pipelineNames) > 0 {
+				codePipelinePipelines.PipelineNames = awsgo.StringValueSlice(pipelineNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, codePipelinePipelines)
+			}
+		}
+		// End CodePipeline Pipelines
+
+		// CodeCommit Repositories
+		codeCommitRepositories := CodeCommitRepositories{}
+		if IsNukeable(codeCommitRepositories.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing CodeCommit Repositories",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			repositoryNames, err := getAllCodeCommitRepositories(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve CodeCommit Repositories",
+					ResourceType: codeCommitRepositories.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing CodeCommit Repositories",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(repositoryNames),
+			})
+			if len(repositoryNames) > 0 {
+				codeCommitRepositories.RepositoryNames = awsgo.StringValueSlice(repositoryNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, codeCommitRepositories)
+			}
+		}
+		// End CodeCommit Repositories
+
+		// CodeDeploy Applications
+		codeDeployApplications := CodeDeployApplications{}
+		if IsNukeable(codeDeployApplications.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing CodeDeploy Applications",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			applicationNames, err := getAllCodeDeployApplications(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve CodeDeploy Applications",
+					ResourceType: codeDeployApplications.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing CodeDeploy Applications",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(applicationNames),
+			})
+			if len(applicationNames) > 0 {
+				codeDeployApplications.ApplicationNames = awsgo.StringValueSlice(applicationNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, codeDeployApplications)
+			}
+		}
+		// End CodeDeploy Applications
+
+		// EventBridge Rules
+		eventBridgeRules := EventBridgeRules{}
+		if IsNukeable(eventBridgeRules.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing EventBridge Rules",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			ruleArns, err := getAllEventBridgeRules(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve EventBridge Rules",
+					ResourceType: eventBridgeRules.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing EventBridge Rules",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(ruleArns),
+			})
+			if len(ruleArns) > 0 {
+				eventBridgeRules.RuleArns = awsgo.StringValueSlice(ruleArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, eventBridgeRules)
+			}
+		}
+		// End EventBridge Rules
+
+		// EventBridge Event Buses
+		eventBridgeBuses := EventBridgeBuses{}
+		if IsNukeable(eventBridgeBuses.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing EventBridge Event Buses",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			busNames, err := getAllEventBridgeBuses(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve EventBridge Event Buses",
+					ResourceType: eventBridgeBuses.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing EventBridge Event Buses",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(busNames),
+			})
+			if len(busNames) > 0 {
+				eventBridgeBuses.Names = awsgo.StringValueSlice(busNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, eventBridgeBuses)
+			}
+		}
+		// End EventBridge Event Buses
+
+		// EventBridge Schedules
+		eventBridgeSchedules := EventBridgeSchedules{}
+		if IsNukeable(eventBridgeSchedules.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing EventBridge Schedules",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			scheduleIdentifiers, err := getAllEventBridgeSchedules(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve EventBridge Schedules",
+					ResourceType: eventBridgeSchedules.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing EventBridge Schedules",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(scheduleIdentifiers),
+			})
+			if len(scheduleIdentifiers) > 0 {
+				eventBridgeSchedules.Identifiers = awsgo.StringValueSlice(scheduleIdentifiers)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, eventBridgeSchedules)
+			}
+		}
+		// End EventBridge Schedules
+
+		// EventBridge Schedule Groups
+		eventBridgeScheduleGroups := EventBridgeScheduleGroups{}
+		if IsNukeable(eventBridgeScheduleGroups.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing EventBridge Schedule Groups",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			groupNames, err := getAllEventBridgeScheduleGroups(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve EventBridge Schedule Groups",
+					ResourceType: eventBridgeScheduleGroups.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing EventBridge Schedule Groups",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(groupNames),
+			})
+			if len(groupNames) > 0 {
+				eventBridgeScheduleGroups.Names = awsgo.StringValueSlice(groupNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, eventBridgeScheduleGroups)
+			}
+		}
+		// End EventBridge Schedule Groups
+
+		// SSM Parameters
+		ssmParameters := SSMParameters{}
+		if IsNukeable(ssmParameters.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing SSM Parameters",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			parameterNames, err := getAllSSMParameters(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve SSM Parameters",
+					ResourceType: ssmParameters.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing SSM Parameters",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(parameterNames),
+			})
+			if len(parameterNames) > 0 {
+				ssmParameters.Names = awsgo.StringValueSlice(parameterNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, ssmParameters)
+			}
+		}
+		// End SSM Parameters
+
+		// SSM Associations
+		ssmAssociations := SSMAssociations{}
+		if IsNukeable(ssmAssociations.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing SSM Associations",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			associationIds, err := getAllSSMAssociations(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve SSM Associations",
+					ResourceType: ssmAssociations.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing SSM Associations",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(associationIds),
+			})
+			if len(associationIds) > 0 {
+				ssmAssociations.AssociationIds = awsgo.StringValueSlice(associationIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, ssmAssociations)
+			}
+		}
+		// End SSM Associations
+
+		// SSM Documents
+		ssmDocuments := SSMDocuments{}
+		if IsNukeable(ssmDocuments.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing SSM Documents",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			documentNames, err := getAllSSMDocuments(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve SSM Documents",
+					ResourceType: ssmDocuments.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing SSM Documents",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(documentNames),
+			})
+			if len(documentNames) > 0 {
+				ssmDocuments.Names = awsgo.StringValueSlice(documentNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, ssmDocuments)
+			}
+		}
+		// End SSM Documents
+
+		// Backup Plans
+		backupPlans := BackupPlans{}
+		if IsNukeable(backupPlans.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Backup Plans",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			planIds, err := getAllBackupPlans(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Backup Plans",
+					ResourceType: backupPlans.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Backup Plans",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(planIds),
+			})
+			if len(planIds) > 0 {
+				backupPlans.PlanIds = awsgo.StringValueSlice(planIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, backupPlans)
+			}
+		}
+		// End Backup Plans
+
+		// Backup Vaults
+		backupVaults := BackupVaults{}
+		if IsNukeable(backupVaults.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Backup Vaults",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			vaultNames, err := getAllBackupVaults(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Backup Vaults",
+					ResourceType: backupVaults.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Backup Vaults",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(vaultNames),
+			})
+			if len(vaultNames) > 0 {
+				backupVaults.Names = awsgo.StringValueSlice(vaultNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, backupVaults)
+			}
+		}
+		// End Backup Vaults
+
+		// Storage Gateways
+		storageGateways := StorageGateways{}
+		if IsNukeable(storageGateways.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Storage Gateways",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			gatewayArns, err := getAllStorageGateways(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Storage Gateways",
+					ResourceType: storageGateways.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Storage Gateways",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(gatewayArns),
+			})
+			if len(gatewayArns) > 0 {
+				storageGateways.GatewayArns = awsgo.StringValueSlice(gatewayArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, storageGateways)
+			}
+		}
+		// End Storage Gateways
+
+		// DataSync Tasks
+		dataSyncTasks := DataSyncTasks{}
+		if IsNukeable(dataSyncTasks.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing DataSync Tasks",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			taskArns, err := getAllDataSyncTasks(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve DataSync Tasks",
+					ResourceType: dataSyncTasks.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing DataSync Tasks",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(taskArns),
+			})
+			if len(taskArns) > 0 {
+				dataSyncTasks.TaskArns = awsgo.StringValueSlice(taskArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, dataSyncTasks)
+			}
+		}
+		// End DataSync Tasks
+
+		// DataSync Locations
+		dataSyncLocations := DataSyncLocations{}
+		if IsNukeable(dataSyncLocations.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing DataSync Locations",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			locationArns, err := getAllDataSyncLocations(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve DataSync Locations",
+					ResourceType: dataSyncLocations.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing DataSync Locations",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(locationArns),
+			})
+			if len(locationArns) > 0 {
+				dataSyncLocations.LocationArns = awsgo.StringValueSlice(locationArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, dataSyncLocations)
+			}
+		}
+		// End DataSync Locations
+
+		// DataSync Agents
+		dataSyncAgents := DataSyncAgents{}
+		if IsNukeable(dataSyncAgents.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing DataSync Agents",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			agentArns, err := getAllDataSyncAgents(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve DataSync Agents",
+					ResourceType: dataSyncAgents.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing DataSync Agents",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(agentArns),
+			})
+			if len(agentArns) > 0 {
+				dataSyncAgents.AgentArns = awsgo.StringValueSlice(agentArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, dataSyncAgents)
+			}
+		}
+		// End DataSync Agents
+
+		// Transfer Family Servers
+		transferServers := TransferServers{}
+		if IsNukeable(transferServers.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Transfer Family Servers",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			serverIds, err := getAllTransferServers(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Transfer Family Servers",
+					ResourceType: transferServers.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Transfer Family Servers",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(serverIds),
+			})
+			if len(serverIds) > 0 {
+				transferServers.ServerIds = awsgo.StringValueSlice(serverIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, transferServers)
+			}
+		}
+		// End Transfer Family Servers
+
+		// App Runner Services
+		appRunnerServices := AppRunnerServices{}
+		if IsNukeable(appRunnerServices.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing App Runner Services",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			serviceArns, err := getAllAppRunnerServices(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve App Runner Services",
+					ResourceType: appRunnerServices.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing App Runner Services",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(serviceArns),
+			})
+			if len(serviceArns) > 0 {
+				appRunnerServices.Arns = awsgo.StringValueSlice(serviceArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, appRunnerServices)
+			}
+		}
+		// End App Runner Services
+
+		// App Runner Auto Scaling Configurations
+		appRunnerAutoScalingConfigurations := AppRunnerAutoScalingConfigurations{}
+		if IsNukeable(appRunnerAutoScalingConfigurations.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing App Runner Auto Scaling Configurations",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			configurationArns, err := getAllAppRunnerAutoScalingConfigurations(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve App Runner Auto Scaling Configurations",
+					ResourceType: appRunnerAutoScalingConfigurations.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing App Runner Auto Scaling Configurations",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(configurationArns),
+			})
+			if len(configurationArns) > 0 {
+				appRunnerAutoScalingConfigurations.Arns = awsgo.StringValueSlice(configurationArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, appRunnerAutoScalingConfigurations)
+			}
+		}
+		// End App Runner Auto Scaling Configurations
+
+		// App Runner VPC Connectors
+		appRunnerVpcConnectors := AppRunnerVpcConnectors{}
+		if IsNukeable(appRunnerVpcConnectors.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing App Runner VPC Connectors",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			connectorArns, err := getAllAppRunnerVpcConnectors(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve App Runner VPC Connectors",
+					ResourceType: appRunnerVpcConnectors.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing App Runner VPC Connectors",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(connectorArns),
+			})
+			if len(connectorArns) > 0 {
+				appRunnerVpcConnectors.Arns = awsgo.StringValueSlice(connectorArns)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, appRunnerVpcConnectors)
+			}
+		}
+		// End App Runner VPC Connectors
+
+		// Amplify Apps
+		amplifyApps := AmplifyApps{}
+		if IsNukeable(amplifyApps.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Amplify Apps",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			appIds, err := getAllAmplifyApps(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Amplify Apps",
+					ResourceType: amplifyApps.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Amplify Apps",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(appIds),
+			})
+			if len(appIds) > 0 {
+				amplifyApps.AppIds = awsgo.StringValueSlice(appIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, amplifyApps)
+			}
+		}
+		// End Amplify Apps
+
+		// IoT Certificates
+		iotCertificates := IoTCertificates{}
+		if IsNukeable(iotCertificates.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing IoT Certificates",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			certificateIds, err := getAllIoTCertificates(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve IoT Certificates",
+					ResourceType: iotCertificates.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing IoT Certificates",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(certificateIds),
+			})
+			if len(certificateIds) > 0 {
+				iotCertificates.CertificateIds = awsgo.StringValueSlice(certificateIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, iotCertificates)
+			}
+		}
+		// End IoT Certificates
+
+		// IoT Things
+		iotThings := IoTThings{}
+		if IsNukeable(iotThings.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing IoT Things",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			thingNames, err := getAllIoTThings(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve IoT Things",
+					ResourceType: iotThings.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing IoT Things",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(thingNames),
+			})
+			if len(thingNames) > 0 {
+				iotThings.ThingNames = awsgo.StringValueSlice(thingNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, iotThings)
+			}
+		}
+		// End IoT Things
+
+		// IoT Thing Groups
+		iotThingGroups := IoTThingGroups{}
+		if IsNukeable(iotThingGroups.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing IoT Thing Groups",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			thingGroupNames, err := getAllIoTThingGroups(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve IoT Thing Groups",
+					ResourceType: iotThingGroups.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing IoT Thing Groups",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(thingGroupNames),
+			})
+			if len(thingGroupNames) > 0 {
+				iotThingGroups.GroupNames = awsgo.StringValueSlice(thingGroupNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, iotThingGroups)
+			}
+		}
+		// End IoT Thing Groups
+
+		// IoT Policies
+		iotPolicies := IoTPolicies{}
+		if IsNukeable(iotPolicies.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing IoT Policies",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			policyNames, err := getAllIoTPolicies(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve IoT Policies",
+					ResourceType: iotPolicies.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing IoT Policies",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(policyNames),
+			})
+			if len(policyNames) > 0 {
+				iotPolicies.PolicyNames = awsgo.StringValueSlice(policyNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, iotPolicies)
+			}
+		}
+		// End IoT Policies
+
+		// WorkSpaces
+		workspaceResources := Workspaces{}
+		if IsNukeable(workspaceResources.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing WorkSpaces",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			workspaceIds, err := getAllWorkspaces(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve WorkSpaces",
+					ResourceType: workspaceResources.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing WorkSpaces",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(workspaceIds),
+			})
+			if len(workspaceIds) > 0 {
+				workspaceResources.WorkspaceIds = awsgo.StringValueSlice(workspaceIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, workspaceResources)
+			}
+		}
+		// End WorkSpaces
+
+		// WorkSpaces Directories
+		workspaceDirectories := WorkspaceDirectories{}
+		if IsNukeable(workspaceDirectories.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing WorkSpaces Directories",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			directoryIds, err := getAllWorkspaceDirectories(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve WorkSpaces Directories",
+					ResourceType: workspaceDirectories.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing WorkSpaces Directories",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(directoryIds),
+			})
+			if len(directoryIds) > 0 {
+				workspaceDirectories.DirectoryIds = awsgo.StringValueSlice(directoryIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, workspaceDirectories)
+			}
+		}
+		// End WorkSpaces Directories
+
+		// AppStream Fleets
+		appStreamFleets := AppStreamFleets{}
+		if IsNukeable(appStreamFleets.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing AppStream Fleets",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			fleetNames, err := getAllAppStreamFleets(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve AppStream Fleets",
+					ResourceType: appStreamFleets.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing AppStream Fleets",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(fleetNames),
+			})
+			if len(fleetNames) > 0 {
+				appStreamFleets.Names = awsgo.StringValueSlice(fleetNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, appStreamFleets)
+			}
+		}
+		// End AppStream Fleets
+
+		// AppStream Stacks
+		appStreamStacks := AppStreamStacks{}
+		if IsNukeable(appStreamStacks.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing AppStream Stacks",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			stackNames, err := getAllAppStreamStacks(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve AppStream Stacks",
+					ResourceType: appStreamStacks.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing AppStream Stacks",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(stackNames),
+			})
+			if len(stackNames) > 0 {
+				appStreamStacks.Names = awsgo.StringValueSlice(stackNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, appStreamStacks)
+			}
+		}
+		// End AppStream Stacks
+
+		// AppStream Image Builders
+		appStreamImageBuilders := AppStreamImageBuilders{}
+		if IsNukeable(appStreamImageBuilders.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing AppStream Image Builders",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			imageBuilderNames, err := getAllAppStreamImageBuilders(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve AppStream Image Builders",
+					ResourceType: appStreamImageBuilders.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing AppStream Image Builders",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(imageBuilderNames),
+			})
+			if len(imageBuilderNames) > 0 {
+				appStreamImageBuilders.Names = awsgo.StringValueSlice(imageBuilderNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, appStreamImageBuilders)
 			}
-
 		}
-		// End KMS Customer managed keys
+		// End AppStream Image Builders
 
-		// GuardDuty detectors
-		guardDutyDetectors := GuardDuty{}
-		if IsNukeable(guardDutyDetectors.ResourceName(), resourceTypes) {
+		// Service Catalog Provisioned Products
+		serviceCatalogProvisionedProducts := ServiceCatalogProvisionedProducts{}
+		if IsNukeable(serviceCatalogProvisionedProducts.ResourceName(), resourceTypes) {
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Listing Guard Duty Detectors",
+				EventName: "Listing Service Catalog Provisioned Products",
 			}, map[string]interface{}{
 				"region": region,
 			})
-			detectors, err := getAllGuardDutyDetectors(cloudNukeSession, excludeAfter, configObj, guardDutyDetectors.MaxBatchSize())
+			provisionedProductIds, err := getAllServiceCatalogProvisionedProducts(cloudNukeSession, excludeAfter, configObj)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
-					Description:  "Unable to retrieve GuardDuty detectors",
-					ResourceType: guardDutyDetectors.ResourceName(),
+					Description:  "Unable to retrieve Service Catalog Provisioned Products",
+					ResourceType: serviceCatalogProvisionedProducts.ResourceName(),
 				}
 				report.RecordError(ge)
 			}
+
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Done Listing Guard Duty Detectors",
+				EventName: "Done Listing Service Catalog Provisioned Products",
 			}, map[string]interface{}{
 				"region":      region,
-				"recordCount": len(detectors),
+				"recordCount": len(provisionedProductIds),
 			})
-			if len(detectors) > 0 {
-				guardDutyDetectors.detectorIds = detectors
-				resourcesInRegion.Resources = append(resourcesInRegion.Resources, guardDutyDetectors)
+			if len(provisionedProductIds) > 0 {
+				serviceCatalogProvisionedProducts.Ids = awsgo.StringValueSlice(provisionedProductIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, serviceCatalogProvisionedProducts)
 			}
 		}
-		// End GuardDuty detectors
+		// End Service Catalog Provisioned Products
 
-		// Macie member accounts
-		macieAccounts := MacieMember{}
-		if IsNukeable(macieAccounts.ResourceName(), resourceTypes) {
+		// Service Catalog Products
+		serviceCatalogProducts := ServiceCatalogProducts{}
+		if IsNukeable(serviceCatalogProducts.ResourceName(), resourceTypes) {
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Listing MACIE Member Accounts",
+				EventName: "Listing Service Catalog Products",
 			}, map[string]interface{}{
 				"region": region,
 			})
-			// Unfortunately, the Macie API doesn't provide the metadata information we'd need to implement the excludeAfter or configObj patterns
-			accountIds, err := getAllMacieMemberAccounts(cloudNukeSession)
+			productIds, err := getAllServiceCatalogProducts(cloudNukeSession, excludeAfter, configObj)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
-					Description:  "Unable to retrieve Macie member accounts",
-					ResourceType: macieAccounts.ResourceName(),
+					Description:  "Unable to retrieve Service Catalog Products",
+					ResourceType: serviceCatalogProducts.ResourceName(),
 				}
 				report.RecordError(ge)
 			}
+
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Done Listing MACIE Member Accounts",
+				EventName: "Done Listing Service Catalog Products",
 			}, map[string]interface{}{
 				"region":      region,
-				"recordCount": len(accountIds),
+				"recordCount": len(productIds),
 			})
-			if len(accountIds) > 0 {
-				macieAccounts.AccountIds = accountIds
-				resourcesInRegion.Resources = append(resourcesInRegion.Resources, macieAccounts)
+			if len(productIds) > 0 {
+				serviceCatalogProducts.Ids = awsgo.StringValueSlice(productIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, serviceCatalogProducts)
 			}
-
 		}
-		// End Macie member accounts
+		// End Service Catalog Products
 
-		// Start SageMaker Notebook Instances
-		notebookInstances := SageMakerNotebookInstances{}
-		if IsNukeable(notebookInstances.ResourceName(), resourceTypes) {
+		// Service Catalog Portfolios
+		serviceCatalogPortfolios := ServiceCatalogPortfolios{}
+		if IsNukeable(serviceCatalogPortfolios.ResourceName(), resourceTypes) {
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Listing Sagemaker Notebook Instances",
+				EventName: "Listing Service Catalog Portfolios",
 			}, map[string]interface{}{
 				"region": region,
 			})
-			instances, err := getAllNotebookInstances(cloudNukeSession, excludeAfter, configObj)
+			portfolioIds, err := getAllServiceCatalogPortfolios(cloudNukeSession, excludeAfter, configObj)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
-					Description:  "Unable to retrieve sagemaker notebook instances",
-					ResourceType: notebookInstances.ResourceName(),
+					Description:  "Unable to retrieve Service Catalog Portfolios",
+					ResourceType: serviceCatalogPortfolios.ResourceName(),
 				}
 				report.RecordError(ge)
 			}
+
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Done Listing Sagemaker Notebook Instances",
+				EventName: "Done Listing Service Catalog Portfolios",
 			}, map[string]interface{}{
 				"region":      region,
-				"recordCount": len(instances),
+				"recordCount": len(portfolioIds),
 			})
-			if len(instances) > 0 {
-				notebookInstances.InstanceNames = awsgo.StringValueSlice(instances)
-				resourcesInRegion.Resources = append(resourcesInRegion.Resources, notebookInstances)
+			if len(portfolioIds) > 0 {
+				serviceCatalogPortfolios.Ids = awsgo.StringValueSlice(portfolioIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, serviceCatalogPortfolios)
 			}
 		}
-		// End SageMaker Notebook Instances
+		// End Service Catalog Portfolios
 
-		// Kinesis Streams
-		kinesisStreams := KinesisStreams{}
-		if IsNukeable(kinesisStreams.ResourceName(), resourceTypes) {
+		// Elastic FileSystems (efs)
+		elasticFileSystems := ElasticFileSystem{}
+		if IsNukeable(elasticFileSystems.ResourceName(), resourceTypes) {
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Listing Kinesis Streams",
+				EventName: "Listing Elastic File Systems",
 			}, map[string]interface{}{
 				"region": region,
 			})
-			streams, err := getAllKinesisStreams(cloudNukeSession, configObj)
+			elasticFileSystemsIds, err := getAllElasticFileSystems(cloudNukeSession, excludeAfter, configObj)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
-					Description:  "Unable to retrieve kinesis streams",
-					ResourceType: kinesisStreams.ResourceName(),
+					Description:  "Unable to retrieve Elastic FileSystems",
+					ResourceType: elasticFileSystems.ResourceName(),
 				}
 				report.RecordError(ge)
 			}
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Done Listing Kinesis Streams",
+				EventName: "Done Listing Elastic File Systems",
 			}, map[string]interface{}{
 				"region":      region,
-				"recordCount": len(streams),
+				"recordCount": len(elasticFileSystemsIds),
 			})
-			if len(streams) > 0 {
-				kinesisStreams.Names = awsgo.StringValueSlice(streams)
-				resourcesInRegion.Resources = append(resourcesInRegion.Resources, kinesisStreams)
+			if len(elasticFileSystemsIds) > 0 {
+				elasticFileSystems.Ids = awsgo.StringValueSlice(elasticFileSystemsIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, elasticFileSystems)
 			}
 		}
-		// End Kinesis Streams
+		// End Elastic FileSystems (efs)
 
-		// API Gateways (v1)
-		apiGateways := ApiGateway{}
-		if IsNukeable(apiGateways.ResourceName(), resourceTypes) {
+		// FSx File Systems
+		fsxFileSystems := FSxFileSystems{}
+		if IsNukeable(fsxFileSystems.ResourceName(), resourceTypes) {
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Listing API Gateways",
+				EventName: "Listing FSx File Systems",
 			}, map[string]interface{}{
 				"region": region,
 			})
-			gatewayIds, err := getAllAPIGateways(cloudNukeSession, excludeAfter, configObj)
+			fsxFileSystemIds, err := getAllFSxFileSystems(cloudNukeSession, excludeAfter, configObj)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
-					Description:  "Unable to retrieve API gateways v1",
-					ResourceType: apiGateways.ResourceName(),
+					Description:  "Unable to retrieve FSx File Systems",
+					ResourceType: fsxFileSystems.ResourceName(),
 				}
 				report.RecordError(ge)
 			}
+
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Done Listing API Gateways",
+				EventName: "Done Listing FSx File Systems",
 			}, map[string]interface{}{
 				"region":      region,
-				"recordCount": len(gatewayIds),
+				"recordCount": len(fsxFileSystemIds),
 			})
-			if len(gatewayIds) > 0 {
-				apiGateways.Ids = awsgo.StringValueSlice(gatewayIds)
-				resourcesInRegion.Resources = append(resourcesInRegion.Resources, apiGateways)
+			if len(fsxFileSystemIds) > 0 {
+				fsxFileSystems.FileSystemIds = awsgo.StringValueSlice(fsxFileSystemIds)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, fsxFileSystems)
 			}
 		}
-		// End API Gateways (v1)
+		// End FSx File Systems
 
-		// API Gateways (v2)
-		apiGatewaysV2 := ApiGatewayV2{}
-		if IsNukeable(apiGatewaysV2.ResourceName(), resourceTypes) {
+		// Elastic Beanstalk Applications
+		elasticBeanstalkApplications := ElasticBeanstalkApplications{}
+		if IsNukeable(elasticBeanstalkApplications.ResourceName(), resourceTypes) {
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Listing API Gateway V2s",
+				EventName: "Listing Elastic Beanstalk Applications",
 			}, map[string]interface{}{
 				"region": region,
 			})
-			gatewayV2Ids, err := getAllAPIGatewaysV2(cloudNukeSession, excludeAfter, configObj)
+			elasticBeanstalkApplicationNames, err := getAllElasticBeanstalkApplications(cloudNukeSession, excludeAfter, configObj)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
-					Description:  "Unable to retrieve API gateways v2",
-					ResourceType: apiGatewaysV2.ResourceName(),
+					Description:  "Unable to retrieve Elastic Beanstalk Applications",
+					ResourceType: elasticBeanstalkApplications.ResourceName(),
 				}
 				report.RecordError(ge)
 			}
+
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Done Listing API Gateway V2s",
+				EventName: "Done Listing Elastic Beanstalk Applications",
 			}, map[string]interface{}{
 				"region":      region,
-				"recordCount": len(gatewayV2Ids),
+				"recordCount": len(elasticBeanstalkApplicationNames),
 			})
-			if len(gatewayV2Ids) > 0 {
-				apiGatewaysV2.Ids = awsgo.StringValueSlice(gatewayV2Ids)
-				resourcesInRegion.Resources = append(resourcesInRegion.Resources, apiGatewaysV2)
+			if len(elasticBeanstalkApplicationNames) > 0 {
+				elasticBeanstalkApplications.ApplicationNames = awsgo.StringValueSlice(elasticBeanstalkApplicationNames)
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, elasticBeanstalkApplications)
 			}
 		}
-		// End API Gateways (v2)
+		// End Elastic Beanstalk Applications
 
-		// Elastic FileSystems (efs)
-		elasticFileSystems := ElasticFileSystem{}
-		if IsNukeable(elasticFileSystems.ResourceName(), resourceTypes) {
+		// CloudFormation Stacks
+		cloudFormationStacks := CloudFormationStacks{}
+		if IsNukeable(cloudFormationStacks.ResourceName(), resourceTypes) {
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Listing Elastic File Systems",
+				EventName: "Listing CloudFormation Stacks",
 			}, map[string]interface{}{
 				"region": region,
 			})
-			elasticFileSystemsIds, err := getAllElasticFileSystems(cloudNukeSession, excludeAfter, configObj)
+			cloudFormationStackNames, err := getAllCloudFormationStacks(cloudNukeSession, excludeAfter, configObj)
 			if err != nil {
 				ge := report.GeneralError{
 					Error:        err,
-					Description:  "Unable to retrieve Elastic FileSystems",
-					ResourceType: elasticFileSystems.ResourceName(),
+					Description:  "Unable to retrieve CloudFormation Stacks",
+					ResourceType: cloudFormationStacks.ResourceName(),
 				}
 				report.RecordError(ge)
 			}
+
 			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Done Listing Elastic File Systems",
+				EventName: "Done Listing CloudFormation Stacks",
 			}, map[string]interface{}{
 				"region":      region,
-				"recordCount": len(elasticFileSystemsIds),
+				"recordCount": len(cloudFormationStackNames),
 			})
-			if len(elasticFileSystemsIds) > 0 {
-				elasticFileSystems.Ids = awsgo.StringValueSlice(elasticFileSystemsIds)
-				resourcesInRegion.Resources = append(resourcesInRegion.Resources, elasticFileSystems)
+			if len(cloudFormationStackNames) > 0 {
+				cloudFormationStacks.StackNames = awsgo.StringValueSlice(cloudFormationStackNames)
+				cloudFormationStacks.DisableTerminationProtection = configObj.CloudFormationStack.DisableTerminationProtection
+				cloudFormationStacks.RetainFailedResources = configObj.CloudFormationStack.RetainFailedResources
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, cloudFormationStacks)
 			}
 		}
-		// End Elastic FileSystems (efs)
+		// End CloudFormation Stacks
 
 		// SNS Topics
 		snsTopics := SNSTopic{}
@@ -1613,6 +4475,37 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 		}
 		// End ECR Repositories
 
+		// Config Conformance Packs
+		configConformancePacks := ConfigConformancePacks{}
+		if IsNukeable(configConformancePacks.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Config Conformance Packs",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			configConformancePackNames, err := getAllConfigConformancePacks(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Config Conformance Packs",
+					ResourceType: configConformancePacks.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Config Conformance Packs",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(configConformancePackNames),
+			})
+			if len(configConformancePackNames) > 0 {
+				configConformancePacks.ConformancePackNames = configConformancePackNames
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, configConformancePacks)
+			}
+		}
+		// End Config Conformance Packs
+
 		// Config Service Rules
 		configServiceRules := ConfigServiceRule{}
 		if IsNukeable(configServiceRules.ResourceName(), resourceTypes) {
@@ -1673,6 +4566,37 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 		}
 		// End Config service recorders
 
+		// Config Delivery Channels
+		configDeliveryChannels := ConfigDeliveryChannels{}
+		if IsNukeable(configDeliveryChannels.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Config Delivery Channels",
+			}, map[string]interface{}{
+				"region": region,
+			})
+			configDeliveryChannelNames, err := getAllConfigDeliveryChannels(cloudNukeSession, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Config Delivery Channels",
+					ResourceType: configDeliveryChannels.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Config Delivery Channels",
+			}, map[string]interface{}{
+				"region":      region,
+				"recordCount": len(configDeliveryChannelNames),
+			})
+			if len(configDeliveryChannelNames) > 0 {
+				configDeliveryChannels.DeliveryChannelNames = configDeliveryChannelNames
+				resourcesInRegion.Resources = append(resourcesInRegion.Resources, configDeliveryChannels)
+			}
+		}
+		// End Config Delivery Channels
+
 		// CloudWatchAlarm
 		cloudwatchAlarms := CloudWatchAlarms{}
 		if IsNukeable(cloudwatchAlarms.ResourceName(), resourceTypes) {
@@ -1835,6 +4759,36 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 		}
 		// End IAM OpenIDConnectProviders
 
+		// IAM SAML Providers
+		samlProviders := SAMLProviders{}
+		if IsNukeable(samlProviders.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing SAML Providers",
+			}, map[string]interface{}{
+				"region": "global",
+			})
+			providerARNs, err := getAllSAMLProviders(session, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve SAML providers",
+					ResourceType: samlProviders.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing SAML Providers",
+			}, map[string]interface{}{
+				"region":      "global",
+				"recordCount": len(providerARNs),
+			})
+			if len(providerARNs) > 0 {
+				samlProviders.ProviderARNs = awsgo.StringValueSlice(providerARNs)
+				globalResources.Resources = append(globalResources.Resources, samlProviders)
+			}
+		}
+		// End IAM SAML Providers
+
 		// IAM Roles
 		iamRoles := IAMRoles{}
 		if IsNukeable(iamRoles.ResourceName(), resourceTypes) {
@@ -1865,6 +4819,37 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 		}
 		// End IAM Roles
 
+		// IAM Instance Profiles
+		iamInstanceProfiles := IAMInstanceProfiles{}
+		if IsNukeable(iamInstanceProfiles.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing IAM Instance Profiles",
+			}, map[string]interface{}{
+				"region": "global",
+			})
+			// Instance profiles of the roles being nuked will be left without a role
+			profileNames, err := getAllIamInstanceProfiles(session, excludeAfter, configObj, iamRoles.RoleNames)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve IAM instance profiles",
+					ResourceType: iamInstanceProfiles.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing IAM Instance Profiles",
+			}, map[string]interface{}{
+				"region":      "global",
+				"recordCount": len(profileNames),
+			})
+			if len(profileNames) > 0 {
+				iamInstanceProfiles.InstanceProfileNames = awsgo.StringValueSlice(profileNames)
+				globalResources.Resources = append(globalResources.Resources, iamInstanceProfiles)
+			}
+		}
+		// End IAM Instance Profiles
+
 		// IAM Service Linked Roles
 		iamServiceLinkedRoles := IAMServiceLinkedRoles{}
 		if IsNukeable(iamServiceLinkedRoles.ResourceName(), resourceTypes) {
@@ -1895,6 +4880,126 @@ func GetAllResources(targetRegions []string, excludeAfter time.Time, resourceTyp
 		}
 		// End IAM Service Linked Roles
 
+		// Route53 Hosted Zones
+		route53HostedZones := Route53HostedZones{}
+		if IsNukeable(route53HostedZones.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Route53 Hosted Zones",
+			}, map[string]interface{}{
+				"region": "global",
+			})
+			hostedZoneIds, err := getAllRoute53HostedZones(session, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Route53 hosted zones",
+					ResourceType: route53HostedZones.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Route53 Hosted Zones",
+			}, map[string]interface{}{
+				"region":      "global",
+				"recordCount": len(hostedZoneIds),
+			})
+			if len(hostedZoneIds) > 0 {
+				route53HostedZones.HostedZoneIds = awsgo.StringValueSlice(hostedZoneIds)
+				globalResources.Resources = append(globalResources.Resources, route53HostedZones)
+			}
+		}
+		// End Route53 Hosted Zones
+
+		// CloudFront Distributions
+		cloudFrontDistributions := CloudFrontDistributions{}
+		if IsNukeable(cloudFrontDistributions.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing CloudFront Distributions",
+			}, map[string]interface{}{
+				"region": "global",
+			})
+			distributionIds, err := getAllCloudFrontDistributions(session, excludeAfter)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve CloudFront distributions",
+					ResourceType: cloudFrontDistributions.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing CloudFront Distributions",
+			}, map[string]interface{}{
+				"region":      "global",
+				"recordCount": len(distributionIds),
+			})
+			if len(distributionIds) > 0 {
+				cloudFrontDistributions.DistributionIds = awsgo.StringValueSlice(distributionIds)
+				globalResources.Resources = append(globalResources.Resources, cloudFrontDistributions)
+			}
+		}
+		// End CloudFront Distributions
+
+		// ECR Public Repositories
+		ecrPublicRepositories := ECRPublic{}
+		if IsNukeable(ecrPublicRepositories.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing ECR Public Repositories",
+			}, map[string]interface{}{
+				"region": "global",
+			})
+			ecrPublicRepositoryNames, err := getAllECRPublicRepositories(session, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve ECR Public repositories",
+					ResourceType: ecrPublicRepositories.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing ECR Public Repositories",
+			}, map[string]interface{}{
+				"region":      "global",
+				"recordCount": len(ecrPublicRepositoryNames),
+			})
+			if len(ecrPublicRepositoryNames) > 0 {
+				ecrPublicRepositories.RepositoryNames = ecrPublicRepositoryNames
+				globalResources.Resources = append(globalResources.Resources, ecrPublicRepositories)
+			}
+		}
+		// End ECR Public Repositories
+
+		// Global Accelerators
+		globalAccelerators := GlobalAccelerators{}
+		if IsNukeable(globalAccelerators.ResourceName(), resourceTypes) {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Listing Global Accelerators",
+			}, map[string]interface{}{
+				"region": "global",
+			})
+			acceleratorArns, err := getAllGlobalAccelerators(session, excludeAfter, configObj)
+			if err != nil {
+				ge := report.GeneralError{
+					Error:        err,
+					Description:  "Unable to retrieve Global Accelerators",
+					ResourceType: globalAccelerators.ResourceName(),
+				}
+				report.RecordError(ge)
+			}
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Done Listing Global Accelerators",
+			}, map[string]interface{}{
+				"region":      "global",
+				"recordCount": len(acceleratorArns),
+			})
+			if len(acceleratorArns) > 0 {
+				globalAccelerators.AcceleratorArns = awsgo.StringValueSlice(acceleratorArns)
+				globalResources.Resources = append(globalResources.Resources, globalAccelerators)
+			}
+		}
+		// End Global Accelerators
+
 		if len(globalResources.Resources) > 0 {
 			account.Resources[GlobalRegion] = globalResources
 		}
@@ -1923,40 +5028,140 @@ func ListResourceTypes() []string {
 		Snapshots{}.ResourceName(),
 		ECSClusters{}.ResourceName(),
 		ECSServices{}.ResourceName(),
+		ECSTaskDefinitions{}.ResourceName(),
 		EKSClusters{}.ResourceName(),
 		DBInstances{}.ResourceName(),
+		RdsSnapshots{}.ResourceName(),
+		RdsClusterSnapshots{}.ResourceName(),
+		RdsProxies{}.ResourceName(),
+		RdsParameterGroups{}.ResourceName(),
+		RdsOptionGroups{}.ResourceName(),
+		RdsSubnetGroups{}.ResourceName(),
 		LambdaFunctions{}.ResourceName(),
+		LambdaLayerVersions{}.ResourceName(),
+		SfnStateMachines{}.ResourceName(),
 		S3Buckets{}.ResourceName(),
 		IAMUsers{}.ResourceName(),
 		IAMRoles{}.ResourceName(),
+		IAMInstanceProfiles{}.ResourceName(),
 		IAMGroups{}.ResourceName(),
 		IAMPolicies{}.ResourceName(),
 		IAMServiceLinkedRoles{}.ResourceName(),
+		Route53HostedZones{}.ResourceName(),
+		CloudFrontDistributions{}.ResourceName(),
+		ECRPublic{}.ResourceName(),
+		GlobalAccelerators{}.ResourceName(),
 		SecretsManagerSecrets{}.ResourceName(),
 		NatGateways{}.ResourceName(),
 		OpenSearchDomains{}.ResourceName(),
 		CloudWatchDashboards{}.ResourceName(),
 		AccessAnalyzer{}.ResourceName(),
 		DynamoDB{}.ResourceName(),
+		DynamoDBBackups{}.ResourceName(),
 		EC2VPCs{}.ResourceName(),
+		VPCEndpoints{}.ResourceName(),
+		InternetGateways{}.ResourceName(),
+		PlacementGroups{}.ResourceName(),
+		NetworkInterfaces{}.ResourceName(),
+		SecurityGroups{}.ResourceName(),
 		Elasticaches{}.ResourceName(),
+		ElasticacheServerlessCaches{}.ResourceName(),
+		ElasticacheUserGroups{}.ResourceName(),
+		ElasticacheUsers{}.ResourceName(),
 		OIDCProviders{}.ResourceName(),
+		SAMLProviders{}.ResourceName(),
 		KmsCustomerKeys{}.ResourceName(),
 		CloudWatchLogGroups{}.ResourceName(),
 		GuardDuty{}.ResourceName(),
 		MacieMember{}.ResourceName(),
+		MacieClassificationJobs{}.ResourceName(),
+		MacieSession{}.ResourceName(),
+		SecurityHub{}.ResourceName(),
+		Inspector2{}.ResourceName(),
 		SageMakerNotebookInstances{}.ResourceName(),
+		SageMakerEndpoints{}.ResourceName(),
+		SageMakerModels{}.ResourceName(),
+		SageMakerStudioDomains{}.ResourceName(),
 		KinesisStreams{}.ResourceName(),
+		KinesisFirehoses{}.ResourceName(),
+		MSKClusters{}.ResourceName(),
+		RedshiftClusters{}.ResourceName(),
+		RedshiftSnapshots{}.ResourceName(),
+		RedshiftServerlessNamespaces{}.ResourceName(),
+		GlueJobs{}.ResourceName(),
+		GlueCrawlers{}.ResourceName(),
+		GlueDatabases{}.ResourceName(),
+		GlueDevEndpoints{}.ResourceName(),
+		AthenaWorkgroups{}.ResourceName(),
 		ApiGateway{}.ResourceName(),
 		ApiGatewayV2{}.ResourceName(),
+		AppSyncGraphqlApis{}.ResourceName(),
+		CognitoUserPools{}.ResourceName(),
+		CognitoIdentityPools{}.ResourceName(),
+		ACMCertificates{}.ResourceName(),
+		WAFv2WebACLs{}.ResourceName(),
+		WAFv2RuleGroups{}.ResourceName(),
+		WAFv2IPSets{}.ResourceName(),
+		LightsailLoadBalancers{}.ResourceName(),
+		LightsailInstances{}.ResourceName(),
+		LightsailStaticIps{}.ResourceName(),
+		LightsailDatabases{}.ResourceName(),
+		BatchJobQueues{}.ResourceName(),
+		BatchComputeEnvironments{}.ResourceName(),
+		EMRClusters{}.ResourceName(),
+		OpenSearchServerlessCollections{}.ResourceName(),
+		NeptuneClusters{}.ResourceName(),
+		DocDBClusters{}.ResourceName(),
+		TimestreamDatabases{}.ResourceName(),
+		QLDBLedgers{}.ResourceName(),
+		MQBrokers{}.ResourceName(),
+		CodeBuildProjects{}.ResourceName(),
+		CodePipelinePipelines{}.ResourceName(),
+		CodeCommitRepositories{}.ResourceName(),
+		CodeDeployApplications{}.ResourceName(),
+		EventBridgeRules{}.ResourceName(),
+		EventBridgeBuses{}.ResourceName(),
+		EventBridgeSchedules{}.ResourceName(),
+		EventBridgeScheduleGroups{}.ResourceName(),
+		SSMParameters{}.ResourceName(),
+		SSMAssociations{}.ResourceName(),
+		SSMDocuments{}.ResourceName(),
+		BackupPlans{}.ResourceName(),
+		BackupVaults{}.ResourceName(),
+		StorageGateways{}.ResourceName(),
+		DataSyncTasks{}.ResourceName(),
+		DataSyncLocations{}.ResourceName(),
+		DataSyncAgents{}.ResourceName(),
+		TransferServers{}.ResourceName(),
+		AppRunnerServices{}.ResourceName(),
+		AppRunnerAutoScalingConfigurations{}.ResourceName(),
+		AppRunnerVpcConnectors{}.ResourceName(),
+		AmplifyApps{}.ResourceName(),
+		IoTCertificates{}.ResourceName(),
+		IoTThings{}.ResourceName(),
+		IoTThingGroups{}.ResourceName(),
+		IoTPolicies{}.ResourceName(),
+		Workspaces{}.ResourceName(),
+		WorkspaceDirectories{}.ResourceName(),
+		AppStreamFleets{}.ResourceName(),
+		AppStreamStacks{}.ResourceName(),
+		AppStreamImageBuilders{}.ResourceName(),
+		ServiceCatalogProvisionedProducts{}.ResourceName(),
+		ServiceCatalogProducts{}.ResourceName(),
+		ServiceCatalogPortfolios{}.ResourceName(),
 		ElasticFileSystem{}.ResourceName(),
+		FSxFileSystems{}.ResourceName(),
+		ElasticBeanstalkApplications{}.ResourceName(),
+		CloudFormationStacks{}.ResourceName(),
 		SNSTopic{}.ResourceName(),
 		CloudtrailTrail{}.ResourceName(),
 		EC2KeyPairs{}.ResourceName(),
 		ECR{}.ResourceName(),
 		LaunchTemplates{}.ResourceName(),
+		ConfigConformancePacks{}.ResourceName(),
 		ConfigServiceRule{}.ResourceName(),
 		ConfigServiceRecorders{}.ResourceName(),
+		ConfigDeliveryChannels{}.ResourceName(),
 		CloudWatchAlarms{}.ResourceName(),
 	}
 	sort.Strings(resourceTypes)
@@ -0,0 +1,276 @@
+package aws
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/backup/backupiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Backup vaults created and managed by AWS services, such as aws/efs/automatic-backup-vault, can't be deleted
+const awsManagedBackupVaultPrefix = "aws/"
+
+// Returns a formatted string of AWS Backup plan IDs
+func getAllBackupPlans(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := backup.New(session)
+
+	var plans []*backup.PlansListMember
+	err := svc.ListBackupPlansPages(&backup.ListBackupPlansInput{}, func(page *backup.ListBackupPlansOutput, lastPage bool) bool {
+		for _, plan := range page.BackupPlansList {
+			if shouldIncludeBackupPlan(plan, excludeAfter, configObj) {
+				plans = append(plans, plan)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var ids []*string
+	for _, plan := range plans {
+		exclude, err := hasBackupExcludeTag(svc, plan.BackupPlanArn)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !exclude {
+			ids = append(ids, plan.BackupPlanId)
+		}
+	}
+	return ids, nil
+}
+
+func shouldIncludeBackupPlan(plan *backup.PlansListMember, excludeAfter time.Time, configObj config.Config) bool {
+	if plan == nil || plan.DeletionDate != nil {
+		return false
+	}
+
+	if plan.CreationDate != nil && excludeAfter.Before(*plan.CreationDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(plan.BackupPlanName),
+		configObj.BackupPlan.IncludeRule.NamesRegExp,
+		configObj.BackupPlan.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Returns a formatted string of AWS Backup vault names. Vaults managed by AWS services are skipped, and so are locked
+// vaults, as the vault lock prevents deleting their recovery points before the end of their retention period.
+func getAllBackupVaults(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := backup.New(session)
+
+	var vaults []*backup.VaultListMember
+	err := svc.ListBackupVaultsPages(&backup.ListBackupVaultsInput{}, func(page *backup.ListBackupVaultsOutput, lastPage bool) bool {
+		for _, vault := range page.BackupVaultList {
+			if shouldIncludeBackupVault(vault, excludeAfter, configObj) {
+				vaults = append(vaults, vault)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, vault := range vaults {
+		exclude, err := hasBackupExcludeTag(svc, vault.BackupVaultArn)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !exclude {
+			names = append(names, vault.BackupVaultName)
+		}
+	}
+	return names, nil
+}
+
+func shouldIncludeBackupVault(vault *backup.VaultListMember, excludeAfter time.Time, configObj config.Config) bool {
+	if vault == nil || strings.HasPrefix(aws.StringValue(vault.BackupVaultName), awsManagedBackupVaultPrefix) {
+		return false
+	}
+
+	if aws.BoolValue(vault.Locked) {
+		logging.Logger.Debugf("Skipping locked backup vault %s", aws.StringValue(vault.BackupVaultName))
+		return false
+	}
+
+	if vault.CreationDate != nil && excludeAfter.Before(*vault.CreationDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(vault.BackupVaultName),
+		configObj.BackupVault.IncludeRule.NamesRegExp,
+		configObj.BackupVault.ExcludeRule.NamesRegExp,
+	)
+}
+
+func hasBackupExcludeTag(svc backupiface.BackupAPI, resourceArn *string) (bool, error) {
+	exclude := false
+	err := svc.ListTagsPages(&backup.ListTagsInput{ResourceArn: resourceArn}, func(page *backup.ListTagsOutput, lastPage bool) bool {
+		if aws.StringValue(page.Tags[AwsResourceExclusionTagKey]) == "true" {
+			exclude = true
+		}
+		return !lastPage
+	})
+	return exclude, errors.WithStackTrace(err)
+}
+
+// nukeBackupPlan deletes the selections of the backup plan, as a plan can't be deleted while it still has selections,
+// and then the plan itself.
+func nukeBackupPlan(svc backupiface.BackupAPI, planId *string) error {
+	var selectionIds []*string
+	err := svc.ListBackupSelectionsPages(&backup.ListBackupSelectionsInput{BackupPlanId: planId}, func(page *backup.ListBackupSelectionsOutput, lastPage bool) bool {
+		for _, selection := range page.BackupSelectionsList {
+			selectionIds = append(selectionIds, selection.SelectionId)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, selectionId := range selectionIds {
+		_, err := svc.DeleteBackupSelection(&backup.DeleteBackupSelectionInput{
+			BackupPlanId: planId,
+			SelectionId:  selectionId,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted selection %s of backup plan %s", aws.StringValue(selectionId), aws.StringValue(planId))
+	}
+
+	_, err = svc.DeleteBackupPlan(&backup.DeleteBackupPlanInput{BackupPlanId: planId})
+	return errors.WithStackTrace(err)
+}
+
+func listBackupVaultRecoveryPoints(svc backupiface.BackupAPI, vaultName *string) ([]*backup.RecoveryPointByBackupVault, error) {
+	var recoveryPoints []*backup.RecoveryPointByBackupVault
+	err := svc.ListRecoveryPointsByBackupVaultPages(
+		&backup.ListRecoveryPointsByBackupVaultInput{BackupVaultName: vaultName},
+		func(page *backup.ListRecoveryPointsByBackupVaultOutput, lastPage bool) bool {
+			recoveryPoints = append(recoveryPoints, page.RecoveryPoints...)
+			return !lastPage
+		},
+	)
+	return recoveryPoints, errors.WithStackTrace(err)
+}
+
+// waitForBackupVaultToBeEmpty waits until the recovery points of the vault are deleted, which happens asynchronously,
+// as a vault can't be deleted while it still has recovery points.
+func waitForBackupVaultToBeEmpty(svc backupiface.BackupAPI, vaultName *string) error {
+	for i := 0; i < 60; i++ {
+		recoveryPoints, err := listBackupVaultRecoveryPoints(svc, vaultName)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		if len(recoveryPoints) == 0 {
+			return nil
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for %d recovery point(s) of backup vault %s to be deleted", len(recoveryPoints), aws.StringValue(vaultName))
+	}
+
+	return BackupVaultRecoveryPointsDeleteTimeoutError{name: aws.StringValue(vaultName)}
+}
+
+// nukeBackupVault deletes the recovery points of the backup vault and then the vault itself
+func nukeBackupVault(svc backupiface.BackupAPI, vaultName *string) error {
+	recoveryPoints, err := listBackupVaultRecoveryPoints(svc, vaultName)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, recoveryPoint := range recoveryPoints {
+		if aws.StringValue(recoveryPoint.Status) == backup.RecoveryPointStatusDeleting {
+			continue
+		}
+
+		_, err := svc.DeleteRecoveryPoint(&backup.DeleteRecoveryPointInput{
+			BackupVaultName:  vaultName,
+			RecoveryPointArn: recoveryPoint.RecoveryPointArn,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted recovery point %s of backup vault %s", aws.StringValue(recoveryPoint.RecoveryPointArn), aws.StringValue(vaultName))
+	}
+
+	if len(recoveryPoints) > 0 {
+		if err := waitForBackupVaultToBeEmpty(svc, vaultName); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	_, err = svc.DeleteBackupVault(&backup.DeleteBackupVaultInput{BackupVaultName: vaultName})
+	return errors.WithStackTrace(err)
+}
+
+// nukeBackupResources deletes the given AWS Backup plans or vaults using deleteFn, recording the status of each of
+// them.
+func nukeBackupResources(session *session.Session, resourceType string, identifiers []*string, deleteFn func(svc backupiface.BackupAPI, identifier *string) error) error {
+	svc := backup.New(session)
+
+	if len(identifiers) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedIdentifiers []*string
+	var allErrs *multierror.Error
+
+	for _, identifier := range identifiers {
+		err := deleteFn(svc, identifier)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(identifier),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIdentifiers = append(deletedIdentifiers, identifier)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(identifier))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedIdentifiers), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// Deletes all AWS Backup plans, along with their selections
+func nukeAllBackupPlans(session *session.Session, ids []*string) error {
+	return nukeBackupResources(session, "Backup Plan", ids, nukeBackupPlan)
+}
+
+// Deletes all AWS Backup vaults, along with their recovery points
+func nukeAllBackupVaults(session *session.Session, names []*string) error {
+	return nukeBackupResources(session, "Backup Vault", names, nukeBackupVault)
+}
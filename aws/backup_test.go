@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/backup/backupiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldIncludeBackupVault(t *testing.T) {
+	vault := func(name string, locked bool) *backup.VaultListMember {
+		return &backup.VaultListMember{
+			BackupVaultName: aws.String(name),
+			Locked:          aws.Bool(locked),
+			CreationDate:    aws.Time(time.Now()),
+		}
+	}
+
+	assert.True(t, shouldIncludeBackupVault(vault("cloud-nuke-test", false), time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeBackupVault(vault("cloud-nuke-test", false), time.Now().Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeBackupVault(vault("cloud-nuke-test", true), time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeBackupVault(vault("aws/efs/automatic-backup-vault", false), time.Now().Add(1*time.Hour), config.Config{}))
+}
+
+type mockedBackupPlan struct {
+	backupiface.BackupAPI
+	Calls []string
+}
+
+func (m *mockedBackupPlan) ListBackupSelectionsPages(input *backup.ListBackupSelectionsInput, fn func(*backup.ListBackupSelectionsOutput, bool) bool) error {
+	fn(&backup.ListBackupSelectionsOutput{BackupSelectionsList: []*backup.SelectionsListMember{
+		{SelectionId: aws.String("selection-1")},
+		{SelectionId: aws.String("selection-2")},
+	}}, true)
+	return nil
+}
+
+func (m *mockedBackupPlan) DeleteBackupSelection(input *backup.DeleteBackupSelectionInput) (*backup.DeleteBackupSelectionOutput, error) {
+	m.Calls = append(m.Calls, "delete-selection/"+aws.StringValue(input.SelectionId))
+	return &backup.DeleteBackupSelectionOutput{}, nil
+}
+
+func (m *mockedBackupPlan) DeleteBackupPlan(input *backup.DeleteBackupPlanInput) (*backup.DeleteBackupPlanOutput, error) {
+	m.Calls = append(m.Calls, "delete-plan/"+aws.StringValue(input.BackupPlanId))
+	return &backup.DeleteBackupPlanOutput{}, nil
+}
+
+func TestNukeBackupPlanDeletesSelectionsFirst(t *testing.T) {
+	svc := &mockedBackupPlan{}
+	require.NoError(t, nukeBackupPlan(svc, aws.String("plan")))
+	assert.Equal(t, []string{"delete-selection/selection-1", "delete-selection/selection-2", "delete-plan/plan"}, svc.Calls)
+}
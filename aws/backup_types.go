@@ -0,0 +1,73 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// BackupPlans - represents all AWS Backup plans
+type BackupPlans struct {
+	PlanIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (plans BackupPlans) ResourceName() string {
+	return "backup-plan"
+}
+
+// ResourceIdentifiers - The IDs of the AWS Backup plans
+func (plans BackupPlans) ResourceIdentifiers() []string {
+	return plans.PlanIds
+}
+
+func (plans BackupPlans) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (plans BackupPlans) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllBackupPlans(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// BackupVaults - represents all AWS Backup vaults that aren't managed by AWS services or locked
+type BackupVaults struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (vaults BackupVaults) ResourceName() string {
+	return "backup-vault"
+}
+
+// ResourceIdentifiers - The names of the AWS Backup vaults
+func (vaults BackupVaults) ResourceIdentifiers() []string {
+	return vaults.Names
+}
+
+func (vaults BackupVaults) MaxBatchSize() int {
+	// Deleting the recovery points of a vault takes a while, so we keep the batches small
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (vaults BackupVaults) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllBackupVaults(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type BackupVaultRecoveryPointsDeleteTimeoutError struct {
+	name string
+}
+
+func (e BackupVaultRecoveryPointsDeleteTimeoutError) Error() string {
+	return "Timed out waiting for the recovery points of backup vault " + e.name + " to be deleted"
+}
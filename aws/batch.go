@@ -0,0 +1,310 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/batch/batchiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of Batch job queue names. Job queues don't expose a creation time, so the first seen tag
+// is used for the excludeAfter filter instead.
+func getAllBatchJobQueues(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := batch.New(session)
+
+	var jobQueues []*batch.JobQueueDetail
+	err := svc.DescribeJobQueuesPages(&batch.DescribeJobQueuesInput{}, func(page *batch.DescribeJobQueuesOutput, lastPage bool) bool {
+		for _, jobQueue := range page.JobQueues {
+			if shouldIncludeBatchResource(aws.StringValue(jobQueue.JobQueueName), aws.StringValue(jobQueue.Status), jobQueue.Tags, configObj.BatchJobQueue) {
+				jobQueues = append(jobQueues, jobQueue)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, jobQueue := range jobQueues {
+		firstSeenTime, err := getOrSetFirstSeenBatchTag(svc, jobQueue.JobQueueArn, jobQueue.Tags)
+		if err != nil {
+			logging.Logger.Errorf("Unable to tag Batch job queue %s: %s", aws.StringValue(jobQueue.JobQueueName), err)
+			return nil, err
+		}
+		if excludeAfter.After(firstSeenTime) {
+			names = append(names, jobQueue.JobQueueName)
+		}
+	}
+	return names, nil
+}
+
+// Returns a formatted string of Batch compute environment names. Compute environments don't expose a creation time,
+// so the first seen tag is used for the excludeAfter filter instead.
+func getAllBatchComputeEnvironments(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := batch.New(session)
+
+	var computeEnvironments []*batch.ComputeEnvironmentDetail
+	err := svc.DescribeComputeEnvironmentsPages(&batch.DescribeComputeEnvironmentsInput{}, func(page *batch.DescribeComputeEnvironmentsOutput, lastPage bool) bool {
+		for _, computeEnvironment := range page.ComputeEnvironments {
+			if shouldIncludeBatchResource(aws.StringValue(computeEnvironment.ComputeEnvironmentName), aws.StringValue(computeEnvironment.Status), computeEnvironment.Tags, configObj.BatchComputeEnvironment) {
+				computeEnvironments = append(computeEnvironments, computeEnvironment)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, computeEnvironment := range computeEnvironments {
+		firstSeenTime, err := getOrSetFirstSeenBatchTag(svc, computeEnvironment.ComputeEnvironmentArn, computeEnvironment.Tags)
+		if err != nil {
+			logging.Logger.Errorf("Unable to tag Batch compute environment %s: %s", aws.StringValue(computeEnvironment.ComputeEnvironmentName), err)
+			return nil, err
+		}
+		if excludeAfter.After(firstSeenTime) {
+			names = append(names, computeEnvironment.ComputeEnvironmentName)
+		}
+	}
+	return names, nil
+}
+
+// shouldIncludeBatchResource skips job queues and compute environments that are already being deleted, along with
+// the ones carrying the exclusion tag or filtered out by name. The job queue and compute environment statuses share
+// the same values.
+func shouldIncludeBatchResource(name string, status string, tags map[string]*string, resourceType config.ResourceType) bool {
+	if status == batch.JQStatusDeleting || status == batch.JQStatusDeleted {
+		return false
+	}
+
+	if aws.StringValue(tags[AwsResourceExclusionTagKey]) == "true" {
+		return false
+	}
+
+	return config.ShouldInclude(
+		name,
+		resourceType.IncludeRule.NamesRegExp,
+		resourceType.ExcludeRule.NamesRegExp,
+	)
+}
+
+// getOrSetFirstSeenBatchTag returns the time cloud-nuke first saw the Batch resource, tagging it with the current time
+// the first time it is seen.
+func getOrSetFirstSeenBatchTag(svc batchiface.BatchAPI, resourceArn *string, tags map[string]*string) (time.Time, error) {
+	if value, ok := tags[firstSeenTagKey]; ok {
+		firstSeenTime, err := time.Parse(time.RFC3339, aws.StringValue(value))
+		if err != nil {
+			return time.Time{}, errors.WithStackTrace(err)
+		}
+		return firstSeenTime, nil
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.TagResource(&batch.TagResourceInput{
+		ResourceArn: resourceArn,
+		Tags:        map[string]*string{firstSeenTagKey: aws.String(now.Format(time.RFC3339))},
+	})
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return now, nil
+}
+
+// waitForBatchResource polls isDone until it reports the job queue or compute environment reached the expected state.
+// Batch takes a few minutes to apply state changes and deletions.
+func waitForBatchResource(description string, isDone func() (bool, error)) error {
+	for i := 0; i < 60; i++ {
+		done, err := isDone()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for %s...", description)
+	}
+
+	return BatchTimeoutError{description: description}
+}
+
+func describeBatchJobQueue(svc batchiface.BatchAPI, name *string) (*batch.JobQueueDetail, error) {
+	output, err := svc.DescribeJobQueues(&batch.DescribeJobQueuesInput{JobQueues: []*string{name}})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	if len(output.JobQueues) == 0 {
+		return nil, nil
+	}
+	return output.JobQueues[0], nil
+}
+
+// nukeBatchJobQueue disables the job queue, so it stops accepting jobs, and deletes it once that is applied. The
+// deletion is awaited, as the compute environments of the queue can't be deleted while they are attached to it.
+func nukeBatchJobQueue(svc batchiface.BatchAPI, name *string) error {
+	jobQueue, err := describeBatchJobQueue(svc, name)
+	if err != nil {
+		return err
+	}
+
+	if jobQueue != nil && aws.StringValue(jobQueue.State) != batch.JQStateDisabled {
+		_, err := svc.UpdateJobQueue(&batch.UpdateJobQueueInput{JobQueue: name, State: aws.String(batch.JQStateDisabled)})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	err = waitForBatchResource("Batch job queue "+aws.StringValue(name)+" to be disabled", func() (bool, error) {
+		jobQueue, err := describeBatchJobQueue(svc, name)
+		if err != nil || jobQueue == nil {
+			return jobQueue == nil, err
+		}
+		return aws.StringValue(jobQueue.State) == batch.JQStateDisabled && aws.StringValue(jobQueue.Status) != batch.JQStatusUpdating, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := svc.DeleteJobQueue(&batch.DeleteJobQueueInput{JobQueue: name}); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return waitForBatchResource("Batch job queue "+aws.StringValue(name)+" to be deleted", func() (bool, error) {
+		jobQueue, err := describeBatchJobQueue(svc, name)
+		if err != nil || jobQueue == nil {
+			return jobQueue == nil, err
+		}
+		return aws.StringValue(jobQueue.Status) == batch.JQStatusDeleted, nil
+	})
+}
+
+func describeBatchComputeEnvironment(svc batchiface.BatchAPI, name *string) (*batch.ComputeEnvironmentDetail, error) {
+	output, err := svc.DescribeComputeEnvironments(&batch.DescribeComputeEnvironmentsInput{ComputeEnvironments: []*string{name}})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	if len(output.ComputeEnvironments) == 0 {
+		return nil, nil
+	}
+	return output.ComputeEnvironments[0], nil
+}
+
+// isBatchComputeEnvironmentAttached returns whether any job queue that isn't deleted yet still uses the compute
+// environment.
+func isBatchComputeEnvironmentAttached(svc batchiface.BatchAPI, computeEnvironmentArn *string) (bool, error) {
+	attached := false
+	err := svc.DescribeJobQueuesPages(&batch.DescribeJobQueuesInput{}, func(page *batch.DescribeJobQueuesOutput, lastPage bool) bool {
+		for _, jobQueue := range page.JobQueues {
+			if aws.StringValue(jobQueue.Status) == batch.JQStatusDeleted {
+				continue
+			}
+			for _, order := range jobQueue.ComputeEnvironmentOrder {
+				if aws.StringValue(order.ComputeEnvironment) == aws.StringValue(computeEnvironmentArn) {
+					attached = true
+				}
+			}
+		}
+		return !attached && !lastPage
+	})
+	return attached, errors.WithStackTrace(err)
+}
+
+// nukeBatchComputeEnvironment disables the compute environment, waits for the job queues using it to be deleted and
+// then deletes it.
+func nukeBatchComputeEnvironment(svc batchiface.BatchAPI, name *string) error {
+	computeEnvironment, err := describeBatchComputeEnvironment(svc, name)
+	if err != nil || computeEnvironment == nil {
+		return err
+	}
+
+	if aws.StringValue(computeEnvironment.State) != batch.CEStateDisabled {
+		_, err := svc.UpdateComputeEnvironment(&batch.UpdateComputeEnvironmentInput{ComputeEnvironment: name, State: aws.String(batch.CEStateDisabled)})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	err = waitForBatchResource("Batch compute environment "+aws.StringValue(name)+" to be disabled and detached from its job queues", func() (bool, error) {
+		computeEnvironment, err := describeBatchComputeEnvironment(svc, name)
+		if err != nil || computeEnvironment == nil {
+			return computeEnvironment == nil, err
+		}
+		if aws.StringValue(computeEnvironment.State) != batch.CEStateDisabled || aws.StringValue(computeEnvironment.Status) == batch.CEStatusUpdating {
+			return false, nil
+		}
+
+		attached, err := isBatchComputeEnvironmentAttached(svc, computeEnvironment.ComputeEnvironmentArn)
+		return !attached, err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = svc.DeleteComputeEnvironment(&batch.DeleteComputeEnvironmentInput{ComputeEnvironment: name})
+	return errors.WithStackTrace(err)
+}
+
+// Deletes all Batch job queues
+func nukeAllBatchJobQueues(session *session.Session, names []*string) error {
+	return nukeBatchResources(session, "Batch Job Queue", names, nukeBatchJobQueue)
+}
+
+// Deletes all Batch compute environments
+func nukeAllBatchComputeEnvironments(session *session.Session, names []*string) error {
+	return nukeBatchResources(session, "Batch Compute Environment", names, nukeBatchComputeEnvironment)
+}
+
+// nukeBatchResources deletes the given Batch resources one by one using deleteFn, recording each of them in the report.
+func nukeBatchResources(session *session.Session, resourceType string, names []*string, deleteFn func(svc batchiface.BatchAPI, name *string) error) error {
+	svc := batch.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		err := deleteFn(svc, name)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedNames), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/batch/batchiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedBatch serves a single enabled job queue attached to a single enabled compute environment. Updates and deletes
+// are applied right away.
+type mockedBatch struct {
+	batchiface.BatchAPI
+	JobQueue           *batch.JobQueueDetail
+	ComputeEnvironment *batch.ComputeEnvironmentDetail
+	Calls              []string
+}
+
+func (m *mockedBatch) DescribeJobQueues(input *batch.DescribeJobQueuesInput) (*batch.DescribeJobQueuesOutput, error) {
+	return &batch.DescribeJobQueuesOutput{JobQueues: []*batch.JobQueueDetail{m.JobQueue}}, nil
+}
+
+func (m *mockedBatch) DescribeJobQueuesPages(input *batch.DescribeJobQueuesInput, fn func(*batch.DescribeJobQueuesOutput, bool) bool) error {
+	fn(&batch.DescribeJobQueuesOutput{JobQueues: []*batch.JobQueueDetail{m.JobQueue}}, true)
+	return nil
+}
+
+func (m *mockedBatch) UpdateJobQueue(input *batch.UpdateJobQueueInput) (*batch.UpdateJobQueueOutput, error) {
+	m.Calls = append(m.Calls, "disable:"+aws.StringValue(input.JobQueue))
+	m.JobQueue.State = input.State
+	return &batch.UpdateJobQueueOutput{}, nil
+}
+
+func (m *mockedBatch) DeleteJobQueue(input *batch.DeleteJobQueueInput) (*batch.DeleteJobQueueOutput, error) {
+	m.Calls = append(m.Calls, "delete:"+aws.StringValue(input.JobQueue))
+	m.JobQueue.Status = aws.String(batch.JQStatusDeleted)
+	return &batch.DeleteJobQueueOutput{}, nil
+}
+
+func (m *mockedBatch) DescribeComputeEnvironments(input *batch.DescribeComputeEnvironmentsInput) (*batch.DescribeComputeEnvironmentsOutput, error) {
+	return &batch.DescribeComputeEnvironmentsOutput{ComputeEnvironments: []*batch.ComputeEnvironmentDetail{m.ComputeEnvironment}}, nil
+}
+
+func (m *mockedBatch) UpdateComputeEnvironment(input *batch.UpdateComputeEnvironmentInput) (*batch.UpdateComputeEnvironmentOutput, error) {
+	m.Calls = append(m.Calls, "disable:"+aws.StringValue(input.ComputeEnvironment))
+	m.ComputeEnvironment.State = input.State
+	return &batch.UpdateComputeEnvironmentOutput{}, nil
+}
+
+func (m *mockedBatch) DeleteComputeEnvironment(input *batch.DeleteComputeEnvironmentInput) (*batch.DeleteComputeEnvironmentOutput, error) {
+	m.Calls = append(m.Calls, "delete:"+aws.StringValue(input.ComputeEnvironment))
+	return &batch.DeleteComputeEnvironmentOutput{}, nil
+}
+
+func TestNukeBatchJobQueueThenComputeEnvironment(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedBatch{
+		JobQueue: &batch.JobQueueDetail{
+			JobQueueName:            aws.String("queue"),
+			State:                   aws.String(batch.JQStateEnabled),
+			Status:                  aws.String(batch.JQStatusValid),
+			ComputeEnvironmentOrder: []*batch.ComputeEnvironmentOrder{{ComputeEnvironment: aws.String("compute-environment-arn")}},
+		},
+		ComputeEnvironment: &batch.ComputeEnvironmentDetail{
+			ComputeEnvironmentName: aws.String("compute-environment"),
+			ComputeEnvironmentArn:  aws.String("compute-environment-arn"),
+			State:                  aws.String(batch.CEStateEnabled),
+			Status:                 aws.String(batch.CEStatusValid),
+		},
+	}
+
+	attached, err := isBatchComputeEnvironmentAttached(mock, aws.String("compute-environment-arn"))
+	require.NoError(t, err)
+	assert.True(t, attached)
+
+	require.NoError(t, nukeBatchJobQueue(mock, aws.String("queue")))
+	require.NoError(t, nukeBatchComputeEnvironment(mock, aws.String("compute-environment")))
+	assert.Equal(t, []string{"disable:queue", "delete:queue", "disable:compute-environment", "delete:compute-environment"}, mock.Calls)
+}
+
+func TestShouldIncludeBatchResource(t *testing.T) {
+	assert.True(t, shouldIncludeBatchResource("queue", batch.JQStatusValid, nil, config.ResourceType{}))
+	assert.False(t, shouldIncludeBatchResource("queue", batch.JQStatusDeleting, nil, config.ResourceType{}))
+	assert.False(t, shouldIncludeBatchResource("queue", batch.JQStatusValid, map[string]*string{AwsResourceExclusionTagKey: aws.String("true")}, config.ResourceType{}))
+}
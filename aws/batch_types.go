@@ -0,0 +1,73 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// BatchJobQueues - represents all Batch job queues
+type BatchJobQueues struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (jobQueues BatchJobQueues) ResourceName() string {
+	return "batch-job-queue"
+}
+
+// ResourceIdentifiers - The names of the Batch job queues
+func (jobQueues BatchJobQueues) ResourceIdentifiers() []string {
+	return jobQueues.Names
+}
+
+func (jobQueues BatchJobQueues) MaxBatchSize() int {
+	// Job queues are disabled and deleted one at a time, waiting for each step, so keep batches small
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (jobQueues BatchJobQueues) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllBatchJobQueues(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// BatchComputeEnvironments - represents all Batch compute environments
+type BatchComputeEnvironments struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (computeEnvironments BatchComputeEnvironments) ResourceName() string {
+	return "batch-compute-environment"
+}
+
+// ResourceIdentifiers - The names of the Batch compute environments
+func (computeEnvironments BatchComputeEnvironments) ResourceIdentifiers() []string {
+	return computeEnvironments.Names
+}
+
+func (computeEnvironments BatchComputeEnvironments) MaxBatchSize() int {
+	// Compute environments are disabled and deleted one at a time, waiting for each step, so keep batches small
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (computeEnvironments BatchComputeEnvironments) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllBatchComputeEnvironments(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type BatchTimeoutError struct {
+	description string
+}
+
+func (e BatchTimeoutError) Error() string {
+	return "Timed out waiting for " + e.description
+}
@@ -0,0 +1,219 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// How many times a plain delete is attempted before giving up on, or retaining, the resources that fail to delete
+const cloudFormationStackDeleteAttempts = 2
+
+// Returns a formatted string of CloudFormation stack names
+func getAllCloudFormationStacks(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := cloudformation.New(session)
+
+	var names []*string
+	err := svc.DescribeStacksPages(&cloudformation.DescribeStacksInput{}, func(page *cloudformation.DescribeStacksOutput, lastPage bool) bool {
+		for _, stack := range page.Stacks {
+			if shouldIncludeCloudFormationStack(stack, excludeAfter, configObj) {
+				names = append(names, stack.StackName)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return names, nil
+}
+
+func shouldIncludeCloudFormationStack(stack *cloudformation.Stack, excludeAfter time.Time, configObj config.Config) bool {
+	if stack == nil {
+		return false
+	}
+
+	// Nested stacks are deleted along with their root stack
+	if stack.ParentId != nil {
+		return false
+	}
+
+	// Stacks that are already being deleted will be gone shortly
+	if aws.StringValue(stack.StackStatus) == cloudformation.StackStatusDeleteInProgress {
+		return false
+	}
+
+	if aws.BoolValue(stack.EnableTerminationProtection) && !configObj.CloudFormationStack.DisableTerminationProtection {
+		return false
+	}
+
+	if stack.CreationTime != nil && excludeAfter.Before(*stack.CreationTime) {
+		return false
+	}
+
+	if hasCloudFormationStackExcludeTag(stack) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(stack.StackName),
+		configObj.CloudFormationStack.IncludeRule.NamesRegExp,
+		configObj.CloudFormationStack.ExcludeRule.NamesRegExp,
+	)
+}
+
+// getCloudFormationStackStatus returns the status of the stack, which must be described by its ID once it is deleted
+func getCloudFormationStackStatus(svc cloudformationiface.CloudFormationAPI, stackId *string) (string, error) {
+	output, err := svc.DescribeStacks(&cloudformation.DescribeStacksInput{StackName: stackId})
+	if err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if len(output.Stacks) == 0 {
+		return cloudformation.StackStatusDeleteComplete, nil
+	}
+	return aws.StringValue(output.Stacks[0].StackStatus), nil
+}
+
+// listCloudFormationStackDeleteFailedResources returns the logical IDs of the stack resources that failed to delete
+func listCloudFormationStackDeleteFailedResources(svc cloudformationiface.CloudFormationAPI, stackId *string) ([]*string, error) {
+	var logicalIds []*string
+	err := svc.ListStackResourcesPages(&cloudformation.ListStackResourcesInput{StackName: stackId}, func(page *cloudformation.ListStackResourcesOutput, lastPage bool) bool {
+		for _, resource := range page.StackResourceSummaries {
+			if aws.StringValue(resource.ResourceStatus) == cloudformation.ResourceStatusDeleteFailed {
+				logicalIds = append(logicalIds, resource.LogicalResourceId)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return logicalIds, nil
+}
+
+// deleteCloudFormationStackAndWait deletes the stack, retaining the given resources, and returns the status the stack
+// settles in.
+func deleteCloudFormationStackAndWait(svc cloudformationiface.CloudFormationAPI, stackId *string, retainResources []*string) (string, error) {
+	_, err := svc.DeleteStack(&cloudformation.DeleteStackInput{
+		StackName:       stackId,
+		RetainResources: retainResources,
+	})
+	if err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+
+	// The waiter also fails when the stack ends up in DELETE_FAILED, so the final status is checked either way
+	if err := svc.WaitUntilStackDeleteComplete(&cloudformation.DescribeStacksInput{StackName: stackId}); err != nil {
+		logging.Logger.Debugf("Stack %s did not finish deleting: %s", aws.StringValue(stackId), err)
+	}
+	return getCloudFormationStackStatus(svc, stackId)
+}
+
+// nukeCloudFormationStack deletes the stack, first lifting termination protection if allowed to. Stacks that keep
+// ending up in DELETE_FAILED are deleted once more while retaining the failed resources, if retainFailedResources is
+// set.
+func nukeCloudFormationStack(svc cloudformationiface.CloudFormationAPI, stackName *string, disableTerminationProtection bool, retainFailedResources bool) error {
+	output, err := svc.DescribeStacks(&cloudformation.DescribeStacksInput{StackName: stackName})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(output.Stacks) == 0 {
+		return nil
+	}
+	stack := output.Stacks[0]
+
+	if aws.BoolValue(stack.EnableTerminationProtection) {
+		if !disableTerminationProtection {
+			return CloudFormationStackTerminationProtectedError{name: aws.StringValue(stackName)}
+		}
+		_, err := svc.UpdateTerminationProtection(&cloudformation.UpdateTerminationProtectionInput{
+			StackName:                   stack.StackId,
+			EnableTerminationProtection: aws.Bool(false),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	var status string
+	for i := 0; i < cloudFormationStackDeleteAttempts; i++ {
+		status, err = deleteCloudFormationStackAndWait(svc, stack.StackId, nil)
+		if err != nil {
+			return err
+		}
+		if status != cloudformation.StackStatusDeleteFailed {
+			break
+		}
+		logging.Logger.Debugf("Stack %s is in %s (attempt %d/%d)", aws.StringValue(stackName), status, i+1, cloudFormationStackDeleteAttempts)
+	}
+
+	if status == cloudformation.StackStatusDeleteFailed && retainFailedResources {
+		failedResources, err := listCloudFormationStackDeleteFailedResources(svc, stack.StackId)
+		if err != nil {
+			return err
+		}
+		logging.Logger.Debugf("Deleting stack %s while retaining %d resource(s) that failed to delete", aws.StringValue(stackName), len(failedResources))
+		status, err = deleteCloudFormationStackAndWait(svc, stack.StackId, failedResources)
+		if err != nil {
+			return err
+		}
+	}
+
+	if status != cloudformation.StackStatusDeleteComplete {
+		return CloudFormationStackDeleteError{name: aws.StringValue(stackName), status: status}
+	}
+	return nil
+}
+
+// Deletes all CloudFormation stacks
+func nukeAllCloudFormationStacks(session *session.Session, names []*string, disableTerminationProtection bool, retainFailedResources bool) error {
+	svc := cloudformation.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No CloudFormation stacks to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all CloudFormation stacks in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		err := nukeCloudFormationStack(svc, name, disableTerminationProtection, retainFailedResources)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: "CloudFormation Stack",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking CloudFormation Stack",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted CloudFormation stack: %s", aws.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d CloudFormation stack(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,70 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// CloudFormationStackNameTagKey is the tag CloudFormation adds to every resource it creates, pointing to the stack
+const CloudFormationStackNameTagKey = "aws:cloudformation:stack-name"
+
+// getParentStackName returns the name of the CloudFormation stack that created a resource, or an empty string if the
+// resource is not governed by a stack
+func getParentStackName(tags []*ec2.Tag) string {
+	for _, tag := range tags {
+		if tag != nil && aws.StringValue(tag.Key) == CloudFormationStackNameTagKey {
+			return aws.StringValue(tag.Value)
+		}
+	}
+	return ""
+}
+
+// hasCloudFormationStackExcludeTag checks whether the exclude tag is set on a stack
+func hasCloudFormationStackExcludeTag(stack *cloudformation.Stack) bool {
+	for _, tag := range stack.Tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// isProtectedByParentStack checks whether the parent stack of a resource carries the exclude tag, so that resources
+// governed by a protected stack are left alone even when they are not tagged themselves. The result is cached per
+// stack name in stackExclusions to avoid describing the same stack for every resource it owns.
+func isProtectedByParentStack(svc cloudformationiface.CloudFormationAPI, tags []*ec2.Tag, stackExclusions map[string]bool) (bool, error) {
+	stackName := getParentStackName(tags)
+	if stackName == "" {
+		return false, nil
+	}
+
+	if excluded, ok := stackExclusions[stackName]; ok {
+		return excluded, nil
+	}
+
+	output, err := svc.DescribeStacks(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		// The stack is gone (e.g. deleted with retained resources), so there is nothing to inherit from
+		if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == "ValidationError" {
+			stackExclusions[stackName] = false
+			return false, nil
+		}
+		return false, errors.WithStackTrace(err)
+	}
+
+	excluded := false
+	for _, stack := range output.Stacks {
+		if hasCloudFormationStackExcludeTag(stack) {
+			excluded = true
+		}
+	}
+	stackExclusions[stackName] = excluded
+
+	return excluded, nil
+}
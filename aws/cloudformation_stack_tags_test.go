@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedCloudFormation serves DescribeStacks from a fixed set of stack tags and counts the calls made
+type mockedCloudFormation struct {
+	cloudformationiface.CloudFormationAPI
+	StackTags map[string][]*cloudformation.Tag
+	Calls     int
+}
+
+func (m *mockedCloudFormation) DescribeStacks(input *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+	m.Calls++
+	tags, ok := m.StackTags[awsgo.StringValue(input.StackName)]
+	if !ok {
+		return nil, awserr.New("ValidationError", "Stack does not exist", nil)
+	}
+	return &cloudformation.DescribeStacksOutput{
+		Stacks: []*cloudformation.Stack{{StackName: input.StackName, Tags: tags}},
+	}, nil
+}
+
+func stackTags(stackName string) []*ec2.Tag {
+	return []*ec2.Tag{
+		{Key: awsgo.String("Name"), Value: awsgo.String("test")},
+		{Key: awsgo.String(CloudFormationStackNameTagKey), Value: awsgo.String(stackName)},
+	}
+}
+
+func TestIsProtectedByParentStack(t *testing.T) {
+	t.Parallel()
+
+	svc := &mockedCloudFormation{
+		StackTags: map[string][]*cloudformation.Tag{
+			"protected-stack": {
+				{Key: awsgo.String(AwsResourceExclusionTagKey), Value: awsgo.String("true")},
+			},
+			"plain-stack": {
+				{Key: awsgo.String("team"), Value: awsgo.String("platform")},
+			},
+		},
+	}
+	stackExclusions := map[string]bool{}
+
+	protected, err := isProtectedByParentStack(svc, stackTags("protected-stack"), stackExclusions)
+	require.NoError(t, err)
+	assert.True(t, protected)
+
+	protected, err = isProtectedByParentStack(svc, stackTags("plain-stack"), stackExclusions)
+	require.NoError(t, err)
+	assert.False(t, protected)
+
+	protected, err = isProtectedByParentStack(svc, stackTags("deleted-stack"), stackExclusions)
+	require.NoError(t, err)
+	assert.False(t, protected)
+
+	// Resources that don't belong to a stack never trigger a lookup
+	protected, err = isProtectedByParentStack(svc, []*ec2.Tag{}, stackExclusions)
+	require.NoError(t, err)
+	assert.False(t, protected)
+	assert.Equal(t, 3, svc.Calls)
+
+	// Repeated lookups are served from the cache
+	protected, err = isProtectedByParentStack(svc, stackTags("protected-stack"), stackExclusions)
+	require.NoError(t, err)
+	assert.True(t, protected)
+	assert.Equal(t, 3, svc.Calls)
+}
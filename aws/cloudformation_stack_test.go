@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedCloudFormationStack serves a single stack that only deletes successfully when its failing resource is retained
+type mockedCloudFormationStack struct {
+	cloudformationiface.CloudFormationAPI
+	Protected bool
+	Status    string
+	Deletes   [][]string
+	Unlocked  bool
+}
+
+func (m *mockedCloudFormationStack) DescribeStacks(input *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+	return &cloudformation.DescribeStacksOutput{Stacks: []*cloudformation.Stack{{
+		StackId:                     aws.String("stack-id"),
+		StackName:                   aws.String("cloud-nuke-test"),
+		StackStatus:                 aws.String(m.Status),
+		EnableTerminationProtection: aws.Bool(m.Protected),
+	}}}, nil
+}
+
+func (m *mockedCloudFormationStack) UpdateTerminationProtection(input *cloudformation.UpdateTerminationProtectionInput) (*cloudformation.UpdateTerminationProtectionOutput, error) {
+	m.Unlocked = true
+	m.Protected = aws.BoolValue(input.EnableTerminationProtection)
+	return &cloudformation.UpdateTerminationProtectionOutput{}, nil
+}
+
+func (m *mockedCloudFormationStack) DeleteStack(input *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error) {
+	retained := aws.StringValueSlice(input.RetainResources)
+	m.Deletes = append(m.Deletes, retained)
+	if len(retained) > 0 {
+		m.Status = cloudformation.StackStatusDeleteComplete
+	} else {
+		m.Status = cloudformation.StackStatusDeleteFailed
+	}
+	return &cloudformation.DeleteStackOutput{}, nil
+}
+
+func (m *mockedCloudFormationStack) WaitUntilStackDeleteComplete(input *cloudformation.DescribeStacksInput) error {
+	if m.Status == cloudformation.StackStatusDeleteFailed {
+		return awserr.New("ResourceNotReady", "failed waiting for successful resource state", nil)
+	}
+	return nil
+}
+
+func (m *mockedCloudFormationStack) ListStackResourcesPages(input *cloudformation.ListStackResourcesInput, fn func(*cloudformation.ListStackResourcesOutput, bool) bool) error {
+	fn(&cloudformation.ListStackResourcesOutput{StackResourceSummaries: []*cloudformation.StackResourceSummary{
+		{LogicalResourceId: aws.String("Bucket"), ResourceStatus: aws.String(cloudformation.ResourceStatusDeleteFailed)},
+		{LogicalResourceId: aws.String("Role"), ResourceStatus: aws.String(cloudformation.ResourceStatusDeleteComplete)},
+	}}, true)
+	return nil
+}
+
+func TestNukeCloudFormationStackRetainsRepeatedlyFailingResources(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedCloudFormationStack{Status: cloudformation.StackStatusCreateComplete}
+	require.NoError(t, nukeCloudFormationStack(mock, aws.String("cloud-nuke-test"), false, true))
+	assert.Equal(t, [][]string{{}, {}, {"Bucket"}}, mock.Deletes)
+}
+
+func TestNukeCloudFormationStackReportsRepeatedDeleteFailures(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedCloudFormationStack{Status: cloudformation.StackStatusCreateComplete}
+	err := nukeCloudFormationStack(mock, aws.String("cloud-nuke-test"), false, false)
+	assert.IsType(t, CloudFormationStackDeleteError{}, err)
+	assert.Len(t, mock.Deletes, cloudFormationStackDeleteAttempts)
+}
+
+func TestNukeCloudFormationStackTerminationProtection(t *testing.T) {
+	t.Parallel()
+
+	protected := &mockedCloudFormationStack{Status: cloudformation.StackStatusCreateComplete, Protected: true}
+	err := nukeCloudFormationStack(protected, aws.String("cloud-nuke-test"), false, true)
+	assert.IsType(t, CloudFormationStackTerminationProtectedError{}, err)
+	assert.Empty(t, protected.Deletes)
+
+	unlocked := &mockedCloudFormationStack{Status: cloudformation.StackStatusCreateComplete, Protected: true}
+	require.NoError(t, nukeCloudFormationStack(unlocked, aws.String("cloud-nuke-test"), true, true))
+	assert.True(t, unlocked.Unlocked)
+}
+
+func TestShouldIncludeCloudFormationStack(t *testing.T) {
+	now := time.Now()
+	stack := func(modify func(stack *cloudformation.Stack)) *cloudformation.Stack {
+		stack := &cloudformation.Stack{
+			StackName:    aws.String("cloud-nuke-test"),
+			StackStatus:  aws.String(cloudformation.StackStatusCreateComplete),
+			CreationTime: aws.Time(now),
+		}
+		if modify != nil {
+			modify(stack)
+		}
+		return stack
+	}
+	overrideConfig := config.Config{CloudFormationStack: config.CloudFormationStackResourceType{DisableTerminationProtection: true}}
+
+	assert.True(t, shouldIncludeCloudFormationStack(stack(nil), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeCloudFormationStack(stack(nil), now.Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeCloudFormationStack(stack(func(s *cloudformation.Stack) { s.ParentId = aws.String("parent") }), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeCloudFormationStack(stack(func(s *cloudformation.Stack) { s.StackStatus = aws.String(cloudformation.StackStatusDeleteInProgress) }), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeCloudFormationStack(stack(func(s *cloudformation.Stack) {
+		s.Tags = []*cloudformation.Tag{{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}}
+	}), now.Add(1*time.Hour), config.Config{}))
+
+	protected := stack(func(s *cloudformation.Stack) { s.EnableTerminationProtection = aws.Bool(true) })
+	assert.False(t, shouldIncludeCloudFormationStack(protected, now.Add(1*time.Hour), config.Config{}))
+	assert.True(t, shouldIncludeCloudFormationStack(protected, now.Add(1*time.Hour), overrideConfig))
+}
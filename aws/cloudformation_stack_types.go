@@ -0,0 +1,57 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// CloudFormationStacks - represents all root CloudFormation stacks
+type CloudFormationStacks struct {
+	StackNames []string
+	// DisableTerminationProtection lifts termination protection from stacks before deleting them
+	DisableTerminationProtection bool
+	// RetainFailedResources deletes stacks that keep failing to delete while retaining the failed resources
+	RetainFailedResources bool
+}
+
+// ResourceName - the simple name of the aws resource
+func (stacks CloudFormationStacks) ResourceName() string {
+	return "cloudformation-stack"
+}
+
+// ResourceIdentifiers - The names of the CloudFormation stacks
+func (stacks CloudFormationStacks) ResourceIdentifiers() []string {
+	return stacks.StackNames
+}
+
+func (stacks CloudFormationStacks) MaxBatchSize() int {
+	// Stacks are deleted one at a time and each deletion can take a while, so keep batches small
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (stacks CloudFormationStacks) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllCloudFormationStacks(session, awsgo.StringSlice(identifiers), stacks.DisableTerminationProtection, stacks.RetainFailedResources); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type CloudFormationStackTerminationProtectedError struct {
+	name string
+}
+
+func (e CloudFormationStackTerminationProtectedError) Error() string {
+	return "CloudFormation stack " + e.name + " has termination protection enabled"
+}
+
+type CloudFormationStackDeleteError struct {
+	name   string
+	status string
+}
+
+func (e CloudFormationStackDeleteError) Error() string {
+	return "CloudFormation stack " + e.name + " was not deleted, its status is " + e.status
+}
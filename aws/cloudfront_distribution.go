@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// getAllCloudFrontDistributions returns the IDs of all the CloudFront distributions. Distributions don't expose a
+// creation time, so the time they were last modified is used for the excludeAfter filter instead.
+func getAllCloudFrontDistributions(session *session.Session, excludeAfter time.Time) ([]*string, error) {
+	svc := cloudfront.New(session)
+
+	var ids []*string
+	err := svc.ListDistributionsPages(
+		&cloudfront.ListDistributionsInput{},
+		func(page *cloudfront.ListDistributionsOutput, lastPage bool) bool {
+			if page.DistributionList == nil {
+				return !lastPage
+			}
+			for _, distribution := range page.DistributionList.Items {
+				if shouldIncludeCloudFrontDistribution(distribution, excludeAfter) {
+					ids = append(ids, distribution.Id)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return ids, nil
+}
+
+func shouldIncludeCloudFrontDistribution(distribution *cloudfront.DistributionSummary, excludeAfter time.Time) bool {
+	if distribution == nil {
+		return false
+	}
+
+	if distribution.LastModifiedTime != nil && excludeAfter.Before(*distribution.LastModifiedTime) {
+		return false
+	}
+
+	return true
+}
+
+// nukeCloudFrontDistribution deletes a single distribution. CloudFront only allows deleting distributions that are
+// disabled and fully deployed, so enabled distributions are disabled first and we wait for that change to propagate
+// to all edge locations, which can take a while.
+func nukeCloudFrontDistribution(svc cloudfrontiface.CloudFrontAPI, id *string) error {
+	configOutput, err := svc.GetDistributionConfig(&cloudfront.GetDistributionConfigInput{Id: id})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if awsgo.BoolValue(configOutput.DistributionConfig.Enabled) {
+		logging.Logger.Debugf("Disabling CloudFront distribution %s", awsgo.StringValue(id))
+		configOutput.DistributionConfig.Enabled = awsgo.Bool(false)
+		_, err := svc.UpdateDistribution(&cloudfront.UpdateDistributionInput{
+			Id:                 id,
+			IfMatch:            configOutput.ETag,
+			DistributionConfig: configOutput.DistributionConfig,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	logging.Logger.Debugf("Waiting for CloudFront distribution %s to be deployed", awsgo.StringValue(id))
+	if err := svc.WaitUntilDistributionDeployed(&cloudfront.GetDistributionInput{Id: id}); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	// Every update changes the ETag, so the latest one has to be fetched before deleting
+	distribution, err := svc.GetDistribution(&cloudfront.GetDistributionInput{Id: id})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	_, err = svc.DeleteDistribution(&cloudfront.DeleteDistributionInput{
+		Id:      id,
+		IfMatch: distribution.ETag,
+	})
+	return errors.WithStackTrace(err)
+}
+
+// deleteCloudFrontDistributionAsync deletes the provided distribution in a goroutine, using wait groups for
+// concurrency control and a return channel for errors.
+func deleteCloudFrontDistributionAsync(wg *sync.WaitGroup, errChan chan error, svc cloudfrontiface.CloudFrontAPI, id *string) {
+	defer wg.Done()
+
+	err := nukeCloudFrontDistribution(svc, id)
+
+	// Record status of this resource
+	e := report.Entry{
+		Identifier:   awsgo.StringValue(id),
+		ResourceType: "CloudFront Distribution",
+		Error:        err,
+	}
+	report.Record(e)
+
+	errChan <- err
+}
+
+// nukeAllCloudFrontDistributions disables and deletes the given distributions. Since waiting for a distribution to be
+// deployed takes minutes, the distributions in a batch are processed concurrently.
+func nukeAllCloudFrontDistributions(session *session.Session, ids []*string) error {
+	svc := cloudfront.New(session)
+
+	if len(ids) == 0 {
+		logging.Logger.Debug("No CloudFront distributions to nuke")
+		return nil
+	}
+
+	logging.Logger.Debug("Deleting all CloudFront distributions")
+	wg := new(sync.WaitGroup)
+	wg.Add(len(ids))
+	errChans := make([]chan error, len(ids))
+	for i, id := range ids {
+		errChans[i] = make(chan error, 1)
+		go deleteCloudFrontDistributionAsync(wg, errChans[i], svc, id)
+	}
+	wg.Wait()
+
+	var allErrs *multierror.Error
+	deleted := 0
+	for i, errChan := range errChans {
+		if err := <-errChan; err != nil {
+			allErrs = multierror.Append(allErrs, err)
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking CloudFront Distribution",
+			}, map[string]interface{}{})
+		} else {
+			deleted++
+			logging.Logger.Debugf("Deleted CloudFront distribution: %s", awsgo.StringValue(ids[i]))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d CloudFront distribution(s) deleted", deleted)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
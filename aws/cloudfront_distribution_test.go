@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedCloudFront tracks the calls made to disable and delete a single distribution, bumping the ETag on every
+// update like CloudFront does
+type mockedCloudFront struct {
+	cloudfrontiface.CloudFrontAPI
+	Enabled bool
+	ETag    int
+	Calls   []string
+}
+
+func (m *mockedCloudFront) etag() *string {
+	return awsgo.String(fmt.Sprintf("ETAG%d", m.ETag))
+}
+
+func (m *mockedCloudFront) GetDistributionConfig(input *cloudfront.GetDistributionConfigInput) (*cloudfront.GetDistributionConfigOutput, error) {
+	m.Calls = append(m.Calls, "GetDistributionConfig")
+	return &cloudfront.GetDistributionConfigOutput{
+		DistributionConfig: &cloudfront.DistributionConfig{Enabled: awsgo.Bool(m.Enabled)},
+		ETag:               m.etag(),
+	}, nil
+}
+
+func (m *mockedCloudFront) UpdateDistribution(input *cloudfront.UpdateDistributionInput) (*cloudfront.UpdateDistributionOutput, error) {
+	m.Calls = append(m.Calls, "UpdateDistribution")
+	if awsgo.StringValue(input.IfMatch) != awsgo.StringValue(m.etag()) {
+		return nil, assert.AnError
+	}
+	m.Enabled = awsgo.BoolValue(input.DistributionConfig.Enabled)
+	m.ETag++
+	return &cloudfront.UpdateDistributionOutput{}, nil
+}
+
+func (m *mockedCloudFront) WaitUntilDistributionDeployed(input *cloudfront.GetDistributionInput) error {
+	m.Calls = append(m.Calls, "WaitUntilDistributionDeployed")
+	return nil
+}
+
+func (m *mockedCloudFront) GetDistribution(input *cloudfront.GetDistributionInput) (*cloudfront.GetDistributionOutput, error) {
+	m.Calls = append(m.Calls, "GetDistribution")
+	return &cloudfront.GetDistributionOutput{ETag: m.etag()}, nil
+}
+
+func (m *mockedCloudFront) DeleteDistribution(input *cloudfront.DeleteDistributionInput) (*cloudfront.DeleteDistributionOutput, error) {
+	m.Calls = append(m.Calls, "DeleteDistribution")
+	if m.Enabled || awsgo.StringValue(input.IfMatch) != awsgo.StringValue(m.etag()) {
+		return nil, assert.AnError
+	}
+	return &cloudfront.DeleteDistributionOutput{}, nil
+}
+
+func TestNukeCloudFrontDistribution(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Name          string
+		Enabled       bool
+		ExpectedCalls []string
+	}{
+		{
+			Name:    "Enabled",
+			Enabled: true,
+			ExpectedCalls: []string{
+				"GetDistributionConfig", "UpdateDistribution", "WaitUntilDistributionDeployed", "GetDistribution", "DeleteDistribution",
+			},
+		},
+		{
+			Name:    "AlreadyDisabled",
+			Enabled: false,
+			ExpectedCalls: []string{
+				"GetDistributionConfig", "WaitUntilDistributionDeployed", "GetDistribution", "DeleteDistribution",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			mock := &mockedCloudFront{Enabled: c.Enabled}
+			require.NoError(t, nukeCloudFrontDistribution(mock, awsgo.String("E123")))
+			assert.Equal(t, c.ExpectedCalls, mock.Calls)
+		})
+	}
+}
+
+func TestShouldIncludeCloudFrontDistribution(t *testing.T) {
+	now := time.Now()
+
+	assert.True(t, shouldIncludeCloudFrontDistribution(&cloudfront.DistributionSummary{LastModifiedTime: awsgo.Time(now)}, now.Add(1*time.Hour)))
+	assert.False(t, shouldIncludeCloudFrontDistribution(&cloudfront.DistributionSummary{LastModifiedTime: awsgo.Time(now)}, now.Add(-1*time.Hour)))
+	assert.False(t, shouldIncludeCloudFrontDistribution(nil, now))
+}
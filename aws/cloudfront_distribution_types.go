@@ -0,0 +1,37 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// CloudFrontDistributions - represents all CloudFront distributions
+type CloudFrontDistributions struct {
+	DistributionIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (d CloudFrontDistributions) ResourceName() string {
+	return "cloudfront-distribution"
+}
+
+// ResourceIdentifiers - The IDs of the CloudFront distributions
+func (d CloudFrontDistributions) ResourceIdentifiers() []string {
+	return d.DistributionIds
+}
+
+func (d CloudFrontDistributions) MaxBatchSize() int {
+	// Distributions are deleted concurrently and each one is polled until it is deployed, so we keep the batch small
+	// to avoid being throttled.
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (d CloudFrontDistributions) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllCloudFrontDistributions(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
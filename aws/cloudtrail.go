@@ -8,41 +8,60 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
 	"github.com/gruntwork-io/go-commons/errors"
 )
 
+// getAllCloudtrailTrails returns the ARNs of the trails whose home region is the current region, as trails can only be
+// deleted from there. Trails have no creation time, so the time they were first seen is kept in a tag instead.
 func getAllCloudtrailTrails(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
 	svc := cloudtrail.New(session)
 
-	param := &cloudtrail.ListTrailsInput{}
+	// Shadow trails are the copies of multi-region trails in the other regions
+	output, err := svc.DescribeTrails(&cloudtrail.DescribeTrailsInput{IncludeShadowTrails: aws.Bool(false)})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
 
 	trailIds := []*string{}
+	for _, trail := range output.TrailList {
+		if !shouldIncludeCloudtrailTrail(trail, configObj) {
+			continue
+		}
 
-	paginator := func(output *cloudtrail.ListTrailsOutput, lastPage bool) bool {
-		for _, trailInfo := range output.Trails {
-			if shouldIncludeCloudtrailTrail(trailInfo, configObj) {
-				trailIds = append(trailIds, trailInfo.TrailARN)
-			}
+		tags, err := getCloudtrailTrailTags(svc, trail.TrailARN)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if tags[AwsResourceExclusionTagKey] == "true" {
+			continue
 		}
-		return !lastPage
-	}
 
-	err := svc.ListTrailsPages(param, paginator)
-	if err != nil {
-		return trailIds, errors.WithStackTrace(err)
+		firstSeenTime, err := getOrSetFirstSeenCloudtrailTrailTag(svc, trail.TrailARN, tags)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if excludeAfter.After(firstSeenTime) {
+			trailIds = append(trailIds, trail.TrailARN)
+		}
 	}
 
 	return trailIds, nil
 }
 
-func shouldIncludeCloudtrailTrail(trail *cloudtrail.TrailInfo, configObj config.Config) bool {
+func shouldIncludeCloudtrailTrail(trail *cloudtrail.Trail, configObj config.Config) bool {
 	if trail == nil {
 		return false
 	}
 
+	// Multi-region and organization trails usually record the activity of more than the account being nuked
+	if configObj.CloudtrailTrail.ProtectMultiRegionTrails && (aws.BoolValue(trail.IsMultiRegionTrail) || aws.BoolValue(trail.IsOrganizationTrail)) {
+		return false
+	}
+
 	return config.ShouldInclude(
 		aws.StringValue(trail.Name),
 		configObj.CloudtrailTrail.IncludeRule.NamesRegExp,
@@ -50,6 +69,41 @@ func shouldIncludeCloudtrailTrail(trail *cloudtrail.TrailInfo, configObj config.
 	)
 }
 
+func getCloudtrailTrailTags(svc cloudtrailiface.CloudTrailAPI, trailArn *string) (map[string]string, error) {
+	output, err := svc.ListTags(&cloudtrail.ListTagsInput{ResourceIdList: []*string{trailArn}})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	tags := map[string]string{}
+	for _, resourceTag := range output.ResourceTagList {
+		for _, tag := range resourceTag.TagsList {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+	}
+	return tags, nil
+}
+
+func getOrSetFirstSeenCloudtrailTrailTag(svc cloudtrailiface.CloudTrailAPI, trailArn *string, tags map[string]string) (time.Time, error) {
+	if value, ok := tags[firstSeenTagKey]; ok {
+		firstSeenTime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, errors.WithStackTrace(err)
+		}
+		return firstSeenTime, nil
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.AddTags(&cloudtrail.AddTagsInput{
+		ResourceId: trailArn,
+		TagsList:   []*cloudtrail.Tag{{Key: aws.String(firstSeenTagKey), Value: aws.String(now.Format(time.RFC3339))}},
+	})
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return now, nil
+}
+
 func nukeAllCloudTrailTrails(session *session.Session, arns []*string) error {
 	svc := cloudtrail.New(session)
 
@@ -32,7 +32,7 @@ func TestListCloudTrailTrails(t *testing.T) {
 	trailArn := createCloudTrailTrail(t, region)
 	defer deleteCloudTrailTrail(t, region, trailArn, false)
 
-	trailArns, err := getAllCloudtrailTrails(session, time.Now(), config.Config{})
+	trailArns, err := getAllCloudtrailTrails(session, time.Now().Add(1*time.Hour), config.Config{})
 	require.NoError(t, err)
 	assert.Contains(t, aws.StringValueSlice(trailArns), aws.StringValue(trailArn))
 }
@@ -216,3 +216,16 @@ func assertCloudTrailTrailsDeleted(t *testing.T, region string, identifiers []*s
 		t.Fatalf("At least one of the following CloudTrail Trails was not deleted: %+v\n", aws.StringValueSlice(identifiers))
 	}
 }
+
+func TestShouldIncludeCloudtrailTrail(t *testing.T) {
+	trail := &cloudtrail.Trail{Name: aws.String("cloud-nuke-test")}
+	multiRegionTrail := &cloudtrail.Trail{Name: aws.String("cloud-nuke-test"), IsMultiRegionTrail: aws.Bool(true)}
+	organizationTrail := &cloudtrail.Trail{Name: aws.String("cloud-nuke-test"), IsOrganizationTrail: aws.Bool(true)}
+
+	protectConfig := config.Config{CloudtrailTrail: config.CloudtrailTrailResourceType{ProtectMultiRegionTrails: true}}
+
+	assert.True(t, shouldIncludeCloudtrailTrail(multiRegionTrail, config.Config{}))
+	assert.True(t, shouldIncludeCloudtrailTrail(trail, protectConfig))
+	assert.False(t, shouldIncludeCloudtrailTrail(multiRegionTrail, protectConfig))
+	assert.False(t, shouldIncludeCloudtrailTrail(organizationTrail, protectConfig))
+}
@@ -11,13 +11,16 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 )
 
 func getAllCloudWatchAlarms(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
 	svc := cloudwatch.New(session)
 
-	allAlarms := []*string{}
+	// Composite alarms are listed first so that they are nuked in earlier batches than the alarms their rules refer to
+	compositeAlarms := []*string{}
+	metricAlarms := []*string{}
 	input := &cloudwatch.DescribeAlarmsInput{
 		AlarmTypes: aws.StringSlice([]string{cloudwatch.AlarmTypeMetricAlarm, cloudwatch.AlarmTypeCompositeAlarm}),
 	}
@@ -26,18 +29,18 @@ func getAllCloudWatchAlarms(session *session.Session, excludeAfter time.Time, co
 		func(page *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
 			for _, alarm := range page.MetricAlarms {
 				if shouldIncludeCloudWatchMetricAlarm(alarm, excludeAfter, configObj) {
-					allAlarms = append(allAlarms, alarm.AlarmName)
+					metricAlarms = append(metricAlarms, alarm.AlarmName)
 				}
 			}
 			for _, alarm := range page.CompositeAlarms {
 				if shouldIncludeCloudWatchCompositeAlarm(alarm, excludeAfter, configObj) {
-					allAlarms = append(allAlarms, alarm.AlarmName)
+					compositeAlarms = append(compositeAlarms, alarm.AlarmName)
 				}
 			}
 			return !lastPage
 		},
 	)
-	return allAlarms, errors.WithStackTrace(err)
+	return append(compositeAlarms, metricAlarms...), errors.WithStackTrace(err)
 }
 
 func shouldIncludeCloudWatchCompositeAlarm(alarm *cloudwatch.CompositeAlarm, excludeAfter time.Time, configObj config.Config) bool {
@@ -72,6 +75,53 @@ func shouldIncludeCloudWatchMetricAlarm(alarm *cloudwatch.MetricAlarm, excludeAf
 	)
 }
 
+// deleteCloudWatchAlarms deletes the given alarms, composite alarms first, as a metric or composite alarm can't be
+// deleted while a composite alarm still refers to it in its rule. Composite alarms can also refer to each other, so the
+// rules of the composite alarms are reset beforehand to break any cycle between them.
+func deleteCloudWatchAlarms(svc cloudwatchiface.CloudWatchAPI, identifiers []*string) error {
+	var compositeAlarmNames []*string
+	var metricAlarmNames []*string
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmTypes: aws.StringSlice([]string{cloudwatch.AlarmTypeMetricAlarm, cloudwatch.AlarmTypeCompositeAlarm}),
+		AlarmNames: identifiers,
+	}
+	err := svc.DescribeAlarmsPages(input, func(page *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+		for _, alarm := range page.CompositeAlarms {
+			compositeAlarmNames = append(compositeAlarmNames, alarm.AlarmName)
+		}
+		for _, alarm := range page.MetricAlarms {
+			metricAlarmNames = append(metricAlarmNames, alarm.AlarmName)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if len(compositeAlarmNames) > 0 {
+		for _, alarmName := range compositeAlarmNames {
+			_, err := svc.PutCompositeAlarm(&cloudwatch.PutCompositeAlarmInput{
+				AlarmName: alarmName,
+				AlarmRule: aws.String("FALSE"),
+			})
+			if err != nil {
+				return errors.WithStackTrace(err)
+			}
+		}
+
+		if _, err := svc.DeleteAlarms(&cloudwatch.DeleteAlarmsInput{AlarmNames: compositeAlarmNames}); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	if len(metricAlarmNames) > 0 {
+		if _, err := svc.DeleteAlarms(&cloudwatch.DeleteAlarmsInput{AlarmNames: metricAlarmNames}); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+	return nil
+}
+
 func nukeAllCloudWatchAlarms(session *session.Session, identifiers []*string) error {
 	region := aws.StringValue(session.Config.Region)
 
@@ -83,47 +133,15 @@ func nukeAllCloudWatchAlarms(session *session.Session, identifiers []*string) er
 	}
 
 	// NOTE: we don't need to do pagination here, because the pagination is handled by the caller to this function,
-	// based on CloudWatchAlarm.MaxBatchSize, however we add a guard here to warn users when the batching fails and has a
-	// chance of throttling AWS. Since we concurrently make one call for each identifier, we pick 100 for the limit here
-	// because many APIs in AWS have a limit of 100 requests per second.
+	// based on CloudWatchAlarm.MaxBatchSize, however we add a guard here to warn users when the batching fails. We pick
+	// 100 for the limit here because that is the most alarms DeleteAlarms accepts in a single call.
 	if len(identifiers) > 100 {
-		logging.Logger.Errorf("Nuking too many CloudWatch Alarms at once (100): halting to avoid hitting AWS API rate limiting")
+		logging.Logger.Errorf("Nuking too many CloudWatch Alarms at once (100): halting as DeleteAlarms can't take more")
 		return TooManyCloudWatchAlarmsErr{}
 	}
 
 	logging.Logger.Debugf("Deleting CloudWatch Alarms in region %s", region)
-
-	// If the alarm's type is composite alarm, remove the dependency by removing the rule.
-	alarms, err := svc.DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
-		AlarmTypes: aws.StringSlice([]string{cloudwatch.AlarmTypeMetricAlarm, cloudwatch.AlarmTypeCompositeAlarm}),
-		AlarmNames: identifiers,
-	})
-	if err != nil {
-		logging.Logger.Debugf("[Failed] %s", err)
-		telemetry.TrackEvent(commonTelemetry.EventContext{
-			EventName: "Error Nuking Cloudwatch Alarm Dependency",
-		}, map[string]interface{}{
-			"region": *session.Config.Region,
-		})
-	}
-
-	for _, compositeAlarm := range alarms.CompositeAlarms {
-		_, err := svc.PutCompositeAlarm(&cloudwatch.PutCompositeAlarmInput{
-			AlarmName: compositeAlarm.AlarmName,
-			AlarmRule: aws.String("FALSE"),
-		})
-		if err != nil {
-			logging.Logger.Debugf("[Failed] %s", err)
-			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Error Nuking Cloudwatch Composite Alarm",
-			}, map[string]interface{}{
-				"region": *session.Config.Region,
-			})
-		}
-	}
-
-	input := cloudwatch.DeleteAlarmsInput{AlarmNames: identifiers}
-	_, err = svc.DeleteAlarms(&input)
+	err := deleteCloudWatchAlarms(svc, identifiers)
 
 	// Record status of this resource
 	e := report.BatchEntry{
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/stretchr/testify/assert"
@@ -177,3 +178,34 @@ func assertCloudWatchAlarmsDeleted(t *testing.T, svc *cloudwatch.CloudWatch, ide
 		}
 	}
 }
+
+type mockedCloudWatchAlarms struct {
+	cloudwatchiface.CloudWatchAPI
+	Calls []string
+}
+
+func (m *mockedCloudWatchAlarms) DescribeAlarmsPages(input *cloudwatch.DescribeAlarmsInput, fn func(*cloudwatch.DescribeAlarmsOutput, bool) bool) error {
+	fn(&cloudwatch.DescribeAlarmsOutput{
+		MetricAlarms:    []*cloudwatch.MetricAlarm{{AlarmName: aws.String("metric")}},
+		CompositeAlarms: []*cloudwatch.CompositeAlarm{{AlarmName: aws.String("composite")}},
+	}, true)
+	return nil
+}
+
+func (m *mockedCloudWatchAlarms) PutCompositeAlarm(input *cloudwatch.PutCompositeAlarmInput) (*cloudwatch.PutCompositeAlarmOutput, error) {
+	m.Calls = append(m.Calls, "reset-rule/"+aws.StringValue(input.AlarmName))
+	return &cloudwatch.PutCompositeAlarmOutput{}, nil
+}
+
+func (m *mockedCloudWatchAlarms) DeleteAlarms(input *cloudwatch.DeleteAlarmsInput) (*cloudwatch.DeleteAlarmsOutput, error) {
+	m.Calls = append(m.Calls, "delete/"+strings.Join(aws.StringValueSlice(input.AlarmNames), ","))
+	return &cloudwatch.DeleteAlarmsOutput{}, nil
+}
+
+func TestDeleteCloudWatchAlarmsDeletesCompositeAlarmsFirst(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedCloudWatchAlarms{}
+	require.NoError(t, deleteCloudWatchAlarms(mock, aws.StringSlice([]string{"metric", "composite"})))
+	assert.Equal(t, []string{"reset-rule/composite", "delete/composite", "delete/metric"}, mock.Calls)
+}
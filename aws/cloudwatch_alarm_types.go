@@ -22,7 +22,8 @@ func (cwal CloudWatchAlarms) ResourceIdentifiers() []string {
 }
 
 func (cwal CloudWatchAlarms) MaxBatchSize() int {
-	return 99
+	// DeleteAlarms accepts at most 100 alarm names per call
+	return 100
 }
 
 // Nuke - nuke 'em all!!!
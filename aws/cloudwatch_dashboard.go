@@ -18,20 +18,44 @@ import (
 func getAllCloudWatchDashboards(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
 	svc := cloudwatch.New(session)
 
-	allDashboards := []*string{}
+	candidates := []*cloudwatch.DashboardEntry{}
 	input := &cloudwatch.ListDashboardsInput{}
 	err := svc.ListDashboardsPages(
 		input,
 		func(page *cloudwatch.ListDashboardsOutput, lastPage bool) bool {
 			for _, dashboard := range page.DashboardEntries {
 				if shouldIncludeCloudWatchDashboard(dashboard, excludeAfter, configObj) {
-					allDashboards = append(allDashboards, dashboard.DashboardName)
+					candidates = append(candidates, dashboard)
 				}
 			}
 			return !lastPage
 		},
 	)
-	return allDashboards, errors.WithStackTrace(err)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	allDashboards := []*string{}
+	for _, dashboard := range candidates {
+		tags, err := svc.ListTagsForResource(&cloudwatch.ListTagsForResourceInput{ResourceARN: dashboard.DashboardArn})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !hasCloudWatchExcludeTag(tags.Tags) {
+			allDashboards = append(allDashboards, dashboard.DashboardName)
+		}
+	}
+	return allDashboards, nil
+}
+
+// hasCloudWatchExcludeTag checks whether the exclude tag is set for a resource to skip deleting it.
+func hasCloudWatchExcludeTag(tags []*cloudwatch.Tag) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
 }
 
 func shouldIncludeCloudWatchDashboard(dashboard *cloudwatch.DashboardEntry, excludeAfter time.Time, configObj config.Config) bool {
@@ -167,3 +167,14 @@ const helloWorldCloudWatchDashboardWidget = `{
       }
    ]
 }`
+
+func TestHasCloudWatchExcludeTag(t *testing.T) {
+	assert.True(t, hasCloudWatchExcludeTag([]*cloudwatch.Tag{
+		{Key: aws.String("team"), Value: aws.String("platform")},
+		{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")},
+	}))
+	assert.False(t, hasCloudWatchExcludeTag([]*cloudwatch.Tag{
+		{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("false")},
+	}))
+	assert.False(t, hasCloudWatchExcludeTag(nil))
+}
@@ -17,12 +17,23 @@ import (
 	"github.com/hashicorp/go-multierror"
 )
 
+// The CloudWatch Logs APIs are rate limited to a handful of requests per second, which accounts with tens of thousands of
+// log groups easily run into, so retry throttled requests more than the SDK does by default.
+const cloudWatchLogsMaxRetries = 10
+
+// The largest page size DescribeLogGroups supports
+const cloudWatchLogGroupsPageSize = 50
+
+func newCloudWatchLogsClient(session *session.Session) *cloudwatchlogs.CloudWatchLogs {
+	return cloudwatchlogs.New(session, aws.NewConfig().WithMaxRetries(cloudWatchLogsMaxRetries))
+}
+
 func getAllCloudWatchLogGroups(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
-	svc := cloudwatchlogs.New(session)
+	svc := newCloudWatchLogsClient(session)
 
 	allLogGroups := []*string{}
 	err := svc.DescribeLogGroupsPages(
-		&cloudwatchlogs.DescribeLogGroupsInput{},
+		&cloudwatchlogs.DescribeLogGroupsInput{Limit: aws.Int64(cloudWatchLogGroupsPageSize)},
 		func(page *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool {
 			for _, logGroup := range page.LogGroups {
 				if shouldIncludeCloudWatchLogGroup(logGroup, excludeAfter, configObj) {
@@ -60,7 +71,7 @@ func shouldIncludeCloudWatchLogGroup(logGroup *cloudwatchlogs.LogGroup, excludeA
 
 func nukeAllCloudWatchLogGroups(session *session.Session, identifiers []*string) error {
 	region := aws.StringValue(session.Config.Region)
-	svc := cloudwatchlogs.New(session)
+	svc := newCloudWatchLogsClient(session)
 
 	if len(identifiers) == 0 {
 		logging.Logger.Debugf("No CloudWatch Log Groups to nuke in region %s", *session.Config.Region)
@@ -92,18 +103,26 @@ func nukeAllCloudWatchLogGroups(session *session.Session, identifiers []*string)
 	// NOTE: We ignore OperationAbortedException which is thrown when there is an eventual consistency issue, where
 	// cloud-nuke picks up a Log Group that is already requested to be deleted.
 	var allErrs *multierror.Error
+	deletedCount := 0
 	for _, errChan := range errChans {
-		if err := <-errChan; err != nil {
-			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() != "OperationAbortedException" {
-				allErrs = multierror.Append(allErrs, err)
-				telemetry.TrackEvent(commonTelemetry.EventContext{
-					EventName: "Error Nuking Cloudwatch Log Group",
-				}, map[string]interface{}{
-					"region": *session.Config.Region,
-				})
-			}
+		err := <-errChan
+		if err == nil {
+			deletedCount++
+			continue
+		}
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "OperationAbortedException" {
+			continue
 		}
+
+		allErrs = multierror.Append(allErrs, err)
+		telemetry.TrackEvent(commonTelemetry.EventContext{
+			EventName: "Error Nuking Cloudwatch Log Group",
+		}, map[string]interface{}{
+			"region": *session.Config.Region,
+		})
 	}
+	logging.Logger.Debugf("[OK] %d CloudWatch Log Group(s) deleted in %s", deletedCount, region)
+
 	finalErr := allErrs.ErrorOrNil()
 	if finalErr != nil {
 		return errors.WithStackTrace(finalErr)
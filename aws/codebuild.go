@@ -0,0 +1,276 @@
+package aws
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/aws-sdk-go/service/codebuild/codebuildiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// BatchGetProjects and BatchGetReportGroups accept at most this many names or ARNs per call
+const codeBuildBatchGetLimit = 100
+
+// Returns a formatted string of CodeBuild project names
+func getAllCodeBuildProjects(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := codebuild.New(session)
+
+	var allNames []*string
+	err := svc.ListProjectsPages(&codebuild.ListProjectsInput{}, func(page *codebuild.ListProjectsOutput, lastPage bool) bool {
+		allNames = append(allNames, page.Projects...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, batch := range split(aws.StringValueSlice(allNames), codeBuildBatchGetLimit) {
+		// The creation time and the tags are only returned when describing the projects
+		output, err := svc.BatchGetProjects(&codebuild.BatchGetProjectsInput{Names: aws.StringSlice(batch)})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, project := range output.Projects {
+			if shouldIncludeCodeBuildProject(project, excludeAfter, configObj) {
+				names = append(names, project.Name)
+			}
+		}
+	}
+	return names, nil
+}
+
+func shouldIncludeCodeBuildProject(project *codebuild.Project, excludeAfter time.Time, configObj config.Config) bool {
+	if project == nil {
+		return false
+	}
+
+	if project.Created != nil && excludeAfter.Before(*project.Created) {
+		return false
+	}
+
+	if hasCodeBuildExcludeTag(project.Tags) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(project.Name),
+		configObj.CodeBuildProject.IncludeRule.NamesRegExp,
+		configObj.CodeBuildProject.ExcludeRule.NamesRegExp,
+	)
+}
+
+func hasCodeBuildExcludeTag(tags []*codebuild.Tag) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+func getAllCodeBuildReportGroups(svc codebuildiface.CodeBuildAPI) ([]*codebuild.ReportGroup, error) {
+	var arns []*string
+	err := svc.ListReportGroupsPages(&codebuild.ListReportGroupsInput{}, func(page *codebuild.ListReportGroupsOutput, lastPage bool) bool {
+		arns = append(arns, page.ReportGroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var reportGroups []*codebuild.ReportGroup
+	for _, batch := range split(aws.StringValueSlice(arns), codeBuildBatchGetLimit) {
+		output, err := svc.BatchGetReportGroups(&codebuild.BatchGetReportGroupsInput{ReportGroupArns: aws.StringSlice(batch)})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		reportGroups = append(reportGroups, output.ReportGroups...)
+	}
+	return reportGroups, nil
+}
+
+// getRemainingCodeBuildProjects returns the names of the projects that exist after the nuked ones are gone
+func getRemainingCodeBuildProjects(svc codebuildiface.CodeBuildAPI) ([]string, error) {
+	var names []string
+	err := svc.ListProjectsPages(&codebuild.ListProjectsInput{}, func(page *codebuild.ListProjectsOutput, lastPage bool) bool {
+		names = append(names, aws.StringValueSlice(page.Projects)...)
+		return !lastPage
+	})
+	return names, errors.WithStackTrace(err)
+}
+
+// getCodeBuildReportGroupProject returns the name of the project whose builds generated the reports of the report
+// group, or an empty string if the report group holds no reports.
+func getCodeBuildReportGroupProject(svc codebuildiface.CodeBuildAPI, reportGroupArn *string) (string, error) {
+	reports, err := svc.ListReportsForReportGroup(&codebuild.ListReportsForReportGroupInput{
+		ReportGroupArn: reportGroupArn,
+		MaxResults:     aws.Int64(1),
+	})
+	if err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if len(reports.Reports) == 0 {
+		return "", nil
+	}
+
+	output, err := svc.BatchGetReports(&codebuild.BatchGetReportsInput{ReportArns: reports.Reports})
+	if err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	for _, report := range output.Reports {
+		return getCodeBuildProjectFromBuildArn(aws.StringValue(report.ExecutionId)), nil
+	}
+	return "", nil
+}
+
+// getCodeBuildProjectFromBuildArn returns the project name of a build ARN, which looks like
+// arn:aws:codebuild:<region>:<account>:build/<project name>:<build id>.
+func getCodeBuildProjectFromBuildArn(buildArn string) string {
+	const buildResource = ":build/"
+	i := strings.Index(buildArn, buildResource)
+	if i < 0 {
+		return ""
+	}
+	projectAndId := buildArn[i+len(buildResource):]
+	if j := strings.LastIndex(projectAndId, ":"); j >= 0 {
+		return projectAndId[:j]
+	}
+	return projectAndId
+}
+
+// deleteCodeBuildReportGroups deletes the report groups of the given projects, along with their reports. The report
+// groups CodeBuild creates for a buildspec are named after the project, as <project name>-<report group name>, so
+// names alone can't tell project app from project app-frontend. The builds that generated the reports of a group are
+// checked instead, and only groups without any reports fall back to matching on the name.
+func deleteCodeBuildReportGroups(svc codebuildiface.CodeBuildAPI, projectNames []*string) error {
+	reportGroups, err := getAllCodeBuildReportGroups(svc)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	remainingNames, err := getRemainingCodeBuildProjects(svc)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	var allErrs *multierror.Error
+	for _, reportGroup := range reportGroups {
+		if getCodeBuildReportGroupNameOwner(reportGroup, projectNames, nil) == "" || hasCodeBuildExcludeTag(reportGroup.Tags) {
+			continue
+		}
+
+		owner, err := getCodeBuildReportGroupProject(svc, reportGroup.Arn)
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			allErrs = multierror.Append(allErrs, err)
+			continue
+		}
+		if !isCodeBuildProjectReportGroup(reportGroup, owner, projectNames, remainingNames) {
+			continue
+		}
+
+		_, err = svc.DeleteReportGroup(&codebuild.DeleteReportGroupInput{
+			Arn:           reportGroup.Arn,
+			DeleteReports: aws.Bool(true),
+		})
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			allErrs = multierror.Append(allErrs, err)
+			continue
+		}
+		logging.Logger.Debugf("Deleted CodeBuild report group: %s", aws.StringValue(reportGroup.Name))
+	}
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// isCodeBuildProjectReportGroup returns whether the report group belongs to one of the nuked projects, either going by
+// the project that generated its reports, or, when it holds no reports, by the longest project name it is prefixed
+// with.
+func isCodeBuildProjectReportGroup(reportGroup *codebuild.ReportGroup, owner string, projectNames []*string, remainingNames []string) bool {
+	if owner == "" {
+		owner = getCodeBuildReportGroupNameOwner(reportGroup, projectNames, remainingNames)
+	}
+	for _, projectName := range projectNames {
+		if aws.StringValue(projectName) == owner {
+			return true
+		}
+	}
+	return false
+}
+
+// getCodeBuildReportGroupNameOwner returns the longest of the given project names the report group name is prefixed
+// with, or an empty string if there is none.
+func getCodeBuildReportGroupNameOwner(reportGroup *codebuild.ReportGroup, projectNames []*string, remainingNames []string) string {
+	owner := ""
+	for _, projectName := range append(aws.StringValueSlice(projectNames), remainingNames...) {
+		if strings.HasPrefix(aws.StringValue(reportGroup.Name), projectName+"-") && len(projectName) > len(owner) {
+			owner = projectName
+		}
+	}
+	return owner
+}
+
+// Deletes all CodeBuild projects, and optionally their report groups
+func nukeAllCodeBuildProjects(session *session.Session, names []*string, deleteReportGroups bool) error {
+	svc := codebuild.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No CodeBuild projects to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all CodeBuild projects in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		_, err := svc.DeleteProject(&codebuild.DeleteProjectInput{Name: name})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: "CodeBuild Project",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking CodeBuild Project",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted CodeBuild project: %s", aws.StringValue(name))
+		}
+	}
+
+	if deleteReportGroups && len(deletedNames) > 0 {
+		if err := deleteCodeBuildReportGroups(svc, deletedNames); err != nil {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking CodeBuild Report Groups",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d CodeBuild project(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/aws-sdk-go/service/codebuild/codebuildiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedCodeBuildReportGroups struct {
+	codebuildiface.CodeBuildAPI
+	ReportGroups        []*codebuild.ReportGroup
+	Projects            []string
+	ReportBuilds        map[string]string
+	DeletedReportGroups []string
+}
+
+func (m *mockedCodeBuildReportGroups) ListReportGroupsPages(input *codebuild.ListReportGroupsInput, fn func(*codebuild.ListReportGroupsOutput, bool) bool) error {
+	var arns []*string
+	for _, reportGroup := range m.ReportGroups {
+		arns = append(arns, reportGroup.Arn)
+	}
+	fn(&codebuild.ListReportGroupsOutput{ReportGroups: arns}, true)
+	return nil
+}
+
+func (m *mockedCodeBuildReportGroups) BatchGetReportGroups(input *codebuild.BatchGetReportGroupsInput) (*codebuild.BatchGetReportGroupsOutput, error) {
+	return &codebuild.BatchGetReportGroupsOutput{ReportGroups: m.ReportGroups}, nil
+}
+
+func (m *mockedCodeBuildReportGroups) ListProjectsPages(input *codebuild.ListProjectsInput, fn func(*codebuild.ListProjectsOutput, bool) bool) error {
+	fn(&codebuild.ListProjectsOutput{Projects: aws.StringSlice(m.Projects)}, true)
+	return nil
+}
+
+// ListReportsForReportGroup returns a single report named after its report group, if the group has a build in
+// ReportBuilds
+func (m *mockedCodeBuildReportGroups) ListReportsForReportGroup(input *codebuild.ListReportsForReportGroupInput) (*codebuild.ListReportsForReportGroupOutput, error) {
+	if _, ok := m.ReportBuilds[aws.StringValue(input.ReportGroupArn)]; !ok {
+		return &codebuild.ListReportsForReportGroupOutput{}, nil
+	}
+	return &codebuild.ListReportsForReportGroupOutput{Reports: []*string{input.ReportGroupArn}}, nil
+}
+
+func (m *mockedCodeBuildReportGroups) BatchGetReports(input *codebuild.BatchGetReportsInput) (*codebuild.BatchGetReportsOutput, error) {
+	var reports []*codebuild.Report
+	for _, arn := range input.ReportArns {
+		reports = append(reports, &codebuild.Report{Arn: arn, ExecutionId: aws.String(m.ReportBuilds[aws.StringValue(arn)])})
+	}
+	return &codebuild.BatchGetReportsOutput{Reports: reports}, nil
+}
+
+func (m *mockedCodeBuildReportGroups) DeleteReportGroup(input *codebuild.DeleteReportGroupInput) (*codebuild.DeleteReportGroupOutput, error) {
+	m.DeletedReportGroups = append(m.DeletedReportGroups, aws.StringValue(input.Arn))
+	return &codebuild.DeleteReportGroupOutput{}, nil
+}
+
+func TestDeleteCodeBuildReportGroupsOfProjects(t *testing.T) {
+	t.Parallel()
+
+	// Project app is nuked, while project app-frontend is kept
+	mock := &mockedCodeBuildReportGroups{
+		ReportGroups: []*codebuild.ReportGroup{
+			{Arn: aws.String("arn-1"), Name: aws.String("app-tests")},
+			{Arn: aws.String("arn-2"), Name: aws.String("kept-tests")},
+			{Arn: aws.String("arn-3"), Name: aws.String("appother-tests")},
+			{
+				Arn:  aws.String("arn-4"),
+				Name: aws.String("app-coverage"),
+				Tags: []*codebuild.Tag{{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}},
+			},
+			{Arn: aws.String("arn-5"), Name: aws.String("app-frontend-tests")},
+			{Arn: aws.String("arn-6"), Name: aws.String("app-frontend-coverage")},
+			{Arn: aws.String("arn-7"), Name: aws.String("app-lint")},
+		},
+		Projects: []string{"app-frontend", "kept"},
+		ReportBuilds: map[string]string{
+			"arn-1": "arn:aws:codebuild:us-east-1:123456789012:build/app:1",
+			"arn-5": "arn:aws:codebuild:us-east-1:123456789012:build/app-frontend:1",
+		},
+	}
+
+	require.NoError(t, deleteCodeBuildReportGroups(mock, aws.StringSlice([]string{"app"})))
+	// arn-6 and arn-7 hold no reports and are matched on the longest project name they are prefixed with
+	assert.Equal(t, []string{"arn-1", "arn-7"}, mock.DeletedReportGroups)
+}
+
+func TestGetCodeBuildProjectFromBuildArn(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "app-frontend", getCodeBuildProjectFromBuildArn("arn:aws:codebuild:us-east-1:123456789012:build/app-frontend:0b1c2d3e"))
+	assert.Equal(t, "", getCodeBuildProjectFromBuildArn("arn:aws:codebuild:us-east-1:123456789012:project/app"))
+}
+
+func TestShouldIncludeCodeBuildProject(t *testing.T) {
+	project := &codebuild.Project{
+		Name:    aws.String("cloud-nuke-test"),
+		Created: aws.Time(time.Now()),
+	}
+	excluded := &codebuild.Project{
+		Name:    aws.String("cloud-nuke-test"),
+		Created: aws.Time(time.Now()),
+		Tags:    []*codebuild.Tag{{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}},
+	}
+
+	assert.True(t, shouldIncludeCodeBuildProject(project, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeCodeBuildProject(project, time.Now().Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeCodeBuildProject(excluded, time.Now().Add(1*time.Hour), config.Config{}))
+}
@@ -0,0 +1,38 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// CodeBuildProjects - represents all CodeBuild projects
+type CodeBuildProjects struct {
+	ProjectNames []string
+	// DeleteReportGroups also deletes the report groups the deleted projects have created
+	DeleteReportGroups bool
+}
+
+// ResourceName - the simple name of the aws resource
+func (projects CodeBuildProjects) ResourceName() string {
+	return "codebuild-project"
+}
+
+// ResourceIdentifiers - The names of the CodeBuild projects
+func (projects CodeBuildProjects) ResourceIdentifiers() []string {
+	return projects.ProjectNames
+}
+
+func (projects CodeBuildProjects) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (projects CodeBuildProjects) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllCodeBuildProjects(session, awsgo.StringSlice(identifiers), projects.DeleteReportGroups); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// BatchGetRepositories accepts at most this many repository names per call
+const codeCommitBatchGetRepositoriesLimit = 25
+
+// Returns a formatted string of CodeCommit repository names
+func getAllCodeCommitRepositories(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := codecommit.New(session)
+
+	var allNames []*string
+	err := svc.ListRepositoriesPages(&codecommit.ListRepositoriesInput{}, func(page *codecommit.ListRepositoriesOutput, lastPage bool) bool {
+		for _, repository := range page.Repositories {
+			allNames = append(allNames, repository.RepositoryName)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, batch := range split(aws.StringValueSlice(allNames), codeCommitBatchGetRepositoriesLimit) {
+		// The creation date is only returned when describing the repositories
+		output, err := svc.BatchGetRepositories(&codecommit.BatchGetRepositoriesInput{RepositoryNames: aws.StringSlice(batch)})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, repository := range output.Repositories {
+			if !shouldIncludeCodeCommitRepository(repository, excludeAfter, configObj) {
+				continue
+			}
+
+			tags, err := svc.ListTagsForResource(&codecommit.ListTagsForResourceInput{ResourceArn: repository.Arn})
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			if aws.StringValue(tags.Tags[AwsResourceExclusionTagKey]) != "true" {
+				names = append(names, repository.RepositoryName)
+			}
+		}
+	}
+	return names, nil
+}
+
+func shouldIncludeCodeCommitRepository(repository *codecommit.RepositoryMetadata, excludeAfter time.Time, configObj config.Config) bool {
+	if repository == nil {
+		return false
+	}
+
+	if repository.CreationDate != nil && excludeAfter.Before(*repository.CreationDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(repository.RepositoryName),
+		configObj.CodeCommitRepository.IncludeRule.NamesRegExp,
+		configObj.CodeCommitRepository.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Deletes all CodeCommit repositories
+func nukeAllCodeCommitRepositories(session *session.Session, names []*string) error {
+	svc := codecommit.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No CodeCommit repositories to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all CodeCommit repositories in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		_, err := svc.DeleteRepository(&codecommit.DeleteRepositoryInput{RepositoryName: name})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: "CodeCommit Repository",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking CodeCommit Repository",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted CodeCommit repository: %s", aws.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d CodeCommit repositories deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldIncludeCodeCommitRepository(t *testing.T) {
+	repository := &codecommit.RepositoryMetadata{
+		RepositoryName: aws.String("cloud-nuke-test"),
+		CreationDate:   aws.Time(time.Now()),
+	}
+
+	excludeConfig := config.Config{
+		CodeCommitRepository: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^cloud-nuke-")}},
+			},
+		},
+	}
+
+	assert.True(t, shouldIncludeCodeCommitRepository(repository, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeCodeCommitRepository(repository, time.Now().Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeCodeCommitRepository(repository, time.Now().Add(1*time.Hour), excludeConfig))
+}
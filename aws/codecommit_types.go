@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// CodeCommitRepositories - represents all CodeCommit repositories
+type CodeCommitRepositories struct {
+	RepositoryNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (repositories CodeCommitRepositories) ResourceName() string {
+	return "codecommit-repository"
+}
+
+// ResourceIdentifiers - The names of the CodeCommit repositories
+func (repositories CodeCommitRepositories) ResourceIdentifiers() []string {
+	return repositories.RepositoryNames
+}
+
+func (repositories CodeCommitRepositories) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (repositories CodeCommitRepositories) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllCodeCommitRepositories(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codedeploy/codedeployiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// BatchGetApplications accepts at most this many application names per call
+const codeDeployBatchGetApplicationsLimit = 100
+
+// Returns a formatted string of CodeDeploy application names
+func getAllCodeDeployApplications(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := codedeploy.New(session)
+
+	var allNames []*string
+	err := svc.ListApplicationsPages(&codedeploy.ListApplicationsInput{}, func(page *codedeploy.ListApplicationsOutput, lastPage bool) bool {
+		allNames = append(allNames, page.Applications...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, batch := range split(aws.StringValueSlice(allNames), codeDeployBatchGetApplicationsLimit) {
+		// The creation time is only returned when describing the applications
+		output, err := svc.BatchGetApplications(&codedeploy.BatchGetApplicationsInput{ApplicationNames: aws.StringSlice(batch)})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, application := range output.ApplicationsInfo {
+			if shouldIncludeCodeDeployApplication(application, excludeAfter, configObj) {
+				names = append(names, application.ApplicationName)
+			}
+		}
+	}
+	return names, nil
+}
+
+func shouldIncludeCodeDeployApplication(application *codedeploy.ApplicationInfo, excludeAfter time.Time, configObj config.Config) bool {
+	if application == nil {
+		return false
+	}
+
+	if application.CreateTime != nil && excludeAfter.Before(*application.CreateTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(application.ApplicationName),
+		configObj.CodeDeployApplication.IncludeRule.NamesRegExp,
+		configObj.CodeDeployApplication.ExcludeRule.NamesRegExp,
+	)
+}
+
+// nukeCodeDeployApplication deletes the deployment groups of the application and then the application itself
+func nukeCodeDeployApplication(svc codedeployiface.CodeDeployAPI, name *string) error {
+	var groupNames []*string
+	input := &codedeploy.ListDeploymentGroupsInput{ApplicationName: name}
+	err := svc.ListDeploymentGroupsPages(input, func(page *codedeploy.ListDeploymentGroupsOutput, lastPage bool) bool {
+		groupNames = append(groupNames, page.DeploymentGroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, groupName := range groupNames {
+		_, err := svc.DeleteDeploymentGroup(&codedeploy.DeleteDeploymentGroupInput{
+			ApplicationName:     name,
+			DeploymentGroupName: groupName,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted deployment group %s of CodeDeploy application %s", aws.StringValue(groupName), aws.StringValue(name))
+	}
+
+	_, err = svc.DeleteApplication(&codedeploy.DeleteApplicationInput{ApplicationName: name})
+	return errors.WithStackTrace(err)
+}
+
+// Deletes all CodeDeploy applications, along with their deployment groups
+func nukeAllCodeDeployApplications(session *session.Session, names []*string) error {
+	svc := codedeploy.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No CodeDeploy applications to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all CodeDeploy applications in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		err := nukeCodeDeployApplication(svc, name)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: "CodeDeploy Application",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking CodeDeploy Application",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted CodeDeploy application: %s", aws.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d CodeDeploy application(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codedeploy/codedeployiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedCodeDeployApplication struct {
+	codedeployiface.CodeDeployAPI
+	DeploymentGroups []string
+	Calls            []string
+}
+
+func (m *mockedCodeDeployApplication) ListDeploymentGroupsPages(input *codedeploy.ListDeploymentGroupsInput, fn func(*codedeploy.ListDeploymentGroupsOutput, bool) bool) error {
+	fn(&codedeploy.ListDeploymentGroupsOutput{DeploymentGroups: aws.StringSlice(m.DeploymentGroups)}, true)
+	return nil
+}
+
+func (m *mockedCodeDeployApplication) DeleteDeploymentGroup(input *codedeploy.DeleteDeploymentGroupInput) (*codedeploy.DeleteDeploymentGroupOutput, error) {
+	m.Calls = append(m.Calls, "delete-group/"+aws.StringValue(input.DeploymentGroupName))
+	return &codedeploy.DeleteDeploymentGroupOutput{}, nil
+}
+
+func (m *mockedCodeDeployApplication) DeleteApplication(input *codedeploy.DeleteApplicationInput) (*codedeploy.DeleteApplicationOutput, error) {
+	m.Calls = append(m.Calls, "delete-application/"+aws.StringValue(input.ApplicationName))
+	return &codedeploy.DeleteApplicationOutput{}, nil
+}
+
+func TestNukeCodeDeployApplicationDeletesDeploymentGroupsFirst(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedCodeDeployApplication{DeploymentGroups: []string{"staging", "production"}}
+	require.NoError(t, nukeCodeDeployApplication(mock, aws.String("app")))
+	assert.Equal(t, []string{"delete-group/staging", "delete-group/production", "delete-application/app"}, mock.Calls)
+}
+
+func TestShouldIncludeCodeDeployApplication(t *testing.T) {
+	application := &codedeploy.ApplicationInfo{
+		ApplicationName: aws.String("cloud-nuke-test"),
+		CreateTime:      aws.Time(time.Now()),
+	}
+
+	assert.True(t, shouldIncludeCodeDeployApplication(application, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeCodeDeployApplication(application, time.Now().Add(-1*time.Hour), config.Config{}))
+}
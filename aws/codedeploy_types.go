@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// CodeDeployApplications - represents all CodeDeploy applications
+type CodeDeployApplications struct {
+	ApplicationNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (applications CodeDeployApplications) ResourceName() string {
+	return "codedeploy-application"
+}
+
+// ResourceIdentifiers - The names of the CodeDeploy applications
+func (applications CodeDeployApplications) ResourceIdentifiers() []string {
+	return applications.ApplicationNames
+}
+
+func (applications CodeDeployApplications) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (applications CodeDeployApplications) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllCodeDeployApplications(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codepipeline/codepipelineiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of CodePipeline pipeline names
+func getAllCodePipelinePipelines(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := codepipeline.New(session)
+
+	var candidates []*string
+	err := svc.ListPipelinesPages(&codepipeline.ListPipelinesInput{}, func(page *codepipeline.ListPipelinesOutput, lastPage bool) bool {
+		for _, pipeline := range page.Pipelines {
+			if shouldIncludeCodePipelinePipeline(pipeline, excludeAfter, configObj) {
+				candidates = append(candidates, pipeline.Name)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, name := range candidates {
+		hasExcludeTag, err := hasCodePipelineExcludeTag(svc, name)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !hasExcludeTag {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func shouldIncludeCodePipelinePipeline(pipeline *codepipeline.PipelineSummary, excludeAfter time.Time, configObj config.Config) bool {
+	if pipeline == nil {
+		return false
+	}
+
+	if pipeline.Created != nil && excludeAfter.Before(*pipeline.Created) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(pipeline.Name),
+		configObj.CodePipelinePipeline.IncludeRule.NamesRegExp,
+		configObj.CodePipelinePipeline.ExcludeRule.NamesRegExp,
+	)
+}
+
+// hasCodePipelineExcludeTag looks up the ARN of the pipeline, which is only returned by GetPipeline, to check its tags
+func hasCodePipelineExcludeTag(svc codepipelineiface.CodePipelineAPI, name *string) (bool, error) {
+	pipeline, err := svc.GetPipeline(&codepipeline.GetPipelineInput{Name: name})
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+
+	hasExcludeTag := false
+	input := &codepipeline.ListTagsForResourceInput{ResourceArn: pipeline.Metadata.PipelineArn}
+	err = svc.ListTagsForResourcePages(input, func(page *codepipeline.ListTagsForResourceOutput, lastPage bool) bool {
+		for _, tag := range page.Tags {
+			if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+				hasExcludeTag = true
+			}
+		}
+		return !lastPage
+	})
+	return hasExcludeTag, errors.WithStackTrace(err)
+}
+
+// stopCodePipelineExecutions abandons the in-progress executions of the pipeline, so that its deletion doesn't fail
+// mid-run
+func stopCodePipelineExecutions(svc codepipelineiface.CodePipelineAPI, name *string) error {
+	var inProgressIds []*string
+	input := &codepipeline.ListPipelineExecutionsInput{PipelineName: name}
+	err := svc.ListPipelineExecutionsPages(input, func(page *codepipeline.ListPipelineExecutionsOutput, lastPage bool) bool {
+		for _, execution := range page.PipelineExecutionSummaries {
+			if aws.StringValue(execution.Status) == codepipeline.PipelineExecutionStatusInProgress {
+				inProgressIds = append(inProgressIds, execution.PipelineExecutionId)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, executionId := range inProgressIds {
+		_, err := svc.StopPipelineExecution(&codepipeline.StopPipelineExecutionInput{
+			PipelineName:        name,
+			PipelineExecutionId: executionId,
+			Abandon:             aws.Bool(true),
+			Reason:              aws.String("Stopped by cloud-nuke to delete the pipeline"),
+		})
+		if err != nil {
+			// The execution may have finished, or started stopping, in the meantime
+			if awsErr, ok := err.(awserr.Error); ok && (awsErr.Code() == codepipeline.ErrCodePipelineExecutionNotStoppableException ||
+				awsErr.Code() == codepipeline.ErrCodeDuplicatedStopRequestException) {
+				continue
+			}
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Stopped execution %s of CodePipeline pipeline %s", aws.StringValue(executionId), aws.StringValue(name))
+	}
+	return nil
+}
+
+// Deletes all CodePipeline pipelines, after stopping their in-progress executions
+func nukeAllCodePipelinePipelines(session *session.Session, names []*string) error {
+	svc := codepipeline.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No CodePipeline pipelines to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all CodePipeline pipelines in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		err := stopCodePipelineExecutions(svc, name)
+		if err == nil {
+			_, err = svc.DeletePipeline(&codepipeline.DeletePipelineInput{Name: name})
+		}
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: "CodePipeline Pipeline",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking CodePipeline Pipeline",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted CodePipeline pipeline: %s", aws.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d CodePipeline pipeline(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codepipeline/codepipelineiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedCodePipelineExecutions struct {
+	codepipelineiface.CodePipelineAPI
+	Executions        map[string]string
+	StoppedExecutions []string
+}
+
+func (m *mockedCodePipelineExecutions) ListPipelineExecutionsPages(input *codepipeline.ListPipelineExecutionsInput, fn func(*codepipeline.ListPipelineExecutionsOutput, bool) bool) error {
+	var executions []*codepipeline.PipelineExecutionSummary
+	for id, status := range m.Executions {
+		executions = append(executions, &codepipeline.PipelineExecutionSummary{
+			PipelineExecutionId: aws.String(id),
+			Status:              aws.String(status),
+		})
+	}
+	fn(&codepipeline.ListPipelineExecutionsOutput{PipelineExecutionSummaries: executions}, true)
+	return nil
+}
+
+func (m *mockedCodePipelineExecutions) StopPipelineExecution(input *codepipeline.StopPipelineExecutionInput) (*codepipeline.StopPipelineExecutionOutput, error) {
+	if aws.StringValue(input.PipelineExecutionId) == "finished-meanwhile" {
+		return nil, awserr.New(codepipeline.ErrCodePipelineExecutionNotStoppableException, "", nil)
+	}
+	m.StoppedExecutions = append(m.StoppedExecutions, aws.StringValue(input.PipelineExecutionId))
+	return &codepipeline.StopPipelineExecutionOutput{}, nil
+}
+
+func TestStopCodePipelineExecutionsStopsInProgressOnes(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedCodePipelineExecutions{Executions: map[string]string{
+		"running":            codepipeline.PipelineExecutionStatusInProgress,
+		"finished-meanwhile": codepipeline.PipelineExecutionStatusInProgress,
+		"succeeded":          codepipeline.PipelineExecutionStatusSucceeded,
+		"stopped":            codepipeline.PipelineExecutionStatusStopped,
+	}}
+
+	require.NoError(t, stopCodePipelineExecutions(mock, aws.String("pipeline")))
+	assert.Equal(t, []string{"running"}, mock.StoppedExecutions)
+}
+
+func TestShouldIncludeCodePipelinePipeline(t *testing.T) {
+	pipeline := &codepipeline.PipelineSummary{
+		Name:    aws.String("cloud-nuke-test"),
+		Created: aws.Time(time.Now()),
+	}
+
+	assert.True(t, shouldIncludeCodePipelinePipeline(pipeline, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeCodePipelinePipeline(pipeline, time.Now().Add(-1*time.Hour), config.Config{}))
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// CodePipelinePipelines - represents all CodePipeline pipelines
+type CodePipelinePipelines struct {
+	PipelineNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (pipelines CodePipelinePipelines) ResourceName() string {
+	return "codepipeline-pipeline"
+}
+
+// ResourceIdentifiers - The names of the CodePipeline pipelines
+func (pipelines CodePipelinePipelines) ResourceIdentifiers() []string {
+	return pipelines.PipelineNames
+}
+
+func (pipelines CodePipelinePipelines) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (pipelines CodePipelinePipelines) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllCodePipelinePipelines(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
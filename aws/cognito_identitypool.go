@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity/cognitoidentityiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of Cognito identity pool IDs. Identity pools don't expose a creation time, so the first
+// seen tag is used for the excludeAfter filter instead.
+func getAllCognitoIdentityPools(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := cognitoidentity.New(session)
+
+	// Tagging an identity pool requires its ARN, which isn't returned by the API
+	identity, err := sts.New(session).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	callerArn, err := arn.Parse(aws.StringValue(identity.Arn))
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var candidateIds []*string
+	input := &cognitoidentity.ListIdentityPoolsInput{MaxResults: aws.Int64(60)}
+	err = svc.ListIdentityPoolsPages(input, func(page *cognitoidentity.ListIdentityPoolsOutput, lastPage bool) bool {
+		for _, identityPool := range page.IdentityPools {
+			if config.ShouldInclude(
+				aws.StringValue(identityPool.IdentityPoolName),
+				configObj.CognitoIdentityPool.IncludeRule.NamesRegExp,
+				configObj.CognitoIdentityPool.ExcludeRule.NamesRegExp,
+			) {
+				candidateIds = append(candidateIds, identityPool.IdentityPoolId)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var identityPoolIds []*string
+	for _, identityPoolId := range candidateIds {
+		// Tags are only returned when describing the identity pool
+		identityPool, err := svc.DescribeIdentityPool(&cognitoidentity.DescribeIdentityPoolInput{IdentityPoolId: identityPoolId})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if aws.StringValue(identityPool.IdentityPoolTags[AwsResourceExclusionTagKey]) == "true" {
+			continue
+		}
+
+		identityPoolArn := fmt.Sprintf(
+			"arn:%s:cognito-identity:%s:%s:identitypool/%s",
+			callerArn.Partition, *session.Config.Region, aws.StringValue(identity.Account), aws.StringValue(identityPoolId),
+		)
+		firstSeenTime, err := getOrSetFirstSeenCognitoIdentityPoolTag(svc, identityPool, identityPoolArn)
+		if err != nil {
+			logging.Logger.Errorf("Unable to retrieve tags for Cognito identity pool %s: %s", aws.StringValue(identityPoolId), err)
+			return nil, err
+		}
+		if excludeAfter.After(firstSeenTime) {
+			identityPoolIds = append(identityPoolIds, identityPoolId)
+		}
+	}
+	return identityPoolIds, nil
+}
+
+// getOrSetFirstSeenCognitoIdentityPoolTag returns the time cloud-nuke first saw the identity pool, tagging it with the
+// current time the first time it is seen.
+func getOrSetFirstSeenCognitoIdentityPoolTag(svc cognitoidentityiface.CognitoIdentityAPI, identityPool *cognitoidentity.IdentityPool, identityPoolArn string) (time.Time, error) {
+	if value, ok := identityPool.IdentityPoolTags[firstSeenTagKey]; ok {
+		firstSeenTime, err := time.Parse(time.RFC3339, aws.StringValue(value))
+		if err != nil {
+			return time.Time{}, errors.WithStackTrace(err)
+		}
+		return firstSeenTime, nil
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.TagResource(&cognitoidentity.TagResourceInput{
+		ResourceArn: aws.String(identityPoolArn),
+		Tags:        map[string]*string{firstSeenTagKey: aws.String(now.Format(time.RFC3339))},
+	})
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return now, nil
+}
+
+// Deletes all Cognito identity pools. The identities of a pool are deleted along with it.
+func nukeAllCognitoIdentityPools(session *session.Session, identityPoolIds []*string) error {
+	svc := cognitoidentity.New(session)
+
+	if len(identityPoolIds) == 0 {
+		logging.Logger.Debugf("No Cognito identity pools to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Cognito identity pools in region %s", *session.Config.Region)
+	var deletedIds []*string
+	var allErrs *multierror.Error
+
+	for _, identityPoolId := range identityPoolIds {
+		_, err := svc.DeleteIdentityPool(&cognitoidentity.DeleteIdentityPoolInput{IdentityPoolId: identityPoolId})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(identityPoolId),
+			ResourceType: "Cognito Identity Pool",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Cognito Identity Pool",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, identityPoolId)
+			logging.Logger.Debugf("Deleted Cognito identity pool: %s", aws.StringValue(identityPoolId))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Cognito identity pool(s) deleted in %s", len(deletedIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
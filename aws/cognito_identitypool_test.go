@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity/cognitoidentityiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedCognitoIdentityPoolTagging struct {
+	cognitoidentityiface.CognitoIdentityAPI
+	TaggedArns []string
+}
+
+func (m *mockedCognitoIdentityPoolTagging) TagResource(input *cognitoidentity.TagResourceInput) (*cognitoidentity.TagResourceOutput, error) {
+	m.TaggedArns = append(m.TaggedArns, aws.StringValue(input.ResourceArn))
+	return &cognitoidentity.TagResourceOutput{}, nil
+}
+
+func TestGetOrSetFirstSeenCognitoIdentityPoolTag(t *testing.T) {
+	t.Parallel()
+
+	identityPoolArn := "arn:aws:cognito-identity:us-east-1:123456789012:identitypool/us-east-1:abc"
+	firstSeen := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Second)
+
+	mock := &mockedCognitoIdentityPoolTagging{}
+	taggedPool := &cognitoidentity.IdentityPool{
+		IdentityPoolTags: map[string]*string{firstSeenTagKey: aws.String(firstSeen.Format(time.RFC3339))},
+	}
+	seenTime, err := getOrSetFirstSeenCognitoIdentityPoolTag(mock, taggedPool, identityPoolArn)
+	require.NoError(t, err)
+	assert.True(t, firstSeen.Equal(seenTime))
+	assert.Empty(t, mock.TaggedArns)
+
+	seenTime, err = getOrSetFirstSeenCognitoIdentityPoolTag(mock, &cognitoidentity.IdentityPool{}, identityPoolArn)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), seenTime, time.Minute)
+	assert.Equal(t, []string{identityPoolArn}, mock.TaggedArns)
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// CognitoIdentityPools - represents all Cognito identity pools (federated identities)
+type CognitoIdentityPools struct {
+	IdentityPoolIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (identityPools CognitoIdentityPools) ResourceName() string {
+	return "cognito-identitypool"
+}
+
+// ResourceIdentifiers - The IDs of the Cognito identity pools
+func (identityPools CognitoIdentityPools) ResourceIdentifiers() []string {
+	return identityPools.IdentityPoolIds
+}
+
+func (identityPools CognitoIdentityPools) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (identityPools CognitoIdentityPools) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllCognitoIdentityPools(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of Cognito user pool IDs
+func getAllCognitoUserPools(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := cognitoidentityprovider.New(session)
+
+	var userPoolIds []*string
+	input := &cognitoidentityprovider.ListUserPoolsInput{MaxResults: aws.Int64(60)}
+	err := svc.ListUserPoolsPages(input, func(page *cognitoidentityprovider.ListUserPoolsOutput, lastPage bool) bool {
+		for _, userPool := range page.UserPools {
+			if shouldIncludeCognitoUserPool(userPool, excludeAfter, configObj) {
+				userPoolIds = append(userPoolIds, userPool.Id)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return userPoolIds, nil
+}
+
+func shouldIncludeCognitoUserPool(userPool *cognitoidentityprovider.UserPoolDescriptionType, excludeAfter time.Time, configObj config.Config) bool {
+	if userPool == nil {
+		return false
+	}
+
+	if userPool.CreationDate != nil && excludeAfter.Before(*userPool.CreationDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(userPool.Name),
+		configObj.CognitoUserPool.IncludeRule.NamesRegExp,
+		configObj.CognitoUserPool.ExcludeRule.NamesRegExp,
+	)
+}
+
+// deleteCognitoUserPoolDomains deletes the prefix and custom domains of the user pool and waits for them to be
+// removed, as a user pool can't be deleted while it has a domain.
+func deleteCognitoUserPoolDomains(svc cognitoidentityprovideriface.CognitoIdentityProviderAPI, userPool *cognitoidentityprovider.UserPoolType) error {
+	for _, domain := range []*string{userPool.Domain, userPool.CustomDomain} {
+		if aws.StringValue(domain) == "" {
+			continue
+		}
+		_, err := svc.DeleteUserPoolDomain(&cognitoidentityprovider.DeleteUserPoolDomainInput{
+			UserPoolId: userPool.Id,
+			Domain:     domain,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted domain %s of Cognito user pool %s", aws.StringValue(domain), aws.StringValue(userPool.Id))
+	}
+
+	// Custom domains are backed by a CloudFront distribution and take a while to be removed
+	for i := 0; i < 30; i++ {
+		output, err := svc.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{UserPoolId: userPool.Id})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		if aws.StringValue(output.UserPool.Domain) == "" && aws.StringValue(output.UserPool.CustomDomain) == "" {
+			return nil
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for the domains of Cognito user pool %s to be deleted...", aws.StringValue(userPool.Id))
+	}
+
+	return CognitoUserPoolDomainDeleteTimeoutError{userPoolId: aws.StringValue(userPool.Id)}
+}
+
+// nukeCognitoUserPool deletes the user pool after deleting its domains and lifting its deletion protection. Protection
+// is lifted last, right before the pool is deleted, so that a failed domain deletion leaves the pool untouched.
+func nukeCognitoUserPool(svc cognitoidentityprovideriface.CognitoIdentityProviderAPI, userPoolId *string) error {
+	output, err := svc.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{UserPoolId: userPoolId})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	userPool := output.UserPool
+
+	if err := deleteCognitoUserPoolDomains(svc, userPool); err != nil {
+		return err
+	}
+
+	if aws.StringValue(userPool.DeletionProtection) == cognitoidentityprovider.DeletionProtectionTypeActive {
+		_, err := svc.UpdateUserPool(getCognitoUserPoolUnprotectInput(userPool))
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Removed deletion protection from Cognito user pool %s", aws.StringValue(userPoolId))
+	}
+
+	_, err = svc.DeleteUserPool(&cognitoidentityprovider.DeleteUserPoolInput{UserPoolId: userPoolId})
+	return errors.WithStackTrace(err)
+}
+
+// getCognitoUserPoolUnprotectInput returns the update that lifts the deletion protection of the user pool. Settings
+// missing from an update are reset to their defaults, so every setting of the described pool is carried over, in case
+// the pool outlives a failed deletion.
+func getCognitoUserPoolUnprotectInput(userPool *cognitoidentityprovider.UserPoolType) *cognitoidentityprovider.UpdateUserPoolInput {
+	adminCreateUserConfig := userPool.AdminCreateUserConfig
+	if adminCreateUserConfig != nil && userPool.Policies != nil && userPool.Policies.PasswordPolicy != nil &&
+		userPool.Policies.PasswordPolicy.TemporaryPasswordValidityDays != nil {
+		// The deprecated UnusedAccountValidityDays is rejected along with the password policy setting replacing it
+		copied := *adminCreateUserConfig
+		copied.UnusedAccountValidityDays = nil
+		adminCreateUserConfig = &copied
+	}
+
+	return &cognitoidentityprovider.UpdateUserPoolInput{
+		UserPoolId:                  userPool.Id,
+		DeletionProtection:          aws.String(cognitoidentityprovider.DeletionProtectionTypeInactive),
+		AccountRecoverySetting:      userPool.AccountRecoverySetting,
+		AdminCreateUserConfig:       adminCreateUserConfig,
+		AutoVerifiedAttributes:      userPool.AutoVerifiedAttributes,
+		DeviceConfiguration:         userPool.DeviceConfiguration,
+		EmailConfiguration:          userPool.EmailConfiguration,
+		EmailVerificationMessage:    userPool.EmailVerificationMessage,
+		EmailVerificationSubject:    userPool.EmailVerificationSubject,
+		LambdaConfig:                userPool.LambdaConfig,
+		MfaConfiguration:            userPool.MfaConfiguration,
+		Policies:                    userPool.Policies,
+		SmsAuthenticationMessage:    userPool.SmsAuthenticationMessage,
+		SmsConfiguration:            userPool.SmsConfiguration,
+		SmsVerificationMessage:      userPool.SmsVerificationMessage,
+		UserAttributeUpdateSettings: userPool.UserAttributeUpdateSettings,
+		UserPoolAddOns:              userPool.UserPoolAddOns,
+		UserPoolTags:                userPool.UserPoolTags,
+		VerificationMessageTemplate: userPool.VerificationMessageTemplate,
+	}
+}
+
+// Deletes all Cognito user pools
+func nukeAllCognitoUserPools(session *session.Session, userPoolIds []*string) error {
+	svc := cognitoidentityprovider.New(session)
+
+	if len(userPoolIds) == 0 {
+		logging.Logger.Debugf("No Cognito user pools to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Cognito user pools in region %s", *session.Config.Region)
+	var deletedIds []*string
+	var allErrs *multierror.Error
+
+	for _, userPoolId := range userPoolIds {
+		err := nukeCognitoUserPool(svc, userPoolId)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(userPoolId),
+			ResourceType: "Cognito User Pool",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Cognito User Pool",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, userPoolId)
+			logging.Logger.Debugf("Deleted Cognito user pool: %s", aws.StringValue(userPoolId))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Cognito user pool(s) deleted in %s", len(deletedIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedCognitoUserPool serves a protected user pool with a prefix and a custom domain
+type mockedCognitoUserPool struct {
+	cognitoidentityprovideriface.CognitoIdentityProviderAPI
+	UserPool *cognitoidentityprovider.UserPoolType
+	Calls    []string
+	Update   *cognitoidentityprovider.UpdateUserPoolInput
+}
+
+func (m *mockedCognitoUserPool) DescribeUserPool(input *cognitoidentityprovider.DescribeUserPoolInput) (*cognitoidentityprovider.DescribeUserPoolOutput, error) {
+	userPool := *m.UserPool
+	return &cognitoidentityprovider.DescribeUserPoolOutput{UserPool: &userPool}, nil
+}
+
+func (m *mockedCognitoUserPool) UpdateUserPool(input *cognitoidentityprovider.UpdateUserPoolInput) (*cognitoidentityprovider.UpdateUserPoolOutput, error) {
+	m.Calls = append(m.Calls, "deletion-protection:"+aws.StringValue(input.DeletionProtection))
+	m.Update = input
+	m.UserPool.DeletionProtection = input.DeletionProtection
+	return &cognitoidentityprovider.UpdateUserPoolOutput{}, nil
+}
+
+func (m *mockedCognitoUserPool) DeleteUserPoolDomain(input *cognitoidentityprovider.DeleteUserPoolDomainInput) (*cognitoidentityprovider.DeleteUserPoolDomainOutput, error) {
+	m.Calls = append(m.Calls, "domain:"+aws.StringValue(input.Domain))
+	if aws.StringValue(input.Domain) == aws.StringValue(m.UserPool.Domain) {
+		m.UserPool.Domain = nil
+	} else {
+		m.UserPool.CustomDomain = nil
+	}
+	return &cognitoidentityprovider.DeleteUserPoolDomainOutput{}, nil
+}
+
+func (m *mockedCognitoUserPool) DeleteUserPool(input *cognitoidentityprovider.DeleteUserPoolInput) (*cognitoidentityprovider.DeleteUserPoolOutput, error) {
+	m.Calls = append(m.Calls, "user-pool:"+aws.StringValue(input.UserPoolId))
+	return &cognitoidentityprovider.DeleteUserPoolOutput{}, nil
+}
+
+func TestNukeCognitoUserPoolDeletesDomainsBeforeRemovingProtection(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedCognitoUserPool{UserPool: &cognitoidentityprovider.UserPoolType{
+		Id:                 aws.String("us-east-1_abc"),
+		DeletionProtection: aws.String(cognitoidentityprovider.DeletionProtectionTypeActive),
+		Domain:             aws.String("cloud-nuke-test"),
+		CustomDomain:       aws.String("auth.example.com"),
+		MfaConfiguration:   aws.String(cognitoidentityprovider.UserPoolMfaTypeOn),
+		LambdaConfig:       &cognitoidentityprovider.LambdaConfigType{PreSignUp: aws.String("arn:aws:lambda:us-east-1:123456789012:function:pre-sign-up")},
+	}}
+	require.NoError(t, nukeCognitoUserPool(mock, aws.String("us-east-1_abc")))
+	assert.Equal(t, []string{
+		"domain:cloud-nuke-test",
+		"domain:auth.example.com",
+		"deletion-protection:" + cognitoidentityprovider.DeletionProtectionTypeInactive,
+		"user-pool:us-east-1_abc",
+	}, mock.Calls)
+
+	// The other settings of the pool are carried over, as the update would reset them otherwise
+	assert.Equal(t, cognitoidentityprovider.UserPoolMfaTypeOn, aws.StringValue(mock.Update.MfaConfiguration))
+	assert.Equal(t, "arn:aws:lambda:us-east-1:123456789012:function:pre-sign-up", aws.StringValue(mock.Update.LambdaConfig.PreSignUp))
+}
+
+func TestGetCognitoUserPoolUnprotectInputDropsDeprecatedValidity(t *testing.T) {
+	t.Parallel()
+
+	input := getCognitoUserPoolUnprotectInput(&cognitoidentityprovider.UserPoolType{
+		Id: aws.String("us-east-1_abc"),
+		AdminCreateUserConfig: &cognitoidentityprovider.AdminCreateUserConfigType{
+			AllowAdminCreateUserOnly:  aws.Bool(true),
+			UnusedAccountValidityDays: aws.Int64(7),
+		},
+		Policies: &cognitoidentityprovider.UserPoolPolicyType{
+			PasswordPolicy: &cognitoidentityprovider.PasswordPolicyType{TemporaryPasswordValidityDays: aws.Int64(7)},
+		},
+	})
+	assert.True(t, aws.BoolValue(input.AdminCreateUserConfig.AllowAdminCreateUserOnly))
+	assert.Nil(t, input.AdminCreateUserConfig.UnusedAccountValidityDays)
+	assert.Equal(t, int64(7), aws.Int64Value(input.Policies.PasswordPolicy.TemporaryPasswordValidityDays))
+}
+
+func TestShouldIncludeCognitoUserPool(t *testing.T) {
+	now := time.Now()
+	userPool := &cognitoidentityprovider.UserPoolDescriptionType{Name: aws.String("cloud-nuke-test"), CreationDate: aws.Time(now)}
+
+	assert.True(t, shouldIncludeCognitoUserPool(userPool, now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeCognitoUserPool(userPool, now.Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeCognitoUserPool(nil, now, config.Config{}))
+}
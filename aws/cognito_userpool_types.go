@@ -0,0 +1,44 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// CognitoUserPools - represents all Cognito user pools
+type CognitoUserPools struct {
+	UserPoolIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (userPools CognitoUserPools) ResourceName() string {
+	return "cognito-userpool"
+}
+
+// ResourceIdentifiers - The IDs of the Cognito user pools
+func (userPools CognitoUserPools) ResourceIdentifiers() []string {
+	return userPools.UserPoolIds
+}
+
+func (userPools CognitoUserPools) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (userPools CognitoUserPools) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllCognitoUserPools(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type CognitoUserPoolDomainDeleteTimeoutError struct {
+	userPoolId string
+}
+
+func (e CognitoUserPoolDomainDeleteTimeoutError) Error() string {
+	return "Timed out waiting for the domains of Cognito user pool " + e.userPoolId + " to be deleted"
+}
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+func getAllConfigConformancePacks(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]string, error) {
+	svc := configservice.New(session)
+
+	conformancePackNames := []string{}
+	err := svc.DescribeConformancePacksPages(&configservice.DescribeConformancePacksInput{}, func(output *configservice.DescribeConformancePacksOutput, lastPage bool) bool {
+		for _, conformancePack := range output.ConformancePackDetails {
+			if shouldIncludeConfigConformancePack(conformancePack, excludeAfter, configObj) {
+				conformancePackNames = append(conformancePackNames, aws.StringValue(conformancePack.ConformancePackName))
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return conformancePackNames, nil
+}
+
+func shouldIncludeConfigConformancePack(conformancePack *configservice.ConformancePackDetail, excludeAfter time.Time, configObj config.Config) bool {
+	if conformancePack == nil {
+		return false
+	}
+
+	// Conformance packs deployed across an organization can only be deleted from the management account
+	if conformancePack.CreatedBy != nil {
+		return false
+	}
+
+	// Conformance packs have no creation time, so we go by the last time they were deployed
+	if conformancePack.LastUpdateRequestedTime != nil && excludeAfter.Before(*conformancePack.LastUpdateRequestedTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(conformancePack.ConformancePackName),
+		configObj.ConfigServiceConformancePack.IncludeRule.NamesRegExp,
+		configObj.ConfigServiceConformancePack.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Deletes all Config conformance packs, along with the rules they have deployed
+func nukeAllConfigConformancePacks(session *session.Session, conformancePackNames []string) error {
+	svc := configservice.New(session)
+
+	if len(conformancePackNames) == 0 {
+		logging.Logger.Debugf("No Config conformance packs to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, conformancePackName := range conformancePackNames {
+		_, err := svc.DeleteConformancePack(&configservice.DeleteConformancePackInput{
+			ConformancePackName: aws.String(conformancePackName),
+		})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   conformancePackName,
+			ResourceType: "Config Conformance Pack",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Config Conformance Pack",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, aws.String(conformancePackName))
+			logging.Logger.Debugf("Deleted Config Conformance Pack: %s", conformancePackName)
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Config Conformance Packs deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
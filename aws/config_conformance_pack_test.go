@@ -0,0 +1,27 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldIncludeConfigConformancePack(t *testing.T) {
+	conformancePack := &configservice.ConformancePackDetail{
+		ConformancePackName:     aws.String("cloud-nuke-test"),
+		LastUpdateRequestedTime: aws.Time(time.Now()),
+	}
+	organizationConformancePack := &configservice.ConformancePackDetail{
+		ConformancePackName:     aws.String("OrgConformsPack-cloud-nuke-test"),
+		CreatedBy:               aws.String("config-multiaccountsetup.amazonaws.com"),
+		LastUpdateRequestedTime: aws.Time(time.Now()),
+	}
+
+	assert.True(t, shouldIncludeConfigConformancePack(conformancePack, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeConfigConformancePack(conformancePack, time.Now().Add(1*time.Hour*-1), config.Config{}))
+	assert.False(t, shouldIncludeConfigConformancePack(organizationConformancePack, time.Now().Add(1*time.Hour), config.Config{}))
+}
@@ -0,0 +1,29 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+type ConfigConformancePacks struct {
+	ConformancePackNames []string
+}
+
+func (c ConfigConformancePacks) ResourceName() string {
+	return "config-conformance-packs"
+}
+
+func (c ConfigConformancePacks) ResourceIdentifiers() []string {
+	return c.ConformancePackNames
+}
+
+func (c ConfigConformancePacks) MaxBatchSize() int {
+	return 50
+}
+
+func (c ConfigConformancePacks) Nuke(session *session.Session, conformancePackNames []string) error {
+	if err := nukeAllConfigConformancePacks(session, conformancePackNames); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+func getAllConfigDeliveryChannels(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]string, error) {
+	svc := configservice.New(session)
+
+	output, err := svc.DescribeDeliveryChannels(&configservice.DescribeDeliveryChannelsInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	deliveryChannelNames := []string{}
+	for _, deliveryChannel := range output.DeliveryChannels {
+		if shouldIncludeConfigDeliveryChannel(deliveryChannel, configObj) {
+			deliveryChannelNames = append(deliveryChannelNames, aws.StringValue(deliveryChannel.Name))
+		}
+	}
+
+	return deliveryChannelNames, nil
+}
+
+func shouldIncludeConfigDeliveryChannel(deliveryChannel *configservice.DeliveryChannel, configObj config.Config) bool {
+	if deliveryChannel == nil {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(deliveryChannel.Name),
+		configObj.ConfigServiceDeliveryChannel.IncludeRule.NamesRegExp,
+		configObj.ConfigServiceDeliveryChannel.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Deletes all Config delivery channels. This only succeeds once the configuration recorder is stopped, which is why
+// the delivery channels are nuked after the recorders.
+func nukeAllConfigDeliveryChannels(session *session.Session, deliveryChannelNames []string) error {
+	svc := configservice.New(session)
+
+	if len(deliveryChannelNames) == 0 {
+		logging.Logger.Debugf("No Config delivery channels to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, deliveryChannelName := range deliveryChannelNames {
+		_, err := svc.DeleteDeliveryChannel(&configservice.DeleteDeliveryChannelInput{
+			DeliveryChannelName: aws.String(deliveryChannelName),
+		})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   deliveryChannelName,
+			ResourceType: "Config Delivery Channel",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Config Delivery Channel",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, aws.String(deliveryChannelName))
+			logging.Logger.Debugf("Deleted Config Delivery Channel: %s", deliveryChannelName)
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Config Delivery Channels deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,29 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+type ConfigDeliveryChannels struct {
+	DeliveryChannelNames []string
+}
+
+func (c ConfigDeliveryChannels) ResourceName() string {
+	return "config-delivery-channels"
+}
+
+func (c ConfigDeliveryChannels) ResourceIdentifiers() []string {
+	return c.DeliveryChannelNames
+}
+
+func (c ConfigDeliveryChannels) MaxBatchSize() int {
+	return 50
+}
+
+func (c ConfigDeliveryChannels) Nuke(session *session.Session, deliveryChannelNames []string) error {
+	if err := nukeAllConfigDeliveryChannels(session, deliveryChannelNames); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/configservice/configserviceiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
@@ -47,6 +48,22 @@ func shouldIncludeConfigRecorder(configRecorder *configservice.ConfigurationReco
 	)
 }
 
+// stopAndDeleteConfigRecorder stops the recorder before deleting it, as the delivery channel can't be deleted while the
+// recorder is still recording.
+func stopAndDeleteConfigRecorder(svc configserviceiface.ConfigServiceAPI, configRecorderName *string) error {
+	_, err := svc.StopConfigurationRecorder(&configservice.StopConfigurationRecorderInput{
+		ConfigurationRecorderName: configRecorderName,
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	_, err = svc.DeleteConfigurationRecorder(&configservice.DeleteConfigurationRecorderInput{
+		ConfigurationRecorderName: configRecorderName,
+	})
+	return errors.WithStackTrace(err)
+}
+
 func nukeAllConfigRecorders(session *session.Session, configRecorderNames []string) error {
 	svc := configservice.New(session)
 
@@ -58,11 +75,7 @@ func nukeAllConfigRecorders(session *session.Session, configRecorderNames []stri
 	var deletedNames []*string
 
 	for _, configRecorderName := range configRecorderNames {
-		params := &configservice.DeleteConfigurationRecorderInput{
-			ConfigurationRecorderName: aws.String(configRecorderName),
-		}
-
-		_, err := svc.DeleteConfigurationRecorder(params)
+		err := stopAndDeleteConfigRecorder(svc, aws.String(configRecorderName))
 
 		// Record status of this resource
 		e := report.Entry{
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/configservice/configserviceiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -90,3 +91,24 @@ func assertConfigRecordersDeleted(t *testing.T, region string) {
 
 	require.Empty(t, resp.ConfigurationRecorders)
 }
+
+type mockedConfigServiceRecorder struct {
+	configserviceiface.ConfigServiceAPI
+	Calls []string
+}
+
+func (m *mockedConfigServiceRecorder) StopConfigurationRecorder(input *configservice.StopConfigurationRecorderInput) (*configservice.StopConfigurationRecorderOutput, error) {
+	m.Calls = append(m.Calls, "StopConfigurationRecorder")
+	return &configservice.StopConfigurationRecorderOutput{}, nil
+}
+
+func (m *mockedConfigServiceRecorder) DeleteConfigurationRecorder(input *configservice.DeleteConfigurationRecorderInput) (*configservice.DeleteConfigurationRecorderOutput, error) {
+	m.Calls = append(m.Calls, "DeleteConfigurationRecorder")
+	return &configservice.DeleteConfigurationRecorderOutput{}, nil
+}
+
+func TestStopAndDeleteConfigRecorderStopsFirst(t *testing.T) {
+	mock := &mockedConfigServiceRecorder{}
+	require.NoError(t, stopAndDeleteConfigRecorder(mock, aws.String("default")))
+	assert.Equal(t, []string{"StopConfigurationRecorder", "DeleteConfigurationRecorder"}, mock.Calls)
+}
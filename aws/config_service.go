@@ -44,6 +44,11 @@ func shouldIncludeConfigRule(configRule *configservice.ConfigRule, excludeAfter
 		return false
 	}
 
+	// Rules created by other services, such as conformance packs or Security Hub, can only be deleted by them
+	if configRule.CreatedBy != nil {
+		return false
+	}
+
 	return config.ShouldInclude(
 		aws.StringValue(configRule.ConfigRuleName),
 		configObj.ConfigServiceRule.IncludeRule.NamesRegExp,
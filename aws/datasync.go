@@ -0,0 +1,256 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/datasync/datasynciface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/collections"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// The statuses of task executions that are still running and need to be cancelled
+var runningDataSyncTaskExecutionStatuses = []string{
+	datasync.TaskExecutionStatusQueued,
+	datasync.TaskExecutionStatusLaunching,
+	datasync.TaskExecutionStatusPreparing,
+	datasync.TaskExecutionStatusTransferring,
+	datasync.TaskExecutionStatusVerifying,
+}
+
+// Returns a formatted string of DataSync task ARNs. The list APIs of DataSync don't return creation times, so the first
+// seen tag is used for the excludeAfter filter of all DataSync resources.
+func getAllDataSyncTasks(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := datasync.New(session)
+
+	var tasks []*datasync.TaskListEntry
+	err := svc.ListTasksPages(&datasync.ListTasksInput{}, func(page *datasync.ListTasksOutput, lastPage bool) bool {
+		tasks = append(tasks, page.Tasks...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var arns []*string
+	for _, task := range tasks {
+		include, err := shouldIncludeDataSyncResource(svc, task.TaskArn, aws.StringValue(task.Name), excludeAfter, configObj.DataSyncTask)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if include {
+			arns = append(arns, task.TaskArn)
+		}
+	}
+	return arns, nil
+}
+
+// Returns a formatted string of DataSync location ARNs. Locations don't have a name, so they are filtered on their URI
+// (e.g. s3://bucket/prefix/).
+func getAllDataSyncLocations(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := datasync.New(session)
+
+	var locations []*datasync.LocationListEntry
+	err := svc.ListLocationsPages(&datasync.ListLocationsInput{}, func(page *datasync.ListLocationsOutput, lastPage bool) bool {
+		locations = append(locations, page.Locations...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var arns []*string
+	for _, location := range locations {
+		include, err := shouldIncludeDataSyncResource(svc, location.LocationArn, aws.StringValue(location.LocationUri), excludeAfter, configObj.DataSyncLocation)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if include {
+			arns = append(arns, location.LocationArn)
+		}
+	}
+	return arns, nil
+}
+
+// Returns a formatted string of DataSync agent ARNs
+func getAllDataSyncAgents(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := datasync.New(session)
+
+	var agents []*datasync.AgentListEntry
+	err := svc.ListAgentsPages(&datasync.ListAgentsInput{}, func(page *datasync.ListAgentsOutput, lastPage bool) bool {
+		agents = append(agents, page.Agents...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var arns []*string
+	for _, agent := range agents {
+		include, err := shouldIncludeDataSyncResource(svc, agent.AgentArn, aws.StringValue(agent.Name), excludeAfter, configObj.DataSyncAgent)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if include {
+			arns = append(arns, agent.AgentArn)
+		}
+	}
+	return arns, nil
+}
+
+// shouldIncludeDataSyncResource filters DataSync tasks, locations and agents on their name and tags, tagging them with
+// the first seen tag when they are seen for the first time.
+func shouldIncludeDataSyncResource(svc datasynciface.DataSyncAPI, resourceArn *string, name string, excludeAfter time.Time, resourceType config.ResourceType) (bool, error) {
+	if !config.ShouldInclude(name, resourceType.IncludeRule.NamesRegExp, resourceType.ExcludeRule.NamesRegExp) {
+		return false, nil
+	}
+
+	tags := map[string]string{}
+	err := svc.ListTagsForResourcePages(
+		&datasync.ListTagsForResourceInput{ResourceArn: resourceArn},
+		func(page *datasync.ListTagsForResourceOutput, lastPage bool) bool {
+			for _, tag := range page.Tags {
+				tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+	if tags[AwsResourceExclusionTagKey] == "true" {
+		return false, nil
+	}
+
+	firstSeenTime, err := getOrSetFirstSeenDataSyncTag(svc, resourceArn, tags)
+	if err != nil {
+		logging.Logger.Errorf("Unable to tag DataSync resource %s: %s", aws.StringValue(resourceArn), err)
+		return false, err
+	}
+	return excludeAfter.After(firstSeenTime), nil
+}
+
+// getOrSetFirstSeenDataSyncTag returns the time cloud-nuke first saw the DataSync resource, tagging it with the
+// current time the first time it is seen.
+func getOrSetFirstSeenDataSyncTag(svc datasynciface.DataSyncAPI, resourceArn *string, tags map[string]string) (time.Time, error) {
+	if value, ok := tags[firstSeenTagKey]; ok {
+		firstSeenTime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, errors.WithStackTrace(err)
+		}
+		return firstSeenTime, nil
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.TagResource(&datasync.TagResourceInput{
+		ResourceArn: resourceArn,
+		Tags:        []*datasync.TagListEntry{{Key: aws.String(firstSeenTagKey), Value: aws.String(now.Format(time.RFC3339))}},
+	})
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return now, nil
+}
+
+// nukeDataSyncTask cancels the running executions of the task and deletes it
+func nukeDataSyncTask(svc datasynciface.DataSyncAPI, taskArn *string) error {
+	var runningExecutionArns []*string
+	err := svc.ListTaskExecutionsPages(&datasync.ListTaskExecutionsInput{TaskArn: taskArn}, func(page *datasync.ListTaskExecutionsOutput, lastPage bool) bool {
+		for _, execution := range page.TaskExecutions {
+			if collections.ListContainsElement(runningDataSyncTaskExecutionStatuses, aws.StringValue(execution.Status)) {
+				runningExecutionArns = append(runningExecutionArns, execution.TaskExecutionArn)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, executionArn := range runningExecutionArns {
+		if _, err := svc.CancelTaskExecution(&datasync.CancelTaskExecutionInput{TaskExecutionArn: executionArn}); err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Cancelled execution %s of DataSync task %s", aws.StringValue(executionArn), aws.StringValue(taskArn))
+	}
+
+	_, err = svc.DeleteTask(&datasync.DeleteTaskInput{TaskArn: taskArn})
+	return errors.WithStackTrace(err)
+}
+
+func nukeDataSyncLocation(svc datasynciface.DataSyncAPI, locationArn *string) error {
+	_, err := svc.DeleteLocation(&datasync.DeleteLocationInput{LocationArn: locationArn})
+	return errors.WithStackTrace(err)
+}
+
+func nukeDataSyncAgent(svc datasynciface.DataSyncAPI, agentArn *string) error {
+	_, err := svc.DeleteAgent(&datasync.DeleteAgentInput{AgentArn: agentArn})
+	return errors.WithStackTrace(err)
+}
+
+// nukeDataSyncResources deletes the given DataSync tasks, locations or agents using deleteFn, recording the status of
+// each of them.
+func nukeDataSyncResources(session *session.Session, resourceType string, identifiers []*string, deleteFn func(svc datasynciface.DataSyncAPI, identifier *string) error) error {
+	svc := datasync.New(session)
+
+	if len(identifiers) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedIdentifiers []*string
+	var allErrs *multierror.Error
+
+	for _, identifier := range identifiers {
+		err := deleteFn(svc, identifier)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(identifier),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIdentifiers = append(deletedIdentifiers, identifier)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(identifier))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedIdentifiers), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// Deletes all DataSync tasks, after cancelling their running executions
+func nukeAllDataSyncTasks(session *session.Session, arns []*string) error {
+	return nukeDataSyncResources(session, "DataSync Task", arns, nukeDataSyncTask)
+}
+
+// Deletes all DataSync locations
+func nukeAllDataSyncLocations(session *session.Session, arns []*string) error {
+	return nukeDataSyncResources(session, "DataSync Location", arns, nukeDataSyncLocation)
+}
+
+// Deletes all DataSync agents
+func nukeAllDataSyncAgents(session *session.Session, arns []*string) error {
+	return nukeDataSyncResources(session, "DataSync Agent", arns, nukeDataSyncAgent)
+}
@@ -0,0 +1,74 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/datasync/datasynciface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedDataSync struct {
+	datasynciface.DataSyncAPI
+	Tags  []*datasync.TagListEntry
+	Calls []string
+}
+
+func (m *mockedDataSync) ListTagsForResourcePages(input *datasync.ListTagsForResourceInput, fn func(*datasync.ListTagsForResourceOutput, bool) bool) error {
+	fn(&datasync.ListTagsForResourceOutput{Tags: m.Tags}, true)
+	return nil
+}
+
+func (m *mockedDataSync) TagResource(input *datasync.TagResourceInput) (*datasync.TagResourceOutput, error) {
+	m.Calls = append(m.Calls, "tag "+aws.StringValue(input.Tags[0].Key))
+	return &datasync.TagResourceOutput{}, nil
+}
+
+func (m *mockedDataSync) ListTaskExecutionsPages(input *datasync.ListTaskExecutionsInput, fn func(*datasync.ListTaskExecutionsOutput, bool) bool) error {
+	fn(&datasync.ListTaskExecutionsOutput{TaskExecutions: []*datasync.TaskExecutionListEntry{
+		{TaskExecutionArn: aws.String("finished"), Status: aws.String(datasync.TaskExecutionStatusSuccess)},
+		{TaskExecutionArn: aws.String("running"), Status: aws.String(datasync.TaskExecutionStatusTransferring)},
+	}}, true)
+	return nil
+}
+
+func (m *mockedDataSync) CancelTaskExecution(input *datasync.CancelTaskExecutionInput) (*datasync.CancelTaskExecutionOutput, error) {
+	m.Calls = append(m.Calls, "cancel "+aws.StringValue(input.TaskExecutionArn))
+	return &datasync.CancelTaskExecutionOutput{}, nil
+}
+
+func (m *mockedDataSync) DeleteTask(input *datasync.DeleteTaskInput) (*datasync.DeleteTaskOutput, error) {
+	m.Calls = append(m.Calls, "delete "+aws.StringValue(input.TaskArn))
+	return &datasync.DeleteTaskOutput{}, nil
+}
+
+func TestNukeDataSyncTaskCancelsRunningExecutions(t *testing.T) {
+	svc := &mockedDataSync{}
+	require.NoError(t, nukeDataSyncTask(svc, aws.String("task")))
+	assert.Equal(t, []string{"cancel running", "delete task"}, svc.Calls)
+}
+
+func TestShouldIncludeDataSyncResource(t *testing.T) {
+	firstSeen := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+	seen := &mockedDataSync{Tags: []*datasync.TagListEntry{{Key: aws.String(firstSeenTagKey), Value: aws.String(firstSeen)}}}
+
+	include, err := shouldIncludeDataSyncResource(seen, aws.String("arn"), "cloud-nuke-test", time.Now().Add(-1*time.Hour), config.ResourceType{})
+	require.NoError(t, err)
+	assert.True(t, include)
+
+	excluded := &mockedDataSync{Tags: []*datasync.TagListEntry{{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}}}
+	include, err = shouldIncludeDataSyncResource(excluded, aws.String("arn"), "cloud-nuke-test", time.Now().Add(1*time.Hour), config.ResourceType{})
+	require.NoError(t, err)
+	assert.False(t, include)
+
+	// Resources seen for the first time are tagged and only nuked by a later run
+	unseen := &mockedDataSync{}
+	include, err = shouldIncludeDataSyncResource(unseen, aws.String("arn"), "cloud-nuke-test", time.Now().Add(-1*time.Hour), config.ResourceType{})
+	require.NoError(t, err)
+	assert.False(t, include)
+	assert.Equal(t, []string{"tag " + firstSeenTagKey}, unseen.Calls)
+}
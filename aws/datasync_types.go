@@ -0,0 +1,94 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// DataSyncTasks - represents all DataSync tasks
+type DataSyncTasks struct {
+	TaskArns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (tasks DataSyncTasks) ResourceName() string {
+	return "datasync-task"
+}
+
+// ResourceIdentifiers - The ARNs of the DataSync tasks
+func (tasks DataSyncTasks) ResourceIdentifiers() []string {
+	return tasks.TaskArns
+}
+
+func (tasks DataSyncTasks) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (tasks DataSyncTasks) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllDataSyncTasks(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// DataSyncLocations - represents all DataSync locations
+type DataSyncLocations struct {
+	LocationArns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (locations DataSyncLocations) ResourceName() string {
+	return "datasync-location"
+}
+
+// ResourceIdentifiers - The ARNs of the DataSync locations
+func (locations DataSyncLocations) ResourceIdentifiers() []string {
+	return locations.LocationArns
+}
+
+func (locations DataSyncLocations) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (locations DataSyncLocations) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllDataSyncLocations(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// DataSyncAgents - represents all DataSync agents
+type DataSyncAgents struct {
+	AgentArns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (agents DataSyncAgents) ResourceName() string {
+	return "datasync-agent"
+}
+
+// ResourceIdentifiers - The ARNs of the DataSync agents
+func (agents DataSyncAgents) ResourceIdentifiers() []string {
+	return agents.AgentArns
+}
+
+func (agents DataSyncAgents) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (agents DataSyncAgents) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllDataSyncAgents(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/docdb/docdbiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of DocumentDB cluster identifiers
+func getAllDocDBClusters(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := docdb.New(session)
+
+	// The DocumentDB API shares its backend with RDS, so only ask for DocumentDB clusters
+	input := &docdb.DescribeDBClustersInput{
+		Filters: []*docdb.Filter{
+			{
+				Name:   aws.String("engine"),
+				Values: aws.StringSlice([]string{"docdb"}),
+			},
+		},
+	}
+
+	var candidates []*docdb.DBCluster
+	err := svc.DescribeDBClustersPages(input, func(page *docdb.DescribeDBClustersOutput, lastPage bool) bool {
+		for _, cluster := range page.DBClusters {
+			if shouldIncludeDocDBCluster(cluster, excludeAfter, configObj) {
+				candidates = append(candidates, cluster)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var identifiers []*string
+	for _, cluster := range candidates {
+		tags, err := svc.ListTagsForResource(&docdb.ListTagsForResourceInput{ResourceName: cluster.DBClusterArn})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !hasDocDBExcludeTag(tags.TagList) {
+			identifiers = append(identifiers, cluster.DBClusterIdentifier)
+		}
+	}
+	return identifiers, nil
+}
+
+func shouldIncludeDocDBCluster(cluster *docdb.DBCluster, excludeAfter time.Time, configObj config.Config) bool {
+	if cluster == nil {
+		return false
+	}
+
+	if cluster.ClusterCreateTime != nil && excludeAfter.Before(*cluster.ClusterCreateTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(cluster.DBClusterIdentifier),
+		configObj.DocDBCluster.IncludeRule.NamesRegExp,
+		configObj.DocDBCluster.ExcludeRule.NamesRegExp,
+	)
+}
+
+func hasDocDBExcludeTag(tags []*docdb.Tag) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// nukeDocDBCluster deletes the instances of the cluster, as a cluster can't be deleted while it still has
+// instances, and then the cluster itself without taking a final snapshot.
+func nukeDocDBCluster(svc docdbiface.DocDBAPI, identifier *string) error {
+	output, err := svc.DescribeDBClusters(&docdb.DescribeDBClustersInput{DBClusterIdentifier: identifier})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(output.DBClusters) == 0 {
+		return nil
+	}
+
+	for _, member := range output.DBClusters[0].DBClusterMembers {
+		_, err := svc.DeleteDBInstance(&docdb.DeleteDBInstanceInput{DBInstanceIdentifier: member.DBInstanceIdentifier})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted DocumentDB instance: %s", aws.StringValue(member.DBInstanceIdentifier))
+	}
+
+	for _, member := range output.DBClusters[0].DBClusterMembers {
+		err := svc.WaitUntilDBInstanceDeleted(&docdb.DescribeDBInstancesInput{
+			DBInstanceIdentifier: member.DBInstanceIdentifier,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	_, err = svc.DeleteDBCluster(&docdb.DeleteDBClusterInput{
+		DBClusterIdentifier: identifier,
+		SkipFinalSnapshot:   aws.Bool(true),
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return waitUntilDocDBClusterDeleted(svc, identifier)
+}
+
+func waitUntilDocDBClusterDeleted(svc docdbiface.DocDBAPI, identifier *string) error {
+	// wait up to 15 minutes
+	for i := 0; i < 90; i++ {
+		_, err := svc.DescribeDBClusters(&docdb.DescribeDBClustersInput{DBClusterIdentifier: identifier})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == docdb.ErrCodeDBClusterNotFoundFault {
+				return nil
+			}
+
+			return errors.WithStackTrace(err)
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for DocumentDB cluster %s to be deleted", aws.StringValue(identifier))
+	}
+
+	return DocDBClusterDeleteTimeoutError{identifier: aws.StringValue(identifier)}
+}
+
+// Deletes all DocumentDB clusters, along with their instances
+func nukeAllDocDBClusters(session *session.Session, identifiers []*string) error {
+	svc := docdb.New(session)
+
+	if len(identifiers) == 0 {
+		logging.Logger.Debugf("No DocumentDB clusters to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all DocumentDB clusters in region %s", *session.Config.Region)
+	var deletedIdentifiers []*string
+	var allErrs *multierror.Error
+
+	for _, identifier := range identifiers {
+		err := nukeDocDBCluster(svc, identifier)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(identifier),
+			ResourceType: "DocumentDB Cluster",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking DocumentDB Cluster",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIdentifiers = append(deletedIdentifiers, identifier)
+			logging.Logger.Debugf("Deleted DocumentDB cluster: %s", aws.StringValue(identifier))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d DocumentDB cluster(s) deleted in %s", len(deletedIdentifiers), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
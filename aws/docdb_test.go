@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/docdb/docdbiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedDocDBCluster struct {
+	docdbiface.DocDBAPI
+	Members []string
+	Deleted bool
+	Calls   []string
+}
+
+func (m *mockedDocDBCluster) DescribeDBClusters(input *docdb.DescribeDBClustersInput) (*docdb.DescribeDBClustersOutput, error) {
+	if m.Deleted {
+		return nil, awserr.New(docdb.ErrCodeDBClusterNotFoundFault, "", nil)
+	}
+
+	var members []*docdb.DBClusterMember
+	for _, member := range m.Members {
+		members = append(members, &docdb.DBClusterMember{DBInstanceIdentifier: aws.String(member)})
+	}
+	return &docdb.DescribeDBClustersOutput{DBClusters: []*docdb.DBCluster{
+		{DBClusterIdentifier: input.DBClusterIdentifier, DBClusterMembers: members},
+	}}, nil
+}
+
+func (m *mockedDocDBCluster) DeleteDBInstance(input *docdb.DeleteDBInstanceInput) (*docdb.DeleteDBInstanceOutput, error) {
+	m.Calls = append(m.Calls, "delete-instance/"+aws.StringValue(input.DBInstanceIdentifier))
+	return &docdb.DeleteDBInstanceOutput{}, nil
+}
+
+func (m *mockedDocDBCluster) WaitUntilDBInstanceDeleted(input *docdb.DescribeDBInstancesInput) error {
+	m.Calls = append(m.Calls, "wait-instance/"+aws.StringValue(input.DBInstanceIdentifier))
+	return nil
+}
+
+func (m *mockedDocDBCluster) DeleteDBCluster(input *docdb.DeleteDBClusterInput) (*docdb.DeleteDBClusterOutput, error) {
+	m.Calls = append(m.Calls, "delete-cluster/"+aws.StringValue(input.DBClusterIdentifier))
+	m.Deleted = true
+	return &docdb.DeleteDBClusterOutput{}, nil
+}
+
+func TestNukeDocDBClusterDeletesInstancesFirst(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedDocDBCluster{Members: []string{"writer", "reader"}}
+	require.NoError(t, nukeDocDBCluster(mock, aws.String("cluster")))
+	assert.Equal(t, []string{
+		"delete-instance/writer",
+		"delete-instance/reader",
+		"wait-instance/writer",
+		"wait-instance/reader",
+		"delete-cluster/cluster",
+	}, mock.Calls)
+}
+
+func TestShouldIncludeDocDBCluster(t *testing.T) {
+	cluster := &docdb.DBCluster{
+		DBClusterIdentifier: aws.String("cloud-nuke-test"),
+		ClusterCreateTime:   aws.Time(time.Now()),
+	}
+
+	assert.True(t, shouldIncludeDocDBCluster(cluster, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeDocDBCluster(cluster, time.Now().Add(-1*time.Hour), config.Config{}))
+}
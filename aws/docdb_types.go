@@ -0,0 +1,44 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// DocDBClusters - represents all DocumentDB clusters
+type DocDBClusters struct {
+	ClusterIdentifiers []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (clusters DocDBClusters) ResourceName() string {
+	return "docdb-cluster"
+}
+
+// ResourceIdentifiers - The identifiers of the DocumentDB clusters
+func (clusters DocDBClusters) ResourceIdentifiers() []string {
+	return clusters.ClusterIdentifiers
+}
+
+func (clusters DocDBClusters) MaxBatchSize() int {
+	// Clusters are deleted one by one, each waiting for its instances and then itself to be gone, which takes minutes
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (clusters DocDBClusters) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllDocDBClusters(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type DocDBClusterDeleteTimeoutError struct {
+	identifier string
+}
+
+func (e DocDBClusterDeleteTimeoutError) Error() string {
+	return "Timed out waiting for DocumentDB cluster " + e.identifier + " to be deleted"
+}
@@ -1,64 +1,50 @@
 package aws
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
-	"log"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
 	"github.com/gruntwork-io/gruntwork-cli/errors"
+	"github.com/hashicorp/go-multierror"
 )
 
-func getAllDynamoTables(session *session.Session, excludeAfter time.Time, configObj config.Config, db DynamoDB) ([]*string, error) {
+func getAllDynamoTables(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
 	var tableNames []*string
 	svc := dynamodb.New(session)
 
-	var lastTableName *string
-	// Run count is used for pagination if the list tables exceeds max value
-	// Tells loop to rerun
-	PaginationRunCount := 1
-	for PaginationRunCount > 0 {
-		result, err := svc.ListTables(&dynamodb.ListTablesInput{ExclusiveStartTableName: lastTableName, Limit: aws.Int64(int64(DynamoDB.MaxBatchSize(db)))})
+	var allTables []*string
+	err := svc.ListTablesPages(
+		&dynamodb.ListTablesInput{},
+		func(page *dynamodb.ListTablesOutput, lastPage bool) bool {
+			allTables = append(allTables, page.TableNames...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
 
-		lastTableName = result.LastEvaluatedTableName
+	for _, table := range allTables {
+		responseDescription, err := svc.DescribeTable(&dynamodb.DescribeTableInput{TableName: table})
 		if err != nil {
-			if aerr, ok := err.(awserr.Error); ok {
-				switch aerr.Error() {
-				case dynamodb.ErrCodeInternalServerError:
-					return nil, errors.WithStackTrace(aerr)
-				default:
-					return nil, errors.WithStackTrace(aerr)
-				}
-			}
+			return nil, errors.WithStackTrace(err)
 		}
 
-		tableLen := len(result.TableNames)
-		// Check table length if it matches the max value add 1 to rerun
-		if tableLen == DynamoDB.MaxBatchSize(db) {
-			// Tell the user that this will be run twice due to max tables detected
-			logging.Logger.Debugf("The tables detected exceed the 100. Running more than once")
-			// Adds one to the count as it will = 2 runs at least until this loops again to check if it's another max.
-			PaginationRunCount += 1
-		}
-		for _, table := range result.TableNames {
-			responseDescription, err := svc.DescribeTable(&dynamodb.DescribeTableInput{TableName: table})
-			if err != nil {
-				log.Fatalf("There was an error describing table: %v\n", err)
-			}
-
-			if shouldIncludeTable(responseDescription.Table, excludeAfter, configObj) {
-				tableNames = append(tableNames, table)
-			}
+		if shouldIncludeTable(responseDescription.Table, excludeAfter, configObj) {
+			tableNames = append(tableNames, table)
 		}
-		// Remove 1 from the counter if it's one run the loop will end as PaginationRunCount will = 0
-		PaginationRunCount -= 1
 	}
 	return tableNames, nil
 }
@@ -79,7 +65,7 @@ func shouldIncludeTable(table *dynamodb.TableDescription, excludeAfter time.Time
 	)
 }
 
-func nukeAllDynamoDBTables(session *session.Session, tables []*string) error {
+func nukeAllDynamoDBTables(session *session.Session, tables []*string, disableDeletionProtection bool) error {
 	svc := dynamodb.New(session)
 	if len(tables) == 0 {
 		logging.Logger.Debugf("No DynamoDB tables to nuke in region %s", *session.Config.Region)
@@ -87,12 +73,9 @@ func nukeAllDynamoDBTables(session *session.Session, tables []*string) error {
 	}
 
 	logging.Logger.Debugf("Deleting all DynamoDB tables in region %s", *session.Config.Region)
+	var allErrs *multierror.Error
 	for _, table := range tables {
-
-		input := &dynamodb.DeleteTableInput{
-			TableName: aws.String(*table),
-		}
-		_, err := svc.DeleteTable(input)
+		err := nukeDynamoDBTable(svc, table, disableDeletionProtection)
 
 		// Record status of this resource
 		e := report.Entry{
@@ -103,20 +86,63 @@ func nukeAllDynamoDBTables(session *session.Session, tables []*string) error {
 		report.Record(e)
 
 		if err != nil {
-			if aerr, ok := err.(awserr.Error); ok {
-				telemetry.TrackEvent(commonTelemetry.EventContext{
-					EventName: "Error Nuking DynamoDB Table",
-				}, map[string]interface{}{
-					"region": *session.Config.Region,
-				})
-				switch aerr.Error() {
-				case dynamodb.ErrCodeInternalServerError:
-					return errors.WithStackTrace(aerr)
-				default:
-					return errors.WithStackTrace(aerr)
-				}
-			}
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking DynamoDB Table",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			// Keep going, so that one protected or failing table doesn't stop the rest of the batch from being deleted
+			allErrs = multierror.Append(allErrs, err)
 		}
 	}
-	return nil
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// nukeDynamoDBTable deletes the table. Tables with deletion protection enabled are either reported, or, when
+// disableDeletionProtection is set, unprotected and deleted.
+func nukeDynamoDBTable(svc dynamodbiface.DynamoDBAPI, table *string, disableDeletionProtection bool) error {
+	_, err := svc.DeleteTable(&dynamodb.DeleteTableInput{TableName: table})
+	if !isDynamoDBDeletionProtectionError(err) {
+		return errors.WithStackTrace(err)
+	}
+	if !disableDeletionProtection {
+		return DynamoDBTableDeletionProtectedError{TableName: aws.StringValue(table)}
+	}
+
+	_, err = svc.UpdateTable(&dynamodb.UpdateTableInput{
+		TableName:                 table,
+		DeletionProtectionEnabled: aws.Bool(false),
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	logging.Logger.Debugf("Disabled deletion protection of DynamoDB table %s", aws.StringValue(table))
+
+	// The table can't be deleted while the update is in progress
+	if err := svc.WaitUntilTableExists(&dynamodb.DescribeTableInput{TableName: table}); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	_, err = svc.DeleteTable(&dynamodb.DeleteTableInput{TableName: table})
+	return errors.WithStackTrace(err)
+}
+
+// isDynamoDBDeletionProtectionError returns true if a DeleteTable call was rejected because deletion protection is
+// enabled on the table. DynamoDB reports this as a generic validation error, so we have to look at the message.
+func isDynamoDBDeletionProtectionError(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == "ValidationException" && strings.Contains(strings.ToLower(aerr.Message()), "deletion protection")
+	}
+	return false
+}
+
+// DynamoDBTableDeletionProtectedError is returned for tables that can't be nuked because deletion protection is
+// enabled on them.
+type DynamoDBTableDeletionProtectedError struct {
+	TableName string
+}
+
+func (err DynamoDBTableDeletionProtectedError) Error() string {
+	return fmt.Sprintf("DynamoDB table %s has deletion protection enabled, set disable_deletion_protection to nuke it", err.TableName)
 }
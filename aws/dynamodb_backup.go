@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// getAllDynamoDBBackups returns the ARNs of the on-demand backups of all the tables in the region.
+func getAllDynamoDBBackups(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	return listDynamoDBBackups(dynamodb.New(session), excludeAfter, configObj)
+}
+
+func listDynamoDBBackups(svc dynamodbiface.DynamoDBAPI, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	var backupArns []*string
+
+	// ListBackups has no paginator in the SDK, so we page manually using the last evaluated ARN. Only on-demand (USER)
+	// backups are listed: SYSTEM backups are created by DynamoDB itself and AWS_BACKUP ones are owned by AWS Backup.
+	input := &dynamodb.ListBackupsInput{BackupType: aws.String(dynamodb.BackupTypeFilterUser)}
+	for {
+		result, err := svc.ListBackups(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, backup := range result.BackupSummaries {
+			if shouldIncludeDynamoDBBackup(backup, excludeAfter, configObj) {
+				backupArns = append(backupArns, backup.BackupArn)
+			}
+		}
+
+		if result.LastEvaluatedBackupArn == nil {
+			break
+		}
+		input.ExclusiveStartBackupArn = result.LastEvaluatedBackupArn
+	}
+
+	return backupArns, nil
+}
+
+func shouldIncludeDynamoDBBackup(backup *dynamodb.BackupSummary, excludeAfter time.Time, configObj config.Config) bool {
+	if backup == nil {
+		return false
+	}
+
+	// Backups that are still being created can't be deleted, and deleted ones are listed until they're gone
+	if aws.StringValue(backup.BackupStatus) != dynamodb.BackupStatusAvailable {
+		return false
+	}
+
+	if backup.BackupCreationDateTime != nil && excludeAfter.Before(*backup.BackupCreationDateTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(backup.BackupName),
+		configObj.DynamoDBBackup.IncludeRule.NamesRegExp,
+		configObj.DynamoDBBackup.ExcludeRule.NamesRegExp,
+	)
+}
+
+func nukeAllDynamoDBBackups(session *session.Session, backupArns []*string) error {
+	svc := dynamodb.New(session)
+	if len(backupArns) == 0 {
+		logging.Logger.Debugf("No DynamoDB backups to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all DynamoDB backups in region %s", *session.Config.Region)
+	var deletedArns []*string
+	var allErrs *multierror.Error
+	for _, backupArn := range backupArns {
+		_, err := svc.DeleteBackup(&dynamodb.DeleteBackupInput{
+			BackupArn: backupArn,
+		})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(backupArn),
+			ResourceType: "DynamoDB Backup",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking DynamoDB Backup",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedArns = append(deletedArns, backupArn)
+			logging.Logger.Debugf("Deleted DynamoDB backup: %s", aws.StringValue(backupArn))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d DynamoDB backup(s) deleted in %s", len(deletedArns), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedDynamoDBBackups serves ListBackups one page at a time
+type mockedDynamoDBBackups struct {
+	dynamodbiface.DynamoDBAPI
+	Pages  []*dynamodb.ListBackupsOutput
+	Inputs []*dynamodb.ListBackupsInput
+}
+
+func (m *mockedDynamoDBBackups) ListBackups(input *dynamodb.ListBackupsInput) (*dynamodb.ListBackupsOutput, error) {
+	copied := *input
+	m.Inputs = append(m.Inputs, &copied)
+	page := m.Pages[0]
+	m.Pages = m.Pages[1:]
+	return page, nil
+}
+
+func TestListDynamoDBBackupsPaginates(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	backup := func(name string) *dynamodb.BackupSummary {
+		return &dynamodb.BackupSummary{
+			BackupArn:              aws.String("arn:aws:dynamodb:us-east-1:123456789012:table/test/backup/" + name),
+			BackupName:             aws.String(name),
+			BackupStatus:           aws.String(dynamodb.BackupStatusAvailable),
+			BackupCreationDateTime: aws.Time(now),
+		}
+	}
+	mock := &mockedDynamoDBBackups{
+		Pages: []*dynamodb.ListBackupsOutput{
+			{BackupSummaries: []*dynamodb.BackupSummary{backup("one")}, LastEvaluatedBackupArn: aws.String("one")},
+			{BackupSummaries: []*dynamodb.BackupSummary{backup("two")}},
+		},
+	}
+
+	arns, err := listDynamoDBBackups(mock, now.Add(1*time.Hour), config.Config{})
+	require.NoError(t, err)
+	assert.Len(t, arns, 2)
+
+	require.Len(t, mock.Inputs, 2)
+	assert.Equal(t, dynamodb.BackupTypeFilterUser, aws.StringValue(mock.Inputs[0].BackupType))
+	assert.Nil(t, mock.Inputs[0].ExclusiveStartBackupArn)
+	assert.Equal(t, "one", aws.StringValue(mock.Inputs[1].ExclusiveStartBackupArn))
+}
+
+func TestShouldIncludeDynamoDBBackup(t *testing.T) {
+	now := time.Now()
+	excludeConfig := config.Config{
+		DynamoDBBackup: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^keep-.*")},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Backup   *dynamodb.BackupSummary
+		Config   config.Config
+		Expected bool
+	}{
+		{
+			Name:     "Available",
+			Backup:   &dynamodb.BackupSummary{BackupName: aws.String("nightly"), BackupStatus: aws.String(dynamodb.BackupStatusAvailable), BackupCreationDateTime: aws.Time(now)},
+			Config:   config.Config{},
+			Expected: true,
+		},
+		{
+			Name:     "Creating",
+			Backup:   &dynamodb.BackupSummary{BackupName: aws.String("nightly"), BackupStatus: aws.String(dynamodb.BackupStatusCreating), BackupCreationDateTime: aws.Time(now)},
+			Config:   config.Config{},
+			Expected: false,
+		},
+		{
+			Name:     "NewerThanExcludeAfter",
+			Backup:   &dynamodb.BackupSummary{BackupName: aws.String("nightly"), BackupStatus: aws.String(dynamodb.BackupStatusAvailable), BackupCreationDateTime: aws.Time(now.Add(2 * time.Hour))},
+			Config:   config.Config{},
+			Expected: false,
+		},
+		{
+			Name:     "ConfigExclude",
+			Backup:   &dynamodb.BackupSummary{BackupName: aws.String("keep-me"), BackupStatus: aws.String(dynamodb.BackupStatusAvailable), BackupCreationDateTime: aws.Time(now)},
+			Config:   excludeConfig,
+			Expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludeDynamoDBBackup(c.Backup, now.Add(1*time.Hour), c.Config))
+		})
+	}
+}
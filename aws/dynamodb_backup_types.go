@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// DynamoDBBackups - represents all on-demand DynamoDB backups
+type DynamoDBBackups struct {
+	BackupArns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (backups DynamoDBBackups) ResourceName() string {
+	return "dynamodb-backup"
+}
+
+// ResourceIdentifiers - The ARNs of the DynamoDB backups
+func (backups DynamoDBBackups) ResourceIdentifiers() []string {
+	return backups.BackupArns
+}
+
+func (backups DynamoDBBackups) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (backups DynamoDBBackups) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllDynamoDBBackups(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -8,8 +8,10 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/gruntwork-io/gruntwork-cli/errors"
@@ -88,7 +90,7 @@ func TestShouldIncludeTable(t *testing.T) {
 	}
 
 	mockExcludeConfig := config.Config{
-		DynamoDB: config.ResourceType{
+		DynamoDB: config.DynamoDBResourceType{ResourceType: config.ResourceType{
 			ExcludeRule: config.FilterRule{
 				NamesRegExp: []config.Expression{
 					{
@@ -96,11 +98,11 @@ func TestShouldIncludeTable(t *testing.T) {
 					},
 				},
 			},
-		},
+		}},
 	}
 
 	mockIncludeConfig := config.Config{
-		DynamoDB: config.ResourceType{
+		DynamoDB: config.DynamoDBResourceType{ResourceType: config.ResourceType{
 			IncludeRule: config.FilterRule{
 				NamesRegExp: []config.Expression{
 					{
@@ -108,7 +110,7 @@ func TestShouldIncludeTable(t *testing.T) {
 					},
 				},
 			},
-		},
+		}},
 	}
 
 	cases := []struct {
@@ -155,20 +157,18 @@ func TestGetTablesDynamo(t *testing.T) {
 	region, err := getRandomRegion()
 	require.NoError(t, err)
 
-	db := DynamoDB{}
 	awsSession, err := session.NewSession(&aws.Config{
 		Region: aws.String(region)},
 	)
 	require.NoError(t, err)
 
-	_, err = getAllDynamoTables(awsSession, time.Now().Add(1*time.Hour*-1), config.Config{}, db)
+	_, err = getAllDynamoTables(awsSession, time.Now().Add(1*time.Hour*-1), config.Config{})
 	require.NoError(t, err)
 }
 
 func TestNukeAllDynamoDBTables(t *testing.T) {
 	telemetry.InitTelemetry("cloud-nuke", "", "")
 	t.Parallel()
-	db := DynamoDB{}
 
 	region, err := getRandomRegion()
 	if err != nil {
@@ -180,7 +180,7 @@ func TestNukeAllDynamoDBTables(t *testing.T) {
 	require.NoError(t, err)
 
 	tableName := "cloud-nuke-test-" + util.UniqueID()
-	defer nukeAllDynamoDBTables(awsSession, []*string{&tableName})
+	defer nukeAllDynamoDBTables(awsSession, []*string{&tableName}, false)
 	createTestDynamoTables(t, tableName, region)
 	COUNTER := 0
 	for COUNTER <= 1 {
@@ -192,12 +192,12 @@ func TestNukeAllDynamoDBTables(t *testing.T) {
 			log.Printf("Table not ready yet: %v", tableName)
 		}
 	}
-	nukeErr := nukeAllDynamoDBTables(awsSession, []*string{&tableName})
+	nukeErr := nukeAllDynamoDBTables(awsSession, []*string{&tableName}, false)
 	require.NoError(t, nukeErr)
 
 	time.Sleep(5 * time.Second)
 
-	tables, err := getAllDynamoTables(awsSession, time.Now().Add(1*time.Hour*-1), config.Config{}, db)
+	tables, err := getAllDynamoTables(awsSession, time.Now().Add(1*time.Hour*-1), config.Config{})
 	require.NoError(t, err)
 
 	for _, table := range tables {
@@ -206,3 +206,54 @@ func TestNukeAllDynamoDBTables(t *testing.T) {
 		}
 	}
 }
+
+func TestIsDynamoDBDeletionProtectionError(t *testing.T) {
+	protectedErr := awserr.New(
+		"ValidationException",
+		"Resource cannot be deleted as it is currently protected against deletion. Disable deletion protection first.",
+		nil,
+	)
+	assert.True(t, isDynamoDBDeletionProtectionError(protectedErr))
+	assert.False(t, isDynamoDBDeletionProtectionError(awserr.New("ValidationException", "Table name is invalid", nil)))
+	assert.False(t, isDynamoDBDeletionProtectionError(awserr.New(dynamodb.ErrCodeResourceInUseException, "Table is being created", nil)))
+	assert.False(t, isDynamoDBDeletionProtectionError(nil))
+}
+
+// mockedProtectedDynamoDBTable serves a table with deletion protection enabled
+type mockedProtectedDynamoDBTable struct {
+	dynamodbiface.DynamoDBAPI
+	Protected bool
+	Calls     []string
+}
+
+func (m *mockedProtectedDynamoDBTable) DeleteTable(input *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
+	m.Calls = append(m.Calls, "delete")
+	if m.Protected {
+		return nil, awserr.New("ValidationException", "Resource cannot be deleted as it is currently protected against deletion. Disable deletion protection first.", nil)
+	}
+	return &dynamodb.DeleteTableOutput{}, nil
+}
+
+func (m *mockedProtectedDynamoDBTable) UpdateTable(input *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error) {
+	m.Calls = append(m.Calls, "update")
+	m.Protected = aws.BoolValue(input.DeletionProtectionEnabled)
+	return &dynamodb.UpdateTableOutput{}, nil
+}
+
+func (m *mockedProtectedDynamoDBTable) WaitUntilTableExists(input *dynamodb.DescribeTableInput) error {
+	m.Calls = append(m.Calls, "wait")
+	return nil
+}
+
+func TestNukeDynamoDBTableDeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	reported := &mockedProtectedDynamoDBTable{Protected: true}
+	err := nukeDynamoDBTable(reported, aws.String("cloud-nuke-test"), false)
+	assert.Equal(t, DynamoDBTableDeletionProtectedError{TableName: "cloud-nuke-test"}, err)
+	assert.Equal(t, []string{"delete"}, reported.Calls)
+
+	unprotected := &mockedProtectedDynamoDBTable{Protected: true}
+	require.NoError(t, nukeDynamoDBTable(unprotected, aws.String("cloud-nuke-test"), true))
+	assert.Equal(t, []string{"delete", "update", "wait", "delete"}, unprotected.Calls)
+}
@@ -8,6 +8,8 @@ import (
 
 type DynamoDB struct {
 	DynamoTableNames []string
+	// DisableDeletionProtection lifts deletion protection from tables before deleting them
+	DisableDeletionProtection bool
 }
 
 func (tables DynamoDB) ResourceName() string {
@@ -25,7 +27,7 @@ func (tables DynamoDB) MaxBatchSize() int {
 
 // Nuke - nuke all Dynamo DB Tables
 func (tables DynamoDB) Nuke(awsSession *session.Session, identifiers []string) error {
-	if err := nukeAllDynamoDBTables(awsSession, awsgo.StringSlice(identifiers)); err != nil {
+	if err := nukeAllDynamoDBTables(awsSession, awsgo.StringSlice(identifiers), tables.DisableDeletionProtection); err != nil {
 		return errors.WithStackTrace(err)
 	}
 	return nil
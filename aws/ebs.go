@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"net"
+	"sync"
 	"time"
 
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
@@ -9,7 +11,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
@@ -33,9 +39,47 @@ func getAllEbsVolumes(session *session.Session, region string, excludeAfter time
 		return nil, errors.WithStackTrace(err)
 	}
 
+	// Private IPs of the instances volumes are attached to, keyed by instance ID, so that each instance is only
+	// described once when CIDR rules are configured
+	instanceIPs := map[string]net.IP{}
+	// Whether a parent CloudFormation stack carries the exclusion tag, keyed by stack name
+	stackExclusions := map[string]bool{}
+	cfnSvc := cloudformation.New(session)
+	cwSvc := cloudwatch.New(session)
+
 	var volumeIds []*string
 	for _, volume := range result.Volumes {
-		if shouldIncludeEBSVolume(volume, excludeAfter, configObj) {
+		if !shouldIncludeEBSVolume(volume, excludeAfter, configObj) {
+			continue
+		}
+
+		if configObj.EBSVolume.InheritStackExclusion {
+			protected, err := isProtectedByParentStack(cfnSvc, volume.Tags, stackExclusions)
+			if err != nil {
+				return nil, err
+			}
+			if protected {
+				logging.Logger.Debugf("EBS volume %s is excluded by its parent CloudFormation stack", aws.StringValue(volume.VolumeId))
+				continue
+			}
+		}
+
+		if window := configObj.EBSVolume.ProtectRecentActivity; window > 0 {
+			active, err := hasRecentEBSVolumeActivity(cwSvc, volume, window, time.Now())
+			if err != nil {
+				return nil, err
+			}
+			if active {
+				logging.Logger.Debugf("EBS volume %s had activity in the last %s, skipping", aws.StringValue(volume.VolumeId), window)
+				continue
+			}
+		}
+
+		inScope, err := isEBSVolumeInCIDRScope(svc, volume, configObj, instanceIPs)
+		if err != nil {
+			return nil, err
+		}
+		if inScope {
 			volumeIds = append(volumeIds, volume.VolumeId)
 		}
 	}
@@ -43,6 +87,118 @@ func getAllEbsVolumes(session *session.Session, region string, excludeAfter time
 	return volumeIds, nil
 }
 
+// The EBS metrics queried for recent activity
+var ebsActivityMetrics = []string{"VolumeReadOps", "VolumeWriteOps"}
+
+// How long the outcome of an activity check for a volume is reused before CloudWatch is queried again
+const ebsActivityCacheTTL = 5 * time.Minute
+
+type ebsActivityCacheEntry struct {
+	active    bool
+	checkedAt time.Time
+}
+
+// ebsActivityCache holds the outcome of recent activity checks, keyed by volume ID, to limit CloudWatch API calls when
+// the same volumes are listed repeatedly (e.g. by inspect and nuke in the same process)
+var ebsActivityCache = struct {
+	sync.Mutex
+	entries map[string]ebsActivityCacheEntry
+}{entries: map[string]ebsActivityCacheEntry{}}
+
+// hasRecentEBSVolumeActivity checks whether a volume was attached, read from or written to within the given window.
+// Attachments are read from the volume itself, while reads and writes require querying CloudWatch metrics.
+func hasRecentEBSVolumeActivity(svc cloudwatchiface.CloudWatchAPI, volume *ec2.Volume, window time.Duration, now time.Time) (bool, error) {
+	since := now.Add(-window)
+	for _, attachment := range volume.Attachments {
+		if attachment.AttachTime != nil && attachment.AttachTime.After(since) {
+			return true, nil
+		}
+	}
+
+	volumeID := aws.StringValue(volume.VolumeId)
+
+	ebsActivityCache.Lock()
+	defer ebsActivityCache.Unlock()
+
+	if entry, ok := ebsActivityCache.entries[volumeID]; ok && now.Sub(entry.checkedAt) < ebsActivityCacheTTL {
+		return entry.active, nil
+	}
+
+	// CloudWatch periods must be a multiple of 60 seconds, so round the window up to the next minute. A single
+	// period covering the whole window keeps the query to one datapoint per metric.
+	period := int64((window + time.Minute - 1) / time.Minute * 60)
+
+	active := false
+	for _, metricName := range ebsActivityMetrics {
+		output, err := svc.GetMetricStatistics(&cloudwatch.GetMetricStatisticsInput{
+			Namespace:  aws.String("AWS/EBS"),
+			MetricName: aws.String(metricName),
+			Dimensions: []*cloudwatch.Dimension{
+				{Name: aws.String("VolumeId"), Value: volume.VolumeId},
+			},
+			StartTime:  aws.Time(since),
+			EndTime:    aws.Time(now),
+			Period:     aws.Int64(period),
+			Statistics: aws.StringSlice([]string{cloudwatch.StatisticSum}),
+		})
+		if err != nil {
+			return false, errors.WithStackTrace(err)
+		}
+		for _, datapoint := range output.Datapoints {
+			if aws.Float64Value(datapoint.Sum) > 0 {
+				active = true
+			}
+		}
+		if active {
+			break
+		}
+	}
+
+	ebsActivityCache.entries[volumeID] = ebsActivityCacheEntry{active: active, checkedAt: now}
+	return active, nil
+}
+
+// isEBSVolumeInCIDRScope checks the private IP of the instance a volume is attached to against the CIDR rules of the
+// config. Resolving the IP requires extra DescribeInstances calls, so they are only made when CIDR rules are set.
+func isEBSVolumeInCIDRScope(svc ec2iface.EC2API, volume *ec2.Volume, configObj config.Config, instanceIPs map[string]net.IP) (bool, error) {
+	includeCIDRs := configObj.EBSVolume.IncludeRule.CIDRs
+	excludeCIDRs := configObj.EBSVolume.ExcludeRule.CIDRs
+	if len(includeCIDRs) == 0 && len(excludeCIDRs) == 0 {
+		return true, nil
+	}
+
+	var ip net.IP
+	for _, attachment := range volume.Attachments {
+		instanceID := aws.StringValue(attachment.InstanceId)
+		if instanceID == "" {
+			continue
+		}
+
+		cachedIP, ok := instanceIPs[instanceID]
+		if !ok {
+			output, err := svc.DescribeInstances(&ec2.DescribeInstancesInput{
+				InstanceIds: []*string{attachment.InstanceId},
+			})
+			if err != nil {
+				return false, errors.WithStackTrace(err)
+			}
+			for _, reservation := range output.Reservations {
+				for _, instance := range reservation.Instances {
+					cachedIP = net.ParseIP(aws.StringValue(instance.PrivateIpAddress))
+				}
+			}
+			instanceIPs[instanceID] = cachedIP
+		}
+
+		if cachedIP != nil {
+			ip = cachedIP
+			break
+		}
+	}
+
+	return config.ShouldIncludeIP(ip, includeCIDRs, excludeCIDRs), nil
+}
+
 // hasEBSExcludeTag checks whether the exlude tag is set for a resource to skip deleting it.
 func hasEBSExcludeTag(volume *ec2.Volume) bool {
 	// Exclude deletion of any buckets with cloud-nuke-excluded tags
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"net"
 	"regexp"
 	"testing"
 	"time"
@@ -10,7 +11,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	mock_ec2iface "github.com/gruntwork-io/cloud-nuke/aws/mocks"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/gruntwork-io/go-commons/errors"
@@ -163,13 +168,13 @@ func TestListEBSVolumesWithConfigFile(t *testing.T) {
 	defer nukeAllEbsVolumes(session, []*string{includedVolume.VolumeId, excludedVolume.VolumeId})
 
 	volumeIds, err := getAllEbsVolumes(session, region, time.Now().Add(1*time.Hour), config.Config{
-		EBSVolume: config.ResourceType{
-			IncludeRule: config.FilterRule{
+		EBSVolume: config.EBSVolumeResourceType{NetworkResourceType: config.NetworkResourceType{
+			IncludeRule: config.NetworkFilterRule{FilterRule: config.FilterRule{
 				NamesRegExp: []config.Expression{
 					{RE: *regexp.MustCompile("^cloud-nuke-test-include-.*")},
 				},
-			},
-		},
+			}},
+		}},
 	})
 	require.NoError(t, err)
 	require.Equal(t, 1, len(volumeIds))
@@ -282,3 +287,131 @@ func TestNukeEBSVolumesInUse(t *testing.T) {
 		assert.Fail(t, errors.WithStackTrace(err).Error())
 	}
 }
+
+func TestEBSVolumeCIDRScope(t *testing.T) {
+	telemetry.InitTelemetry("cloud-nuke", "", "")
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockEC2 := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	_, sandbox, err := net.ParseCIDR("10.0.0.0/16")
+	require.NoError(t, err)
+	configObj := config.Config{
+		EBSVolume: config.EBSVolumeResourceType{NetworkResourceType: config.NetworkResourceType{
+			IncludeRule: config.NetworkFilterRule{
+				CIDRs: []config.CIDR{{Net: *sandbox}},
+			},
+		}},
+	}
+
+	attachedVolume := func(instanceID string) *ec2.Volume {
+		return &ec2.Volume{
+			VolumeId: awsgo.String("vol-" + instanceID),
+			Attachments: []*ec2.VolumeAttachment{
+				{InstanceId: awsgo.String(instanceID)},
+			},
+		}
+	}
+	describeOutput := func(ip string) *ec2.DescribeInstancesOutput {
+		return &ec2.DescribeInstancesOutput{
+			Reservations: []*ec2.Reservation{
+				{Instances: []*ec2.Instance{{PrivateIpAddress: awsgo.String(ip)}}},
+			},
+		}
+	}
+
+	// Each instance should only be described once, even when several volumes are attached to it
+	mockEC2.EXPECT().DescribeInstances(&ec2.DescribeInstancesInput{
+		InstanceIds: awsgo.StringSlice([]string{"i-inside"}),
+	}).Return(describeOutput("10.0.4.20"), nil).Times(1)
+	mockEC2.EXPECT().DescribeInstances(&ec2.DescribeInstancesInput{
+		InstanceIds: awsgo.StringSlice([]string{"i-outside"}),
+	}).Return(describeOutput("172.31.4.20"), nil).Times(1)
+
+	instanceIPs := map[string]net.IP{}
+
+	inScope, err := isEBSVolumeInCIDRScope(mockEC2, attachedVolume("i-inside"), configObj, instanceIPs)
+	require.NoError(t, err)
+	assert.True(t, inScope)
+
+	inScope, err = isEBSVolumeInCIDRScope(mockEC2, attachedVolume("i-inside"), configObj, instanceIPs)
+	require.NoError(t, err)
+	assert.True(t, inScope)
+
+	inScope, err = isEBSVolumeInCIDRScope(mockEC2, attachedVolume("i-outside"), configObj, instanceIPs)
+	require.NoError(t, err)
+	assert.False(t, inScope)
+
+	// A detached volume can't be placed within the CIDR, so it must not be included
+	inScope, err = isEBSVolumeInCIDRScope(mockEC2, &ec2.Volume{VolumeId: awsgo.String("vol-detached")}, configObj, instanceIPs)
+	require.NoError(t, err)
+	assert.False(t, inScope)
+
+	// Without CIDR rules no describe calls are made and every volume is in scope
+	inScope, err = isEBSVolumeInCIDRScope(mockEC2, attachedVolume("i-unknown"), config.Config{}, instanceIPs)
+	require.NoError(t, err)
+	assert.True(t, inScope)
+}
+
+// mockedCloudWatchMetrics serves GetMetricStatistics from a fixed set of sums, keyed by volume ID and metric name
+type mockedCloudWatchMetrics struct {
+	cloudwatchiface.CloudWatchAPI
+	Sums  map[string]map[string]float64
+	Calls int
+}
+
+func (m *mockedCloudWatchMetrics) GetMetricStatistics(input *cloudwatch.GetMetricStatisticsInput) (*cloudwatch.GetMetricStatisticsOutput, error) {
+	m.Calls++
+	volumeID := awsgo.StringValue(input.Dimensions[0].Value)
+	sum, ok := m.Sums[volumeID][awsgo.StringValue(input.MetricName)]
+	if !ok {
+		return &cloudwatch.GetMetricStatisticsOutput{}, nil
+	}
+	return &cloudwatch.GetMetricStatisticsOutput{
+		Datapoints: []*cloudwatch.Datapoint{{Sum: awsgo.Float64(sum)}},
+	}, nil
+}
+
+func TestHasRecentEBSVolumeActivity(t *testing.T) {
+	t.Parallel()
+
+	uniqueID := util.UniqueID()
+	idleVolume := &ec2.Volume{VolumeId: awsgo.String("vol-idle-" + uniqueID)}
+	writtenVolume := &ec2.Volume{VolumeId: awsgo.String("vol-written-" + uniqueID)}
+	svc := &mockedCloudWatchMetrics{
+		Sums: map[string]map[string]float64{
+			awsgo.StringValue(idleVolume.VolumeId):    {"VolumeReadOps": 0, "VolumeWriteOps": 0},
+			awsgo.StringValue(writtenVolume.VolumeId): {"VolumeReadOps": 0, "VolumeWriteOps": 12},
+		},
+	}
+	now := time.Now()
+
+	active, err := hasRecentEBSVolumeActivity(svc, idleVolume, 30*time.Minute, now)
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	active, err = hasRecentEBSVolumeActivity(svc, writtenVolume, 30*time.Minute, now)
+	require.NoError(t, err)
+	assert.True(t, active)
+	assert.Equal(t, 4, svc.Calls)
+
+	// The outcome is cached, so checking again doesn't query CloudWatch
+	active, err = hasRecentEBSVolumeActivity(svc, idleVolume, 30*time.Minute, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, active)
+	assert.Equal(t, 4, svc.Calls)
+
+	// A recent attachment counts as activity without querying CloudWatch
+	attachedVolume := &ec2.Volume{
+		VolumeId: awsgo.String("vol-attached-" + uniqueID),
+		Attachments: []*ec2.VolumeAttachment{
+			{AttachTime: awsgo.Time(now.Add(-10 * time.Minute))},
+		},
+	}
+	active, err = hasRecentEBSVolumeActivity(svc, attachedVolume, 30*time.Minute, now)
+	require.NoError(t, err)
+	assert.True(t, active)
+	assert.Equal(t, 4, svc.Calls)
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"fmt"
+
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/gruntwork-io/go-commons/errors"
@@ -26,6 +28,15 @@ func (volume EBSVolumes) MaxBatchSize() int {
 	return 49
 }
 
+// CLICommands - the AWS CLI commands that delete the ebs volumes
+func (volume EBSVolumes) CLICommands(region string) []string {
+	var commands []string
+	for _, volumeID := range volume.VolumeIds {
+		commands = append(commands, fmt.Sprintf("aws ec2 delete-volume --volume-id %s --region %s", volumeID, region))
+	}
+	return commands
+}
+
 // Nuke - nuke 'em all!!!
 func (volume EBSVolumes) Nuke(session *session.Session, identifiers []string) error {
 	if err := nukeAllEbsVolumes(session, awsgo.StringSlice(identifiers)); err != nil {
@@ -246,13 +246,19 @@ func (v Vpc) nukeInternetGateway(spinner *pterm.SpinnerPrinter) error {
 		return errors.WithStackTrace(err)
 	}
 
-	if len(igw.InternetGateways) == 1 {
-		msg := fmt.Sprintf("...detaching Internet Gateway %s", awsgo.StringValue(igw.InternetGateways[0].InternetGatewayId))
+	if len(igw.InternetGateways) == 0 {
+		spinnerMsg := "...no Internet Gateway found"
+		logging.Logger.Debug(spinnerMsg)
+		return nil
+	}
+
+	for _, gateway := range igw.InternetGateways {
+		msg := fmt.Sprintf("...detaching Internet Gateway %s", awsgo.StringValue(gateway.InternetGatewayId))
 		spinner.UpdateText(msg)
 		logging.Logger.Debug(msg)
 		_, err := v.svc.DetachInternetGateway(
 			&ec2.DetachInternetGatewayInput{
-				InternetGatewayId: igw.InternetGateways[0].InternetGatewayId,
+				InternetGatewayId: gateway.InternetGatewayId,
 				VpcId:             awsgo.String(v.VpcId),
 			},
 		)
@@ -260,27 +266,24 @@ func (v Vpc) nukeInternetGateway(spinner *pterm.SpinnerPrinter) error {
 			return errors.WithStackTrace(err)
 		}
 
-		spinnerMsg := fmt.Sprintf("...deleting Internet Gateway %s", awsgo.StringValue(igw.InternetGateways[0].InternetGatewayId))
+		spinnerMsg := fmt.Sprintf("...deleting Internet Gateway %s", awsgo.StringValue(gateway.InternetGatewayId))
 		spinner.UpdateText(spinnerMsg)
 		logging.Logger.Debugf(spinnerMsg)
 		_, err = v.svc.DeleteInternetGateway(
 			&ec2.DeleteInternetGatewayInput{
-				InternetGatewayId: igw.InternetGateways[0].InternetGatewayId,
+				InternetGatewayId: gateway.InternetGatewayId,
 			},
 		)
 		if err != nil {
 			return errors.WithStackTrace(err)
 		}
-	} else {
-		spinnerMsg := "...no Internet Gateway found"
-		logging.Logger.Debug(spinnerMsg)
 	}
 
 	return nil
 }
 
 func (v Vpc) nukeSubnets(spinner *pterm.SpinnerPrinter) error {
-	subnets, _ := v.svc.DescribeSubnets(
+	subnets, err := v.svc.DescribeSubnets(
 		&ec2.DescribeSubnetsInput{
 			Filters: []*ec2.Filter{
 				{
@@ -290,6 +293,9 @@ func (v Vpc) nukeSubnets(spinner *pterm.SpinnerPrinter) error {
 			},
 		},
 	)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
 	if len(subnets.Subnets) > 0 {
 		for _, subnet := range subnets.Subnets {
 			msg := fmt.Sprintf("...deleting subnet %s", awsgo.StringValue(subnet.SubnetId))
@@ -312,7 +318,7 @@ func (v Vpc) nukeSubnets(spinner *pterm.SpinnerPrinter) error {
 }
 
 func (v Vpc) nukeRouteTables(spinner *pterm.SpinnerPrinter) error {
-	routeTables, _ := v.svc.DescribeRouteTables(
+	routeTables, err := v.svc.DescribeRouteTables(
 		&ec2.DescribeRouteTablesInput{
 			Filters: []*ec2.Filter{
 				{
@@ -322,6 +328,9 @@ func (v Vpc) nukeRouteTables(spinner *pterm.SpinnerPrinter) error {
 			},
 		},
 	)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
 	for _, routeTable := range routeTables.RouteTables {
 		// Skip main route table
 		if len(routeTable.Associations) > 0 && *routeTable.Associations[0].Main {
@@ -344,7 +353,7 @@ func (v Vpc) nukeRouteTables(spinner *pterm.SpinnerPrinter) error {
 }
 
 func (v Vpc) nukeNacls(spinner *pterm.SpinnerPrinter) error {
-	networkACLs, _ := v.svc.DescribeNetworkAcls(
+	networkACLs, err := v.svc.DescribeNetworkAcls(
 		&ec2.DescribeNetworkAclsInput{
 			Filters: []*ec2.Filter{
 				{
@@ -358,6 +367,9 @@ func (v Vpc) nukeNacls(spinner *pterm.SpinnerPrinter) error {
 			},
 		},
 	)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
 	for _, networkACL := range networkACLs.NetworkAcls {
 		msg := fmt.Sprintf("...deleting Network ACL %s", awsgo.StringValue(networkACL.NetworkAclId))
 		spinner.UpdateText(msg)
@@ -375,7 +387,7 @@ func (v Vpc) nukeNacls(spinner *pterm.SpinnerPrinter) error {
 }
 
 func (v Vpc) nukeSecurityGroups(spinner *pterm.SpinnerPrinter) error {
-	securityGroups, _ := v.svc.DescribeSecurityGroups(
+	securityGroups, err := v.svc.DescribeSecurityGroups(
 		&ec2.DescribeSecurityGroupsInput{
 			Filters: []*ec2.Filter{
 				{
@@ -385,9 +397,12 @@ func (v Vpc) nukeSecurityGroups(spinner *pterm.SpinnerPrinter) error {
 			},
 		},
 	)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
 
 	for _, securityGroup := range securityGroups.SecurityGroups {
-		securityGroupRules, _ := v.svc.DescribeSecurityGroupRules(
+		securityGroupRules, err := v.svc.DescribeSecurityGroupRules(
 			&ec2.DescribeSecurityGroupRulesInput{
 				Filters: []*ec2.Filter{
 					{
@@ -397,6 +412,9 @@ func (v Vpc) nukeSecurityGroups(spinner *pterm.SpinnerPrinter) error {
 				},
 			},
 		)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
 		for _, securityGroupRule := range securityGroupRules.SecurityGroupRules {
 			msg := fmt.Sprintf("...deleting Security Group Rule %s", awsgo.StringValue(securityGroupRule.SecurityGroupRuleId))
 			spinner.UpdateText(msg)
@@ -444,7 +462,7 @@ func (v Vpc) nukeSecurityGroups(spinner *pterm.SpinnerPrinter) error {
 }
 
 func (v Vpc) nukeEndpoints(spinner *pterm.SpinnerPrinter) error {
-	endpoints, _ := v.svc.DescribeVpcEndpoints(
+	endpoints, err := v.svc.DescribeVpcEndpoints(
 		&ec2.DescribeVpcEndpointsInput{
 			Filters: []*ec2.Filter{
 				{
@@ -454,6 +472,9 @@ func (v Vpc) nukeEndpoints(spinner *pterm.SpinnerPrinter) error {
 			},
 		},
 	)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
 
 	var endpointIds []*string
 
@@ -471,20 +492,85 @@ func (v Vpc) nukeEndpoints(spinner *pterm.SpinnerPrinter) error {
 		return nil
 	}
 
-	_, err := v.svc.DeleteVpcEndpoints(&ec2.DeleteVpcEndpointsInput{
+	_, err = v.svc.DeleteVpcEndpoints(&ec2.DeleteVpcEndpointsInput{
 		VpcEndpointIds: endpointIds,
 	})
 	if err != nil {
 		return errors.WithStackTrace(err)
 	}
 
-	if err := waitForVPCEndpointsToBeDeleted(v); err != nil {
+	if err := waitForVPCEndpointsToBeDeleted(v.svc, endpointIds); err != nil {
 		return errors.WithStackTrace(err)
 	}
 
 	return nil
 }
 
+func (v Vpc) nukePeeringConnections(spinner *pterm.SpinnerPrinter) error {
+	msg := "Finding VPC Peering Connections to Nuke"
+	spinner.UpdateText(msg)
+	logging.Logger.Debug(msg)
+
+	// A peering connection can reference this VPC from either side, and filters with different names are ANDed
+	// together, so the requester and accepter sides have to be looked up separately.
+	peeringConnectionIds := []*string{}
+	seen := map[string]bool{}
+	for _, filterName := range []string{"requester-vpc-info.vpc-id", "accepter-vpc-info.vpc-id"} {
+		err := v.svc.DescribeVpcPeeringConnectionsPages(
+			&ec2.DescribeVpcPeeringConnectionsInput{
+				Filters: []*ec2.Filter{
+					{
+						Name:   awsgo.String(filterName),
+						Values: []*string{awsgo.String(v.VpcId)},
+					},
+				},
+			},
+			func(page *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool {
+				for _, connection := range page.VpcPeeringConnections {
+					id := awsgo.StringValue(connection.VpcPeeringConnectionId)
+					if seen[id] || !isVpcPeeringConnectionActive(connection) {
+						continue
+					}
+					seen[id] = true
+					peeringConnectionIds = append(peeringConnectionIds, connection.VpcPeeringConnectionId)
+				}
+				return !lastPage
+			},
+		)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	finalMsg := fmt.Sprintf("Found %d VPC Peering Connections to Nuke.", len(peeringConnectionIds))
+	spinner.UpdateText(finalMsg)
+	logging.Logger.Debug(finalMsg)
+
+	var allErrs *multierror.Error
+	for _, connectionId := range peeringConnectionIds {
+		_, e := v.svc.DeleteVpcPeeringConnection(&ec2.DeleteVpcPeeringConnectionInput{VpcPeeringConnectionId: connectionId})
+		allErrs = multierror.Append(allErrs, e)
+	}
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// isVpcPeeringConnectionActive returns false for peering connections that are already gone or can no longer be
+// deleted, since those are still reported by the API for a while.
+func isVpcPeeringConnectionActive(connection *ec2.VpcPeeringConnection) bool {
+	if connection == nil || connection.Status == nil {
+		return false
+	}
+	switch awsgo.StringValue(connection.Status.Code) {
+	case ec2.VpcPeeringConnectionStateReasonCodeDeleted,
+		ec2.VpcPeeringConnectionStateReasonCodeDeleting,
+		ec2.VpcPeeringConnectionStateReasonCodeRejected,
+		ec2.VpcPeeringConnectionStateReasonCodeFailed,
+		ec2.VpcPeeringConnectionStateReasonCodeExpired:
+		return false
+	}
+	return true
+}
+
 func (v Vpc) nukeEgressOnlyGateways(spinner *pterm.SpinnerPrinter) error {
 	allEgressGateways := []*string{}
 	msg := "Finding Egress Only Internet Gateways to Nuke"
@@ -563,14 +649,15 @@ func (v Vpc) dissociateDhcpOptions(spinner *pterm.SpinnerPrinter) error {
 	return err
 }
 
-func waitForVPCEndpointsToBeDeleted(v Vpc) error {
+// waitForVPCEndpointsToBeDeleted polls the given VPC endpoints until none of them is left in the deleting state.
+func waitForVPCEndpointsToBeDeleted(svc ec2iface.EC2API, ids []*string) error {
 	for i := 0; i < 30; i++ {
-		endpoints, err := v.svc.DescribeVpcEndpoints(
+		endpoints, err := svc.DescribeVpcEndpoints(
 			&ec2.DescribeVpcEndpointsInput{
 				Filters: []*ec2.Filter{
 					{
-						Name:   awsgo.String("vpc-id"),
-						Values: []*string{awsgo.String(v.VpcId)},
+						Name:   awsgo.String("vpc-endpoint-id"),
+						Values: ids,
 					},
 					{
 						Name:   awsgo.String("vpc-endpoint-state"),
@@ -636,6 +723,12 @@ func (v Vpc) nuke(spinner *pterm.SpinnerPrinter) error {
 		return err
 	}
 
+	err = v.nukePeeringConnections(spinner)
+	if err != nil {
+		logging.Logger.Debugf("Error cleaning up VPC Peering Connections for VPC %s: %s", v.VpcId, err.Error())
+		return err
+	}
+
 	err = v.nukeNetworkInterfaces(spinner)
 	if err != nil {
 		logging.Logger.Debugf("Error cleaning up Elastic Network Interfaces for VPC %s: %s", v.VpcId, err.Error())
@@ -868,3 +961,32 @@ func GetEC2ResourceNameTagValue(tags []*ec2.Tag) (string, error) {
 	}
 	return "", fmt.Errorf("Resource does not have Name tag")
 }
+
+// getOrSetFirstSeenEC2ResourceTag returns the time cloud-nuke first saw the given EC2 resource, for resources that do
+// not expose a creation time. The first time a resource is seen it is tagged with the current time.
+func getOrSetFirstSeenEC2ResourceTag(svc ec2iface.EC2API, resourceId *string, tags []*ec2.Tag) (time.Time, error) {
+	for _, tag := range tags {
+		if awsgo.StringValue(tag.Key) == firstSeenTagKey {
+			firstSeenTime, err := time.Parse(time.RFC3339, awsgo.StringValue(tag.Value))
+			if err != nil {
+				return time.Time{}, errors.WithStackTrace(err)
+			}
+			return firstSeenTime, nil
+		}
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.CreateTags(&ec2.CreateTagsInput{
+		Resources: []*string{resourceId},
+		Tags: []*ec2.Tag{
+			{
+				Key:   awsgo.String(firstSeenTagKey),
+				Value: awsgo.String(now.Format(time.RFC3339)),
+			},
+		},
+	})
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return now, nil
+}
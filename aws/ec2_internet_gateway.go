@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// getAllOrphanedInternetGateways returns the IDs of the Internet Gateways that are not attached to any VPC. Gateways
+// that are still attached are removed together with their VPC by the `vpc` resource.
+func getAllOrphanedInternetGateways(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := ec2.New(session)
+
+	var gateways []*ec2.InternetGateway
+	err := svc.DescribeInternetGatewaysPages(
+		&ec2.DescribeInternetGatewaysInput{},
+		func(page *ec2.DescribeInternetGatewaysOutput, lastPage bool) bool {
+			gateways = append(gateways, page.InternetGateways...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var ids []*string
+	for _, gateway := range gateways {
+		if !isInternetGatewayOrphaned(gateway) {
+			continue
+		}
+
+		// Internet Gateways don't have a creation time, so we rely on the first seen tag instead
+		firstSeenTime, err := getOrSetFirstSeenEC2ResourceTag(svc, gateway.InternetGatewayId, gateway.Tags)
+		if err != nil {
+			logging.Logger.Errorf("Unable to retrieve tags for Internet Gateway %s", awsgo.StringValue(gateway.InternetGatewayId))
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if shouldIncludeInternetGateway(gateway, excludeAfter, firstSeenTime, configObj) {
+			ids = append(ids, gateway.InternetGatewayId)
+		}
+	}
+
+	return ids, nil
+}
+
+// isInternetGatewayOrphaned returns true if the Internet Gateway is not attached to any VPC.
+func isInternetGatewayOrphaned(gateway *ec2.InternetGateway) bool {
+	for _, attachment := range gateway.Attachments {
+		if awsgo.StringValue(attachment.State) != ec2.AttachmentStatusDetached {
+			return false
+		}
+	}
+	return true
+}
+
+func shouldIncludeInternetGateway(gateway *ec2.InternetGateway, excludeAfter time.Time, firstSeenTime time.Time, configObj config.Config) bool {
+	if gateway == nil {
+		return false
+	}
+
+	if excludeAfter.Before(firstSeenTime) {
+		return false
+	}
+
+	if hasInternetGatewayExcludeTag(gateway) {
+		return false
+	}
+
+	// If Name is unset, GetEC2ResourceNameTagValue returns error and zero value string
+	// Ignore this error and pass empty string to config.ShouldInclude
+	gatewayName, _ := GetEC2ResourceNameTagValue(gateway.Tags)
+
+	return config.ShouldInclude(
+		gatewayName,
+		configObj.InternetGateway.IncludeRule.NamesRegExp,
+		configObj.InternetGateway.ExcludeRule.NamesRegExp,
+	)
+}
+
+// hasInternetGatewayExcludeTag checks whether the exclude tag is set for an Internet Gateway to skip deleting it.
+func hasInternetGatewayExcludeTag(gateway *ec2.InternetGateway) bool {
+	for _, tag := range gateway.Tags {
+		if awsgo.StringValue(tag.Key) == AwsResourceExclusionTagKey && awsgo.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// nukeAllInternetGateways deletes the given detached Internet Gateways.
+func nukeAllInternetGateways(session *session.Session, ids []*string) error {
+	svc := ec2.New(session)
+
+	if len(ids) == 0 {
+		logging.Logger.Debugf("No Internet Gateways to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all orphaned Internet Gateways in region %s", *session.Config.Region)
+
+	var deletedIds []*string
+	var allErrs *multierror.Error
+	for _, id := range ids {
+		_, err := svc.DeleteInternetGateway(&ec2.DeleteInternetGatewayInput{
+			InternetGatewayId: id,
+		})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   awsgo.StringValue(id),
+			ResourceType: "Internet Gateway",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Internet Gateway",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, id)
+			logging.Logger.Debugf("Deleted Internet Gateway: %s", awsgo.StringValue(id))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Internet Gateway(s) deleted in %s", len(deletedIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	mock_ec2iface "github.com/gruntwork-io/cloud-nuke/aws/mocks"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsInternetGatewayOrphaned(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Attachments []*ec2.InternetGatewayAttachment
+		Expected    bool
+	}{
+		{
+			Name:        "NoAttachments",
+			Attachments: nil,
+			Expected:    true,
+		},
+		{
+			Name: "Detached",
+			Attachments: []*ec2.InternetGatewayAttachment{
+				{VpcId: awsgo.String(ExampleVpcId), State: awsgo.String(ec2.AttachmentStatusDetached)},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Attached",
+			Attachments: []*ec2.InternetGatewayAttachment{
+				{VpcId: awsgo.String(ExampleVpcId), State: awsgo.String("available")},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Detaching",
+			Attachments: []*ec2.InternetGatewayAttachment{
+				{VpcId: awsgo.String(ExampleVpcId), State: awsgo.String(ec2.AttachmentStatusDetaching)},
+			},
+			Expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			gateway := &ec2.InternetGateway{InternetGatewayId: awsgo.String(ExampleInternetGatewayId), Attachments: c.Attachments}
+			assert.Equal(t, c.Expected, isInternetGatewayOrphaned(gateway))
+		})
+	}
+}
+
+func TestShouldIncludeInternetGateway(t *testing.T) {
+	now := time.Now()
+	includeConfig := config.Config{
+		InternetGateway: config.ResourceType{
+			IncludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^cloud-nuke-test-.*")},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name          string
+		Tags          []*ec2.Tag
+		FirstSeenTime time.Time
+		Config        config.Config
+		Expected      bool
+	}{
+		{
+			Name:          "NoFilters",
+			FirstSeenTime: now,
+			Config:        config.Config{},
+			Expected:      true,
+		},
+		{
+			Name:          "SeenAfterExcludeAfter",
+			FirstSeenTime: now.Add(2 * time.Hour),
+			Config:        config.Config{},
+			Expected:      false,
+		},
+		{
+			Name:          "ExclusionTag",
+			Tags:          []*ec2.Tag{{Key: awsgo.String(AwsResourceExclusionTagKey), Value: awsgo.String("true")}},
+			FirstSeenTime: now,
+			Config:        config.Config{},
+			Expected:      false,
+		},
+		{
+			Name:          "ConfigInclude",
+			Tags:          []*ec2.Tag{{Key: awsgo.String("Name"), Value: awsgo.String("cloud-nuke-test-igw")}},
+			FirstSeenTime: now,
+			Config:        includeConfig,
+			Expected:      true,
+		},
+		{
+			Name:          "ConfigNotIncluded",
+			Tags:          []*ec2.Tag{{Key: awsgo.String("Name"), Value: awsgo.String("production-igw")}},
+			FirstSeenTime: now,
+			Config:        includeConfig,
+			Expected:      false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			gateway := &ec2.InternetGateway{InternetGatewayId: awsgo.String(ExampleInternetGatewayId), Tags: c.Tags}
+			assert.Equal(t, c.Expected, shouldIncludeInternetGateway(gateway, now.Add(1*time.Hour), c.FirstSeenTime, c.Config))
+		})
+	}
+}
+
+func TestGetOrSetFirstSeenEC2ResourceTag(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockEC2 := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	// An existing tag is parsed without touching the resource
+	seen := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	firstSeen, err := getOrSetFirstSeenEC2ResourceTag(
+		mockEC2,
+		awsgo.String(ExampleInternetGatewayId),
+		[]*ec2.Tag{{Key: awsgo.String(firstSeenTagKey), Value: awsgo.String(seen.Format(time.RFC3339))}},
+	)
+	require.NoError(t, err)
+	assert.True(t, seen.Equal(firstSeen))
+
+	// A resource seen for the first time gets tagged
+	mockEC2.EXPECT().CreateTags(gomock.Any()).DoAndReturn(func(input *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error) {
+		assert.Equal(t, []string{ExampleInternetGatewayId}, awsgo.StringValueSlice(input.Resources))
+		assert.Equal(t, firstSeenTagKey, awsgo.StringValue(input.Tags[0].Key))
+		return &ec2.CreateTagsOutput{}, nil
+	})
+	before := time.Now().Add(-1 * time.Second)
+	firstSeen, err = getOrSetFirstSeenEC2ResourceTag(mockEC2, awsgo.String(ExampleInternetGatewayId), nil)
+	require.NoError(t, err)
+	assert.True(t, firstSeen.After(before))
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// InternetGateways - represents all Internet Gateways that are not attached to a VPC
+type InternetGateways struct {
+	InternetGatewayIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (igw InternetGateways) ResourceName() string {
+	return "internet-gateway"
+}
+
+// ResourceIdentifiers - The IDs of the Internet Gateways
+func (igw InternetGateways) ResourceIdentifiers() []string {
+	return igw.InternetGatewayIds
+}
+
+func (igw InternetGateways) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (igw InternetGateways) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllInternetGateways(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"net"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// getAllAvailableNetworkInterfaces returns the IDs of the Elastic Network Interfaces that are not attached to anything.
+func getAllAvailableNetworkInterfaces(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := ec2.New(session)
+
+	var networkInterfaces []*ec2.NetworkInterface
+	err := svc.DescribeNetworkInterfacesPages(
+		&ec2.DescribeNetworkInterfacesInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   awsgo.String("status"),
+					Values: []*string{awsgo.String(ec2.NetworkInterfaceStatusAvailable)},
+				},
+			},
+		},
+		func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+			networkInterfaces = append(networkInterfaces, page.NetworkInterfaces...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var ids []*string
+	for _, networkInterface := range networkInterfaces {
+		// Interfaces managed by another AWS service can't be deleted by us, and are cleaned up by that service
+		if awsgo.BoolValue(networkInterface.RequesterManaged) {
+			continue
+		}
+
+		// Network interfaces don't have a creation time, so we rely on the first seen tag instead
+		firstSeenTime, err := getOrSetFirstSeenEC2ResourceTag(svc, networkInterface.NetworkInterfaceId, networkInterface.TagSet)
+		if err != nil {
+			logging.Logger.Errorf("Unable to retrieve tags for network interface %s", awsgo.StringValue(networkInterface.NetworkInterfaceId))
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if shouldIncludeNetworkInterface(networkInterface, excludeAfter, firstSeenTime, configObj) {
+			ids = append(ids, networkInterface.NetworkInterfaceId)
+		}
+	}
+
+	return ids, nil
+}
+
+func shouldIncludeNetworkInterface(networkInterface *ec2.NetworkInterface, excludeAfter time.Time, firstSeenTime time.Time, configObj config.Config) bool {
+	if networkInterface == nil {
+		return false
+	}
+
+	if awsgo.StringValue(networkInterface.Status) != ec2.NetworkInterfaceStatusAvailable {
+		return false
+	}
+
+	if excludeAfter.Before(firstSeenTime) {
+		return false
+	}
+
+	if hasNetworkInterfaceExcludeTag(networkInterface) {
+		return false
+	}
+
+	// The private IP of an interface is known up front, so CIDR scoping doesn't need any extra calls here
+	if !config.ShouldIncludeIP(
+		net.ParseIP(awsgo.StringValue(networkInterface.PrivateIpAddress)),
+		configObj.NetworkInterface.IncludeRule.CIDRs,
+		configObj.NetworkInterface.ExcludeRule.CIDRs,
+	) {
+		return false
+	}
+
+	// If Name is unset, GetEC2ResourceNameTagValue returns error and zero value string
+	// Ignore this error and pass empty string to config.ShouldInclude
+	interfaceName, _ := GetEC2ResourceNameTagValue(networkInterface.TagSet)
+
+	return config.ShouldInclude(
+		interfaceName,
+		configObj.NetworkInterface.IncludeRule.NamesRegExp,
+		configObj.NetworkInterface.ExcludeRule.NamesRegExp,
+	)
+}
+
+// hasNetworkInterfaceExcludeTag checks whether the exclude tag is set for a network interface to skip deleting it.
+func hasNetworkInterfaceExcludeTag(networkInterface *ec2.NetworkInterface) bool {
+	for _, tag := range networkInterface.TagSet {
+		if awsgo.StringValue(tag.Key) == AwsResourceExclusionTagKey && awsgo.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// nukeAllNetworkInterfaces deletes the given unattached network interfaces.
+func nukeAllNetworkInterfaces(session *session.Session, ids []*string) error {
+	svc := ec2.New(session)
+
+	if len(ids) == 0 {
+		logging.Logger.Debugf("No network interfaces to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all available network interfaces in region %s", *session.Config.Region)
+
+	var deletedIds []*string
+	var allErrs *multierror.Error
+	for _, id := range ids {
+		_, err := svc.DeleteNetworkInterface(&ec2.DeleteNetworkInterfaceInput{
+			NetworkInterfaceId: id,
+		})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   awsgo.StringValue(id),
+			ResourceType: "Network Interface",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Network Interface",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, id)
+			logging.Logger.Debugf("Deleted network interface: %s", awsgo.StringValue(id))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d network interface(s) deleted in %s", len(deletedIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"net"
+	"regexp"
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldIncludeNetworkInterface(t *testing.T) {
+	now := time.Now()
+
+	_, includeNet, err := net.ParseCIDR("10.0.0.0/16")
+	require.NoError(t, err)
+	_, excludeNet, err := net.ParseCIDR("10.0.128.0/24")
+	require.NoError(t, err)
+	cidrConfig := config.Config{
+		NetworkInterface: config.NetworkResourceType{
+			IncludeRule: config.NetworkFilterRule{CIDRs: []config.CIDR{{Net: *includeNet}}},
+			ExcludeRule: config.NetworkFilterRule{CIDRs: []config.CIDR{{Net: *excludeNet}}},
+		},
+	}
+	nameConfig := config.Config{
+		NetworkInterface: config.NetworkResourceType{
+			IncludeRule: config.NetworkFilterRule{FilterRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^cloud-nuke-test-.*")},
+				},
+			}},
+		},
+	}
+
+	availableInterface := func(ip string, tags ...*ec2.Tag) *ec2.NetworkInterface {
+		return &ec2.NetworkInterface{
+			Status:           awsgo.String(ec2.NetworkInterfaceStatusAvailable),
+			PrivateIpAddress: awsgo.String(ip),
+			TagSet:           tags,
+		}
+	}
+
+	cases := []struct {
+		Name             string
+		NetworkInterface *ec2.NetworkInterface
+		FirstSeenTime    time.Time
+		Config           config.Config
+		Expected         bool
+	}{
+		{
+			Name:             "Available",
+			NetworkInterface: availableInterface("10.0.0.10"),
+			FirstSeenTime:    now,
+			Config:           config.Config{},
+			Expected:         true,
+		},
+		{
+			Name: "InUse",
+			NetworkInterface: &ec2.NetworkInterface{
+				Status:           awsgo.String(ec2.NetworkInterfaceStatusInUse),
+				PrivateIpAddress: awsgo.String("10.0.0.10"),
+			},
+			FirstSeenTime: now,
+			Config:        config.Config{},
+			Expected:      false,
+		},
+		{
+			Name:             "SeenAfterExcludeAfter",
+			NetworkInterface: availableInterface("10.0.0.10"),
+			FirstSeenTime:    now.Add(2 * time.Hour),
+			Config:           config.Config{},
+			Expected:         false,
+		},
+		{
+			Name:             "ExclusionTag",
+			NetworkInterface: availableInterface("10.0.0.10", &ec2.Tag{Key: awsgo.String(AwsResourceExclusionTagKey), Value: awsgo.String("true")}),
+			FirstSeenTime:    now,
+			Config:           config.Config{},
+			Expected:         false,
+		},
+		{
+			Name:             "InsideIncludeCIDR",
+			NetworkInterface: availableInterface("10.0.1.10"),
+			FirstSeenTime:    now,
+			Config:           cidrConfig,
+			Expected:         true,
+		},
+		{
+			Name:             "InsideExcludeCIDR",
+			NetworkInterface: availableInterface("10.0.128.10"),
+			FirstSeenTime:    now,
+			Config:           cidrConfig,
+			Expected:         false,
+		},
+		{
+			Name:             "OutsideIncludeCIDR",
+			NetworkInterface: availableInterface("192.168.0.10"),
+			FirstSeenTime:    now,
+			Config:           cidrConfig,
+			Expected:         false,
+		},
+		{
+			Name:             "ConfigIncludeByName",
+			NetworkInterface: availableInterface("10.0.0.10", &ec2.Tag{Key: awsgo.String("Name"), Value: awsgo.String("cloud-nuke-test-eni")}),
+			FirstSeenTime:    now,
+			Config:           nameConfig,
+			Expected:         true,
+		},
+		{
+			Name:             "ConfigNotIncludedByName",
+			NetworkInterface: availableInterface("10.0.0.10"),
+			FirstSeenTime:    now,
+			Config:           nameConfig,
+			Expected:         false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludeNetworkInterface(c.NetworkInterface, now.Add(1*time.Hour), c.FirstSeenTime, c.Config))
+		})
+	}
+}
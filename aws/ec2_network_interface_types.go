@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// NetworkInterfaces - represents all Elastic Network Interfaces that are not attached to anything
+type NetworkInterfaces struct {
+	NetworkInterfaceIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (eni NetworkInterfaces) ResourceName() string {
+	return "network-interface"
+}
+
+// ResourceIdentifiers - The IDs of the network interfaces
+func (eni NetworkInterfaces) ResourceIdentifiers() []string {
+	return eni.NetworkInterfaceIds
+}
+
+func (eni NetworkInterfaces) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (eni NetworkInterfaces) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllNetworkInterfaces(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// getPlacementGroupsInUse returns the names of the placement groups that still have instances in them. Terminated
+// instances don't keep a placement group from being deleted, and neither do the given instances being nuked, since
+// they are terminated before their placement groups are deleted.
+func getPlacementGroupsInUse(svc ec2iface.EC2API, nukedInstanceIds []string) (map[string]bool, error) {
+	nukedInstances := map[string]bool{}
+	for _, id := range nukedInstanceIds {
+		nukedInstances[id] = true
+	}
+
+	inUse := map[string]bool{}
+	err := svc.DescribeInstancesPages(&ec2.DescribeInstancesInput{}, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				if instance.State != nil && awsgo.StringValue(instance.State.Name) == ec2.InstanceStateNameTerminated {
+					continue
+				}
+				if nukedInstances[awsgo.StringValue(instance.InstanceId)] {
+					continue
+				}
+				if instance.Placement != nil && instance.Placement.GroupName != nil {
+					inUse[awsgo.StringValue(instance.Placement.GroupName)] = true
+				}
+			}
+		}
+		return !lastPage
+	})
+	return inUse, errors.WithStackTrace(err)
+}
+
+// getAllEmptyPlacementGroups returns the names of the placement groups that have no instances in them, other than the
+// given instances being nuked
+func getAllEmptyPlacementGroups(session *session.Session, excludeAfter time.Time, configObj config.Config, nukedInstanceIds []string) ([]*string, error) {
+	svc := ec2.New(session)
+
+	inUse, err := getPlacementGroupsInUse(svc, nukedInstanceIds)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := svc.DescribePlacementGroups(&ec2.DescribePlacementGroupsInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, group := range output.PlacementGroups {
+		if inUse[awsgo.StringValue(group.GroupName)] {
+			continue
+		}
+
+		// Placement groups don't have a creation time, so we rely on the first seen tag instead
+		firstSeenTime, err := getOrSetFirstSeenEC2ResourceTag(svc, group.GroupId, group.Tags)
+		if err != nil {
+			logging.Logger.Errorf("Unable to retrieve tags for placement group %s", awsgo.StringValue(group.GroupName))
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if shouldIncludePlacementGroup(group, excludeAfter, firstSeenTime, configObj) {
+			names = append(names, group.GroupName)
+		}
+	}
+
+	return names, nil
+}
+
+func shouldIncludePlacementGroup(group *ec2.PlacementGroup, excludeAfter time.Time, firstSeenTime time.Time, configObj config.Config) bool {
+	if group == nil {
+		return false
+	}
+
+	// Placement groups being deleted will be gone shortly
+	state := awsgo.StringValue(group.State)
+	if state == ec2.PlacementGroupStateDeleting || state == ec2.PlacementGroupStateDeleted {
+		return false
+	}
+
+	if excludeAfter.Before(firstSeenTime) {
+		return false
+	}
+
+	if hasPlacementGroupExcludeTag(group) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		awsgo.StringValue(group.GroupName),
+		configObj.EC2PlacementGroup.IncludeRule.NamesRegExp,
+		configObj.EC2PlacementGroup.ExcludeRule.NamesRegExp,
+	)
+}
+
+// hasPlacementGroupExcludeTag checks whether the exclude tag is set for a placement group to skip deleting it.
+func hasPlacementGroupExcludeTag(group *ec2.PlacementGroup) bool {
+	for _, tag := range group.Tags {
+		if awsgo.StringValue(tag.Key) == AwsResourceExclusionTagKey && awsgo.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// nukeAllPlacementGroups deletes the given empty placement groups.
+func nukeAllPlacementGroups(session *session.Session, names []*string) error {
+	svc := ec2.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No placement groups to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all empty placement groups in region %s", *session.Config.Region)
+
+	var deletedNames []*string
+	var allErrs *multierror.Error
+	for _, name := range names {
+		_, err := svc.DeletePlacementGroup(&ec2.DeletePlacementGroupInput{
+			GroupName: name,
+		})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   awsgo.StringValue(name),
+			ResourceType: "Placement Group",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Placement Group",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted placement group: %s", awsgo.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d placement group(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedPlacementGroupInstances struct {
+	ec2iface.EC2API
+}
+
+func (m *mockedPlacementGroupInstances) DescribeInstancesPages(input *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool) error {
+	fn(&ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{{Instances: []*ec2.Instance{
+		{
+			State:     &ec2.InstanceState{Name: awsgo.String(ec2.InstanceStateNameRunning)},
+			Placement: &ec2.Placement{GroupName: awsgo.String("running-group")},
+		},
+		{
+			State:     &ec2.InstanceState{Name: awsgo.String(ec2.InstanceStateNameStopped)},
+			Placement: &ec2.Placement{GroupName: awsgo.String("stopped-group")},
+		},
+		{
+			State:     &ec2.InstanceState{Name: awsgo.String(ec2.InstanceStateNameTerminated)},
+			Placement: &ec2.Placement{GroupName: awsgo.String("terminated-group")},
+		},
+		{
+			State:     &ec2.InstanceState{Name: awsgo.String(ec2.InstanceStateNameRunning)},
+			Placement: &ec2.Placement{},
+		},
+		{
+			InstanceId: awsgo.String("i-nuked"),
+			State:      &ec2.InstanceState{Name: awsgo.String(ec2.InstanceStateNameRunning)},
+			Placement:  &ec2.Placement{GroupName: awsgo.String("nuked-group")},
+		},
+	}}}}, true)
+	return nil
+}
+
+func TestGetPlacementGroupsInUse(t *testing.T) {
+	// Instances terminated in the same run don't keep their placement group in use
+	inUse, err := getPlacementGroupsInUse(&mockedPlacementGroupInstances{}, []string{"i-nuked"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"running-group": true, "stopped-group": true}, inUse)
+}
+
+func TestShouldIncludePlacementGroup(t *testing.T) {
+	firstSeen := time.Now().Add(-2 * time.Hour)
+	excludeConfig := config.Config{
+		EC2PlacementGroup: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^keep-.*")}},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name         string
+		Group        *ec2.PlacementGroup
+		Config       config.Config
+		ExcludeAfter time.Time
+		Expected     bool
+	}{
+		{
+			Name:         "SeenBefore",
+			Group:        &ec2.PlacementGroup{GroupName: awsgo.String("cloud-nuke-test"), State: awsgo.String(ec2.PlacementGroupStateAvailable)},
+			ExcludeAfter: time.Now().Add(-1 * time.Hour),
+			Expected:     true,
+		},
+		{
+			Name:         "SeenAfter",
+			Group:        &ec2.PlacementGroup{GroupName: awsgo.String("cloud-nuke-test"), State: awsgo.String(ec2.PlacementGroupStateAvailable)},
+			ExcludeAfter: time.Now().Add(-3 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "Deleting",
+			Group:        &ec2.PlacementGroup{GroupName: awsgo.String("cloud-nuke-test"), State: awsgo.String(ec2.PlacementGroupStateDeleting)},
+			ExcludeAfter: time.Now().Add(-1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name: "ExcludeTag",
+			Group: &ec2.PlacementGroup{
+				GroupName: awsgo.String("cloud-nuke-test"),
+				State:     awsgo.String(ec2.PlacementGroupStateAvailable),
+				Tags:      []*ec2.Tag{{Key: awsgo.String(AwsResourceExclusionTagKey), Value: awsgo.String("true")}},
+			},
+			ExcludeAfter: time.Now().Add(-1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "ConfigExclude",
+			Group:        &ec2.PlacementGroup{GroupName: awsgo.String("keep-me"), State: awsgo.String(ec2.PlacementGroupStateAvailable)},
+			Config:       excludeConfig,
+			ExcludeAfter: time.Now().Add(-1 * time.Hour),
+			Expected:     false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludePlacementGroup(c.Group, c.ExcludeAfter, firstSeen, c.Config))
+		})
+	}
+}
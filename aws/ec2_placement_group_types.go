@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// PlacementGroups - represents all placement groups that have no instances in them
+type PlacementGroups struct {
+	GroupNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (groups PlacementGroups) ResourceName() string {
+	return "ec2-placement-group"
+}
+
+// ResourceIdentifiers - The names of the placement groups
+func (groups PlacementGroups) ResourceIdentifiers() []string {
+	return groups.GroupNames
+}
+
+func (groups PlacementGroups) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (groups PlacementGroups) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllPlacementGroups(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
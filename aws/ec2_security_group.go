@@ -0,0 +1,317 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// getAllUnusedSecurityGroups returns the IDs of the non-default security groups that are not attached to any network
+// interface and that are not referenced by the rules of a security group that is kept.
+func getAllUnusedSecurityGroups(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := ec2.New(session)
+
+	var groups []*ec2.SecurityGroup
+	err := svc.DescribeSecurityGroupsPages(
+		&ec2.DescribeSecurityGroupsInput{},
+		func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+			groups = append(groups, page.SecurityGroups...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	attached, err := getSecurityGroupsAttachedToNetworkInterfaces(svc)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	candidates := map[string]bool{}
+	for _, group := range groups {
+		groupId := awsgo.StringValue(group.GroupId)
+		if awsgo.StringValue(group.GroupName) == "default" || attached[groupId] {
+			continue
+		}
+
+		// Security groups don't have a creation time, so we rely on the first seen tag instead
+		firstSeenTime, err := getOrSetFirstSeenEC2ResourceTag(svc, group.GroupId, group.Tags)
+		if err != nil {
+			logging.Logger.Errorf("Unable to retrieve tags for security group %s", groupId)
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if shouldIncludeSecurityGroup(group, excludeAfter, firstSeenTime, configObj) {
+			candidates[groupId] = true
+		}
+	}
+
+	dropSecurityGroupsReferencedByKeptGroups(groups, candidates)
+
+	var ids []*string
+	for _, group := range groups {
+		if candidates[awsgo.StringValue(group.GroupId)] {
+			ids = append(ids, group.GroupId)
+		}
+	}
+	return ids, nil
+}
+
+// getSecurityGroupsAttachedToNetworkInterfaces returns the set of security group IDs used by at least one ENI.
+func getSecurityGroupsAttachedToNetworkInterfaces(svc ec2iface.EC2API) (map[string]bool, error) {
+	attached := map[string]bool{}
+	err := svc.DescribeNetworkInterfacesPages(
+		&ec2.DescribeNetworkInterfacesInput{},
+		func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+			for _, networkInterface := range page.NetworkInterfaces {
+				for _, group := range networkInterface.Groups {
+					attached[awsgo.StringValue(group.GroupId)] = true
+				}
+			}
+			return !lastPage
+		},
+	)
+	return attached, errors.WithStackTrace(err)
+}
+
+// dropSecurityGroupsReferencedByKeptGroups removes from candidates every security group that is referenced by a rule
+// of a group that is not going to be deleted, since deleting it would fail with a DependencyViolation. Removing a
+// candidate turns it into a kept group, so this repeats until the set of candidates is stable.
+func dropSecurityGroupsReferencedByKeptGroups(groups []*ec2.SecurityGroup, candidates map[string]bool) {
+	for changed := true; changed; {
+		changed = false
+		for _, group := range groups {
+			if candidates[awsgo.StringValue(group.GroupId)] {
+				continue
+			}
+			for _, referencedId := range getReferencedSecurityGroupIds(group) {
+				if candidates[referencedId] {
+					delete(candidates, referencedId)
+					changed = true
+				}
+			}
+		}
+	}
+}
+
+// getReferencedSecurityGroupIds returns the IDs of the security groups referenced by the ingress and egress rules of
+// the given group.
+func getReferencedSecurityGroupIds(group *ec2.SecurityGroup) []string {
+	var ids []string
+	for _, permissions := range [][]*ec2.IpPermission{group.IpPermissions, group.IpPermissionsEgress} {
+		for _, permission := range permissions {
+			for _, pair := range permission.UserIdGroupPairs {
+				if pair.GroupId != nil {
+					ids = append(ids, awsgo.StringValue(pair.GroupId))
+				}
+			}
+		}
+	}
+	return ids
+}
+
+func shouldIncludeSecurityGroup(group *ec2.SecurityGroup, excludeAfter time.Time, firstSeenTime time.Time, configObj config.Config) bool {
+	if group == nil {
+		return false
+	}
+
+	if excludeAfter.Before(firstSeenTime) {
+		return false
+	}
+
+	if hasSecurityGroupExcludeTag(group) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		awsgo.StringValue(group.GroupName),
+		configObj.SecurityGroup.IncludeRule.NamesRegExp,
+		configObj.SecurityGroup.ExcludeRule.NamesRegExp,
+	)
+}
+
+// hasSecurityGroupExcludeTag checks whether the exclude tag is set for a security group to skip deleting it.
+func hasSecurityGroupExcludeTag(group *ec2.SecurityGroup) bool {
+	for _, tag := range group.Tags {
+		if awsgo.StringValue(tag.Key) == AwsResourceExclusionTagKey && awsgo.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// revokeSecurityGroupReferences revokes the rules by which the given security groups reference one another, since a
+// group that is referenced by another group's rules can't be deleted, even when both are being deleted. Rules of groups
+// that are not being deleted are never touched. The groups they still reference are returned instead, so that they can
+// be skipped.
+func revokeSecurityGroupReferences(svc ec2iface.EC2API, ids []*string) (map[string]bool, error) {
+	candidates := map[string]bool{}
+	for _, id := range ids {
+		candidates[awsgo.StringValue(id)] = true
+	}
+
+	var referencingGroups []*ec2.SecurityGroup
+	seen := map[string]bool{}
+	for _, filterName := range []string{"ip-permission.group-id", "egress.ip-permission.group-id"} {
+		err := svc.DescribeSecurityGroupsPages(
+			&ec2.DescribeSecurityGroupsInput{
+				Filters: []*ec2.Filter{
+					{
+						Name:   awsgo.String(filterName),
+						Values: ids,
+					},
+				},
+			},
+			func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+				for _, group := range page.SecurityGroups {
+					if !seen[awsgo.StringValue(group.GroupId)] {
+						seen[awsgo.StringValue(group.GroupId)] = true
+						referencingGroups = append(referencingGroups, group)
+					}
+				}
+				return !lastPage
+			},
+		)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+	}
+
+	// Groups may have started referencing the candidates since they were listed, and the groups of other batches are
+	// outside of this one as well
+	dropSecurityGroupsReferencedByKeptGroups(referencingGroups, candidates)
+	referenced := map[string]bool{}
+	for _, id := range ids {
+		if !candidates[awsgo.StringValue(id)] {
+			referenced[awsgo.StringValue(id)] = true
+		}
+	}
+
+	for _, group := range referencingGroups {
+		groupId := group.GroupId
+		if !candidates[awsgo.StringValue(groupId)] {
+			continue
+		}
+
+		var ingressRuleIds, egressRuleIds []*string
+		err := svc.DescribeSecurityGroupRulesPages(
+			&ec2.DescribeSecurityGroupRulesInput{
+				Filters: []*ec2.Filter{
+					{
+						Name:   awsgo.String("group-id"),
+						Values: []*string{groupId},
+					},
+				},
+			},
+			func(page *ec2.DescribeSecurityGroupRulesOutput, lastPage bool) bool {
+				for _, rule := range page.SecurityGroupRules {
+					if rule.ReferencedGroupInfo == nil || !candidates[awsgo.StringValue(rule.ReferencedGroupInfo.GroupId)] {
+						continue
+					}
+					if awsgo.BoolValue(rule.IsEgress) {
+						egressRuleIds = append(egressRuleIds, rule.SecurityGroupRuleId)
+					} else {
+						ingressRuleIds = append(ingressRuleIds, rule.SecurityGroupRuleId)
+					}
+				}
+				return !lastPage
+			},
+		)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if len(ingressRuleIds) > 0 {
+			logging.Logger.Debugf("Revoking %d ingress rule(s) of security group %s", len(ingressRuleIds), awsgo.StringValue(groupId))
+			_, err := svc.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+				GroupId:              groupId,
+				SecurityGroupRuleIds: ingressRuleIds,
+			})
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+		}
+		if len(egressRuleIds) > 0 {
+			logging.Logger.Debugf("Revoking %d egress rule(s) of security group %s", len(egressRuleIds), awsgo.StringValue(groupId))
+			_, err := svc.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+				GroupId:              groupId,
+				SecurityGroupRuleIds: egressRuleIds,
+			})
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+		}
+	}
+
+	return referenced, nil
+}
+
+// nukeAllSecurityGroups deletes the given security groups, after revoking the rules by which they reference one
+// another.
+func nukeAllSecurityGroups(session *session.Session, ids []*string) error {
+	svc := ec2.New(session)
+
+	if len(ids) == 0 {
+		logging.Logger.Debugf("No security groups to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all unused security groups in region %s", *session.Config.Region)
+
+	referenced, revokeErr := revokeSecurityGroupReferences(svc, ids)
+	if revokeErr != nil {
+		logging.Logger.Debugf("[Failed] Unable to revoke rules referencing security groups: %s", revokeErr)
+	}
+
+	var deletedIds []*string
+	var allErrs *multierror.Error
+	for _, id := range ids {
+		var err error
+		switch {
+		case revokeErr != nil:
+			err = revokeErr
+		case referenced[awsgo.StringValue(id)]:
+			err = SecurityGroupReferencedError{securityGroupId: awsgo.StringValue(id)}
+		default:
+			_, err = svc.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{
+				GroupId: id,
+			})
+		}
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   awsgo.StringValue(id),
+			ResourceType: "Security Group",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Security Group",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, id)
+			logging.Logger.Debugf("Deleted security group: %s", awsgo.StringValue(id))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d security group(s) deleted in %s", len(deletedIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
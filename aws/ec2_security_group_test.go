@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	mock_ec2iface "github.com/gruntwork-io/cloud-nuke/aws/mocks"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func securityGroupReferencing(groupId string, referencedIds ...string) *ec2.SecurityGroup {
+	var pairs []*ec2.UserIdGroupPair
+	for _, id := range referencedIds {
+		pairs = append(pairs, &ec2.UserIdGroupPair{GroupId: awsgo.String(id)})
+	}
+	return &ec2.SecurityGroup{
+		GroupId:       awsgo.String(groupId),
+		IpPermissions: []*ec2.IpPermission{{UserIdGroupPairs: pairs}},
+	}
+}
+
+func TestDropSecurityGroupsReferencedByKeptGroups(t *testing.T) {
+	// sg-kept references sg-two, which in turn references sg-three. Neither can be deleted while sg-kept stays around.
+	// sg-one only references itself and is free to go.
+	groups := []*ec2.SecurityGroup{
+		securityGroupReferencing("sg-kept", "sg-two"),
+		securityGroupReferencing("sg-two", "sg-three"),
+		securityGroupReferencing("sg-three"),
+		securityGroupReferencing("sg-one", "sg-one"),
+	}
+	candidates := map[string]bool{"sg-one": true, "sg-two": true, "sg-three": true}
+
+	dropSecurityGroupsReferencedByKeptGroups(groups, candidates)
+
+	assert.Equal(t, map[string]bool{"sg-one": true}, candidates)
+}
+
+func TestShouldIncludeSecurityGroup(t *testing.T) {
+	now := time.Now()
+	excludeConfig := config.Config{
+		SecurityGroup: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^keep-.*")},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name          string
+		Group         *ec2.SecurityGroup
+		FirstSeenTime time.Time
+		Config        config.Config
+		Expected      bool
+	}{
+		{
+			Name:          "NoFilters",
+			Group:         &ec2.SecurityGroup{GroupName: awsgo.String("cloud-nuke-test")},
+			FirstSeenTime: now,
+			Config:        config.Config{},
+			Expected:      true,
+		},
+		{
+			Name:          "SeenAfterExcludeAfter",
+			Group:         &ec2.SecurityGroup{GroupName: awsgo.String("cloud-nuke-test")},
+			FirstSeenTime: now.Add(2 * time.Hour),
+			Config:        config.Config{},
+			Expected:      false,
+		},
+		{
+			Name: "ExclusionTag",
+			Group: &ec2.SecurityGroup{
+				GroupName: awsgo.String("cloud-nuke-test"),
+				Tags:      []*ec2.Tag{{Key: awsgo.String(AwsResourceExclusionTagKey), Value: awsgo.String("true")}},
+			},
+			FirstSeenTime: now,
+			Config:        config.Config{},
+			Expected:      false,
+		},
+		{
+			Name:          "ConfigExclude",
+			Group:         &ec2.SecurityGroup{GroupName: awsgo.String("keep-me")},
+			FirstSeenTime: now,
+			Config:        excludeConfig,
+			Expected:      false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludeSecurityGroup(c.Group, now.Add(1*time.Hour), c.FirstSeenTime, c.Config))
+		})
+	}
+}
+
+func TestRevokeSecurityGroupReferences(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockEC2 := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	// sg-two references the example group, and both are being deleted. sg-referenced was meant to be deleted as well,
+	// but sg-kept references it, so it has to stay and sg-kept is left untouched.
+	ids := awsgo.StringSlice([]string{ExampleSecurityGroupId, ExampleSecurityGroupIdTwo, "sg-referenced"})
+	referencingGroups := []*ec2.SecurityGroup{
+		securityGroupReferencing(ExampleSecurityGroupIdTwo, ExampleSecurityGroupId, "sg-referenced"),
+		securityGroupReferencing("sg-kept", "sg-referenced"),
+	}
+	describeReferencingGroupsFunc := func(groups ...*ec2.SecurityGroup) func(*ec2.DescribeSecurityGroupsInput, func(*ec2.DescribeSecurityGroupsOutput, bool) bool) error {
+		return func(input *ec2.DescribeSecurityGroupsInput, fn func(*ec2.DescribeSecurityGroupsOutput, bool) bool) error {
+			fn(&ec2.DescribeSecurityGroupsOutput{SecurityGroups: groups}, true)
+			return nil
+		}
+	}
+	referencingRulePages := []*ec2.DescribeSecurityGroupRulesOutput{
+		{
+			SecurityGroupRules: []*ec2.SecurityGroupRule{
+				{
+					SecurityGroupRuleId: awsgo.String("sgr-ingress"),
+					IsEgress:            awsgo.Bool(false),
+					ReferencedGroupInfo: &ec2.ReferencedSecurityGroup{GroupId: awsgo.String(ExampleSecurityGroupId)},
+				},
+				{
+					// Rules that don't reference a group being deleted are left alone
+					SecurityGroupRuleId: awsgo.String("sgr-cidr"),
+					IsEgress:            awsgo.Bool(false),
+				},
+				{
+					// sg-referenced stays, so the rule referencing it can stay as well
+					SecurityGroupRuleId: awsgo.String("sgr-referenced"),
+					IsEgress:            awsgo.Bool(false),
+					ReferencedGroupInfo: &ec2.ReferencedSecurityGroup{GroupId: awsgo.String("sg-referenced")},
+				},
+			},
+		},
+		{
+			SecurityGroupRules: []*ec2.SecurityGroupRule{
+				{
+					SecurityGroupRuleId: awsgo.String("sgr-egress"),
+					IsEgress:            awsgo.Bool(true),
+					ReferencedGroupInfo: &ec2.ReferencedSecurityGroup{GroupId: awsgo.String(ExampleSecurityGroupId)},
+				},
+			},
+		},
+	}
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeSecurityGroupsPages(&ec2.DescribeSecurityGroupsInput{
+			Filters: []*ec2.Filter{{Name: awsgo.String("ip-permission.group-id"), Values: ids}},
+		}, gomock.Any()).DoAndReturn(describeReferencingGroupsFunc(referencingGroups...)),
+		mockEC2.EXPECT().DescribeSecurityGroupsPages(&ec2.DescribeSecurityGroupsInput{
+			Filters: []*ec2.Filter{{Name: awsgo.String("egress.ip-permission.group-id"), Values: ids}},
+		}, gomock.Any()).DoAndReturn(describeReferencingGroupsFunc(referencingGroups[0])),
+		mockEC2.EXPECT().DescribeSecurityGroupRulesPages(getDescribeSecurityGroupRulesInput(ExampleSecurityGroupIdTwo), gomock.Any()).DoAndReturn(
+			func(input *ec2.DescribeSecurityGroupRulesInput, fn func(*ec2.DescribeSecurityGroupRulesOutput, bool) bool) error {
+				for i, page := range referencingRulePages {
+					if !fn(page, i == len(referencingRulePages)-1) {
+						break
+					}
+				}
+				return nil
+			},
+		),
+		mockEC2.EXPECT().RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              awsgo.String(ExampleSecurityGroupIdTwo),
+			SecurityGroupRuleIds: awsgo.StringSlice([]string{"sgr-ingress"}),
+		}),
+		mockEC2.EXPECT().RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              awsgo.String(ExampleSecurityGroupIdTwo),
+			SecurityGroupRuleIds: awsgo.StringSlice([]string{"sgr-egress"}),
+		}),
+	)
+
+	referenced, err := revokeSecurityGroupReferences(mockEC2, ids)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"sg-referenced": true}, referenced)
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"fmt"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// SecurityGroups - represents all non-default security groups that are not in use
+type SecurityGroups struct {
+	SecurityGroupIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (sg SecurityGroups) ResourceName() string {
+	return "security-group"
+}
+
+// ResourceIdentifiers - The IDs of the security groups
+func (sg SecurityGroups) ResourceIdentifiers() []string {
+	return sg.SecurityGroupIds
+}
+
+func (sg SecurityGroups) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (sg SecurityGroups) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSecurityGroups(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// SecurityGroupReferencedError is returned for the security groups that are left in place because a group that is not
+// being deleted references them
+type SecurityGroupReferencedError struct {
+	securityGroupId string
+}
+
+func (e SecurityGroupReferencedError) Error() string {
+	return fmt.Sprintf("Security group %s is referenced by a security group that is not being deleted", e.securityGroupId)
+}
@@ -36,6 +36,7 @@ const (
 	ExampleSecurityGroupRuleId  = "sgr-" + ExampleId
 	ExampleInternetGatewayId    = "igw-" + ExampleId
 	ExampleEndpointId           = "vpce-" + ExampleId
+	ExamplePeeringConnectionId  = "pcx-" + ExampleId
 )
 
 // getAMIIdByName - Retrieves an AMI ImageId given the name of the Id. Used for
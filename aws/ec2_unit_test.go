@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/golang/mock/gomock"
 	mock_ec2iface "github.com/gruntwork-io/cloud-nuke/aws/mocks"
+	"github.com/pterm/pterm"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -124,12 +125,15 @@ func TestNukeMockVpcs(t *testing.T) {
 		}
 		deleteEndpointInput := getDeleteEndpointInput(ExampleEndpointId)
 
-		describeEndpointsWaitForDeletionInput := getDescribeEndpointsWaitForDeletionInput(vpc.VpcId)
+		describeEndpointsWaitForDeletionInput := getDescribeEndpointsWaitForDeletionInput([]string{ExampleEndpointId})
 		describeEndpointsWaitForDeletionOutput := getDescribeEndpointsOutput(nil)
 		describeEndpointsWaitForDeletionFunc := func(input *ec2.DescribeVpcEndpointsInput) (*ec2.DescribeVpcEndpointsOutput, error) {
 			return describeEndpointsWaitForDeletionOutput, nil
 		}
 
+		describeRequesterPeeringConnectionsInput := getDescribeVpcPeeringConnectionsInput("requester-vpc-info.vpc-id", vpc.VpcId)
+		describeAccepterPeeringConnectionsInput := getDescribeVpcPeeringConnectionsInput("accepter-vpc-info.vpc-id", vpc.VpcId)
+
 		describeSubnetsInput := getDescribeSubnetsInput(vpc.VpcId)
 		describeSubnetsOutput := getDescribeSubnetsOutput([]string{ExampleSubnetId, ExampleSubnetIdTwo, ExampleSubnetIdThree})
 		describeSubnetsFunc := func(input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
@@ -179,6 +183,8 @@ func TestNukeMockVpcs(t *testing.T) {
 			mockEC2.EXPECT().DescribeVpcEndpoints(describeEndpointsInput).DoAndReturn(describeEndpointsFunc),
 			mockEC2.EXPECT().DeleteVpcEndpoints(deleteEndpointInput),
 			mockEC2.EXPECT().DescribeVpcEndpoints(describeEndpointsWaitForDeletionInput).DoAndReturn(describeEndpointsWaitForDeletionFunc),
+			mockEC2.EXPECT().DescribeVpcPeeringConnectionsPages(describeRequesterPeeringConnectionsInput, gomock.Any()),
+			mockEC2.EXPECT().DescribeVpcPeeringConnectionsPages(describeAccepterPeeringConnectionsInput, gomock.Any()),
 			mockEC2.EXPECT().DescribeNetworkInterfacesPages(describeNetworkInterfacesInput, gomock.Any()),
 			mockEC2.EXPECT().DescribeSubnets(describeSubnetsInput).DoAndReturn(describeSubnetsFunc),
 			mockEC2.EXPECT().DeleteSubnet(deleteSubnetInputOne),
@@ -202,6 +208,47 @@ func TestNukeMockVpcs(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestNukeMockVpcPeeringConnections(t *testing.T) {
+	telemetry.InitTelemetry("cloud-nuke", "", "")
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockEC2 := mock_ec2iface.NewMockEC2API(mockCtrl)
+	vpc := Vpc{Region: "us-east-1", VpcId: ExampleVpcId, svc: mockEC2}
+
+	describePeeringConnectionsFunc := func(connections ...*ec2.VpcPeeringConnection) func(*ec2.DescribeVpcPeeringConnectionsInput, func(*ec2.DescribeVpcPeeringConnectionsOutput, bool) bool) error {
+		return func(input *ec2.DescribeVpcPeeringConnectionsInput, fn func(*ec2.DescribeVpcPeeringConnectionsOutput, bool) bool) error {
+			fn(&ec2.DescribeVpcPeeringConnectionsOutput{VpcPeeringConnections: connections}, true)
+			return nil
+		}
+	}
+	activeConnection := &ec2.VpcPeeringConnection{
+		VpcPeeringConnectionId: awsgo.String(ExamplePeeringConnectionId),
+		Status:                 &ec2.VpcPeeringConnectionStateReason{Code: awsgo.String(ec2.VpcPeeringConnectionStateReasonCodeActive)},
+	}
+	deletedConnection := &ec2.VpcPeeringConnection{
+		VpcPeeringConnectionId: awsgo.String("pcx-" + ExampleIdTwo),
+		Status:                 &ec2.VpcPeeringConnectionStateReason{Code: awsgo.String(ec2.VpcPeeringConnectionStateReasonCodeDeleted)},
+	}
+
+	gomock.InOrder(
+		mockEC2.EXPECT().DescribeVpcPeeringConnectionsPages(getDescribeVpcPeeringConnectionsInput("requester-vpc-info.vpc-id", ExampleVpcId), gomock.Any()).
+			DoAndReturn(describePeeringConnectionsFunc(activeConnection, deletedConnection)),
+		// The same connection reported from the accepter side must only be deleted once
+		mockEC2.EXPECT().DescribeVpcPeeringConnectionsPages(getDescribeVpcPeeringConnectionsInput("accepter-vpc-info.vpc-id", ExampleVpcId), gomock.Any()).
+			DoAndReturn(describePeeringConnectionsFunc(activeConnection)),
+		mockEC2.EXPECT().DeleteVpcPeeringConnection(&ec2.DeleteVpcPeeringConnectionInput{
+			VpcPeeringConnectionId: awsgo.String(ExamplePeeringConnectionId),
+		}),
+	)
+
+	spinner, err := pterm.DefaultSpinner.WithRemoveWhenDone(true).Start("")
+	require.NoError(t, err)
+	defer spinner.Stop()
+
+	require.NoError(t, vpc.nukePeeringConnections(spinner))
+}
+
 func TestNukeDefaultSecurityGroups(t *testing.T) {
 	telemetry.InitTelemetry("cloud-nuke", "", "")
 	mockCtrl := gomock.NewController(t)
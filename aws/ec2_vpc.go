@@ -139,7 +139,7 @@ func nukeAllVPCs(session *session.Session, vpcIds []string, vpcs []Vpc) error {
 	logging.Logger.Debug("Deleting all VPCs")
 
 	deletedVPCs := 0
-	multiErr := new(multierror.Error)
+	var multiErr *multierror.Error
 
 	for _, vpc := range vpcs {
 		err := vpc.nuke(spinnerSuccess)
@@ -152,14 +152,13 @@ func nukeAllVPCs(session *session.Session, vpcIds []string, vpcs []Vpc) error {
 		report.Record(e)
 
 		if err != nil {
-
 			logging.Logger.Errorf("[Failed] %s", err)
 			telemetry.TrackEvent(commonTelemetry.EventContext{
 				EventName: "Error Nuking VPC",
 			}, map[string]interface{}{
 				"region": *session.Config.Region,
 			})
-			multierror.Append(multiErr, err)
+			multiErr = multierror.Append(multiErr, err)
 		} else {
 			deletedVPCs++
 			logging.Logger.Debugf("Deleted VPC: %s", vpc.VpcId)
@@ -168,5 +167,5 @@ func nukeAllVPCs(session *session.Session, vpcIds []string, vpcs []Vpc) error {
 
 	logging.Logger.Debugf("[OK] %d VPC terminated", deletedVPCs)
 
-	return nil
+	return multiErr.ErrorOrNil()
 }
@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// getAllVpcEndpoints returns the IDs of all the gateway and interface VPC endpoints in the region that match the
+// configured filters.
+func getAllVpcEndpoints(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := ec2.New(session)
+
+	var ids []*string
+	err := svc.DescribeVpcEndpointsPages(
+		&ec2.DescribeVpcEndpointsInput{},
+		func(page *ec2.DescribeVpcEndpointsOutput, lastPage bool) bool {
+			for _, endpoint := range page.VpcEndpoints {
+				if shouldIncludeVpcEndpoint(endpoint, excludeAfter, configObj) {
+					ids = append(ids, endpoint.VpcEndpointId)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return ids, nil
+}
+
+func shouldIncludeVpcEndpoint(endpoint *ec2.VpcEndpoint, excludeAfter time.Time, configObj config.Config) bool {
+	if endpoint == nil {
+		return false
+	}
+
+	// Endpoints that are already on their way out are reported by the API until they are fully gone.
+	state := awsgo.StringValue(endpoint.State)
+	if state == ec2.StateDeleting || state == ec2.StateDeleted {
+		return false
+	}
+
+	if endpoint.CreationTimestamp != nil && excludeAfter.Before(*endpoint.CreationTimestamp) {
+		return false
+	}
+
+	if hasVpcEndpointExcludeTag(endpoint) {
+		return false
+	}
+
+	// If Name is unset, GetEC2ResourceNameTagValue returns error and zero value string
+	// Ignore this error and pass empty string to config.ShouldInclude
+	endpointName, _ := GetEC2ResourceNameTagValue(endpoint.Tags)
+
+	return config.ShouldInclude(
+		endpointName,
+		configObj.VPCEndpoint.IncludeRule.NamesRegExp,
+		configObj.VPCEndpoint.ExcludeRule.NamesRegExp,
+	)
+}
+
+// hasVpcEndpointExcludeTag checks whether the exclude tag is set for a VPC endpoint to skip deleting it.
+func hasVpcEndpointExcludeTag(endpoint *ec2.VpcEndpoint) bool {
+	for _, tag := range endpoint.Tags {
+		if awsgo.StringValue(tag.Key) == AwsResourceExclusionTagKey && awsgo.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// nukeAllVpcEndpoints deletes all the given VPC endpoints and waits for them to be fully removed, since endpoints
+// in the deleting state still block the deletion of their subnets, security groups and VPC.
+func nukeAllVpcEndpoints(session *session.Session, ids []*string) error {
+	svc := ec2.New(session)
+
+	if len(ids) == 0 {
+		logging.Logger.Debugf("No VPC endpoints to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all VPC endpoints in region %s", *session.Config.Region)
+
+	deletedIds, err := deleteVpcEndpoints(svc, ids)
+	if err != nil {
+		logging.Logger.Debugf("[Failed] %s", err)
+		telemetry.TrackEvent(commonTelemetry.EventContext{
+			EventName: "Error Nuking VPC Endpoint",
+		}, map[string]interface{}{
+			"region": *session.Config.Region,
+		})
+	}
+
+	// Keep the delete failures around when the wait fails too, so that neither of them gets lost
+	allErrs := multierror.Append(nil, err)
+	if len(deletedIds) > 0 {
+		if waitErr := waitForVPCEndpointsToBeDeleted(svc, deletedIds); waitErr != nil {
+			logging.Logger.Debugf("[Failed] %s", waitErr)
+			allErrs = multierror.Append(allErrs, waitErr)
+		} else {
+			logging.Logger.Debugf("[OK] %d VPC endpoint(s) deleted in %s", len(deletedIds), *session.Config.Region)
+		}
+	}
+
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// deleteVpcEndpoints issues a single bulk delete for the given VPC endpoints and records the outcome of each one.
+// The IDs of the endpoints that AWS accepted for deletion are returned.
+func deleteVpcEndpoints(svc ec2iface.EC2API, ids []*string) ([]*string, error) {
+	output, err := svc.DeleteVpcEndpoints(&ec2.DeleteVpcEndpointsInput{
+		VpcEndpointIds: ids,
+	})
+	if err != nil {
+		for _, id := range ids {
+			report.Record(report.Entry{
+				Identifier:   awsgo.StringValue(id),
+				ResourceType: "VPC Endpoint",
+				Error:        err,
+			})
+		}
+		return nil, errors.WithStackTrace(err)
+	}
+
+	// The bulk delete call succeeds even when individual endpoints could not be deleted, so failures have to be
+	// picked out of the response.
+	failures := map[string]error{}
+	var allErrs *multierror.Error
+	for _, item := range output.Unsuccessful {
+		if item == nil || item.Error == nil {
+			continue
+		}
+		itemErr := errors.WithStackTrace(VpcEndpointDeleteError{
+			Id:      awsgo.StringValue(item.ResourceId),
+			Code:    awsgo.StringValue(item.Error.Code),
+			Message: awsgo.StringValue(item.Error.Message),
+		})
+		failures[awsgo.StringValue(item.ResourceId)] = itemErr
+		allErrs = multierror.Append(allErrs, itemErr)
+	}
+
+	var deletedIds []*string
+	for _, id := range ids {
+		itemErr := failures[awsgo.StringValue(id)]
+		report.Record(report.Entry{
+			Identifier:   awsgo.StringValue(id),
+			ResourceType: "VPC Endpoint",
+			Error:        itemErr,
+		})
+		if itemErr == nil {
+			deletedIds = append(deletedIds, id)
+			logging.Logger.Debugf("Deleted VPC endpoint: %s", awsgo.StringValue(id))
+		}
+	}
+
+	return deletedIds, allErrs.ErrorOrNil()
+}
+
+type VpcEndpointDeleteError struct {
+	Id      string
+	Code    string
+	Message string
+}
+
+func (e VpcEndpointDeleteError) Error() string {
+	return "Unable to delete VPC endpoint " + e.Id + ": " + e.Code + ": " + e.Message
+}
@@ -0,0 +1,188 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	mock_ec2iface "github.com/gruntwork-io/cloud-nuke/aws/mocks"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestGatewayVpcEndpoint(t *testing.T, awsSession *session.Session, vpcId string) string {
+	svc := ec2.New(awsSession)
+	endpoint, err := svc.CreateVpcEndpoint(&ec2.CreateVpcEndpointInput{
+		VpcId:           awsgo.String(vpcId),
+		ServiceName:     awsgo.String("com.amazonaws." + awsgo.StringValue(awsSession.Config.Region) + ".s3"),
+		VpcEndpointType: awsgo.String(ec2.VpcEndpointTypeGateway),
+	})
+	require.NoError(t, err)
+	return awsgo.StringValue(endpoint.VpcEndpoint.VpcEndpointId)
+}
+
+func TestListAndNukeVpcEndpoints(t *testing.T) {
+	telemetry.InitTelemetry("cloud-nuke", "", "")
+	t.Parallel()
+
+	region, err := getRandomRegion()
+	require.NoError(t, err)
+
+	awsSession, err := session.NewSession(&awsgo.Config{
+		Region: awsgo.String(region)},
+	)
+	require.NoError(t, err)
+
+	vpcId := createTestVpc(t, awsSession)
+	defer nukeAllVPCs(awsSession, []string{vpcId}, []Vpc{{VpcId: vpcId, Region: region, svc: ec2.New(awsSession)}})
+
+	endpointId := createTestGatewayVpcEndpoint(t, awsSession, vpcId)
+
+	olderThan := time.Now().Add(-1 * time.Hour)
+	endpointIds, err := getAllVpcEndpoints(awsSession, olderThan, config.Config{})
+	require.NoError(t, err)
+	assert.NotContains(t, awsgo.StringValueSlice(endpointIds), endpointId)
+
+	endpointIds, err = getAllVpcEndpoints(awsSession, time.Now().Add(1*time.Hour), config.Config{})
+	require.NoError(t, err)
+	assert.Contains(t, awsgo.StringValueSlice(endpointIds), endpointId)
+
+	require.NoError(t, nukeAllVpcEndpoints(awsSession, []*string{awsgo.String(endpointId)}))
+
+	endpointIds, err = getAllVpcEndpoints(awsSession, time.Now().Add(1*time.Hour), config.Config{})
+	require.NoError(t, err)
+	assert.NotContains(t, awsgo.StringValueSlice(endpointIds), endpointId)
+}
+
+func TestShouldIncludeVpcEndpoint(t *testing.T) {
+	now := time.Now()
+	namedEndpoint := func(name string) *ec2.VpcEndpoint {
+		return &ec2.VpcEndpoint{
+			State:             awsgo.String(ec2.StateAvailable),
+			CreationTimestamp: awsgo.Time(now),
+			Tags:              []*ec2.Tag{{Key: awsgo.String("Name"), Value: awsgo.String(name)}},
+		}
+	}
+	excludeConfig := config.Config{
+		VPCEndpoint: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^cloud-nuke-test-exclude-.*")},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Endpoint *ec2.VpcEndpoint
+		Config   config.Config
+		Expected bool
+	}{
+		{
+			Name:     "Available",
+			Endpoint: &ec2.VpcEndpoint{State: awsgo.String(ec2.StateAvailable), CreationTimestamp: awsgo.Time(now)},
+			Config:   config.Config{},
+			Expected: true,
+		},
+		{
+			Name:     "Deleting",
+			Endpoint: &ec2.VpcEndpoint{State: awsgo.String(ec2.StateDeleting), CreationTimestamp: awsgo.Time(now)},
+			Config:   config.Config{},
+			Expected: false,
+		},
+		{
+			Name:     "NewerThanExcludeAfter",
+			Endpoint: &ec2.VpcEndpoint{State: awsgo.String(ec2.StateAvailable), CreationTimestamp: awsgo.Time(now.Add(2 * time.Hour))},
+			Config:   config.Config{},
+			Expected: false,
+		},
+		{
+			Name: "ExclusionTag",
+			Endpoint: &ec2.VpcEndpoint{
+				State:             awsgo.String(ec2.StateAvailable),
+				CreationTimestamp: awsgo.Time(now),
+				Tags:              []*ec2.Tag{{Key: awsgo.String(AwsResourceExclusionTagKey), Value: awsgo.String("true")}},
+			},
+			Config:   config.Config{},
+			Expected: false,
+		},
+		{
+			Name:     "ConfigExclude",
+			Endpoint: namedEndpoint("cloud-nuke-test-exclude-1"),
+			Config:   excludeConfig,
+			Expected: false,
+		},
+		{
+			Name:     "ConfigNotExcluded",
+			Endpoint: namedEndpoint("cloud-nuke-test-1"),
+			Config:   excludeConfig,
+			Expected: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludeVpcEndpoint(c.Endpoint, now.Add(1*time.Hour), c.Config))
+		})
+	}
+}
+
+func TestDeleteVpcEndpointsReportsUnsuccessfulItems(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockEC2 := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	ids := awsgo.StringSlice([]string{"vpce-ok", "vpce-failed"})
+	mockEC2.EXPECT().DeleteVpcEndpoints(&ec2.DeleteVpcEndpointsInput{VpcEndpointIds: ids}).Return(
+		&ec2.DeleteVpcEndpointsOutput{
+			Unsuccessful: []*ec2.UnsuccessfulItem{
+				{
+					ResourceId: awsgo.String("vpce-failed"),
+					Error: &ec2.UnsuccessfulItemError{
+						Code:    awsgo.String("InvalidVpcEndpoint.NotFound"),
+						Message: awsgo.String("not found"),
+					},
+				},
+			},
+		},
+		nil,
+	)
+
+	deletedIds, err := deleteVpcEndpoints(mockEC2, ids)
+	require.Error(t, err)
+	assert.Equal(t, []string{"vpce-ok"}, awsgo.StringValueSlice(deletedIds))
+}
+
+func TestWaitForVPCEndpointsToBeDeletedFiltersOnIds(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockEC2 := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+	ids := awsgo.StringSlice([]string{"vpce-one", "vpce-two"})
+	mockEC2.EXPECT().DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   awsgo.String("vpc-endpoint-id"),
+				Values: ids,
+			},
+			{
+				Name:   awsgo.String("vpc-endpoint-state"),
+				Values: []*string{awsgo.String("deleting")},
+			},
+		},
+	}).Return(&ec2.DescribeVpcEndpointsOutput{}, nil)
+
+	require.NoError(t, waitForVPCEndpointsToBeDeleted(mockEC2, ids))
+}
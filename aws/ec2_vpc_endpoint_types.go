@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// VPCEndpoints - represents all gateway and interface VPC endpoints
+type VPCEndpoints struct {
+	VpcEndpointIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (e VPCEndpoints) ResourceName() string {
+	return "vpc-endpoint"
+}
+
+// ResourceIdentifiers - The IDs of the VPC endpoints
+func (e VPCEndpoints) ResourceIdentifiers() []string {
+	return e.VpcEndpointIds
+}
+
+func (e VPCEndpoints) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle. DeleteVpcEndpoints accepts the whole batch in one call.
+	return 25
+}
+
+// Nuke - nuke 'em all!!!
+func (e VPCEndpoints) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllVpcEndpoints(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
 	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
 )
 
 func getAllECRRepositories(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]string, error) {
@@ -65,8 +66,10 @@ func nukeAllECRRepositories(session *session.Session, repositoryNames []string)
 	}
 
 	var deletedNames []*string
+	var allErrs *multierror.Error
 
 	for _, repositoryName := range repositoryNames {
+		// Force deletes the images in the repository along with it, otherwise non-empty repositories can't be deleted
 		params := &ecr.DeleteRepositoryInput{
 			Force:          aws.Bool(true),
 			RepositoryName: aws.String(repositoryName),
@@ -89,8 +92,8 @@ func nukeAllECRRepositories(session *session.Session, repositoryNames []string)
 				"region": *session.Config.Region,
 			})
 			logging.Logger.Debugf("[Failed] %s", err)
+			allErrs = multierror.Append(allErrs, err)
 		} else {
-
 			deletedNames = append(deletedNames, aws.String(repositoryName))
 			logging.Logger.Debugf("Deleted ECR Repository: %s", repositoryName)
 		}
@@ -98,5 +101,5 @@ func nukeAllECRRepositories(session *session.Session, repositoryNames []string)
 
 	logging.Logger.Debugf("[OK] %d ECR Repositories deleted in %s", len(deletedNames), *session.Config.Region)
 
-	return nil
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
 }
@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// The ECR Public API is only served from us-east-1, regardless of where the repositories are consumed from
+const ecrPublicRegion = "us-east-1"
+
+func newECRPublicClient(session *session.Session) *ecrpublic.ECRPublic {
+	return ecrpublic.New(session, aws.NewConfig().WithRegion(ecrPublicRegion))
+}
+
+func getAllECRPublicRepositories(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]string, error) {
+	svc := newECRPublicClient(session)
+
+	repositoryNames := []string{}
+
+	paginator := func(output *ecrpublic.DescribeRepositoriesOutput, lastPage bool) bool {
+		for _, repository := range output.Repositories {
+			if shouldIncludeECRPublicRepository(repository, excludeAfter, configObj) {
+				repositoryNames = append(repositoryNames, aws.StringValue(repository.RepositoryName))
+			}
+		}
+		return !lastPage
+	}
+
+	err := svc.DescribeRepositoriesPages(&ecrpublic.DescribeRepositoriesInput{}, paginator)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return repositoryNames, nil
+}
+
+func shouldIncludeECRPublicRepository(repository *ecrpublic.Repository, excludeAfter time.Time, configObj config.Config) bool {
+	if repository == nil {
+		return false
+	}
+
+	if excludeAfter.Before(aws.TimeValue(repository.CreatedAt)) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(repository.RepositoryName),
+		configObj.ECRPublicRepository.IncludeRule.NamesRegExp,
+		configObj.ECRPublicRepository.ExcludeRule.NamesRegExp,
+	)
+}
+
+func nukeAllECRPublicRepositories(session *session.Session, repositoryNames []string) error {
+	svc := newECRPublicClient(session)
+
+	if len(repositoryNames) == 0 {
+		logging.Logger.Debugf("No ECR Public repositories to nuke")
+		return nil
+	}
+
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, repositoryName := range repositoryNames {
+		params := &ecrpublic.DeleteRepositoryInput{
+			Force:          aws.Bool(true),
+			RepositoryName: aws.String(repositoryName),
+		}
+
+		_, err := svc.DeleteRepository(params)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   repositoryName,
+			ResourceType: "ECR Public Repository",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking ECR Public Repo",
+			}, map[string]interface{}{
+				"region": "global",
+			})
+			logging.Logger.Debugf("[Failed] %s", err)
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, aws.String(repositoryName))
+			logging.Logger.Debugf("Deleted ECR Public Repository: %s", repositoryName)
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d ECR Public Repositories deleted", len(deletedNames))
+
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
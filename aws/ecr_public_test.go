@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldIncludeECRPublicRepository(t *testing.T) {
+	now := time.Now()
+	includeConfig := config.Config{
+		ECRPublicRepository: config.ResourceType{
+			IncludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^cloud-nuke-.*")},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name         string
+		Repository   *ecrpublic.Repository
+		Config       config.Config
+		ExcludeAfter time.Time
+		Expected     bool
+	}{
+		{
+			Name:         "OlderThan",
+			Repository:   &ecrpublic.Repository{RepositoryName: aws.String("cloud-nuke-test"), CreatedAt: aws.Time(now)},
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     true,
+		},
+		{
+			Name:         "NotOlderThan",
+			Repository:   &ecrpublic.Repository{RepositoryName: aws.String("cloud-nuke-test"), CreatedAt: aws.Time(now)},
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(-1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "ConfigInclude",
+			Repository:   &ecrpublic.Repository{RepositoryName: aws.String("cloud-nuke-test"), CreatedAt: aws.Time(now)},
+			Config:       includeConfig,
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     true,
+		},
+		{
+			Name:         "ConfigIncludeNotMatching",
+			Repository:   &ecrpublic.Repository{RepositoryName: aws.String("other"), CreatedAt: aws.Time(now)},
+			Config:       includeConfig,
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludeECRPublicRepository(c.Repository, c.ExcludeAfter, c.Config))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+type ECRPublic struct {
+	RepositoryNames []string
+}
+
+func (registry ECRPublic) ResourceName() string {
+	return "ecr-public"
+}
+
+func (registry ECRPublic) ResourceIdentifiers() []string {
+	return registry.RepositoryNames
+}
+
+func (registry ECRPublic) MaxBatchSize() int {
+	return 50
+}
+
+func (registry ECRPublic) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllECRPublicRepositories(session, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -145,3 +146,59 @@ func assertECRRepositoriesDeleted(t *testing.T, region string, repositoryNames [
 		}
 	}
 }
+
+func TestShouldIncludeECRRepository(t *testing.T) {
+	now := time.Now()
+	excludeConfig := config.Config{
+		ECRRepository: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^keep-.*")},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name         string
+		Repository   *ecr.Repository
+		Config       config.Config
+		ExcludeAfter time.Time
+		Expected     bool
+	}{
+		{
+			Name:         "OlderThan",
+			Repository:   &ecr.Repository{RepositoryName: aws.String("cloud-nuke-test"), CreatedAt: aws.Time(now)},
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     true,
+		},
+		{
+			Name:         "NotOlderThan",
+			Repository:   &ecr.Repository{RepositoryName: aws.String("cloud-nuke-test"), CreatedAt: aws.Time(now)},
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(-1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "ConfigExclude",
+			Repository:   &ecr.Repository{RepositoryName: aws.String("keep-me"), CreatedAt: aws.Time(now)},
+			Config:       excludeConfig,
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "Nil",
+			Repository:   nil,
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludeECRRepository(c.Repository, c.ExcludeAfter, c.Config))
+		})
+	}
+}
@@ -9,10 +9,12 @@ import (
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
 	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
 )
 
 // Used in this context to determine if the ECS Cluster is ready to be used & tagged
@@ -119,11 +121,22 @@ func nukeEcsClusters(awsSession *session.Session, ecsClusterArns []*string) erro
 	logging.Logger.Debugf("Deleting %d ECS clusters in region %s", numNuking, aws.StringValue(awsSession.Config.Region))
 
 	var nukedEcsClusters []*string
+	var allErrs *multierror.Error
 	for _, clusterArn := range ecsClusterArns {
-		params := &ecs.DeleteClusterInput{
-			Cluster: clusterArn,
+		// A cluster can only be deleted once it has no services, no registered container instances and no capacity
+		// providers associated with it, so tear those down first.
+		err := nukeEcsClusterServices(svc, clusterArn)
+		if err == nil {
+			err = deregisterEcsContainerInstances(svc, clusterArn)
+		}
+		if err == nil {
+			err = deleteEcsClusterCapacityProviders(svc, clusterArn)
+		}
+		if err == nil {
+			_, err = svc.DeleteCluster(&ecs.DeleteClusterInput{
+				Cluster: clusterArn,
+			})
 		}
-		_, err := svc.DeleteCluster(params)
 
 		// Record status of this resource
 		e := report.Entry{
@@ -134,13 +147,14 @@ func nukeEcsClusters(awsSession *session.Session, ecsClusterArns []*string) erro
 		report.Record(e)
 
 		if err != nil {
-			logging.Logger.Debugf("Error, failed to delete cluster with ARN %s", aws.StringValue(clusterArn))
+			logging.Logger.Debugf("Error, failed to delete cluster with ARN %s: %s", aws.StringValue(clusterArn), err)
 			telemetry.TrackEvent(commonTelemetry.EventContext{
 				EventName: "Error Nuking ECS Cluster",
 			}, map[string]interface{}{
 				"region": *awsSession.Config.Region,
 			})
-			return errors.WithStackTrace(err)
+			allErrs = multierror.Append(allErrs, err)
+			continue
 		}
 
 		logging.Logger.Debugf("Success, deleted cluster: %s", aws.StringValue(clusterArn))
@@ -150,9 +164,112 @@ func nukeEcsClusters(awsSession *session.Session, ecsClusterArns []*string) erro
 	numNuked := len(nukedEcsClusters)
 	logging.Logger.Debugf("[OK] %d of %d ECS cluster(s) deleted in %s", numNuked, numNuking, aws.StringValue(awsSession.Config.Region))
 
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// nukeEcsClusterServices - Scales down and deletes all the services remaining in the cluster, using the same steps
+// as the ECS service resource.
+func nukeEcsClusterServices(svc *ecs.ECS, clusterArn *string) error {
+	serviceArns, err := listEcsClusterServices(svc, clusterArn)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(serviceArns) == 0 {
+		return nil
+	}
+
+	ecsServiceClusterMap := map[string]string{}
+	for _, serviceArn := range serviceArns {
+		ecsServiceClusterMap[aws.StringValue(serviceArn)] = aws.StringValue(clusterArn)
+	}
+
+	requestedDrains := drainEcsServices(svc, ecsServiceClusterMap, serviceArns)
+	successfullyDrained := waitUntilServicesDrained(svc, ecsServiceClusterMap, requestedDrains)
+	requestedDeletes := deleteEcsServices(svc, ecsServiceClusterMap, successfullyDrained)
+	successfullyDeleted := waitUntilServicesDeleted(svc, ecsServiceClusterMap, requestedDeletes)
+	if len(successfullyDeleted) != len(serviceArns) {
+		return errors.WithStackTrace(EcsClusterServicesNotDeletedErr{
+			clusterArn: aws.StringValue(clusterArn),
+			remaining:  len(serviceArns) - len(successfullyDeleted),
+		})
+	}
+	return nil
+}
+
+// deregisterEcsContainerInstances - Deregisters all the container instances of the cluster. The underlying EC2
+// instances are not terminated here; they are handled by the EC2 and ASG resources.
+func deregisterEcsContainerInstances(svc ecsiface.ECSAPI, clusterArn *string) error {
+	var containerInstanceArns []*string
+	err := svc.ListContainerInstancesPages(&ecs.ListContainerInstancesInput{
+		Cluster: clusterArn,
+	}, func(page *ecs.ListContainerInstancesOutput, lastPage bool) bool {
+		containerInstanceArns = append(containerInstanceArns, page.ContainerInstanceArns...)
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, containerInstanceArn := range containerInstanceArns {
+		// Force is required to deregister instances that still have tasks running on them
+		_, err := svc.DeregisterContainerInstance(&ecs.DeregisterContainerInstanceInput{
+			Cluster:           clusterArn,
+			ContainerInstance: containerInstanceArn,
+			Force:             aws.Bool(true),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deregistered container instance %s from cluster %s", aws.StringValue(containerInstanceArn), aws.StringValue(clusterArn))
+	}
+	return nil
+}
+
+// deleteEcsClusterCapacityProviders - Disassociates all capacity providers from the cluster and deletes the custom
+// ones. The FARGATE and FARGATE_SPOT capacity providers are managed by AWS and can't be deleted.
+func deleteEcsClusterCapacityProviders(svc ecsiface.ECSAPI, clusterArn *string) error {
+	describedClusters, err := svc.DescribeClusters(&ecs.DescribeClustersInput{
+		Clusters: []*string{clusterArn},
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(describedClusters.Clusters) == 0 {
+		return nil
+	}
+	capacityProviders := describedClusters.Clusters[0].CapacityProviders
+	if len(capacityProviders) == 0 {
+		return nil
+	}
+
+	_, err = svc.PutClusterCapacityProviders(&ecs.PutClusterCapacityProvidersInput{
+		Cluster:                         clusterArn,
+		CapacityProviders:               []*string{},
+		DefaultCapacityProviderStrategy: []*ecs.CapacityProviderStrategyItem{},
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, capacityProvider := range capacityProviders {
+		if isAwsManagedEcsCapacityProvider(aws.StringValue(capacityProvider)) {
+			continue
+		}
+		_, err := svc.DeleteCapacityProvider(&ecs.DeleteCapacityProviderInput{
+			CapacityProvider: capacityProvider,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted capacity provider %s of cluster %s", aws.StringValue(capacityProvider), aws.StringValue(clusterArn))
+	}
 	return nil
 }
 
+func isAwsManagedEcsCapacityProvider(name string) bool {
+	return name == "FARGATE" || name == "FARGATE_SPOT"
+}
+
 // Tag an ECS cluster identified by the given cluster ARN when it's first seen by cloud-nuke
 func tagEcsClusterWhenFirstSeen(awsSession *session.Session, clusterArn *string, timestamp time.Time) error {
 	svc := ecs.New(awsSession)
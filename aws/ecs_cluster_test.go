@@ -9,6 +9,7 @@ import (
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/util"
@@ -212,3 +213,77 @@ func TestShouldIncludeECSCluster(t *testing.T) {
 		})
 	}
 }
+
+// mockedEcsClusterTeardown serves a single cluster with container instances and capacity providers attached
+type mockedEcsClusterTeardown struct {
+	ecsiface.ECSAPI
+	ContainerInstanceArns      []*string
+	CapacityProviders          []*string
+	DeregisteredInstances      []*ecs.DeregisterContainerInstanceInput
+	PutClusterCapacityProvider *ecs.PutClusterCapacityProvidersInput
+	DeletedCapacityProviders   []string
+}
+
+func (m *mockedEcsClusterTeardown) ListContainerInstancesPages(input *ecs.ListContainerInstancesInput, fn func(*ecs.ListContainerInstancesOutput, bool) bool) error {
+	fn(&ecs.ListContainerInstancesOutput{ContainerInstanceArns: m.ContainerInstanceArns}, true)
+	return nil
+}
+
+func (m *mockedEcsClusterTeardown) DeregisterContainerInstance(input *ecs.DeregisterContainerInstanceInput) (*ecs.DeregisterContainerInstanceOutput, error) {
+	m.DeregisteredInstances = append(m.DeregisteredInstances, input)
+	return &ecs.DeregisterContainerInstanceOutput{}, nil
+}
+
+func (m *mockedEcsClusterTeardown) DescribeClusters(input *ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error) {
+	return &ecs.DescribeClustersOutput{
+		Clusters: []*ecs.Cluster{{ClusterArn: input.Clusters[0], CapacityProviders: m.CapacityProviders}},
+	}, nil
+}
+
+func (m *mockedEcsClusterTeardown) PutClusterCapacityProviders(input *ecs.PutClusterCapacityProvidersInput) (*ecs.PutClusterCapacityProvidersOutput, error) {
+	m.PutClusterCapacityProvider = input
+	return &ecs.PutClusterCapacityProvidersOutput{}, nil
+}
+
+func (m *mockedEcsClusterTeardown) DeleteCapacityProvider(input *ecs.DeleteCapacityProviderInput) (*ecs.DeleteCapacityProviderOutput, error) {
+	m.DeletedCapacityProviders = append(m.DeletedCapacityProviders, awsgo.StringValue(input.CapacityProvider))
+	return &ecs.DeleteCapacityProviderOutput{}, nil
+}
+
+func TestDeregisterEcsContainerInstances(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedEcsClusterTeardown{
+		ContainerInstanceArns: awsgo.StringSlice([]string{"instance-1", "instance-2"}),
+	}
+	require.NoError(t, deregisterEcsContainerInstances(mock, awsgo.String("cluster")))
+
+	require.Len(t, mock.DeregisteredInstances, 2)
+	for _, input := range mock.DeregisteredInstances {
+		assert.Equal(t, "cluster", awsgo.StringValue(input.Cluster))
+		assert.True(t, awsgo.BoolValue(input.Force))
+	}
+}
+
+func TestDeleteEcsClusterCapacityProviders(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedEcsClusterTeardown{
+		CapacityProviders: awsgo.StringSlice([]string{"FARGATE", "FARGATE_SPOT", "cloud-nuke-asg-provider"}),
+	}
+	require.NoError(t, deleteEcsClusterCapacityProviders(mock, awsgo.String("cluster")))
+
+	require.NotNil(t, mock.PutClusterCapacityProvider)
+	assert.Empty(t, mock.PutClusterCapacityProvider.CapacityProviders)
+	assert.Equal(t, []string{"cloud-nuke-asg-provider"}, mock.DeletedCapacityProviders)
+}
+
+func TestDeleteEcsClusterCapacityProvidersWithoutProviders(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedEcsClusterTeardown{}
+	require.NoError(t, deleteEcsClusterCapacityProviders(mock, awsgo.String("cluster")))
+
+	assert.Nil(t, mock.PutClusterCapacityProvider)
+	assert.Empty(t, mock.DeletedCapacityProviders)
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"fmt"
+
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/gruntwork-io/go-commons/errors"
@@ -39,3 +41,14 @@ func (clusters ECSClusters) Nuke(awsSession *session.Session, identifiers []stri
 	}
 	return nil
 }
+
+// EcsClusterServicesNotDeletedErr - Returned when some services of a cluster could not be deleted, which prevents
+// the cluster from being deleted
+type EcsClusterServicesNotDeletedErr struct {
+	clusterArn string
+	remaining  int
+}
+
+func (err EcsClusterServicesNotDeletedErr) Error() string {
+	return fmt.Sprintf("%d service(s) of ECS cluster %s could not be deleted", err.remaining, err.clusterArn)
+}
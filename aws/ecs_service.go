@@ -9,6 +9,7 @@ import (
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
@@ -38,6 +39,20 @@ func getAllEcsClusters(awsSession *session.Session) ([]*string, error) {
 	return clusterArns, nil
 }
 
+// listEcsClusterServices - Returns the ARNs of all the services in the given
+// cluster. ListServices only returns 10 services per page by default.
+func listEcsClusterServices(svc ecsiface.ECSAPI, clusterArn *string) ([]*string, error) {
+	var serviceArns []*string
+	err := svc.ListServicesPages(&ecs.ListServicesInput{Cluster: clusterArn}, func(page *ecs.ListServicesOutput, lastPage bool) bool {
+		serviceArns = append(serviceArns, page.ServiceArns...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return serviceArns, nil
+}
+
 // filterOutRecentServices - Given a list of services and an excludeAfter
 // timestamp, filter out any services that were created after `excludeAfter.
 // Additionally, filter based on Config file patterns.
@@ -93,11 +108,11 @@ func getAllEcsServices(awsSession *session.Session, ecsClusterArns []*string, ex
 	// ones.
 	var ecsServiceArns []*string
 	for _, clusterArn := range ecsClusterArns {
-		result, err := svc.ListServices(&ecs.ListServicesInput{Cluster: clusterArn})
+		serviceArns, err := listEcsClusterServices(svc, clusterArn)
 		if err != nil {
 			return nil, nil, errors.WithStackTrace(err)
 		}
-		filteredServiceArns, err := filterOutRecentServices(svc, clusterArn, awsgo.StringValueSlice(result.ServiceArns), excludeAfter, configObj)
+		filteredServiceArns, err := filterOutRecentServices(svc, clusterArn, awsgo.StringValueSlice(serviceArns), excludeAfter, configObj)
 		if err != nil {
 			return nil, nil, errors.WithStackTrace(err)
 		}
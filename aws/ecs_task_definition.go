@@ -0,0 +1,284 @@
+package aws
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// ECS DescribeServices accepts at most this many services per call
+const ecsDescribeServicesBatchLimit = 10
+
+// ECS DeleteTaskDefinitions accepts at most this many revisions per call
+const ecsDeleteTaskDefinitionsBatchLimit = 10
+
+// getAllEcsTaskDefinitions returns the ARNs of the active task definition revisions in the region that were
+// registered before excludeAfter. The latest revision of every family, and the revisions services still use, are
+// kept. When revisions are deleted too, the ARNs of the inactive revisions registered before excludeAfter are returned
+// as well, as they can be deleted without being deregistered first.
+func getAllEcsTaskDefinitions(awsSession *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, []*string, error) {
+	return listEcsTaskDefinitions(ecs.New(awsSession), excludeAfter, configObj)
+}
+
+func listEcsTaskDefinitions(svc ecsiface.ECSAPI, excludeAfter time.Time, configObj config.Config) ([]*string, []*string, error) {
+	inUse, err := getEcsTaskDefinitionsInUse(svc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	latestRevisions := map[string]int{}
+	activeCandidateArns, err := listEcsTaskDefinitionCandidates(svc, ecs.TaskDefinitionStatusActive, latestRevisions, configObj)
+	if err != nil {
+		return nil, nil, err
+	}
+	activeArns, err := filterEcsTaskDefinitions(svc, activeCandidateArns, latestRevisions, inUse, excludeAfter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !configObj.ECSTaskDefinition.DeleteRevisions {
+		return activeArns, nil, nil
+	}
+
+	// Inactive revisions can't become the latest revision of a family again, so they don't count towards it
+	inactiveCandidateArns, err := listEcsTaskDefinitionCandidates(svc, ecs.TaskDefinitionStatusInactive, map[string]int{}, configObj)
+	if err != nil {
+		return nil, nil, err
+	}
+	inactiveArns, err := filterEcsTaskDefinitions(svc, inactiveCandidateArns, latestRevisions, inUse, excludeAfter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return activeArns, inactiveArns, nil
+}
+
+// listEcsTaskDefinitionCandidates lists the revisions with the given status whose family matches the config, and
+// records the latest revision of every family in latestRevisions
+func listEcsTaskDefinitionCandidates(svc ecsiface.ECSAPI, status string, latestRevisions map[string]int, configObj config.Config) ([]*string, error) {
+	var candidateArns []*string
+	err := svc.ListTaskDefinitionsPages(&ecs.ListTaskDefinitionsInput{
+		Status: aws.String(status),
+	}, func(page *ecs.ListTaskDefinitionsOutput, lastPage bool) bool {
+		for _, taskDefinitionArn := range page.TaskDefinitionArns {
+			family, revision := getEcsTaskDefinitionFamilyAndRevision(aws.StringValue(taskDefinitionArn))
+			if revision > latestRevisions[family] {
+				latestRevisions[family] = revision
+			}
+
+			// The family is part of the ARN, so apply the name based config filter before describing the revision
+			if config.ShouldInclude(
+				family,
+				configObj.ECSTaskDefinition.IncludeRule.NamesRegExp,
+				configObj.ECSTaskDefinition.ExcludeRule.NamesRegExp,
+			) {
+				candidateArns = append(candidateArns, taskDefinitionArn)
+			}
+		}
+		return !lastPage
+	})
+	return candidateArns, errors.WithStackTrace(err)
+}
+
+// filterEcsTaskDefinitions leaves out the latest revisions, the revisions in use, the revisions registered after
+// excludeAfter and the ones tagged for exclusion
+func filterEcsTaskDefinitions(svc ecsiface.ECSAPI, candidateArns []*string, latestRevisions map[string]int, inUse map[string]bool, excludeAfter time.Time) ([]*string, error) {
+	var taskDefinitionArns []*string
+	for _, taskDefinitionArn := range candidateArns {
+		family, revision := getEcsTaskDefinitionFamilyAndRevision(aws.StringValue(taskDefinitionArn))
+		if revision == latestRevisions[family] || inUse[aws.StringValue(taskDefinitionArn)] {
+			continue
+		}
+
+		result, err := svc.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+			TaskDefinition: taskDefinitionArn,
+			Include:        aws.StringSlice([]string{ecs.TaskDefinitionFieldTags}),
+		})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		registeredAt := result.TaskDefinition.RegisteredAt
+		if registeredAt != nil && excludeAfter.Before(*registeredAt) {
+			continue
+		}
+		if hasEcsTaskDefinitionExcludeTag(result.Tags) {
+			continue
+		}
+		taskDefinitionArns = append(taskDefinitionArns, taskDefinitionArn)
+	}
+
+	return taskDefinitionArns, nil
+}
+
+func hasEcsTaskDefinitionExcludeTag(tags []*ecs.Tag) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// getEcsTaskDefinitionsInUse returns the ARNs of the task definition revisions the services of all clusters run,
+// including the ones of deployments that are still in progress.
+func getEcsTaskDefinitionsInUse(svc ecsiface.ECSAPI) (map[string]bool, error) {
+	var clusterArns []*string
+	err := svc.ListClustersPages(&ecs.ListClustersInput{}, func(page *ecs.ListClustersOutput, lastPage bool) bool {
+		clusterArns = append(clusterArns, page.ClusterArns...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	inUse := map[string]bool{}
+	for _, clusterArn := range clusterArns {
+		serviceArns, err := listEcsClusterServices(svc, clusterArn)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, batch := range split(aws.StringValueSlice(serviceArns), ecsDescribeServicesBatchLimit) {
+			output, err := svc.DescribeServices(&ecs.DescribeServicesInput{
+				Cluster:  clusterArn,
+				Services: aws.StringSlice(batch),
+			})
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
+			for _, service := range output.Services {
+				inUse[aws.StringValue(service.TaskDefinition)] = true
+				for _, deployment := range service.Deployments {
+					inUse[aws.StringValue(deployment.TaskDefinition)] = true
+				}
+			}
+		}
+	}
+	return inUse, nil
+}
+
+// getEcsTaskDefinitionFamilyAndRevision extracts the family and the revision from a task definition ARN, which has the
+// form arn:aws:ecs:<region>:<account>:task-definition/<family>:<revision>. The revision is 0 if the ARN doesn't hold one.
+func getEcsTaskDefinitionFamilyAndRevision(taskDefinitionArn string) (string, int) {
+	familyAndRevision := taskDefinitionArn[strings.LastIndex(taskDefinitionArn, "/")+1:]
+	idx := strings.LastIndex(familyAndRevision, ":")
+	if idx == -1 {
+		return familyAndRevision, 0
+	}
+	revision, err := strconv.Atoi(familyAndRevision[idx+1:])
+	if err != nil {
+		return familyAndRevision[:idx], 0
+	}
+	return familyAndRevision[:idx], revision
+}
+
+// nukeAllEcsTaskDefinitions deregisters the given task definition revisions. Deregistered revisions become INACTIVE,
+// which means they can no longer be used to run new tasks or services. When deleteRevisions is set, the deregistered
+// revisions and the given inactive ones are deleted afterwards.
+func nukeAllEcsTaskDefinitions(awsSession *session.Session, taskDefinitionArns []*string, inactiveArns map[string]bool, deleteRevisions bool) error {
+	svc := ecs.New(awsSession)
+
+	if len(taskDefinitionArns) == 0 {
+		logging.Logger.Debugf("No ECS task definitions to nuke in region %s", *awsSession.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deregistering all ECS task definitions in region %s", *awsSession.Config.Region)
+	errs := map[string]error{}
+	var deregisteredArns []*string
+	for _, taskDefinitionArn := range taskDefinitionArns {
+		if inactiveArns[aws.StringValue(taskDefinitionArn)] {
+			deregisteredArns = append(deregisteredArns, taskDefinitionArn)
+			continue
+		}
+
+		_, err := svc.DeregisterTaskDefinition(&ecs.DeregisterTaskDefinitionInput{
+			TaskDefinition: taskDefinitionArn,
+		})
+		if err != nil {
+			errs[aws.StringValue(taskDefinitionArn)] = errors.WithStackTrace(err)
+		} else {
+			deregisteredArns = append(deregisteredArns, taskDefinitionArn)
+			logging.Logger.Debugf("Deregistered ECS task definition: %s", aws.StringValue(taskDefinitionArn))
+		}
+	}
+
+	if deleteRevisions {
+		for arn, err := range deleteEcsTaskDefinitions(svc, deregisteredArns) {
+			errs[arn] = err
+		}
+	}
+
+	var nukedCount int
+	var allErrs *multierror.Error
+	for _, taskDefinitionArn := range taskDefinitionArns {
+		err := errs[aws.StringValue(taskDefinitionArn)]
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(taskDefinitionArn),
+			ResourceType: "ECS Task Definition",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking ECS Task Definition",
+			}, map[string]interface{}{
+				"region": *awsSession.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			nukedCount++
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d ECS task definition(s) nuked in %s", nukedCount, *awsSession.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// deleteEcsTaskDefinitions deletes the given inactive task definition revisions, and returns the errors of the ones
+// that couldn't be deleted keyed by ARN. The revisions are only fully deleted once no task uses them anymore.
+func deleteEcsTaskDefinitions(svc ecsiface.ECSAPI, taskDefinitionArns []*string) map[string]error {
+	errs := map[string]error{}
+	for _, batch := range split(aws.StringValueSlice(taskDefinitionArns), ecsDeleteTaskDefinitionsBatchLimit) {
+		output, err := svc.DeleteTaskDefinitions(&ecs.DeleteTaskDefinitionsInput{
+			TaskDefinitions: aws.StringSlice(batch),
+		})
+		if err != nil {
+			for _, arn := range batch {
+				errs[arn] = errors.WithStackTrace(err)
+			}
+			continue
+		}
+
+		// The call succeeds even when some of the revisions couldn't be deleted
+		for _, failure := range output.Failures {
+			errs[aws.StringValue(failure.Arn)] = EcsTaskDefinitionDeleteError{
+				arn:    aws.StringValue(failure.Arn),
+				reason: aws.StringValue(failure.Reason),
+				detail: aws.StringValue(failure.Detail),
+			}
+		}
+		for _, taskDefinition := range output.TaskDefinitions {
+			logging.Logger.Debugf("Deleted ECS task definition: %s", aws.StringValue(taskDefinition.TaskDefinitionArn))
+		}
+	}
+	return errs
+}
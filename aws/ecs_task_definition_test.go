@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedEcsTaskDefinitions serves a fixed set of task definition revisions keyed by ARN, and a single cluster whose
+// service runs ServiceTaskDefinition
+type mockedEcsTaskDefinitions struct {
+	ecsiface.ECSAPI
+	RegisteredAt          map[string]time.Time
+	Tags                  map[string][]*ecs.Tag
+	ServiceTaskDefinition string
+	Inactive              map[string]bool
+	Described             []string
+	Deleted               []string
+}
+
+func (m *mockedEcsTaskDefinitions) ListClustersPages(input *ecs.ListClustersInput, fn func(*ecs.ListClustersOutput, bool) bool) error {
+	fn(&ecs.ListClustersOutput{ClusterArns: aws.StringSlice([]string{"cluster"})}, true)
+	return nil
+}
+
+func (m *mockedEcsTaskDefinitions) ListServicesPages(input *ecs.ListServicesInput, fn func(*ecs.ListServicesOutput, bool) bool) error {
+	fn(&ecs.ListServicesOutput{ServiceArns: aws.StringSlice([]string{"service"})}, true)
+	return nil
+}
+
+func (m *mockedEcsTaskDefinitions) DescribeServices(input *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error) {
+	return &ecs.DescribeServicesOutput{Services: []*ecs.Service{{
+		ServiceArn:  aws.String("service"),
+		Deployments: []*ecs.Deployment{{TaskDefinition: aws.String(m.ServiceTaskDefinition)}},
+	}}}, nil
+}
+
+func (m *mockedEcsTaskDefinitions) ListTaskDefinitionsPages(input *ecs.ListTaskDefinitionsInput, fn func(*ecs.ListTaskDefinitionsOutput, bool) bool) error {
+	output := &ecs.ListTaskDefinitionsOutput{}
+	inactive := aws.StringValue(input.Status) == ecs.TaskDefinitionStatusInactive
+	for arn := range m.RegisteredAt {
+		if m.Inactive[arn] == inactive {
+			output.TaskDefinitionArns = append(output.TaskDefinitionArns, aws.String(arn))
+		}
+	}
+	fn(output, true)
+	return nil
+}
+
+func (m *mockedEcsTaskDefinitions) DescribeTaskDefinition(input *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error) {
+	arn := aws.StringValue(input.TaskDefinition)
+	m.Described = append(m.Described, arn)
+	return &ecs.DescribeTaskDefinitionOutput{
+		TaskDefinition: &ecs.TaskDefinition{TaskDefinitionArn: input.TaskDefinition, RegisteredAt: aws.Time(m.RegisteredAt[arn])},
+		Tags:           m.Tags[arn],
+	}, nil
+}
+
+func (m *mockedEcsTaskDefinitions) DeleteTaskDefinitions(input *ecs.DeleteTaskDefinitionsInput) (*ecs.DeleteTaskDefinitionsOutput, error) {
+	output := &ecs.DeleteTaskDefinitionsOutput{}
+	for _, arn := range input.TaskDefinitions {
+		if !m.Inactive[aws.StringValue(arn)] {
+			output.Failures = append(output.Failures, &ecs.Failure{Arn: arn, Reason: aws.String("TASK_DEFINITION_NOT_INACTIVE")})
+			continue
+		}
+		m.Deleted = append(m.Deleted, aws.StringValue(arn))
+		output.TaskDefinitions = append(output.TaskDefinitions, &ecs.TaskDefinition{TaskDefinitionArn: arn})
+	}
+	return output, nil
+}
+
+func TestListEcsTaskDefinitions(t *testing.T) {
+	t.Parallel()
+
+	oldArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/cloud-nuke-test:1"
+	usedArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/cloud-nuke-test:2"
+	latestArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/cloud-nuke-test:10"
+	recentArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/recent:1"
+	recentLatestArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/recent:2"
+	taggedArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/tagged:1"
+	taggedLatestArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/tagged:2"
+	keptArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/keep-me:1"
+	keptLatestArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/keep-me:2"
+	old := time.Now().Add(-48 * time.Hour)
+	mock := &mockedEcsTaskDefinitions{
+		RegisteredAt: map[string]time.Time{
+			oldArn:          old,
+			usedArn:         old,
+			latestArn:       old,
+			recentArn:       time.Now(),
+			recentLatestArn: time.Now(),
+			taggedArn:       old,
+			taggedLatestArn: old,
+			keptArn:         old,
+			keptLatestArn:   old,
+		},
+		Tags: map[string][]*ecs.Tag{
+			taggedArn: {{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}},
+		},
+		ServiceTaskDefinition: usedArn,
+	}
+	excludeConfig := config.Config{
+		ECSTaskDefinition: config.ECSTaskDefinitionResourceType{ResourceType: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^keep-.*")},
+				},
+			},
+		}},
+	}
+
+	arns, inactiveArns, err := listEcsTaskDefinitions(mock, time.Now().Add(-24*time.Hour), excludeConfig)
+	require.NoError(t, err)
+	assert.Equal(t, []string{oldArn}, aws.StringValueSlice(arns))
+	assert.Empty(t, inactiveArns)
+
+	// Excluded families, the latest revisions and the revisions in use are filtered out before being described
+	assert.ElementsMatch(t, []string{oldArn, recentArn, taggedArn}, mock.Described)
+}
+
+func TestListEcsTaskDefinitionsWithInactiveRevisions(t *testing.T) {
+	t.Parallel()
+
+	inactiveArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/cloud-nuke-test:1"
+	recentInactiveArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/cloud-nuke-test:2"
+	oldArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/cloud-nuke-test:3"
+	latestArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/cloud-nuke-test:4"
+	old := time.Now().Add(-48 * time.Hour)
+	mock := &mockedEcsTaskDefinitions{
+		RegisteredAt: map[string]time.Time{
+			inactiveArn:       old,
+			recentInactiveArn: time.Now(),
+			oldArn:            old,
+			latestArn:         old,
+		},
+		Inactive: map[string]bool{inactiveArn: true, recentInactiveArn: true},
+	}
+	deleteConfig := config.Config{ECSTaskDefinition: config.ECSTaskDefinitionResourceType{DeleteRevisions: true}}
+
+	arns, inactiveArns, err := listEcsTaskDefinitions(mock, time.Now().Add(-24*time.Hour), deleteConfig)
+	require.NoError(t, err)
+	assert.Equal(t, []string{oldArn}, aws.StringValueSlice(arns))
+	assert.Equal(t, []string{inactiveArn}, aws.StringValueSlice(inactiveArns))
+}
+
+func TestDeleteEcsTaskDefinitions(t *testing.T) {
+	t.Parallel()
+
+	inactiveArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/cloud-nuke-test:1"
+	activeArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/cloud-nuke-test:2"
+	mock := &mockedEcsTaskDefinitions{Inactive: map[string]bool{inactiveArn: true}}
+
+	// Revisions that DeleteTaskDefinitions reports as failed are returned as errors
+	errs := deleteEcsTaskDefinitions(mock, aws.StringSlice([]string{inactiveArn, activeArn}))
+	assert.Equal(t, []string{inactiveArn}, mock.Deleted)
+	assert.Equal(t, map[string]error{
+		activeArn: EcsTaskDefinitionDeleteError{arn: activeArn, reason: "TASK_DEFINITION_NOT_INACTIVE"},
+	}, errs)
+}
+
+func TestGetEcsTaskDefinitionFamilyAndRevision(t *testing.T) {
+	family, revision := getEcsTaskDefinitionFamilyAndRevision("arn:aws:ecs:us-east-1:123456789012:task-definition/cloud-nuke-test:12")
+	assert.Equal(t, "cloud-nuke-test", family)
+	assert.Equal(t, 12, revision)
+
+	family, revision = getEcsTaskDefinitionFamilyAndRevision("cloud-nuke-test")
+	assert.Equal(t, "cloud-nuke-test", family)
+	assert.Equal(t, 0, revision)
+}
@@ -0,0 +1,56 @@
+package aws
+
+import (
+	"fmt"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// ECSTaskDefinitions - Represents all the ECS task definition revisions to nuke in a region
+type ECSTaskDefinitions struct {
+	TaskDefinitionArns []string
+	// InactiveTaskDefinitionArns are the already deregistered revisions among TaskDefinitionArns
+	InactiveTaskDefinitionArns []string
+	// DeleteRevisions deletes the revisions once they are deregistered
+	DeleteRevisions bool
+}
+
+// ResourceName - The simple name of the aws resource
+func (taskDefinitions ECSTaskDefinitions) ResourceName() string {
+	return "ecs-task-definition"
+}
+
+// ResourceIdentifiers - The ARNs of the task definition revisions
+func (taskDefinitions ECSTaskDefinitions) ResourceIdentifiers() []string {
+	return taskDefinitions.TaskDefinitionArns
+}
+
+func (taskDefinitions ECSTaskDefinitions) MaxBatchSize() int {
+	return maxBatchSize
+}
+
+// Nuke - deregister all the ECS task definition revisions, and optionally delete them
+func (taskDefinitions ECSTaskDefinitions) Nuke(awsSession *session.Session, identifiers []string) error {
+	inactiveArns := map[string]bool{}
+	for _, arn := range taskDefinitions.InactiveTaskDefinitionArns {
+		inactiveArns[arn] = true
+	}
+
+	if err := nukeAllEcsTaskDefinitions(awsSession, awsgo.StringSlice(identifiers), inactiveArns, taskDefinitions.DeleteRevisions); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
+
+// EcsTaskDefinitionDeleteError is returned for the revisions DeleteTaskDefinitions reports as failed
+type EcsTaskDefinitionDeleteError struct {
+	arn    string
+	reason string
+	detail string
+}
+
+func (err EcsTaskDefinitionDeleteError) Error() string {
+	return fmt.Sprintf("Unable to delete ECS task definition %s: %s %s", err.arn, err.reason, err.detail)
+}
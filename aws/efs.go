@@ -26,15 +26,18 @@ func getAllElasticFileSystems(session *session.Session, excludeAfter time.Time,
 	}
 	svc := efs.NewFromConfig(cfg)
 
-	result, err := svc.DescribeFileSystems(context.TODO(), &efs.DescribeFileSystemsInput{})
-	if err != nil {
-		return []*string{}, errors.WithStackTrace(err)
-	}
-
 	allEfs := []*string{}
-	for _, fileSystem := range result.FileSystems {
-		if shouldIncludeElasticFileSystem(&fileSystem, excludeAfter, configObj) {
-			allEfs = append(allEfs, fileSystem.FileSystemId)
+	paginator := efs.NewDescribeFileSystemsPaginator(svc, &efs.DescribeFileSystemsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return []*string{}, errors.WithStackTrace(err)
+		}
+
+		for _, fileSystem := range page.FileSystems {
+			if shouldIncludeElasticFileSystem(&fileSystem, excludeAfter, configObj) {
+				allEfs = append(allEfs, fileSystem.FileSystemId)
+			}
 		}
 	}
 	return allEfs, nil
@@ -45,6 +48,17 @@ func shouldIncludeElasticFileSystem(efsDescription *types.FileSystemDescription,
 		return false
 	}
 
+	// File systems that are already being deleted will be gone shortly
+	if efsDescription.LifeCycleState == types.LifeCycleStateDeleting || efsDescription.LifeCycleState == types.LifeCycleStateDeleted {
+		return false
+	}
+
+	for _, tag := range efsDescription.Tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return false
+		}
+	}
+
 	if efsDescription.CreationTime != nil {
 		if excludeAfter.Before(aws.TimeValue(efsDescription.CreationTime)) {
 			return false
@@ -124,6 +138,12 @@ func deleteElasticFileSystemAsync(wg *sync.WaitGroup, errChan chan error, svc *e
 	out, err := svc.DescribeAccessPoints(context.TODO(), accessPointParam)
 	if err != nil {
 		allErrs = multierror.Append(allErrs, err)
+		report.Record(report.Entry{
+			Identifier:   aws.StringValue(efsID),
+			ResourceType: "Elastic FileSystem (EFS)",
+			Error:        err,
+		})
+		return
 	}
 
 	for _, ap := range out.AccessPoints {
@@ -168,7 +188,13 @@ func deleteElasticFileSystemAsync(wg *sync.WaitGroup, errChan chan error, svc *e
 
 		mountTargetsOutput, describeMountsErr := svc.DescribeMountTargets(context.TODO(), mountTargetParam)
 		if describeMountsErr != nil {
-			allErrs = multierror.Append(allErrs, err)
+			allErrs = multierror.Append(allErrs, describeMountsErr)
+			report.Record(report.Entry{
+				Identifier:   aws.StringValue(efsID),
+				ResourceType: "Elastic FileSystem (EFS)",
+				Error:        describeMountsErr,
+			})
+			return
 		}
 
 		for _, mountTarget := range mountTargetsOutput.MountTargets {
@@ -199,8 +225,16 @@ func deleteElasticFileSystemAsync(wg *sync.WaitGroup, errChan chan error, svc *e
 		}
 	}
 
-	logging.Logger.Debug("Sleeping 20 seconds to allow AWS to realize the Elastic FileSystem is no longer in use...")
-	time.Sleep(20 * time.Second)
+	// Mount targets are deleted asynchronously, and the Elastic FileSystem remains in use until all of them are gone
+	if err := waitForElasticFileSystemMountTargetsToBeDeleted(svc, efsID); err != nil {
+		allErrs = multierror.Append(allErrs, err)
+		report.Record(report.Entry{
+			Identifier:   aws.StringValue(efsID),
+			ResourceType: "Elastic FileSystem (EFS)",
+			Error:        err,
+		})
+		return
+	}
 
 	// Now we can attempt to delete the Elastic FileSystem itself
 	deleteEfsParam := &efs.DeleteFileSystemInput{
@@ -212,7 +246,7 @@ func deleteElasticFileSystemAsync(wg *sync.WaitGroup, errChan chan error, svc *e
 	e := report.Entry{
 		Identifier:   aws.StringValue(efsID),
 		ResourceType: "Elastic FileSystem (EFS)",
-		Error:        err,
+		Error:        deleteErr,
 	}
 	report.Record(e)
 
@@ -220,9 +254,29 @@ func deleteElasticFileSystemAsync(wg *sync.WaitGroup, errChan chan error, svc *e
 		allErrs = multierror.Append(allErrs, deleteErr)
 	}
 
-	if err == nil {
+	if deleteErr == nil {
 		logging.Logger.Debugf("[OK] Elastic FileSystem (efs) %s deleted in %s", aws.StringValue(efsID), region)
 	} else {
 		logging.Logger.Debugf("[Failed] Error deleting Elastic FileSystem (efs) %s in %s", aws.StringValue(efsID), region)
 	}
 }
+
+// waitForElasticFileSystemMountTargetsToBeDeleted waits until the Elastic FileSystem has no mount targets left.
+func waitForElasticFileSystemMountTargetsToBeDeleted(svc *efs.Client, efsID *string) error {
+	for i := 0; i < 30; i++ {
+		output, err := svc.DescribeMountTargets(context.TODO(), &efs.DescribeMountTargetsInput{
+			FileSystemId: efsID,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		if len(output.MountTargets) == 0 {
+			return nil
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for mount targets of Elastic FileSystem (%s) to be deleted...", aws.StringValue(efsID))
+	}
+
+	return ElasticFileSystemMountTargetsDeleteTimeoutError{efsID: aws.StringValue(efsID)}
+}
@@ -8,6 +8,7 @@ import (
 
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/aws/aws-sdk-go-v2/service/efs/types"
 	"github.com/aws/aws-sdk-go/aws"
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -161,3 +162,22 @@ func TestNukeElasticFileSystemsMoreThanOne(t *testing.T) {
 	assert.NotContains(t, aws.StringValueSlice(efsIds), aws.StringValue(testEFS.ID))
 	assert.NotContains(t, aws.StringValueSlice(efsIds), aws.StringValue(testEFS2.ID))
 }
+
+func TestShouldIncludeElasticFileSystem(t *testing.T) {
+	now := time.Now()
+	fileSystem := func(state types.LifeCycleState, tags ...types.Tag) *types.FileSystemDescription {
+		return &types.FileSystemDescription{
+			Name:           awsgo.String("cloud-nuke-test"),
+			CreationTime:   awsgo.Time(now),
+			LifeCycleState: state,
+			Tags:           tags,
+		}
+	}
+	excludeTag := types.Tag{Key: awsgo.String(AwsResourceExclusionTagKey), Value: awsgo.String("true")}
+
+	assert.True(t, shouldIncludeElasticFileSystem(fileSystem(types.LifeCycleStateAvailable), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeElasticFileSystem(fileSystem(types.LifeCycleStateAvailable, excludeTag), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeElasticFileSystem(fileSystem(types.LifeCycleStateDeleting), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeElasticFileSystem(fileSystem(types.LifeCycleStateAvailable), now.Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeElasticFileSystem(nil, now, config.Config{}))
+}
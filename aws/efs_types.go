@@ -36,3 +36,11 @@ type TooManyElasticFileSystemsErr struct{}
 func (err TooManyElasticFileSystemsErr) Error() string {
 	return "Too many Elastic FileSystems requested at once."
 }
+
+type ElasticFileSystemMountTargetsDeleteTimeoutError struct {
+	efsID string
+}
+
+func (err ElasticFileSystemMountTargetsDeleteTimeoutError) Error() string {
+	return "Timed out waiting for the mount targets of Elastic FileSystem " + err.efsID + " to be deleted."
+}
@@ -106,6 +106,12 @@ func shouldIncludeAllocationId(address *ec2.Address, excludeAfter time.Time, fir
 		return false
 	}
 
+	// Only unassociated addresses are released, so that EIPs still in use by an instance or network interface that
+	// isn't being nuked are left alone
+	if address.AssociationId != nil {
+		return false
+	}
+
 	// If Name is unset, GetEC2ResourceNameTagValue returns error and zero value string
 	// Ignore this error and pass empty string to config.ShouldInclude
 	allocationName, _ := GetEC2ResourceNameTagValue(address.Tags)
@@ -223,6 +223,12 @@ func TestShouldIncludeElasticIP(t *testing.T) {
 		},
 	}
 
+	mockAssociatedAddress := &ec2.Address{
+		AssociationId: awsgo.String("eipassoc-12345678"),
+		InstanceId:    awsgo.String("i-12345678"),
+		Tags:          mockAddress.Tags,
+	}
+
 	mockExpression, err := regexp.Compile("^cloud-nuke-*")
 	if err != nil {
 		logging.Logger.Fatalf("There was an error compiling regex expression %v", err)
@@ -284,6 +290,14 @@ func TestShouldIncludeElasticIP(t *testing.T) {
 			FirstSeenTime: time.Now(),
 			Expected:      false,
 		},
+		{
+			Name:          "Associated",
+			Address:       mockAssociatedAddress,
+			Config:        config.Config{},
+			ExcludeAfter:  time.Now().Add(1 * time.Hour),
+			FirstSeenTime: time.Now(),
+			Expected:      false,
+		},
 	}
 
 	for _, c := range cases {
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
@@ -19,11 +20,15 @@ import (
 // getAllEksClusters returns a list of strings of EKS Cluster Names that uniquely identify each cluster.
 func getAllEksClusters(awsSession *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
 	svc := eks.New(awsSession)
-	result, err := svc.ListClusters(&eks.ListClustersInput{})
+	var clusterNames []*string
+	err := svc.ListClustersPages(&eks.ListClustersInput{}, func(page *eks.ListClustersOutput, lastPage bool) bool {
+		clusterNames = append(clusterNames, page.Clusters...)
+		return !lastPage
+	})
 	if err != nil {
 		return nil, errors.WithStackTrace(err)
 	}
-	filteredClusters, err := filterOutEksClusters(svc, result.Clusters, excludeAfter, configObj)
+	filteredClusters, err := filterOutEksClusters(svc, clusterNames, excludeAfter, configObj)
 	if err != nil {
 		return nil, errors.WithStackTrace(err)
 	}
@@ -43,7 +48,7 @@ func hasClusterExcludeTag(cluster *eks.Cluster) bool {
 
 // filterOutEksClusters will take in the list of clusters and filter out any clusters that were created after
 // `excludeAfter`, and those that are excluded by the config file.
-func filterOutEksClusters(svc *eks.EKS, clusterNames []*string, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+func filterOutEksClusters(svc eksiface.EKSAPI, clusterNames []*string, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
 	var filteredEksClusterNames []*string
 	for _, clusterName := range clusterNames {
 		// Since we already have the name here, avoid an extra API call by applying the name based config filter first.
@@ -76,7 +81,7 @@ func filterOutEksClusters(svc *eks.EKS, clusterNames []*string, excludeAfter tim
 // deleteEKSClusterAsync deletes the provided EKS Cluster asynchronously in a goroutine, using wait groups for
 // concurrency control and a return channel for errors. Note that this routine attempts to delete all managed compute
 // resources associated with the EKS cluster (Managed Node Groups and Fargate Profiles).
-func deleteEKSClusterAsync(wg *sync.WaitGroup, errChan chan error, svc *eks.EKS, eksClusterName string) {
+func deleteEKSClusterAsync(wg *sync.WaitGroup, errChan chan error, svc eksiface.EKSAPI, eksClusterName string) {
 	defer wg.Done()
 
 	// Aggregate errors for each subresource being deleted
@@ -124,7 +129,7 @@ func deleteEKSClusterAsync(wg *sync.WaitGroup, errChan chan error, svc *eks.EKS,
 // scheduleDeleteEKSClusterManagedNodeGroup looks up all the associated Managed Node Group resources on the EKS cluster
 // and requests each one to be deleted. Note that this function will not wait for the Node Groups to be deleted. This
 // will return the list of Node Groups that were successfully scheduled for deletion.
-func scheduleDeleteEKSClusterManagedNodeGroup(svc *eks.EKS, eksClusterName string) ([]*string, error) {
+func scheduleDeleteEKSClusterManagedNodeGroup(svc eksiface.EKSAPI, eksClusterName string) ([]*string, error) {
 	allNodeGroups := []*string{}
 	err := svc.ListNodegroupsPages(
 		&eks.ListNodegroupsInput{ClusterName: aws.String(eksClusterName)},
@@ -161,7 +166,7 @@ func scheduleDeleteEKSClusterManagedNodeGroup(svc *eks.EKS, eksClusterName strin
 // deleteEKSClusterFargateProfiles looks up all the associated Fargate Profile resources on the EKS cluster and requests
 // each one to be deleted. Since only one Fargate Profile can be deleted at a time, this function will wait until the
 // Fargate Profile is actually deleted for each one before moving on to the next one.
-func deleteEKSClusterFargateProfiles(svc *eks.EKS, eksClusterName string) error {
+func deleteEKSClusterFargateProfiles(svc eksiface.EKSAPI, eksClusterName string) error {
 	allFargateProfiles := []*string{}
 	err := svc.ListFargateProfilesPages(
 		&eks.ListFargateProfilesInput{ClusterName: aws.String(eksClusterName)},
@@ -208,7 +213,7 @@ func deleteEKSClusterFargateProfiles(svc *eks.EKS, eksClusterName string) error
 
 // waitUntilEksClustersDeleted waits until the EKS cluster has been actually deleted from AWS. Returns a list of EKS
 // cluster names that have been successfully deleted.
-func waitUntilEksClustersDeleted(svc *eks.EKS, eksClusterNames []*string) []*string {
+func waitUntilEksClustersDeleted(svc eksiface.EKSAPI, eksClusterNames []*string) []*string {
 	var successfullyDeleted []*string
 	for _, eksClusterName := range eksClusterNames {
 		err := svc.WaitUntilClusterDeleted(&eks.DescribeClusterInput{Name: eksClusterName})
@@ -262,9 +267,11 @@ func nukeAllEksClusters(awsSession *session.Session, eksClusterNames []*string)
 	}
 	wg.Wait()
 
-	// Collect all the errors from the async delete calls into a single error struct.
+	// Collect all the errors from the async delete calls into a single error struct. Clusters that failed to be deleted
+	// are recorded here, as they won't be waited on below.
 	var allErrs *multierror.Error
-	for _, errChan := range errChans {
+	var requestedDeletion []*string
+	for i, errChan := range errChans {
 		if err := <-errChan; err != nil {
 			allErrs = multierror.Append(allErrs, err)
 			logging.Logger.Debugf("[Failed] %s", err)
@@ -273,18 +280,21 @@ func nukeAllEksClusters(awsSession *session.Session, eksClusterNames []*string)
 			}, map[string]interface{}{
 				"region": *awsSession.Config.Region,
 			})
+			report.Record(report.Entry{
+				Identifier:   aws.StringValue(eksClusterNames[i]),
+				ResourceType: "EKS Cluster",
+				Error:        err,
+			})
+		} else {
+			requestedDeletion = append(requestedDeletion, eksClusterNames[i])
 		}
 	}
-	finalErr := allErrs.ErrorOrNil()
-	if finalErr != nil {
-		return errors.WithStackTrace(finalErr)
-	}
 
 	// Now wait until the EKS Clusters are deleted
-	successfullyDeleted := waitUntilEksClustersDeleted(svc, eksClusterNames)
+	successfullyDeleted := waitUntilEksClustersDeleted(svc, requestedDeletion)
 	numNuked := len(successfullyDeleted)
 	logging.Logger.Debugf("[OK] %d of %d EKS cluster(s) deleted in %s", numNuked, numNuking, *awsSession.Config.Region)
-	return nil
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
 }
 
 // Custom errors
@@ -8,6 +8,8 @@ import (
 
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/gruntwork-io/terratest/modules/logger"
@@ -135,3 +137,67 @@ func TestNukeEksClustersWithCompute(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotContains(t, awsgo.StringValueSlice(eksClusterNames), *cluster.Name)
 }
+
+// mockedEKSTeardown records the order of the calls made while tearing down a cluster
+type mockedEKSTeardown struct {
+	eksiface.EKSAPI
+	Calls []string
+}
+
+func (m *mockedEKSTeardown) ListNodegroupsPages(input *eks.ListNodegroupsInput, fn func(*eks.ListNodegroupsOutput, bool) bool) error {
+	fn(&eks.ListNodegroupsOutput{Nodegroups: awsgo.StringSlice([]string{"ng-1", "ng-2"})}, true)
+	return nil
+}
+
+func (m *mockedEKSTeardown) DeleteNodegroup(input *eks.DeleteNodegroupInput) (*eks.DeleteNodegroupOutput, error) {
+	m.Calls = append(m.Calls, "DeleteNodegroup "+awsgo.StringValue(input.NodegroupName))
+	return &eks.DeleteNodegroupOutput{}, nil
+}
+
+func (m *mockedEKSTeardown) WaitUntilNodegroupDeleted(input *eks.DescribeNodegroupInput) error {
+	m.Calls = append(m.Calls, "WaitUntilNodegroupDeleted "+awsgo.StringValue(input.NodegroupName))
+	return nil
+}
+
+func (m *mockedEKSTeardown) ListFargateProfilesPages(input *eks.ListFargateProfilesInput, fn func(*eks.ListFargateProfilesOutput, bool) bool) error {
+	fn(&eks.ListFargateProfilesOutput{FargateProfileNames: awsgo.StringSlice([]string{"fp-1"})}, true)
+	return nil
+}
+
+func (m *mockedEKSTeardown) DeleteFargateProfile(input *eks.DeleteFargateProfileInput) (*eks.DeleteFargateProfileOutput, error) {
+	m.Calls = append(m.Calls, "DeleteFargateProfile "+awsgo.StringValue(input.FargateProfileName))
+	return &eks.DeleteFargateProfileOutput{}, nil
+}
+
+func (m *mockedEKSTeardown) WaitUntilFargateProfileDeleted(input *eks.DescribeFargateProfileInput) error {
+	m.Calls = append(m.Calls, "WaitUntilFargateProfileDeleted "+awsgo.StringValue(input.FargateProfileName))
+	return nil
+}
+
+func (m *mockedEKSTeardown) DeleteCluster(input *eks.DeleteClusterInput) (*eks.DeleteClusterOutput, error) {
+	m.Calls = append(m.Calls, "DeleteCluster "+awsgo.StringValue(input.Name))
+	return &eks.DeleteClusterOutput{}, nil
+}
+
+// Test that the node groups and Fargate profiles are deleted, and waited on, before the cluster itself
+func TestDeleteEKSClusterAsyncTearsDownComputeFirst(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedEKSTeardown{}
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
+	errChan := make(chan error, 1)
+	deleteEKSClusterAsync(wg, errChan, mock, "cloud-nuke-test")
+	wg.Wait()
+	require.NoError(t, <-errChan)
+
+	assert.Equal(t, []string{
+		"DeleteNodegroup ng-1",
+		"DeleteNodegroup ng-2",
+		"DeleteFargateProfile fp-1",
+		"WaitUntilFargateProfileDeleted fp-1",
+		"WaitUntilNodegroupDeleted ng-1",
+		"WaitUntilNodegroupDeleted ng-2",
+		"DeleteCluster cloud-nuke-test",
+	}, mock.Calls)
+}
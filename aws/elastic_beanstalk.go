@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk/elasticbeanstalkiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of Elastic Beanstalk application names
+func getAllElasticBeanstalkApplications(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := elasticbeanstalk.New(session)
+
+	result, err := svc.DescribeApplications(&elasticbeanstalk.DescribeApplicationsInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, application := range result.Applications {
+		if shouldIncludeElasticBeanstalkApplication(application, excludeAfter, configObj) {
+			names = append(names, application.ApplicationName)
+		}
+	}
+	return names, nil
+}
+
+func shouldIncludeElasticBeanstalkApplication(application *elasticbeanstalk.ApplicationDescription, excludeAfter time.Time, configObj config.Config) bool {
+	if application == nil {
+		return false
+	}
+
+	if application.DateCreated != nil && excludeAfter.Before(*application.DateCreated) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(application.ApplicationName),
+		configObj.ElasticBeanstalk.IncludeRule.NamesRegExp,
+		configObj.ElasticBeanstalk.ExcludeRule.NamesRegExp,
+	)
+}
+
+// listElasticBeanstalkEnvironments returns the environments of the application that haven't been terminated yet
+func listElasticBeanstalkEnvironments(svc elasticbeanstalkiface.ElasticBeanstalkAPI, applicationName *string) ([]*elasticbeanstalk.EnvironmentDescription, error) {
+	var environments []*elasticbeanstalk.EnvironmentDescription
+	input := &elasticbeanstalk.DescribeEnvironmentsInput{
+		ApplicationName: applicationName,
+		IncludeDeleted:  aws.Bool(false),
+	}
+	for {
+		result, err := svc.DescribeEnvironments(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for _, environment := range result.Environments {
+			if aws.StringValue(environment.Status) != elasticbeanstalk.EnvironmentStatusTerminated {
+				environments = append(environments, environment)
+			}
+		}
+		if result.NextToken == nil {
+			return environments, nil
+		}
+		input.NextToken = result.NextToken
+	}
+}
+
+// terminateElasticBeanstalkEnvironments terminates the environments of the application, along with the EC2 instances,
+// load balancers and other resources they run on, and waits for them to be terminated.
+func terminateElasticBeanstalkEnvironments(svc elasticbeanstalkiface.ElasticBeanstalkAPI, applicationName *string) error {
+	environments, err := listElasticBeanstalkEnvironments(svc, applicationName)
+	if err != nil {
+		return err
+	}
+	if len(environments) == 0 {
+		return nil
+	}
+
+	var environmentIds []*string
+	for _, environment := range environments {
+		environmentIds = append(environmentIds, environment.EnvironmentId)
+		if aws.StringValue(environment.Status) == elasticbeanstalk.EnvironmentStatusTerminating {
+			continue
+		}
+
+		_, err := svc.TerminateEnvironment(&elasticbeanstalk.TerminateEnvironmentInput{
+			EnvironmentId:      environment.EnvironmentId,
+			TerminateResources: aws.Bool(true),
+			ForceTerminate:     aws.Bool(true),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Terminating Elastic Beanstalk environment %s of application %s", aws.StringValue(environment.EnvironmentName), aws.StringValue(applicationName))
+	}
+
+	err = svc.WaitUntilEnvironmentTerminated(&elasticbeanstalk.DescribeEnvironmentsInput{
+		EnvironmentIds: environmentIds,
+	})
+	return errors.WithStackTrace(err)
+}
+
+// nukeElasticBeanstalkApplication terminates the environments of the application before deleting it, so they don't
+// keep running once their application is gone. Deleting the application also deletes its application versions and
+// saved configurations, though their source bundles are left in S3.
+func nukeElasticBeanstalkApplication(svc elasticbeanstalkiface.ElasticBeanstalkAPI, applicationName *string) error {
+	if err := terminateElasticBeanstalkEnvironments(svc, applicationName); err != nil {
+		return err
+	}
+
+	_, err := svc.DeleteApplication(&elasticbeanstalk.DeleteApplicationInput{
+		ApplicationName:     applicationName,
+		TerminateEnvByForce: aws.Bool(true),
+	})
+	return errors.WithStackTrace(err)
+}
+
+// Deletes all Elastic Beanstalk applications
+func nukeAllElasticBeanstalkApplications(session *session.Session, names []*string) error {
+	svc := elasticbeanstalk.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No Elastic Beanstalk applications to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Elastic Beanstalk applications in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		err := nukeElasticBeanstalkApplication(svc, name)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: "Elastic Beanstalk Application",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Elastic Beanstalk Application",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted Elastic Beanstalk application: %s", aws.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Elastic Beanstalk application(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk/elasticbeanstalkiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedElasticBeanstalk struct {
+	elasticbeanstalkiface.ElasticBeanstalkAPI
+	Environments []*elasticbeanstalk.EnvironmentDescription
+	Calls        []string
+}
+
+func (m *mockedElasticBeanstalk) DescribeEnvironments(input *elasticbeanstalk.DescribeEnvironmentsInput) (*elasticbeanstalk.EnvironmentDescriptionsMessage, error) {
+	return &elasticbeanstalk.EnvironmentDescriptionsMessage{Environments: m.Environments}, nil
+}
+
+func (m *mockedElasticBeanstalk) TerminateEnvironment(input *elasticbeanstalk.TerminateEnvironmentInput) (*elasticbeanstalk.EnvironmentDescription, error) {
+	m.Calls = append(m.Calls, "terminate:"+aws.StringValue(input.EnvironmentId))
+	return &elasticbeanstalk.EnvironmentDescription{}, nil
+}
+
+func (m *mockedElasticBeanstalk) WaitUntilEnvironmentTerminated(input *elasticbeanstalk.DescribeEnvironmentsInput) error {
+	m.Calls = append(m.Calls, "wait:"+strings.Join(aws.StringValueSlice(input.EnvironmentIds), ","))
+	return nil
+}
+
+func (m *mockedElasticBeanstalk) DeleteApplication(input *elasticbeanstalk.DeleteApplicationInput) (*elasticbeanstalk.DeleteApplicationOutput, error) {
+	m.Calls = append(m.Calls, "delete:"+aws.StringValue(input.ApplicationName))
+	return &elasticbeanstalk.DeleteApplicationOutput{}, nil
+}
+
+func TestNukeElasticBeanstalkApplicationTerminatesEnvironmentsFirst(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedElasticBeanstalk{
+		Environments: []*elasticbeanstalk.EnvironmentDescription{
+			{EnvironmentId: aws.String("e-ready"), Status: aws.String(elasticbeanstalk.EnvironmentStatusReady)},
+			{EnvironmentId: aws.String("e-terminating"), Status: aws.String(elasticbeanstalk.EnvironmentStatusTerminating)},
+		},
+	}
+	require.NoError(t, nukeElasticBeanstalkApplication(mock, aws.String("cloud-nuke-test")))
+	assert.Equal(t, []string{"terminate:e-ready", "wait:e-ready,e-terminating", "delete:cloud-nuke-test"}, mock.Calls)
+}
+
+func TestShouldIncludeElasticBeanstalkApplication(t *testing.T) {
+	now := time.Now()
+	application := &elasticbeanstalk.ApplicationDescription{ApplicationName: aws.String("cloud-nuke-test"), DateCreated: aws.Time(now)}
+
+	assert.True(t, shouldIncludeElasticBeanstalkApplication(application, now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeElasticBeanstalkApplication(application, now.Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeElasticBeanstalkApplication(nil, now, config.Config{}))
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// ElasticBeanstalkApplications - represents all Elastic Beanstalk applications
+type ElasticBeanstalkApplications struct {
+	ApplicationNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (applications ElasticBeanstalkApplications) ResourceName() string {
+	return "elastic-beanstalk"
+}
+
+// ResourceIdentifiers - The names of the Elastic Beanstalk applications
+func (applications ElasticBeanstalkApplications) ResourceIdentifiers() []string {
+	return applications.ApplicationNames
+}
+
+func (applications ElasticBeanstalkApplications) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (applications ElasticBeanstalkApplications) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllElasticBeanstalkApplications(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -84,6 +85,11 @@ func shouldIncludeElasticacheReplicationGroup(replicationGroup *elasticache.Repl
 	)
 }
 
+const (
+	elasticacheGlobalReplicationGroupRoleSecondary = "secondary"
+	elasticacheReplicationGroupStatusAvailable     = "available"
+)
+
 type CacheClusterType string
 
 const (
@@ -151,9 +157,131 @@ func nukeNonReplicationGroupElasticacheCluster(svc *elasticache.ElastiCache, clu
 	})
 }
 
+// getElasticacheGlobalReplicationGroupInfo returns the Global datastore membership of the replication group, or nil
+// if it isn't a member of one, along with the status of the replication group
+func getElasticacheGlobalReplicationGroupInfo(svc *elasticache.ElastiCache, replicationGroupId *string) (*elasticache.GlobalReplicationGroupInfo, string, error) {
+	output, err := svc.DescribeReplicationGroups(&elasticache.DescribeReplicationGroupsInput{ReplicationGroupId: replicationGroupId})
+	if err != nil {
+		return nil, "", errors.WithStackTrace(err)
+	}
+	if len(output.ReplicationGroups) == 0 {
+		return nil, "", nil
+	}
+
+	replicationGroup := output.ReplicationGroups[0]
+	info := replicationGroup.GlobalReplicationGroupInfo
+	if info != nil && info.GlobalReplicationGroupId == nil {
+		info = nil
+	}
+	return info, aws.StringValue(replicationGroup.Status), nil
+}
+
+// waitForElasticacheGlobalReplicationGroupMembers waits until the Global datastore is down to the given number of
+// members, as disassociating a secondary replication group happens asynchronously
+func waitForElasticacheGlobalReplicationGroupMembers(svc *elasticache.ElastiCache, globalReplicationGroupId *string, count int) error {
+	for i := 0; i < 60; i++ {
+		output, err := svc.DescribeGlobalReplicationGroups(&elasticache.DescribeGlobalReplicationGroupsInput{
+			GlobalReplicationGroupId: globalReplicationGroupId,
+			ShowMemberInfo:           aws.Bool(true),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		if len(output.GlobalReplicationGroups) == 0 || len(output.GlobalReplicationGroups[0].Members) <= count {
+			return nil
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for secondary replication groups to leave Global datastore %s", aws.StringValue(globalReplicationGroupId))
+	}
+
+	return ElasticacheGlobalReplicationGroupTimeoutError{id: aws.StringValue(globalReplicationGroupId)}
+}
+
+// leaveElasticacheGlobalReplicationGroup takes the replication group out of the Global datastore it's a member of, as
+// a member can't be deleted. A secondary is disassociated from the datastore, while for a primary the secondaries
+// are disassociated and the datastore itself is deleted, retaining the primary.
+func leaveElasticacheGlobalReplicationGroup(svc *elasticache.ElastiCache, replicationGroupId *string) error {
+	info, _, err := getElasticacheGlobalReplicationGroupInfo(svc, replicationGroupId)
+	if err != nil || info == nil {
+		return err
+	}
+
+	globalReplicationGroupId := info.GlobalReplicationGroupId
+	if strings.EqualFold(aws.StringValue(info.GlobalReplicationGroupMemberRole), elasticacheGlobalReplicationGroupRoleSecondary) {
+		_, err := svc.DisassociateGlobalReplicationGroup(&elasticache.DisassociateGlobalReplicationGroupInput{
+			GlobalReplicationGroupId: globalReplicationGroupId,
+			ReplicationGroupId:       replicationGroupId,
+			ReplicationGroupRegion:   svc.Config.Region,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	} else {
+		output, err := svc.DescribeGlobalReplicationGroups(&elasticache.DescribeGlobalReplicationGroupsInput{
+			GlobalReplicationGroupId: globalReplicationGroupId,
+			ShowMemberInfo:           aws.Bool(true),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		for _, globalReplicationGroup := range output.GlobalReplicationGroups {
+			for _, member := range globalReplicationGroup.Members {
+				if !strings.EqualFold(aws.StringValue(member.Role), elasticacheGlobalReplicationGroupRoleSecondary) {
+					continue
+				}
+
+				_, err := svc.DisassociateGlobalReplicationGroup(&elasticache.DisassociateGlobalReplicationGroupInput{
+					GlobalReplicationGroupId: globalReplicationGroupId,
+					ReplicationGroupId:       member.ReplicationGroupId,
+					ReplicationGroupRegion:   member.ReplicationGroupRegion,
+				})
+				if err != nil {
+					return errors.WithStackTrace(err)
+				}
+				logging.Logger.Debugf("Disassociated replication group %s in %s from Global datastore %s", aws.StringValue(member.ReplicationGroupId), aws.StringValue(member.ReplicationGroupRegion), aws.StringValue(globalReplicationGroupId))
+			}
+		}
+
+		if err := waitForElasticacheGlobalReplicationGroupMembers(svc, globalReplicationGroupId, 1); err != nil {
+			return err
+		}
+
+		_, err = svc.DeleteGlobalReplicationGroup(&elasticache.DeleteGlobalReplicationGroupInput{
+			GlobalReplicationGroupId:      globalReplicationGroupId,
+			RetainPrimaryReplicationGroup: aws.Bool(true),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	// The replication group can only be deleted once it's available again outside of the Global datastore
+	for i := 0; i < 90; i++ {
+		info, status, err := getElasticacheGlobalReplicationGroupInfo(svc, replicationGroupId)
+		if err != nil {
+			return err
+		}
+		if info == nil && status == elasticacheReplicationGroupStatusAvailable {
+			logging.Logger.Debugf("Replication group %s left Global datastore %s", aws.StringValue(replicationGroupId), aws.StringValue(globalReplicationGroupId))
+			return nil
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for replication group %s to leave Global datastore %s", aws.StringValue(replicationGroupId), aws.StringValue(globalReplicationGroupId))
+	}
+
+	return ElasticacheGlobalReplicationGroupTimeoutError{id: aws.StringValue(globalReplicationGroupId)}
+}
+
 func nukeReplicationGroupMemberElasticacheCluster(svc *elasticache.ElastiCache, clusterId *string) error {
 	logging.Logger.Debugf("Elasticache cluster Id: %s is a member of a replication group. Therefore, deleting its replication group", aws.StringValue(clusterId))
 
+	if err := leaveElasticacheGlobalReplicationGroup(svc, clusterId); err != nil {
+		return err
+	}
+
 	params := &elasticache.DeleteReplicationGroupInput{
 		ReplicationGroupId: clusterId,
 	}
@@ -237,3 +365,11 @@ type CouldNotLookupCacheClusterErr struct {
 func (err CouldNotLookupCacheClusterErr) Error() string {
 	return fmt.Sprintf("Failed to lookup clusterId: %s", aws.StringValue(err.ClusterId))
 }
+
+type ElasticacheGlobalReplicationGroupTimeoutError struct {
+	id string
+}
+
+func (err ElasticacheGlobalReplicationGroupTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for a replication group to leave Global datastore %s", err.id)
+}
@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+const elasticacheServerlessCacheStatusDeleting = "deleting"
+
+// getAllElasticacheServerlessCaches returns the names of the serverless caches created before excludeAfter
+func getAllElasticacheServerlessCaches(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	return listElasticacheServerlessCaches(elasticache.New(session), excludeAfter, configObj)
+}
+
+func listElasticacheServerlessCaches(svc elasticacheiface.ElastiCacheAPI, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	var caches []*elasticache.ServerlessCache
+	err := svc.DescribeServerlessCachesPages(&elasticache.DescribeServerlessCachesInput{}, func(page *elasticache.DescribeServerlessCachesOutput, lastPage bool) bool {
+		for _, cache := range page.ServerlessCaches {
+			if shouldIncludeElasticacheServerlessCache(cache, excludeAfter, configObj) {
+				caches = append(caches, cache)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	// The exclusion tag needs a call per cache, so it is only checked for the caches the other filters keep
+	var names []*string
+	for _, cache := range caches {
+		tags, err := svc.ListTagsForResource(&elasticache.ListTagsForResourceInput{ResourceName: cache.ARN})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !hasElasticacheExcludeTag(tags.TagList) {
+			names = append(names, cache.ServerlessCacheName)
+		}
+	}
+	return names, nil
+}
+
+func shouldIncludeElasticacheServerlessCache(cache *elasticache.ServerlessCache, excludeAfter time.Time, configObj config.Config) bool {
+	if cache == nil {
+		return false
+	}
+
+	// Caches being deleted will be gone shortly
+	if aws.StringValue(cache.Status) == elasticacheServerlessCacheStatusDeleting {
+		return false
+	}
+
+	if cache.CreateTime != nil && excludeAfter.Before(*cache.CreateTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(cache.ServerlessCacheName),
+		configObj.ElasticacheServerless.IncludeRule.NamesRegExp,
+		configObj.ElasticacheServerless.ExcludeRule.NamesRegExp,
+	)
+}
+
+// waitForElasticacheServerlessCacheToBeDeleted polls the serverless cache until it is gone, so that its user group can
+// be deleted afterwards
+func waitForElasticacheServerlessCacheToBeDeleted(svc elasticacheiface.ElastiCacheAPI, name *string) error {
+	for i := 0; i < 60; i++ {
+		_, err := svc.DescribeServerlessCaches(&elasticache.DescribeServerlessCachesInput{ServerlessCacheName: name})
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == elasticache.ErrCodeServerlessCacheNotFoundFault {
+			return nil
+		}
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for Elasticache serverless cache %s to be deleted...", aws.StringValue(name))
+	}
+
+	return ElasticacheServerlessCacheDeleteTimeoutError{name: aws.StringValue(name)}
+}
+
+func nukeElasticacheServerlessCache(svc elasticacheiface.ElastiCacheAPI, name *string) error {
+	_, err := svc.DeleteServerlessCache(&elasticache.DeleteServerlessCacheInput{ServerlessCacheName: name})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return waitForElasticacheServerlessCacheToBeDeleted(svc, name)
+}
+
+// Deletes all Elasticache serverless caches
+func nukeAllElasticacheServerlessCaches(session *session.Session, names []*string) error {
+	svc := elasticache.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No Elasticache serverless caches to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Elasticache serverless caches in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		err := nukeElasticacheServerlessCache(svc, name)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: "Elasticache Serverless Cache",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Elasticache Serverless Cache",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted Elasticache serverless cache: %s", aws.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Elasticache serverless cache(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
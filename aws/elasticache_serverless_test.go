@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedElasticacheServerless struct {
+	elasticacheiface.ElastiCacheAPI
+	Caches      []*elasticache.ServerlessCache
+	Tags        map[string][]*elasticache.Tag
+	DeletedName string
+}
+
+func (m *mockedElasticacheServerless) DescribeServerlessCachesPages(input *elasticache.DescribeServerlessCachesInput, fn func(*elasticache.DescribeServerlessCachesOutput, bool) bool) error {
+	fn(&elasticache.DescribeServerlessCachesOutput{ServerlessCaches: m.Caches}, true)
+	return nil
+}
+
+func (m *mockedElasticacheServerless) DescribeServerlessCaches(input *elasticache.DescribeServerlessCachesInput) (*elasticache.DescribeServerlessCachesOutput, error) {
+	if aws.StringValue(input.ServerlessCacheName) == m.DeletedName {
+		return nil, awserr.New(elasticache.ErrCodeServerlessCacheNotFoundFault, "not found", nil)
+	}
+	return &elasticache.DescribeServerlessCachesOutput{}, nil
+}
+
+func (m *mockedElasticacheServerless) DeleteServerlessCache(input *elasticache.DeleteServerlessCacheInput) (*elasticache.DeleteServerlessCacheOutput, error) {
+	m.DeletedName = aws.StringValue(input.ServerlessCacheName)
+	return &elasticache.DeleteServerlessCacheOutput{}, nil
+}
+
+func (m *mockedElasticacheServerless) ListTagsForResource(input *elasticache.ListTagsForResourceInput) (*elasticache.TagListMessage, error) {
+	return &elasticache.TagListMessage{TagList: m.Tags[aws.StringValue(input.ResourceName)]}, nil
+}
+
+func TestListElasticacheServerlessCaches(t *testing.T) {
+	now := time.Now()
+	mock := &mockedElasticacheServerless{
+		Caches: []*elasticache.ServerlessCache{
+			{ServerlessCacheName: aws.String("old"), ARN: aws.String("arn-old"), CreateTime: aws.Time(now.Add(-2 * time.Hour)), Status: aws.String("available")},
+			{ServerlessCacheName: aws.String("new"), ARN: aws.String("arn-new"), CreateTime: aws.Time(now), Status: aws.String("available")},
+			{ServerlessCacheName: aws.String("deleting"), ARN: aws.String("arn-deleting"), CreateTime: aws.Time(now.Add(-2 * time.Hour)), Status: aws.String("deleting")},
+			{ServerlessCacheName: aws.String("excluded"), ARN: aws.String("arn-excluded"), CreateTime: aws.Time(now.Add(-2 * time.Hour)), Status: aws.String("available")},
+			{ServerlessCacheName: aws.String("keep-me"), ARN: aws.String("arn-keep"), CreateTime: aws.Time(now.Add(-2 * time.Hour)), Status: aws.String("available")},
+		},
+		Tags: map[string][]*elasticache.Tag{
+			"arn-excluded": {{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}},
+		},
+	}
+	configObj := config.Config{ElasticacheServerless: config.ResourceType{
+		ExcludeRule: config.FilterRule{
+			NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^keep-.*")}},
+		},
+	}}
+
+	names, err := listElasticacheServerlessCaches(mock, now.Add(-1*time.Hour), configObj)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old"}, aws.StringValueSlice(names))
+}
+
+func TestNukeElasticacheServerlessCacheWaitsForDeletion(t *testing.T) {
+	mock := &mockedElasticacheServerless{}
+
+	require.NoError(t, nukeElasticacheServerlessCache(mock, aws.String("cloud-nuke-test")))
+	assert.Equal(t, "cloud-nuke-test", mock.DeletedName)
+}
+
+func TestIsElasticacheUserGroupUsedByServerlessCaches(t *testing.T) {
+	userGroup := &elasticache.UserGroup{ServerlessCaches: aws.StringSlice([]string{"nuked", "other"})}
+
+	assert.True(t, isElasticacheUserGroupUsedByServerlessCaches(userGroup, map[string]bool{"nuked": true}))
+	assert.False(t, isElasticacheUserGroupUsedByServerlessCaches(userGroup, map[string]bool{"nuked": true, "other": true}))
+	assert.False(t, isElasticacheUserGroupUsedByServerlessCaches(&elasticache.UserGroup{}, map[string]bool{}))
+}
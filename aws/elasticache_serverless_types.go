@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"fmt"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// ElasticacheServerlessCaches - represents all Elasticache serverless caches
+type ElasticacheServerlessCaches struct {
+	CacheNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (caches ElasticacheServerlessCaches) ResourceName() string {
+	return "elasticache-serverless"
+}
+
+// ResourceIdentifiers - The names of the Elasticache serverless caches
+func (caches ElasticacheServerlessCaches) ResourceIdentifiers() []string {
+	return caches.CacheNames
+}
+
+func (caches ElasticacheServerlessCaches) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (caches ElasticacheServerlessCaches) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllElasticacheServerlessCaches(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type ElasticacheServerlessCacheDeleteTimeoutError struct {
+	name string
+}
+
+func (err ElasticacheServerlessCacheDeleteTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for Elasticache serverless cache %s to be deleted", err.name)
+}
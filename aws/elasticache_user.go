@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// The default user is created by ElastiCache itself, and has to remain part of every user group
+const elasticacheDefaultUserId = "default"
+
+const elasticacheUserStatusDeleting = "deleting"
+
+// getOrSetFirstSeenElasticacheTag returns when cloud-nuke first saw the ElastiCache resource, tagging it now if it
+// wasn't seen before. Users and user groups don't expose when they were created.
+func getOrSetFirstSeenElasticacheTag(svc elasticacheiface.ElastiCacheAPI, resourceArn *string, tags []*elasticache.Tag) (time.Time, error) {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == firstSeenTagKey {
+			firstSeenTime, err := time.Parse(time.RFC3339, aws.StringValue(tag.Value))
+			if err != nil {
+				return time.Time{}, errors.WithStackTrace(err)
+			}
+			return firstSeenTime, nil
+		}
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.AddTagsToResource(&elasticache.AddTagsToResourceInput{
+		ResourceName: resourceArn,
+		Tags:         []*elasticache.Tag{{Key: aws.String(firstSeenTagKey), Value: aws.String(now.Format(time.RFC3339))}},
+	})
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return now, nil
+}
+
+func hasElasticacheExcludeTag(tags []*elasticache.Tag) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// shouldIncludeElasticacheRbacResource checks the name of the user or user group against the config, and its tags
+// for the exclusion tag and whether it was first seen before excludeAfter
+func shouldIncludeElasticacheRbacResource(svc elasticacheiface.ElastiCacheAPI, name *string, arn *string, excludeAfter time.Time, resourceType config.ResourceType) (bool, error) {
+	if !config.ShouldInclude(aws.StringValue(name), resourceType.IncludeRule.NamesRegExp, resourceType.ExcludeRule.NamesRegExp) {
+		return false, nil
+	}
+
+	tags, err := svc.ListTagsForResource(&elasticache.ListTagsForResourceInput{ResourceName: arn})
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+	if hasElasticacheExcludeTag(tags.TagList) {
+		return false, nil
+	}
+
+	firstSeenTime, err := getOrSetFirstSeenElasticacheTag(svc, arn, tags.TagList)
+	if err != nil {
+		return false, err
+	}
+	return excludeAfter.After(firstSeenTime), nil
+}
+
+// getAllElasticacheUserGroups returns the IDs of the user groups that no replication group or serverless cache uses
+// anymore, other than the given serverless caches being nuked
+func getAllElasticacheUserGroups(session *session.Session, excludeAfter time.Time, configObj config.Config, nukedServerlessCacheNames []string) ([]*string, error) {
+	svc := elasticache.New(session)
+
+	nukedServerlessCaches := map[string]bool{}
+	for _, name := range nukedServerlessCacheNames {
+		nukedServerlessCaches[name] = true
+	}
+
+	var userGroups []*elasticache.UserGroup
+	err := svc.DescribeUserGroupsPages(&elasticache.DescribeUserGroupsInput{}, func(page *elasticache.DescribeUserGroupsOutput, lastPage bool) bool {
+		for _, userGroup := range page.UserGroups {
+			// User groups can't be deleted while a replication group or a serverless cache uses them
+			if len(userGroup.ReplicationGroups) == 0 &&
+				!isElasticacheUserGroupUsedByServerlessCaches(userGroup, nukedServerlessCaches) &&
+				aws.StringValue(userGroup.Status) != elasticacheUserStatusDeleting {
+				userGroups = append(userGroups, userGroup)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var ids []*string
+	for _, userGroup := range userGroups {
+		include, err := shouldIncludeElasticacheRbacResource(svc, userGroup.UserGroupId, userGroup.ARN, excludeAfter, configObj.ElasticacheUserGroup)
+		if err != nil {
+			return nil, err
+		}
+		if include {
+			ids = append(ids, userGroup.UserGroupId)
+		}
+	}
+	return ids, nil
+}
+
+// isElasticacheUserGroupUsedByServerlessCaches returns true if a serverless cache other than the given ones being
+// nuked uses the user group. Those are deleted, and waited for, before their user groups.
+func isElasticacheUserGroupUsedByServerlessCaches(userGroup *elasticache.UserGroup, nukedServerlessCaches map[string]bool) bool {
+	for _, name := range userGroup.ServerlessCaches {
+		if !nukedServerlessCaches[aws.StringValue(name)] {
+			return true
+		}
+	}
+	return false
+}
+
+// getAllElasticacheUsers returns the IDs of the users that aren't part of a user group anymore
+func getAllElasticacheUsers(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := elasticache.New(session)
+
+	var users []*elasticache.User
+	err := svc.DescribeUsersPages(&elasticache.DescribeUsersInput{}, func(page *elasticache.DescribeUsersOutput, lastPage bool) bool {
+		for _, user := range page.Users {
+			if aws.StringValue(user.UserId) == elasticacheDefaultUserId || aws.StringValue(user.Status) == elasticacheUserStatusDeleting {
+				continue
+			}
+			// Users can't be deleted while they are part of a user group
+			if len(user.UserGroupIds) == 0 {
+				users = append(users, user)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var ids []*string
+	for _, user := range users {
+		include, err := shouldIncludeElasticacheRbacResource(svc, user.UserName, user.ARN, excludeAfter, configObj.ElasticacheUser)
+		if err != nil {
+			return nil, err
+		}
+		if include {
+			ids = append(ids, user.UserId)
+		}
+	}
+	return ids, nil
+}
+
+// nukeElasticacheRbacResources deletes the given users or user groups using deleteFn, recording the status of each of
+// them.
+func nukeElasticacheRbacResources(session *session.Session, resourceType string, ids []*string, deleteFn func(svc elasticacheiface.ElastiCacheAPI, id *string) error) error {
+	svc := elasticache.New(session)
+
+	if len(ids) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedIds []*string
+	var allErrs *multierror.Error
+
+	for _, id := range ids {
+		err := deleteFn(svc, id)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(id),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, id)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(id))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedIds), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// Deletes all ElastiCache user groups
+func nukeAllElasticacheUserGroups(session *session.Session, ids []*string) error {
+	return nukeElasticacheRbacResources(session, "Elasticache User Group", ids, func(svc elasticacheiface.ElastiCacheAPI, id *string) error {
+		_, err := svc.DeleteUserGroup(&elasticache.DeleteUserGroupInput{UserGroupId: id})
+		return errors.WithStackTrace(err)
+	})
+}
+
+// Deletes all ElastiCache users
+func nukeAllElasticacheUsers(session *session.Session, ids []*string) error {
+	return nukeElasticacheRbacResources(session, "Elasticache User", ids, func(svc elasticacheiface.ElastiCacheAPI, id *string) error {
+		_, err := svc.DeleteUser(&elasticache.DeleteUserInput{UserId: id})
+		return errors.WithStackTrace(err)
+	})
+}
@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedElasticacheRbac struct {
+	elasticacheiface.ElastiCacheAPI
+	Tags       map[string][]*elasticache.Tag
+	TaggedArns []string
+}
+
+func (m *mockedElasticacheRbac) ListTagsForResource(input *elasticache.ListTagsForResourceInput) (*elasticache.TagListMessage, error) {
+	return &elasticache.TagListMessage{TagList: m.Tags[aws.StringValue(input.ResourceName)]}, nil
+}
+
+func (m *mockedElasticacheRbac) AddTagsToResource(input *elasticache.AddTagsToResourceInput) (*elasticache.TagListMessage, error) {
+	m.TaggedArns = append(m.TaggedArns, aws.StringValue(input.ResourceName))
+	return &elasticache.TagListMessage{}, nil
+}
+
+func TestShouldIncludeElasticacheRbacResource(t *testing.T) {
+	firstSeen := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+	mock := &mockedElasticacheRbac{Tags: map[string][]*elasticache.Tag{
+		"seen":     {{Key: aws.String(firstSeenTagKey), Value: aws.String(firstSeen)}},
+		"excluded": {{Key: aws.String(firstSeenTagKey), Value: aws.String(firstSeen)}, {Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}},
+	}}
+	excludeConfig := config.ResourceType{
+		ExcludeRule: config.FilterRule{
+			NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^keep-.*")}},
+		},
+	}
+
+	cases := []struct {
+		Name         string
+		ResourceName string
+		Arn          string
+		Config       config.ResourceType
+		ExcludeAfter time.Time
+		Expected     bool
+	}{
+		{"SeenBefore", "cloud-nuke-test", "seen", config.ResourceType{}, time.Now().Add(-1 * time.Hour), true},
+		{"SeenAfter", "cloud-nuke-test", "seen", config.ResourceType{}, time.Now().Add(-3 * time.Hour), false},
+		{"NotSeenYet", "cloud-nuke-test", "new", config.ResourceType{}, time.Now().Add(-1 * time.Hour), false},
+		{"ExcludeTag", "cloud-nuke-test", "excluded", config.ResourceType{}, time.Now().Add(-1 * time.Hour), false},
+		{"ConfigExclude", "keep-me", "seen", excludeConfig, time.Now().Add(-1 * time.Hour), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			include, err := shouldIncludeElasticacheRbacResource(mock, aws.String(c.ResourceName), aws.String(c.Arn), c.ExcludeAfter, c.Config)
+			require.NoError(t, err)
+			assert.Equal(t, c.Expected, include)
+		})
+	}
+	assert.Equal(t, []string{"new"}, mock.TaggedArns)
+}
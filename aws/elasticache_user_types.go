@@ -0,0 +1,65 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// ElasticacheUserGroups - represents all ElastiCache user groups that no replication group uses
+type ElasticacheUserGroups struct {
+	UserGroupIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (userGroups ElasticacheUserGroups) ResourceName() string {
+	return "elasticache-user-group"
+}
+
+// ResourceIdentifiers - The IDs of the ElastiCache user groups
+func (userGroups ElasticacheUserGroups) ResourceIdentifiers() []string {
+	return userGroups.UserGroupIds
+}
+
+func (userGroups ElasticacheUserGroups) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (userGroups ElasticacheUserGroups) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllElasticacheUserGroups(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// ElasticacheUsers - represents all ElastiCache users that aren't part of a user group
+type ElasticacheUsers struct {
+	UserIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (users ElasticacheUsers) ResourceName() string {
+	return "elasticache-user"
+}
+
+// ResourceIdentifiers - The IDs of the ElastiCache users
+func (users ElasticacheUsers) ResourceIdentifiers() []string {
+	return users.UserIds
+}
+
+func (users ElasticacheUsers) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (users ElasticacheUsers) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllElasticacheUsers(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
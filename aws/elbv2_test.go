@@ -226,7 +226,7 @@ func TestShouldIncludeELBv2(t *testing.T) {
 
 	for _, c := range cases {
 		t.Run(c.Name, func(t *testing.T) {
-			result := shouldIncludeELBv2(c.ELBv2, c.ExcludeAfter, c.Config)
+			result := shouldIncludeELBv2(c.ELBv2, c.ExcludeAfter, c.Config, &elbv2.DescribeTagsOutput{})
 			assert.Equal(t, c.Expected, result)
 		})
 	}
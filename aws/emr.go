@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/emr/emriface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of EMR cluster IDs. Only clusters that are up, either running steps or waiting for them,
+// are returned, as the other ones are either still starting or already shutting down.
+func getAllEMRClusters(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := emr.New(session)
+
+	var candidateIds []*string
+	input := &emr.ListClustersInput{
+		ClusterStates: aws.StringSlice([]string{emr.ClusterStateWaiting, emr.ClusterStateRunning}),
+	}
+	err := svc.ListClustersPages(input, func(page *emr.ListClustersOutput, lastPage bool) bool {
+		for _, cluster := range page.Clusters {
+			if shouldIncludeEMRCluster(cluster, excludeAfter, configObj) {
+				candidateIds = append(candidateIds, cluster.Id)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var clusterIds []*string
+	for _, clusterId := range candidateIds {
+		// Tags are only returned when describing the cluster
+		output, err := svc.DescribeCluster(&emr.DescribeClusterInput{ClusterId: clusterId})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !hasEMRClusterExcludeTag(output.Cluster) {
+			clusterIds = append(clusterIds, clusterId)
+		}
+	}
+	return clusterIds, nil
+}
+
+func shouldIncludeEMRCluster(cluster *emr.ClusterSummary, excludeAfter time.Time, configObj config.Config) bool {
+	if cluster == nil {
+		return false
+	}
+
+	if cluster.Status != nil && cluster.Status.Timeline != nil && cluster.Status.Timeline.CreationDateTime != nil &&
+		excludeAfter.Before(*cluster.Status.Timeline.CreationDateTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(cluster.Name),
+		configObj.EMRCluster.IncludeRule.NamesRegExp,
+		configObj.EMRCluster.ExcludeRule.NamesRegExp,
+	)
+}
+
+func hasEMRClusterExcludeTag(cluster *emr.Cluster) bool {
+	for _, tag := range cluster.Tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// terminateEMRCluster lifts the termination protection of the cluster, if set, and terminates it. Clusters take a few
+// minutes to shut down, which is not awaited.
+func terminateEMRCluster(svc emriface.EMRAPI, clusterId *string) error {
+	output, err := svc.DescribeCluster(&emr.DescribeClusterInput{ClusterId: clusterId})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if aws.BoolValue(output.Cluster.TerminationProtected) {
+		_, err := svc.SetTerminationProtection(&emr.SetTerminationProtectionInput{
+			JobFlowIds:           []*string{clusterId},
+			TerminationProtected: aws.Bool(false),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Disabled termination protection of EMR cluster %s", aws.StringValue(clusterId))
+	}
+
+	_, err = svc.TerminateJobFlows(&emr.TerminateJobFlowsInput{JobFlowIds: []*string{clusterId}})
+	return errors.WithStackTrace(err)
+}
+
+// Terminates all EMR clusters
+func nukeAllEMRClusters(session *session.Session, clusterIds []*string) error {
+	svc := emr.New(session)
+
+	if len(clusterIds) == 0 {
+		logging.Logger.Debugf("No EMR clusters to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Terminating all EMR clusters in region %s", *session.Config.Region)
+	var terminatedIds []*string
+	var allErrs *multierror.Error
+
+	for _, clusterId := range clusterIds {
+		err := terminateEMRCluster(svc, clusterId)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(clusterId),
+			ResourceType: "EMR Cluster",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking EMR Cluster",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			terminatedIds = append(terminatedIds, clusterId)
+			logging.Logger.Debugf("Terminated EMR cluster: %s", aws.StringValue(clusterId))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d EMR cluster(s) terminated in %s", len(terminatedIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
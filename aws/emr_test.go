@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/emr/emriface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedEMRCluster struct {
+	emriface.EMRAPI
+	TerminationProtected bool
+	Calls                []string
+}
+
+func (m *mockedEMRCluster) DescribeCluster(input *emr.DescribeClusterInput) (*emr.DescribeClusterOutput, error) {
+	return &emr.DescribeClusterOutput{Cluster: &emr.Cluster{
+		Id:                   input.ClusterId,
+		TerminationProtected: aws.Bool(m.TerminationProtected),
+	}}, nil
+}
+
+func (m *mockedEMRCluster) SetTerminationProtection(input *emr.SetTerminationProtectionInput) (*emr.SetTerminationProtectionOutput, error) {
+	m.Calls = append(m.Calls, "unprotect")
+	return &emr.SetTerminationProtectionOutput{}, nil
+}
+
+func (m *mockedEMRCluster) TerminateJobFlows(input *emr.TerminateJobFlowsInput) (*emr.TerminateJobFlowsOutput, error) {
+	m.Calls = append(m.Calls, "terminate")
+	return &emr.TerminateJobFlowsOutput{}, nil
+}
+
+func TestTerminateEMRClusterLiftsTerminationProtection(t *testing.T) {
+	t.Parallel()
+
+	protected := &mockedEMRCluster{TerminationProtected: true}
+	require.NoError(t, terminateEMRCluster(protected, aws.String("j-1")))
+	assert.Equal(t, []string{"unprotect", "terminate"}, protected.Calls)
+
+	unprotected := &mockedEMRCluster{}
+	require.NoError(t, terminateEMRCluster(unprotected, aws.String("j-2")))
+	assert.Equal(t, []string{"terminate"}, unprotected.Calls)
+}
+
+func TestShouldIncludeEMRCluster(t *testing.T) {
+	cluster := &emr.ClusterSummary{
+		Name:   aws.String("cloud-nuke-test"),
+		Status: &emr.ClusterStatus{Timeline: &emr.ClusterTimeline{CreationDateTime: aws.Time(time.Now())}},
+	}
+
+	assert.True(t, shouldIncludeEMRCluster(cluster, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeEMRCluster(cluster, time.Now().Add(-1*time.Hour), config.Config{}))
+}
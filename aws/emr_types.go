@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// EMRClusters - represents all running or waiting EMR clusters
+type EMRClusters struct {
+	ClusterIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (clusters EMRClusters) ResourceName() string {
+	return "emr-cluster"
+}
+
+// ResourceIdentifiers - The IDs of the EMR clusters
+func (clusters EMRClusters) ResourceIdentifiers() []string {
+	return clusters.ClusterIds
+}
+
+func (clusters EMRClusters) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (clusters EMRClusters) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllEMRClusters(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,331 @@
+package aws
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// The event bus every account has, which can't be deleted
+const defaultEventBridgeBusName = "default"
+
+// RemoveTargets accepts at most this many target IDs per call
+const eventBridgeRemoveTargetsLimit = 100
+
+// Returns a formatted string of EventBridge rule ARNs, across all event buses. Rules that AWS services manage on our
+// behalf are skipped, as they are deleted along with the resource they belong to. Rules don't expose a creation time,
+// so the first seen tag is used for the excludeAfter filter instead.
+func getAllEventBridgeRules(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := eventbridge.New(session)
+
+	buses, err := listEventBridgeBuses(svc)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var ruleArns []*string
+	for _, bus := range buses {
+		rules, err := listEventBridgeRules(svc, bus.Name)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, rule := range rules {
+			if aws.StringValue(rule.ManagedBy) != "" {
+				continue
+			}
+
+			include, err := shouldIncludeEventBridgeResource(svc, rule.Arn, aws.StringValue(rule.Name), excludeAfter, configObj.EventBridgeRule)
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			if include {
+				ruleArns = append(ruleArns, rule.Arn)
+			}
+		}
+	}
+	return ruleArns, nil
+}
+
+// Returns a formatted string of custom EventBridge event bus names. Event buses don't expose a creation time, so the
+// first seen tag is used for the excludeAfter filter instead.
+func getAllEventBridgeBuses(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := eventbridge.New(session)
+
+	buses, err := listEventBridgeBuses(svc)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, bus := range buses {
+		if aws.StringValue(bus.Name) == defaultEventBridgeBusName {
+			continue
+		}
+
+		include, err := shouldIncludeEventBridgeResource(svc, bus.Arn, aws.StringValue(bus.Name), excludeAfter, configObj.EventBridgeBus)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if include {
+			names = append(names, bus.Name)
+		}
+	}
+	return names, nil
+}
+
+func listEventBridgeBuses(svc eventbridgeiface.EventBridgeAPI) ([]*eventbridge.EventBus, error) {
+	var buses []*eventbridge.EventBus
+	input := &eventbridge.ListEventBusesInput{}
+	for {
+		output, err := svc.ListEventBuses(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		buses = append(buses, output.EventBuses...)
+
+		if output.NextToken == nil {
+			return buses, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+func listEventBridgeRules(svc eventbridgeiface.EventBridgeAPI, busName *string) ([]*eventbridge.Rule, error) {
+	var rules []*eventbridge.Rule
+	input := &eventbridge.ListRulesInput{EventBusName: busName}
+	for {
+		output, err := svc.ListRules(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		rules = append(rules, output.Rules...)
+
+		if output.NextToken == nil {
+			return rules, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// shouldIncludeEventBridgeResource filters rules and event buses on their name and tags, tagging them with the first
+// seen tag when they are seen for the first time.
+func shouldIncludeEventBridgeResource(svc eventbridgeiface.EventBridgeAPI, resourceArn *string, name string, excludeAfter time.Time, resourceType config.ResourceType) (bool, error) {
+	if !config.ShouldInclude(name, resourceType.IncludeRule.NamesRegExp, resourceType.ExcludeRule.NamesRegExp) {
+		return false, nil
+	}
+
+	output, err := svc.ListTagsForResource(&eventbridge.ListTagsForResourceInput{ResourceARN: resourceArn})
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+
+	tags := map[string]string{}
+	for _, tag := range output.Tags {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	if tags[AwsResourceExclusionTagKey] == "true" {
+		return false, nil
+	}
+
+	firstSeenTime, err := getOrSetFirstSeenEventBridgeTag(svc, resourceArn, tags)
+	if err != nil {
+		logging.Logger.Errorf("Unable to tag EventBridge resource %s: %s", aws.StringValue(resourceArn), err)
+		return false, err
+	}
+	return excludeAfter.After(firstSeenTime), nil
+}
+
+// getOrSetFirstSeenEventBridgeTag returns the time cloud-nuke first saw the EventBridge resource, tagging it with the
+// current time the first time it is seen.
+func getOrSetFirstSeenEventBridgeTag(svc eventbridgeiface.EventBridgeAPI, resourceArn *string, tags map[string]string) (time.Time, error) {
+	if value, ok := tags[firstSeenTagKey]; ok {
+		firstSeenTime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, errors.WithStackTrace(err)
+		}
+		return firstSeenTime, nil
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.TagResource(&eventbridge.TagResourceInput{
+		ResourceARN: resourceArn,
+		Tags:        []*eventbridge.Tag{{Key: aws.String(firstSeenTagKey), Value: aws.String(now.Format(time.RFC3339))}},
+	})
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return now, nil
+}
+
+// parseEventBridgeRuleArn returns the event bus and the name of the rule identified by the given ARN. Rules on the
+// default event bus have ARNs like arn:aws:events:<region>:<account>:rule/<rule name>, while rules on other event buses
+// have ARNs like arn:aws:events:<region>:<account>:rule/<event bus name>/<rule name>.
+func parseEventBridgeRuleArn(ruleArn string) (string, string, error) {
+	parts := strings.SplitN(ruleArn, ":rule/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", InvalidEventBridgeRuleArnError{arn: ruleArn}
+	}
+
+	resource := parts[1]
+	separator := strings.LastIndex(resource, "/")
+	if separator == -1 {
+		return defaultEventBridgeBusName, resource, nil
+	}
+	return resource[:separator], resource[separator+1:], nil
+}
+
+// deleteEventBridgeRule removes the targets of the rule, as a rule can't be deleted while it still has targets, and
+// deletes it.
+func deleteEventBridgeRule(svc eventbridgeiface.EventBridgeAPI, busName string, ruleName string) error {
+	var targetIds []string
+	input := &eventbridge.ListTargetsByRuleInput{EventBusName: aws.String(busName), Rule: aws.String(ruleName)}
+	for {
+		output, err := svc.ListTargetsByRule(input)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		for _, target := range output.Targets {
+			targetIds = append(targetIds, aws.StringValue(target.Id))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	for _, batch := range split(targetIds, eventBridgeRemoveTargetsLimit) {
+		output, err := svc.RemoveTargets(&eventbridge.RemoveTargetsInput{
+			EventBusName: aws.String(busName),
+			Rule:         aws.String(ruleName),
+			Ids:          aws.StringSlice(batch),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		if aws.Int64Value(output.FailedEntryCount) > 0 {
+			return errors.WithStackTrace(EventBridgeRemoveTargetsError{rule: ruleName, failedCount: aws.Int64Value(output.FailedEntryCount)})
+		}
+	}
+
+	_, err := svc.DeleteRule(&eventbridge.DeleteRuleInput{
+		EventBusName: aws.String(busName),
+		Name:         aws.String(ruleName),
+	})
+	return errors.WithStackTrace(err)
+}
+
+func nukeEventBridgeRule(svc eventbridgeiface.EventBridgeAPI, ruleArn *string) error {
+	busName, ruleName, err := parseEventBridgeRuleArn(aws.StringValue(ruleArn))
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return deleteEventBridgeRule(svc, busName, ruleName)
+}
+
+// nukeEventBridgeBus deletes the archives of the event bus, along with any rule left on it, as an event bus can't be
+// deleted while it still has rules, and then the event bus itself.
+func nukeEventBridgeBus(svc eventbridgeiface.EventBridgeAPI, name *string) error {
+	bus, err := svc.DescribeEventBus(&eventbridge.DescribeEventBusInput{Name: name})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	input := &eventbridge.ListArchivesInput{EventSourceArn: bus.Arn}
+	for {
+		output, err := svc.ListArchives(input)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		for _, archive := range output.Archives {
+			if _, err := svc.DeleteArchive(&eventbridge.DeleteArchiveInput{ArchiveName: archive.ArchiveName}); err != nil {
+				return errors.WithStackTrace(err)
+			}
+			logging.Logger.Debugf("Deleted archive %s of EventBridge event bus %s", aws.StringValue(archive.ArchiveName), aws.StringValue(name))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	rules, err := listEventBridgeRules(svc, name)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, rule := range rules {
+		if err := deleteEventBridgeRule(svc, aws.StringValue(name), aws.StringValue(rule.Name)); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	_, err = svc.DeleteEventBus(&eventbridge.DeleteEventBusInput{Name: name})
+	return errors.WithStackTrace(err)
+}
+
+// nukeEventBridgeResources deletes the given EventBridge rules or event buses using deleteFn, recording the status of
+// each of them.
+func nukeEventBridgeResources(session *session.Session, resourceType string, identifiers []*string, deleteFn func(svc eventbridgeiface.EventBridgeAPI, identifier *string) error) error {
+	svc := eventbridge.New(session)
+
+	if len(identifiers) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedIdentifiers []*string
+	var allErrs *multierror.Error
+
+	for _, identifier := range identifiers {
+		err := deleteFn(svc, identifier)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(identifier),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIdentifiers = append(deletedIdentifiers, identifier)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(identifier))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedIdentifiers), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// Deletes all EventBridge rules, after removing their targets
+func nukeAllEventBridgeRules(session *session.Session, ruleArns []*string) error {
+	return nukeEventBridgeResources(session, "EventBridge Rule", ruleArns, nukeEventBridgeRule)
+}
+
+// Deletes all custom EventBridge event buses, along with their archives and rules
+func nukeAllEventBridgeBuses(session *session.Session, names []*string) error {
+	return nukeEventBridgeResources(session, "EventBridge Event Bus", names, nukeEventBridgeBus)
+}
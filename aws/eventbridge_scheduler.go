@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/scheduler"
+	"github.com/aws/aws-sdk-go/service/scheduler/scheduleriface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// The schedule group every account has, which can't be deleted
+const defaultEventBridgeScheduleGroupName = "default"
+
+// Returns a formatted string of EventBridge Scheduler schedule identifiers, which are made up of the schedule group
+// and the name of the schedule, since schedule names are only unique within their group.
+func getAllEventBridgeSchedules(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := scheduler.New(session)
+
+	var identifiers []*string
+	err := svc.ListSchedulesPages(&scheduler.ListSchedulesInput{}, func(page *scheduler.ListSchedulesOutput, lastPage bool) bool {
+		for _, schedule := range page.Schedules {
+			if shouldIncludeEventBridgeSchedule(schedule, excludeAfter, configObj) {
+				identifiers = append(identifiers, aws.String(formatEventBridgeScheduleIdentifier(schedule)))
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return identifiers, nil
+}
+
+func shouldIncludeEventBridgeSchedule(schedule *scheduler.ScheduleSummary, excludeAfter time.Time, configObj config.Config) bool {
+	if schedule == nil {
+		return false
+	}
+
+	if schedule.CreationDate != nil && excludeAfter.Before(*schedule.CreationDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(schedule.Name),
+		configObj.EventBridgeSchedule.IncludeRule.NamesRegExp,
+		configObj.EventBridgeSchedule.ExcludeRule.NamesRegExp,
+	)
+}
+
+func formatEventBridgeScheduleIdentifier(schedule *scheduler.ScheduleSummary) string {
+	return aws.StringValue(schedule.GroupName) + "/" + aws.StringValue(schedule.Name)
+}
+
+// parseEventBridgeScheduleIdentifier returns the schedule group and the name of the schedule identified by the given
+// <group name>/<schedule name> identifier.
+func parseEventBridgeScheduleIdentifier(identifier string) (string, string, error) {
+	parts := strings.SplitN(identifier, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", InvalidEventBridgeScheduleIdentifierError{identifier: identifier}
+	}
+	return parts[0], parts[1], nil
+}
+
+// Returns a formatted string of EventBridge Scheduler schedule group names. The default schedule group is skipped, as
+// it can't be deleted.
+func getAllEventBridgeScheduleGroups(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := scheduler.New(session)
+
+	var groups []*scheduler.ScheduleGroupSummary
+	err := svc.ListScheduleGroupsPages(&scheduler.ListScheduleGroupsInput{}, func(page *scheduler.ListScheduleGroupsOutput, lastPage bool) bool {
+		for _, group := range page.ScheduleGroups {
+			if shouldIncludeEventBridgeScheduleGroup(group, excludeAfter, configObj) {
+				groups = append(groups, group)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, group := range groups {
+		tags, err := svc.ListTagsForResource(&scheduler.ListTagsForResourceInput{ResourceArn: group.Arn})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !hasEventBridgeScheduleGroupExcludeTag(tags.Tags) {
+			names = append(names, group.Name)
+		}
+	}
+	return names, nil
+}
+
+func shouldIncludeEventBridgeScheduleGroup(group *scheduler.ScheduleGroupSummary, excludeAfter time.Time, configObj config.Config) bool {
+	if group == nil {
+		return false
+	}
+
+	// Groups being deleted will be gone shortly, along with their schedules
+	if aws.StringValue(group.Name) == defaultEventBridgeScheduleGroupName || aws.StringValue(group.State) == scheduler.ScheduleGroupStateDeleting {
+		return false
+	}
+
+	if group.CreationDate != nil && excludeAfter.Before(*group.CreationDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(group.Name),
+		configObj.EventBridgeScheduleGroup.IncludeRule.NamesRegExp,
+		configObj.EventBridgeScheduleGroup.ExcludeRule.NamesRegExp,
+	)
+}
+
+func hasEventBridgeScheduleGroupExcludeTag(tags []*scheduler.Tag) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+func nukeEventBridgeSchedule(svc scheduleriface.SchedulerAPI, identifier *string) error {
+	groupName, name, err := parseEventBridgeScheduleIdentifier(aws.StringValue(identifier))
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	_, err = svc.DeleteSchedule(&scheduler.DeleteScheduleInput{
+		GroupName: aws.String(groupName),
+		Name:      aws.String(name),
+	})
+	return errors.WithStackTrace(err)
+}
+
+// nukeEventBridgeScheduleGroup deletes the schedule group. AWS deletes the schedules left in the group along with it.
+func nukeEventBridgeScheduleGroup(svc scheduleriface.SchedulerAPI, name *string) error {
+	_, err := svc.DeleteScheduleGroup(&scheduler.DeleteScheduleGroupInput{Name: name})
+	return errors.WithStackTrace(err)
+}
+
+// nukeEventBridgeSchedulerResources deletes the given EventBridge Scheduler schedules or schedule groups using
+// deleteFn, recording the status of each of them.
+func nukeEventBridgeSchedulerResources(session *session.Session, resourceType string, identifiers []*string, deleteFn func(svc scheduleriface.SchedulerAPI, identifier *string) error) error {
+	svc := scheduler.New(session)
+
+	if len(identifiers) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedIdentifiers []*string
+	var allErrs *multierror.Error
+
+	for _, identifier := range identifiers {
+		err := deleteFn(svc, identifier)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(identifier),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIdentifiers = append(deletedIdentifiers, identifier)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(identifier))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedIdentifiers), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// Deletes all EventBridge Scheduler schedules
+func nukeAllEventBridgeSchedules(session *session.Session, identifiers []*string) error {
+	return nukeEventBridgeSchedulerResources(session, "EventBridge Schedule", identifiers, nukeEventBridgeSchedule)
+}
+
+// Deletes all custom EventBridge Scheduler schedule groups, along with their schedules
+func nukeAllEventBridgeScheduleGroups(session *session.Session, names []*string) error {
+	return nukeEventBridgeSchedulerResources(session, "EventBridge Schedule Group", names, nukeEventBridgeScheduleGroup)
+}
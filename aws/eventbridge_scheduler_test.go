@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/scheduler"
+	"github.com/aws/aws-sdk-go/service/scheduler/scheduleriface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedEventBridgeScheduler struct {
+	scheduleriface.SchedulerAPI
+	Calls []string
+}
+
+func (m *mockedEventBridgeScheduler) DeleteSchedule(input *scheduler.DeleteScheduleInput) (*scheduler.DeleteScheduleOutput, error) {
+	m.Calls = append(m.Calls, aws.StringValue(input.GroupName)+"/"+aws.StringValue(input.Name))
+	return &scheduler.DeleteScheduleOutput{}, nil
+}
+
+func TestNukeEventBridgeScheduleUsesItsGroup(t *testing.T) {
+	t.Parallel()
+
+	svc := &mockedEventBridgeScheduler{}
+	require.NoError(t, nukeEventBridgeSchedule(svc, aws.String("reports/nightly-export")))
+	assert.Equal(t, []string{"reports/nightly-export"}, svc.Calls)
+
+	assert.Error(t, nukeEventBridgeSchedule(svc, aws.String("nightly-export")))
+}
+
+func TestShouldIncludeEventBridgeScheduleGroup(t *testing.T) {
+	t.Parallel()
+
+	group := func(name string, state string) *scheduler.ScheduleGroupSummary {
+		return &scheduler.ScheduleGroupSummary{
+			Name:         aws.String(name),
+			State:        aws.String(state),
+			CreationDate: aws.Time(time.Now()),
+		}
+	}
+
+	assert.True(t, shouldIncludeEventBridgeScheduleGroup(group("reports", scheduler.ScheduleGroupStateActive), time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeEventBridgeScheduleGroup(group("reports", scheduler.ScheduleGroupStateActive), time.Now().Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeEventBridgeScheduleGroup(group("reports", scheduler.ScheduleGroupStateDeleting), time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeEventBridgeScheduleGroup(group(defaultEventBridgeScheduleGroupName, scheduler.ScheduleGroupStateActive), time.Now().Add(1*time.Hour), config.Config{}))
+}
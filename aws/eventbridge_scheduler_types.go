@@ -0,0 +1,73 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// EventBridgeSchedules - represents all EventBridge Scheduler schedules, across all schedule groups
+type EventBridgeSchedules struct {
+	Identifiers []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (schedules EventBridgeSchedules) ResourceName() string {
+	return "eventbridge-schedule"
+}
+
+// ResourceIdentifiers - The <group name>/<schedule name> identifiers of the EventBridge Scheduler schedules
+func (schedules EventBridgeSchedules) ResourceIdentifiers() []string {
+	return schedules.Identifiers
+}
+
+func (schedules EventBridgeSchedules) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (schedules EventBridgeSchedules) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllEventBridgeSchedules(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// EventBridgeScheduleGroups - represents all custom EventBridge Scheduler schedule groups
+type EventBridgeScheduleGroups struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (groups EventBridgeScheduleGroups) ResourceName() string {
+	return "eventbridge-schedule-group"
+}
+
+// ResourceIdentifiers - The names of the EventBridge Scheduler schedule groups
+func (groups EventBridgeScheduleGroups) ResourceIdentifiers() []string {
+	return groups.Names
+}
+
+func (groups EventBridgeScheduleGroups) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (groups EventBridgeScheduleGroups) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllEventBridgeScheduleGroups(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type InvalidEventBridgeScheduleIdentifierError struct {
+	identifier string
+}
+
+func (e InvalidEventBridgeScheduleIdentifierError) Error() string {
+	return "Unable to parse the schedule group and name of EventBridge schedule " + e.identifier
+}
@@ -0,0 +1,59 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedEventBridgeRule struct {
+	eventbridgeiface.EventBridgeAPI
+	Targets []string
+	Calls   []string
+}
+
+func (m *mockedEventBridgeRule) ListTargetsByRule(input *eventbridge.ListTargetsByRuleInput) (*eventbridge.ListTargetsByRuleOutput, error) {
+	var targets []*eventbridge.Target
+	for _, id := range m.Targets {
+		targets = append(targets, &eventbridge.Target{Id: aws.String(id)})
+	}
+	return &eventbridge.ListTargetsByRuleOutput{Targets: targets}, nil
+}
+
+func (m *mockedEventBridgeRule) RemoveTargets(input *eventbridge.RemoveTargetsInput) (*eventbridge.RemoveTargetsOutput, error) {
+	m.Calls = append(m.Calls, "remove-targets/"+aws.StringValue(input.EventBusName)+"/"+aws.StringValue(input.Rule))
+	return &eventbridge.RemoveTargetsOutput{FailedEntryCount: aws.Int64(0)}, nil
+}
+
+func (m *mockedEventBridgeRule) DeleteRule(input *eventbridge.DeleteRuleInput) (*eventbridge.DeleteRuleOutput, error) {
+	m.Calls = append(m.Calls, "delete/"+aws.StringValue(input.EventBusName)+"/"+aws.StringValue(input.Name))
+	return &eventbridge.DeleteRuleOutput{}, nil
+}
+
+func TestNukeEventBridgeRuleRemovesTargetsFirst(t *testing.T) {
+	t.Parallel()
+
+	withTargets := &mockedEventBridgeRule{Targets: []string{"lambda", "queue"}}
+	require.NoError(t, nukeEventBridgeRule(withTargets, aws.String("arn:aws:events:us-east-1:123456789012:rule/orders/on-order")))
+	assert.Equal(t, []string{"remove-targets/orders/on-order", "delete/orders/on-order"}, withTargets.Calls)
+
+	withoutTargets := &mockedEventBridgeRule{}
+	require.NoError(t, nukeEventBridgeRule(withoutTargets, aws.String("arn:aws:events:us-east-1:123456789012:rule/nightly")))
+	assert.Equal(t, []string{"delete/default/nightly"}, withoutTargets.Calls)
+}
+
+func TestParseEventBridgeRuleArn(t *testing.T) {
+	t.Parallel()
+
+	busName, ruleName, err := parseEventBridgeRuleArn("arn:aws:events:us-east-1:123456789012:rule/aws.partner/example.com/123/orders/on-order")
+	require.NoError(t, err)
+	assert.Equal(t, "aws.partner/example.com/123/orders", busName)
+	assert.Equal(t, "on-order", ruleName)
+
+	_, _, err = parseEventBridgeRuleArn("arn:aws:events:us-east-1:123456789012:event-bus/orders")
+	assert.Error(t, err)
+}
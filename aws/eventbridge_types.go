@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"fmt"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// EventBridgeRules - represents all EventBridge rules that aren't managed by AWS services, across all event buses
+type EventBridgeRules struct {
+	RuleArns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (rules EventBridgeRules) ResourceName() string {
+	return "eventbridge-rule"
+}
+
+// ResourceIdentifiers - The ARNs of the EventBridge rules
+func (rules EventBridgeRules) ResourceIdentifiers() []string {
+	return rules.RuleArns
+}
+
+func (rules EventBridgeRules) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (rules EventBridgeRules) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllEventBridgeRules(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// EventBridgeBuses - represents all custom EventBridge event buses
+type EventBridgeBuses struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (buses EventBridgeBuses) ResourceName() string {
+	return "eventbridge-bus"
+}
+
+// ResourceIdentifiers - The names of the EventBridge event buses
+func (buses EventBridgeBuses) ResourceIdentifiers() []string {
+	return buses.Names
+}
+
+func (buses EventBridgeBuses) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (buses EventBridgeBuses) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllEventBridgeBuses(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type InvalidEventBridgeRuleArnError struct {
+	arn string
+}
+
+func (e InvalidEventBridgeRuleArnError) Error() string {
+	return "Unable to parse the event bus and name of EventBridge rule " + e.arn
+}
+
+type EventBridgeRemoveTargetsError struct {
+	rule        string
+	failedCount int64
+}
+
+func (e EventBridgeRemoveTargetsError) Error() string {
+	return fmt.Sprintf("Failed to remove %d target(s) of EventBridge rule %s", e.failedCount, e.rule)
+}
@@ -0,0 +1,292 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/fsx/fsxiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of FSx file system IDs
+func getAllFSxFileSystems(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := fsx.New(session)
+
+	var fileSystemIds []*string
+	err := svc.DescribeFileSystemsPages(&fsx.DescribeFileSystemsInput{}, func(page *fsx.DescribeFileSystemsOutput, lastPage bool) bool {
+		for _, fileSystem := range page.FileSystems {
+			if shouldIncludeFSxFileSystem(fileSystem, excludeAfter, configObj) {
+				fileSystemIds = append(fileSystemIds, fileSystem.FileSystemId)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return fileSystemIds, nil
+}
+
+// getFSxFileSystemName returns the value of the Name tag of the file system, falling back to its ID when it isn't set
+func getFSxFileSystemName(fileSystem *fsx.FileSystem) string {
+	for _, tag := range fileSystem.Tags {
+		if aws.StringValue(tag.Key) == "Name" {
+			return aws.StringValue(tag.Value)
+		}
+	}
+	return aws.StringValue(fileSystem.FileSystemId)
+}
+
+func shouldIncludeFSxFileSystem(fileSystem *fsx.FileSystem, excludeAfter time.Time, configObj config.Config) bool {
+	if fileSystem == nil {
+		return false
+	}
+
+	// File systems that are already being deleted will be gone shortly
+	if aws.StringValue(fileSystem.Lifecycle) == fsx.FileSystemLifecycleDeleting {
+		return false
+	}
+
+	if fileSystem.CreationTime != nil && excludeAfter.Before(*fileSystem.CreationTime) {
+		return false
+	}
+
+	for _, tag := range fileSystem.Tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return false
+		}
+	}
+
+	return config.ShouldInclude(
+		getFSxFileSystemName(fileSystem),
+		configObj.FSx.IncludeRule.NamesRegExp,
+		configObj.FSx.ExcludeRule.NamesRegExp,
+	)
+}
+
+func isFSxNotFoundErr(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	switch awsErr.Code() {
+	case fsx.ErrCodeFileSystemNotFound, fsx.ErrCodeVolumeNotFound, fsx.ErrCodeStorageVirtualMachineNotFound:
+		return true
+	}
+	return false
+}
+
+// waitForFSxResourcesDeleted polls countRemaining until none of the described resources are left.
+func waitForFSxResourcesDeleted(description string, countRemaining func() (int, error)) error {
+	for i := 0; i < 90; i++ {
+		remaining, err := countRemaining()
+		if err != nil {
+			return err
+		}
+		if remaining == 0 {
+			return nil
+		}
+
+		time.Sleep(20 * time.Second)
+		logging.Logger.Debugf("Waiting for %d FSx %s to be deleted...", remaining, description)
+	}
+
+	return FSxDeleteTimeoutError{description: description}
+}
+
+// listFSxOntapVolumes returns the volumes of the ONTAP file system, except for the root volumes of its SVMs, which
+// are deleted along with the SVMs.
+func listFSxOntapVolumes(svc fsxiface.FSxAPI, fileSystemId *string) ([]*fsx.Volume, error) {
+	var volumes []*fsx.Volume
+	input := &fsx.DescribeVolumesInput{
+		Filters: []*fsx.VolumeFilter{
+			{Name: aws.String(fsx.VolumeFilterNameFileSystemId), Values: []*string{fileSystemId}},
+		},
+	}
+	err := svc.DescribeVolumesPages(input, func(page *fsx.DescribeVolumesOutput, lastPage bool) bool {
+		for _, volume := range page.Volumes {
+			if volume.OntapConfiguration != nil && aws.BoolValue(volume.OntapConfiguration.StorageVirtualMachineRoot) {
+				continue
+			}
+			volumes = append(volumes, volume)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return volumes, nil
+}
+
+func listFSxStorageVirtualMachines(svc fsxiface.FSxAPI, fileSystemId *string) ([]*fsx.StorageVirtualMachine, error) {
+	var svms []*fsx.StorageVirtualMachine
+	input := &fsx.DescribeStorageVirtualMachinesInput{
+		Filters: []*fsx.StorageVirtualMachineFilter{
+			{Name: aws.String(fsx.StorageVirtualMachineFilterNameFileSystemId), Values: []*string{fileSystemId}},
+		},
+	}
+	err := svc.DescribeStorageVirtualMachinesPages(input, func(page *fsx.DescribeStorageVirtualMachinesOutput, lastPage bool) bool {
+		svms = append(svms, page.StorageVirtualMachines...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return svms, nil
+}
+
+// deleteFSxOntapResources deletes the volumes and then the storage virtual machines (SVMs) backing the ONTAP file
+// system, as the file system can't be deleted while it has any.
+func deleteFSxOntapResources(svc fsxiface.FSxAPI, fileSystemId *string) error {
+	volumes, err := listFSxOntapVolumes(svc, fileSystemId)
+	if err != nil {
+		return err
+	}
+	for _, volume := range volumes {
+		if aws.StringValue(volume.Lifecycle) == fsx.VolumeLifecycleDeleting {
+			continue
+		}
+		_, err := svc.DeleteVolume(&fsx.DeleteVolumeInput{
+			VolumeId: volume.VolumeId,
+			OntapConfiguration: &fsx.DeleteVolumeOntapConfiguration{
+				SkipFinalBackup: aws.Bool(true),
+			},
+		})
+		if err != nil && !isFSxNotFoundErr(err) {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleting volume %s of FSx file system %s", aws.StringValue(volume.VolumeId), aws.StringValue(fileSystemId))
+	}
+	err = waitForFSxResourcesDeleted("ONTAP volumes", func() (int, error) {
+		volumes, err := listFSxOntapVolumes(svc, fileSystemId)
+		return len(volumes), err
+	})
+	if err != nil {
+		return err
+	}
+
+	svms, err := listFSxStorageVirtualMachines(svc, fileSystemId)
+	if err != nil {
+		return err
+	}
+	for _, svm := range svms {
+		if aws.StringValue(svm.Lifecycle) == fsx.StorageVirtualMachineLifecycleDeleting {
+			continue
+		}
+		_, err := svc.DeleteStorageVirtualMachine(&fsx.DeleteStorageVirtualMachineInput{
+			StorageVirtualMachineId: svm.StorageVirtualMachineId,
+		})
+		if err != nil && !isFSxNotFoundErr(err) {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleting storage virtual machine %s of FSx file system %s", aws.StringValue(svm.StorageVirtualMachineId), aws.StringValue(fileSystemId))
+	}
+	return waitForFSxResourcesDeleted("ONTAP storage virtual machines", func() (int, error) {
+		svms, err := listFSxStorageVirtualMachines(svc, fileSystemId)
+		return len(svms), err
+	})
+}
+
+// getFSxDeleteFileSystemInput builds the delete request for the file system, skipping the final backup for every
+// file system type that would otherwise take one.
+func getFSxDeleteFileSystemInput(fileSystem *fsx.FileSystem) *fsx.DeleteFileSystemInput {
+	input := &fsx.DeleteFileSystemInput{FileSystemId: fileSystem.FileSystemId}
+
+	switch aws.StringValue(fileSystem.FileSystemType) {
+	case fsx.FileSystemTypeWindows:
+		input.WindowsConfiguration = &fsx.DeleteFileSystemWindowsConfiguration{SkipFinalBackup: aws.Bool(true)}
+	case fsx.FileSystemTypeLustre:
+		input.LustreConfiguration = &fsx.DeleteFileSystemLustreConfiguration{SkipFinalBackup: aws.Bool(true)}
+	case fsx.FileSystemTypeOpenzfs:
+		input.OpenZFSConfiguration = &fsx.DeleteFileSystemOpenZFSConfiguration{
+			SkipFinalBackup: aws.Bool(true),
+			Options:         aws.StringSlice([]string{fsx.DeleteFileSystemOpenZFSOptionDeleteChildVolumesAndSnapshots}),
+		}
+	}
+	return input
+}
+
+// nukeFSxFileSystem deletes the file system, along with its volumes and SVMs for ONTAP, and waits for it to be gone.
+func nukeFSxFileSystem(svc fsxiface.FSxAPI, fileSystemId *string) error {
+	result, err := svc.DescribeFileSystems(&fsx.DescribeFileSystemsInput{FileSystemIds: []*string{fileSystemId}})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(result.FileSystems) == 0 {
+		return nil
+	}
+	fileSystem := result.FileSystems[0]
+
+	if aws.StringValue(fileSystem.FileSystemType) == fsx.FileSystemTypeOntap {
+		if err := deleteFSxOntapResources(svc, fileSystemId); err != nil {
+			return err
+		}
+	}
+
+	if _, err := svc.DeleteFileSystem(getFSxDeleteFileSystemInput(fileSystem)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return waitForFSxResourcesDeleted("file systems", func() (int, error) {
+		_, err := svc.DescribeFileSystems(&fsx.DescribeFileSystemsInput{FileSystemIds: []*string{fileSystemId}})
+		if isFSxNotFoundErr(err) {
+			return 0, nil
+		}
+		if err != nil {
+			return 0, errors.WithStackTrace(err)
+		}
+		return 1, nil
+	})
+}
+
+// Deletes all FSx file systems
+func nukeAllFSxFileSystems(session *session.Session, fileSystemIds []*string) error {
+	svc := fsx.New(session)
+
+	if len(fileSystemIds) == 0 {
+		logging.Logger.Debugf("No FSx file systems to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all FSx file systems in region %s", *session.Config.Region)
+	var deletedIds []*string
+	var allErrs *multierror.Error
+
+	for _, fileSystemId := range fileSystemIds {
+		err := nukeFSxFileSystem(svc, fileSystemId)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(fileSystemId),
+			ResourceType: "FSx File System",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking FSx File System",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, fileSystemId)
+			logging.Logger.Debugf("Deleted FSx file system: %s", aws.StringValue(fileSystemId))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d FSx file system(s) deleted in %s", len(deletedIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
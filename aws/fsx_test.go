@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/fsx/fsxiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedFSxOntap serves an ONTAP file system with one SVM and its volumes, which are gone as soon as they are deleted
+type mockedFSxOntap struct {
+	fsxiface.FSxAPI
+	Calls []string
+}
+
+func (m *mockedFSxOntap) hasDeleted(call string) bool {
+	for _, c := range m.Calls {
+		if c == call {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *mockedFSxOntap) DescribeFileSystems(input *fsx.DescribeFileSystemsInput) (*fsx.DescribeFileSystemsOutput, error) {
+	if m.hasDeleted("file-system:fs-1") {
+		return nil, awserr.New(fsx.ErrCodeFileSystemNotFound, "not found", nil)
+	}
+	return &fsx.DescribeFileSystemsOutput{FileSystems: []*fsx.FileSystem{
+		{FileSystemId: aws.String("fs-1"), FileSystemType: aws.String(fsx.FileSystemTypeOntap)},
+	}}, nil
+}
+
+func (m *mockedFSxOntap) DescribeVolumesPages(input *fsx.DescribeVolumesInput, fn func(*fsx.DescribeVolumesOutput, bool) bool) error {
+	volumes := []*fsx.Volume{
+		{VolumeId: aws.String("fsvol-root"), OntapConfiguration: &fsx.OntapVolumeConfiguration{StorageVirtualMachineRoot: aws.Bool(true)}},
+	}
+	if !m.hasDeleted("volume:fsvol-data") {
+		volumes = append(volumes, &fsx.Volume{VolumeId: aws.String("fsvol-data"), OntapConfiguration: &fsx.OntapVolumeConfiguration{}})
+	}
+	fn(&fsx.DescribeVolumesOutput{Volumes: volumes}, true)
+	return nil
+}
+
+func (m *mockedFSxOntap) DeleteVolume(input *fsx.DeleteVolumeInput) (*fsx.DeleteVolumeOutput, error) {
+	m.Calls = append(m.Calls, "volume:"+aws.StringValue(input.VolumeId))
+	return &fsx.DeleteVolumeOutput{}, nil
+}
+
+func (m *mockedFSxOntap) DescribeStorageVirtualMachinesPages(input *fsx.DescribeStorageVirtualMachinesInput, fn func(*fsx.DescribeStorageVirtualMachinesOutput, bool) bool) error {
+	var svms []*fsx.StorageVirtualMachine
+	if !m.hasDeleted("svm:svm-1") {
+		svms = append(svms, &fsx.StorageVirtualMachine{StorageVirtualMachineId: aws.String("svm-1")})
+	}
+	fn(&fsx.DescribeStorageVirtualMachinesOutput{StorageVirtualMachines: svms}, true)
+	return nil
+}
+
+func (m *mockedFSxOntap) DeleteStorageVirtualMachine(input *fsx.DeleteStorageVirtualMachineInput) (*fsx.DeleteStorageVirtualMachineOutput, error) {
+	m.Calls = append(m.Calls, "svm:"+aws.StringValue(input.StorageVirtualMachineId))
+	return &fsx.DeleteStorageVirtualMachineOutput{}, nil
+}
+
+func (m *mockedFSxOntap) DeleteFileSystem(input *fsx.DeleteFileSystemInput) (*fsx.DeleteFileSystemOutput, error) {
+	m.Calls = append(m.Calls, "file-system:"+aws.StringValue(input.FileSystemId))
+	return &fsx.DeleteFileSystemOutput{}, nil
+}
+
+func TestNukeFSxOntapFileSystemDeletesVolumesAndSVMsFirst(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedFSxOntap{}
+	require.NoError(t, nukeFSxFileSystem(mock, aws.String("fs-1")))
+	assert.Equal(t, []string{"volume:fsvol-data", "svm:svm-1", "file-system:fs-1"}, mock.Calls)
+}
+
+func TestGetFSxDeleteFileSystemInputSkipsFinalBackup(t *testing.T) {
+	t.Parallel()
+
+	windows := getFSxDeleteFileSystemInput(&fsx.FileSystem{FileSystemId: aws.String("fs-1"), FileSystemType: aws.String(fsx.FileSystemTypeWindows)})
+	assert.True(t, aws.BoolValue(windows.WindowsConfiguration.SkipFinalBackup))
+
+	lustre := getFSxDeleteFileSystemInput(&fsx.FileSystem{FileSystemId: aws.String("fs-1"), FileSystemType: aws.String(fsx.FileSystemTypeLustre)})
+	assert.True(t, aws.BoolValue(lustre.LustreConfiguration.SkipFinalBackup))
+
+	openZFS := getFSxDeleteFileSystemInput(&fsx.FileSystem{FileSystemId: aws.String("fs-1"), FileSystemType: aws.String(fsx.FileSystemTypeOpenzfs)})
+	assert.True(t, aws.BoolValue(openZFS.OpenZFSConfiguration.SkipFinalBackup))
+	assert.Equal(t, []string{fsx.DeleteFileSystemOpenZFSOptionDeleteChildVolumesAndSnapshots}, aws.StringValueSlice(openZFS.OpenZFSConfiguration.Options))
+
+	ontap := getFSxDeleteFileSystemInput(&fsx.FileSystem{FileSystemId: aws.String("fs-1"), FileSystemType: aws.String(fsx.FileSystemTypeOntap)})
+	assert.Nil(t, ontap.WindowsConfiguration)
+	assert.Nil(t, ontap.LustreConfiguration)
+	assert.Nil(t, ontap.OpenZFSConfiguration)
+}
+
+func TestShouldIncludeFSxFileSystem(t *testing.T) {
+	now := time.Now()
+	fileSystem := func(lifecycle string, tags ...*fsx.Tag) *fsx.FileSystem {
+		return &fsx.FileSystem{FileSystemId: aws.String("fs-1"), Lifecycle: aws.String(lifecycle), CreationTime: aws.Time(now), Tags: tags}
+	}
+	excludeTag := &fsx.Tag{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}
+
+	assert.True(t, shouldIncludeFSxFileSystem(fileSystem(fsx.FileSystemLifecycleAvailable), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeFSxFileSystem(fileSystem(fsx.FileSystemLifecycleAvailable, excludeTag), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeFSxFileSystem(fileSystem(fsx.FileSystemLifecycleDeleting), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeFSxFileSystem(fileSystem(fsx.FileSystemLifecycleAvailable), now.Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeFSxFileSystem(nil, now, config.Config{}))
+}
+
+func TestGetFSxFileSystemName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "cloud-nuke-test", getFSxFileSystemName(&fsx.FileSystem{FileSystemId: aws.String("fs-1"), Tags: []*fsx.Tag{{Key: aws.String("Name"), Value: aws.String("cloud-nuke-test")}}}))
+	assert.Equal(t, "fs-1", getFSxFileSystemName(&fsx.FileSystem{FileSystemId: aws.String("fs-1")}))
+}
@@ -0,0 +1,44 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// FSxFileSystems - represents all FSx file systems
+type FSxFileSystems struct {
+	FileSystemIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (fileSystems FSxFileSystems) ResourceName() string {
+	return "fsx"
+}
+
+// ResourceIdentifiers - The IDs of the FSx file systems
+func (fileSystems FSxFileSystems) ResourceIdentifiers() []string {
+	return fileSystems.FileSystemIds
+}
+
+func (fileSystems FSxFileSystems) MaxBatchSize() int {
+	// File systems are deleted one at a time and each deletion can take a while, so keep batches small
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (fileSystems FSxFileSystems) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllFSxFileSystems(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type FSxDeleteTimeoutError struct {
+	description string
+}
+
+func (e FSxDeleteTimeoutError) Error() string {
+	return "Timed out waiting for FSx " + e.description + " to be deleted"
+}
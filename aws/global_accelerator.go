@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator/globalacceleratoriface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Accelerators are global, but the Global Accelerator API is only served from us-west-2
+const globalAcceleratorRegion = "us-west-2"
+
+func newGlobalAcceleratorClient(session *session.Session) *globalaccelerator.GlobalAccelerator {
+	return globalaccelerator.New(session, aws.NewConfig().WithRegion(globalAcceleratorRegion))
+}
+
+// Returns a formatted string of Global Accelerator ARNs
+func getAllGlobalAccelerators(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := newGlobalAcceleratorClient(session)
+
+	var acceleratorArns []*string
+	err := svc.ListAcceleratorsPages(&globalaccelerator.ListAcceleratorsInput{}, func(page *globalaccelerator.ListAcceleratorsOutput, lastPage bool) bool {
+		for _, accelerator := range page.Accelerators {
+			if shouldIncludeGlobalAccelerator(accelerator, excludeAfter, configObj) {
+				acceleratorArns = append(acceleratorArns, accelerator.AcceleratorArn)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return acceleratorArns, nil
+}
+
+func shouldIncludeGlobalAccelerator(accelerator *globalaccelerator.Accelerator, excludeAfter time.Time, configObj config.Config) bool {
+	if accelerator == nil {
+		return false
+	}
+
+	if accelerator.CreatedTime != nil && excludeAfter.Before(*accelerator.CreatedTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(accelerator.Name),
+		configObj.GlobalAccelerator.IncludeRule.NamesRegExp,
+		configObj.GlobalAccelerator.ExcludeRule.NamesRegExp,
+	)
+}
+
+// waitForGlobalAcceleratorToBeDeployed waits for pending changes to the accelerator, such as disabling it, to be
+// deployed, as an accelerator can only be deleted once it is disabled and deployed.
+func waitForGlobalAcceleratorToBeDeployed(svc globalacceleratoriface.GlobalAcceleratorAPI, acceleratorArn *string) error {
+	for i := 0; i < 60; i++ {
+		output, err := svc.DescribeAccelerator(&globalaccelerator.DescribeAcceleratorInput{AcceleratorArn: acceleratorArn})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		if aws.StringValue(output.Accelerator.Status) == globalaccelerator.AcceleratorStatusDeployed {
+			return nil
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for Global Accelerator %s to be deployed...", aws.StringValue(acceleratorArn))
+	}
+
+	return GlobalAcceleratorDeployTimeoutError{acceleratorArn: aws.StringValue(acceleratorArn)}
+}
+
+// deleteGlobalAcceleratorListeners deletes the endpoint groups of every listener of the accelerator, and then the
+// listeners themselves.
+func deleteGlobalAcceleratorListeners(svc globalacceleratoriface.GlobalAcceleratorAPI, acceleratorArn *string) error {
+	var listenerArns []*string
+	err := svc.ListListenersPages(&globalaccelerator.ListListenersInput{AcceleratorArn: acceleratorArn}, func(page *globalaccelerator.ListListenersOutput, lastPage bool) bool {
+		for _, listener := range page.Listeners {
+			listenerArns = append(listenerArns, listener.ListenerArn)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, listenerArn := range listenerArns {
+		var endpointGroupArns []*string
+		err := svc.ListEndpointGroupsPages(&globalaccelerator.ListEndpointGroupsInput{ListenerArn: listenerArn}, func(page *globalaccelerator.ListEndpointGroupsOutput, lastPage bool) bool {
+			for _, endpointGroup := range page.EndpointGroups {
+				endpointGroupArns = append(endpointGroupArns, endpointGroup.EndpointGroupArn)
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		for _, endpointGroupArn := range endpointGroupArns {
+			if _, err := svc.DeleteEndpointGroup(&globalaccelerator.DeleteEndpointGroupInput{EndpointGroupArn: endpointGroupArn}); err != nil {
+				return errors.WithStackTrace(err)
+			}
+			logging.Logger.Debugf("Deleted Global Accelerator endpoint group %s", aws.StringValue(endpointGroupArn))
+		}
+
+		if _, err := svc.DeleteListener(&globalaccelerator.DeleteListenerInput{ListenerArn: listenerArn}); err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted Global Accelerator listener %s", aws.StringValue(listenerArn))
+	}
+	return nil
+}
+
+// nukeGlobalAccelerator disables the accelerator, waits for that to be deployed, deletes its listeners and endpoint
+// groups and finally deletes the accelerator.
+func nukeGlobalAccelerator(svc globalacceleratoriface.GlobalAcceleratorAPI, acceleratorArn *string) error {
+	_, err := svc.UpdateAccelerator(&globalaccelerator.UpdateAcceleratorInput{
+		AcceleratorArn: acceleratorArn,
+		Enabled:        aws.Bool(false),
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if err := waitForGlobalAcceleratorToBeDeployed(svc, acceleratorArn); err != nil {
+		return err
+	}
+
+	if err := deleteGlobalAcceleratorListeners(svc, acceleratorArn); err != nil {
+		return err
+	}
+
+	_, err = svc.DeleteAccelerator(&globalaccelerator.DeleteAcceleratorInput{AcceleratorArn: acceleratorArn})
+	return errors.WithStackTrace(err)
+}
+
+// Deletes all Global Accelerators
+func nukeAllGlobalAccelerators(session *session.Session, acceleratorArns []*string) error {
+	svc := newGlobalAcceleratorClient(session)
+
+	if len(acceleratorArns) == 0 {
+		logging.Logger.Debugf("No Global Accelerators to nuke")
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Global Accelerators")
+	var deletedArns []*string
+	var allErrs *multierror.Error
+
+	for _, acceleratorArn := range acceleratorArns {
+		err := nukeGlobalAccelerator(svc, acceleratorArn)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(acceleratorArn),
+			ResourceType: "Global Accelerator",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Global Accelerator",
+			}, map[string]interface{}{
+				"region": "global",
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedArns = append(deletedArns, acceleratorArn)
+			logging.Logger.Debugf("Deleted Global Accelerator: %s", aws.StringValue(acceleratorArn))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Global Accelerator(s) deleted", len(deletedArns))
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
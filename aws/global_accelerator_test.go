@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator/globalacceleratoriface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedGlobalAccelerator serves an accelerator with one listener and one endpoint group, and records the calls made
+type mockedGlobalAccelerator struct {
+	globalacceleratoriface.GlobalAcceleratorAPI
+	Calls []string
+}
+
+func (m *mockedGlobalAccelerator) UpdateAccelerator(input *globalaccelerator.UpdateAcceleratorInput) (*globalaccelerator.UpdateAcceleratorOutput, error) {
+	if !aws.BoolValue(input.Enabled) {
+		m.Calls = append(m.Calls, "disable")
+	}
+	return &globalaccelerator.UpdateAcceleratorOutput{}, nil
+}
+
+func (m *mockedGlobalAccelerator) DescribeAccelerator(input *globalaccelerator.DescribeAcceleratorInput) (*globalaccelerator.DescribeAcceleratorOutput, error) {
+	return &globalaccelerator.DescribeAcceleratorOutput{Accelerator: &globalaccelerator.Accelerator{
+		Status: aws.String(globalaccelerator.AcceleratorStatusDeployed),
+	}}, nil
+}
+
+func (m *mockedGlobalAccelerator) ListListenersPages(input *globalaccelerator.ListListenersInput, fn func(*globalaccelerator.ListListenersOutput, bool) bool) error {
+	fn(&globalaccelerator.ListListenersOutput{Listeners: []*globalaccelerator.Listener{{ListenerArn: aws.String("listener")}}}, true)
+	return nil
+}
+
+func (m *mockedGlobalAccelerator) ListEndpointGroupsPages(input *globalaccelerator.ListEndpointGroupsInput, fn func(*globalaccelerator.ListEndpointGroupsOutput, bool) bool) error {
+	fn(&globalaccelerator.ListEndpointGroupsOutput{EndpointGroups: []*globalaccelerator.EndpointGroup{{EndpointGroupArn: aws.String("endpoint-group")}}}, true)
+	return nil
+}
+
+func (m *mockedGlobalAccelerator) DeleteEndpointGroup(input *globalaccelerator.DeleteEndpointGroupInput) (*globalaccelerator.DeleteEndpointGroupOutput, error) {
+	m.Calls = append(m.Calls, "delete:"+aws.StringValue(input.EndpointGroupArn))
+	return &globalaccelerator.DeleteEndpointGroupOutput{}, nil
+}
+
+func (m *mockedGlobalAccelerator) DeleteListener(input *globalaccelerator.DeleteListenerInput) (*globalaccelerator.DeleteListenerOutput, error) {
+	m.Calls = append(m.Calls, "delete:"+aws.StringValue(input.ListenerArn))
+	return &globalaccelerator.DeleteListenerOutput{}, nil
+}
+
+func (m *mockedGlobalAccelerator) DeleteAccelerator(input *globalaccelerator.DeleteAcceleratorInput) (*globalaccelerator.DeleteAcceleratorOutput, error) {
+	m.Calls = append(m.Calls, "delete:"+aws.StringValue(input.AcceleratorArn))
+	return &globalaccelerator.DeleteAcceleratorOutput{}, nil
+}
+
+func TestNukeGlobalAcceleratorOrder(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedGlobalAccelerator{}
+	require.NoError(t, nukeGlobalAccelerator(mock, aws.String("accelerator")))
+	assert.Equal(t, []string{"disable", "delete:endpoint-group", "delete:listener", "delete:accelerator"}, mock.Calls)
+}
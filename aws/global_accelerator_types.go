@@ -0,0 +1,44 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// GlobalAccelerators - represents all Global Accelerator accelerators
+type GlobalAccelerators struct {
+	AcceleratorArns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (accelerators GlobalAccelerators) ResourceName() string {
+	return "global-accelerator"
+}
+
+// ResourceIdentifiers - The ARNs of the accelerators
+func (accelerators GlobalAccelerators) ResourceIdentifiers() []string {
+	return accelerators.AcceleratorArns
+}
+
+func (accelerators GlobalAccelerators) MaxBatchSize() int {
+	// Disabling an accelerator takes a few minutes to deploy, so keep batches small
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (accelerators GlobalAccelerators) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllGlobalAccelerators(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type GlobalAcceleratorDeployTimeoutError struct {
+	acceleratorArn string
+}
+
+func (e GlobalAcceleratorDeployTimeoutError) Error() string {
+	return "Timed out waiting for Global Accelerator " + e.acceleratorArn + " to be deployed"
+}
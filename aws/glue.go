@@ -0,0 +1,213 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/glue/glueiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// The default Glue database is created on demand by services such as Athena and EMR, so it is never nuked
+const defaultGlueDatabaseName = "default"
+
+// shouldIncludeGlueResource applies the excludeAfter and config name filters shared by all the Glue resources.
+func shouldIncludeGlueResource(name string, createdAt *time.Time, excludeAfter time.Time, resourceType config.ResourceType) bool {
+	if name == "" {
+		return false
+	}
+
+	if createdAt != nil && excludeAfter.Before(*createdAt) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		name,
+		resourceType.IncludeRule.NamesRegExp,
+		resourceType.ExcludeRule.NamesRegExp,
+	)
+}
+
+func getAllGlueJobs(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := glue.New(session)
+
+	var names []*string
+	err := svc.GetJobsPages(&glue.GetJobsInput{}, func(page *glue.GetJobsOutput, lastPage bool) bool {
+		for _, job := range page.Jobs {
+			if shouldIncludeGlueResource(aws.StringValue(job.Name), job.CreatedOn, excludeAfter, configObj.GlueJob) {
+				names = append(names, job.Name)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return names, nil
+}
+
+func getAllGlueCrawlers(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := glue.New(session)
+
+	var names []*string
+	err := svc.GetCrawlersPages(&glue.GetCrawlersInput{}, func(page *glue.GetCrawlersOutput, lastPage bool) bool {
+		for _, crawler := range page.Crawlers {
+			if shouldIncludeGlueResource(aws.StringValue(crawler.Name), crawler.CreationTime, excludeAfter, configObj.GlueCrawler) {
+				names = append(names, crawler.Name)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return names, nil
+}
+
+func getAllGlueDatabases(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := glue.New(session)
+
+	var names []*string
+	err := svc.GetDatabasesPages(&glue.GetDatabasesInput{}, func(page *glue.GetDatabasesOutput, lastPage bool) bool {
+		for _, database := range page.DatabaseList {
+			if aws.StringValue(database.Name) == defaultGlueDatabaseName {
+				continue
+			}
+			if shouldIncludeGlueResource(aws.StringValue(database.Name), database.CreateTime, excludeAfter, configObj.GlueDatabase) {
+				names = append(names, database.Name)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return names, nil
+}
+
+func getAllGlueDevEndpoints(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := glue.New(session)
+
+	var names []*string
+	err := svc.GetDevEndpointsPages(&glue.GetDevEndpointsInput{}, func(page *glue.GetDevEndpointsOutput, lastPage bool) bool {
+		for _, endpoint := range page.DevEndpoints {
+			if shouldIncludeGlueResource(aws.StringValue(endpoint.EndpointName), endpoint.CreatedTimestamp, excludeAfter, configObj.GlueDevEndpoint) {
+				names = append(names, endpoint.EndpointName)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return names, nil
+}
+
+// stopGlueCrawlerIfRunning stops the crawler and waits for it to be ready, as running crawlers can't be deleted.
+func stopGlueCrawlerIfRunning(svc glueiface.GlueAPI, name *string) error {
+	for i := 0; i < 30; i++ {
+		result, err := svc.GetCrawler(&glue.GetCrawlerInput{Name: name})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		switch aws.StringValue(result.Crawler.State) {
+		case glue.CrawlerStateReady:
+			return nil
+		case glue.CrawlerStateRunning:
+			logging.Logger.Debugf("Stopping running Glue crawler %s", aws.StringValue(name))
+			if _, err := svc.StopCrawler(&glue.StopCrawlerInput{Name: name}); err != nil {
+				return errors.WithStackTrace(err)
+			}
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for Glue crawler %s to stop...", aws.StringValue(name))
+	}
+
+	return GlueCrawlerStopTimeoutError{name: aws.StringValue(name)}
+}
+
+func nukeAllGlueJobs(session *session.Session, names []*string) error {
+	return nukeGlueResources(session, "Glue Job", names, func(svc glueiface.GlueAPI, name *string) error {
+		_, err := svc.DeleteJob(&glue.DeleteJobInput{JobName: name})
+		return err
+	})
+}
+
+func nukeAllGlueCrawlers(session *session.Session, names []*string) error {
+	return nukeGlueResources(session, "Glue Crawler", names, func(svc glueiface.GlueAPI, name *string) error {
+		if err := stopGlueCrawlerIfRunning(svc, name); err != nil {
+			return err
+		}
+		_, err := svc.DeleteCrawler(&glue.DeleteCrawlerInput{Name: name})
+		return err
+	})
+}
+
+// nukeAllGlueDatabases deletes the given databases. Deleting a database also deletes all the tables and partitions
+// it contains.
+func nukeAllGlueDatabases(session *session.Session, names []*string) error {
+	return nukeGlueResources(session, "Glue Database", names, func(svc glueiface.GlueAPI, name *string) error {
+		_, err := svc.DeleteDatabase(&glue.DeleteDatabaseInput{Name: name})
+		return err
+	})
+}
+
+func nukeAllGlueDevEndpoints(session *session.Session, names []*string) error {
+	return nukeGlueResources(session, "Glue Dev Endpoint", names, func(svc glueiface.GlueAPI, name *string) error {
+		_, err := svc.DeleteDevEndpoint(&glue.DeleteDevEndpointInput{EndpointName: name})
+		return err
+	})
+}
+
+// nukeGlueResources deletes the given Glue resources one by one using deleteFn, recording each of them in the report.
+func nukeGlueResources(session *session.Session, resourceType string, names []*string, deleteFn func(svc glueiface.GlueAPI, name *string) error) error {
+	svc := glue.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		err := deleteFn(svc, name)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedNames), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/glue/glueiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedGlueCrawler reports the given states in order, one per GetCrawler call
+type mockedGlueCrawler struct {
+	glueiface.GlueAPI
+	States  []string
+	Stopped int
+}
+
+func (m *mockedGlueCrawler) GetCrawler(input *glue.GetCrawlerInput) (*glue.GetCrawlerOutput, error) {
+	state := m.States[0]
+	if len(m.States) > 1 {
+		m.States = m.States[1:]
+	}
+	return &glue.GetCrawlerOutput{Crawler: &glue.Crawler{Name: input.Name, State: aws.String(state)}}, nil
+}
+
+func (m *mockedGlueCrawler) StopCrawler(input *glue.StopCrawlerInput) (*glue.StopCrawlerOutput, error) {
+	m.Stopped++
+	return &glue.StopCrawlerOutput{}, nil
+}
+
+func TestStopGlueCrawlerIfRunning(t *testing.T) {
+	t.Parallel()
+
+	ready := &mockedGlueCrawler{States: []string{glue.CrawlerStateReady}}
+	require.NoError(t, stopGlueCrawlerIfRunning(ready, aws.String("cloud-nuke-test")))
+	assert.Equal(t, 0, ready.Stopped)
+
+	running := &mockedGlueCrawler{States: []string{glue.CrawlerStateRunning, glue.CrawlerStateReady}}
+	require.NoError(t, stopGlueCrawlerIfRunning(running, aws.String("cloud-nuke-test")))
+	assert.Equal(t, 1, running.Stopped)
+}
+
+func TestShouldIncludeGlueResource(t *testing.T) {
+	now := time.Now()
+	excludeConfig := config.ResourceType{
+		ExcludeRule: config.FilterRule{
+			NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^cloud-nuke-")}},
+		},
+	}
+
+	assert.True(t, shouldIncludeGlueResource("cloud-nuke-test", aws.Time(now), now.Add(1*time.Hour), config.ResourceType{}))
+	assert.True(t, shouldIncludeGlueResource("cloud-nuke-test", nil, now.Add(1*time.Hour), config.ResourceType{}))
+	assert.False(t, shouldIncludeGlueResource("cloud-nuke-test", aws.Time(now), now.Add(-1*time.Hour), config.ResourceType{}))
+	assert.False(t, shouldIncludeGlueResource("cloud-nuke-test", aws.Time(now), now.Add(1*time.Hour), excludeConfig))
+	assert.False(t, shouldIncludeGlueResource("", aws.Time(now), now.Add(1*time.Hour), config.ResourceType{}))
+}
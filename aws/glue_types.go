@@ -0,0 +1,131 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// GlueJobs - represents all Glue jobs
+type GlueJobs struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (jobs GlueJobs) ResourceName() string {
+	return "glue-job"
+}
+
+// ResourceIdentifiers - The names of the Glue jobs
+func (jobs GlueJobs) ResourceIdentifiers() []string {
+	return jobs.Names
+}
+
+func (jobs GlueJobs) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (jobs GlueJobs) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllGlueJobs(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// GlueCrawlers - represents all Glue crawlers
+type GlueCrawlers struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (crawlers GlueCrawlers) ResourceName() string {
+	return "glue-crawler"
+}
+
+// ResourceIdentifiers - The names of the Glue crawlers
+func (crawlers GlueCrawlers) ResourceIdentifiers() []string {
+	return crawlers.Names
+}
+
+func (crawlers GlueCrawlers) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (crawlers GlueCrawlers) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllGlueCrawlers(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// GlueDatabases - represents all Glue databases
+type GlueDatabases struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (databases GlueDatabases) ResourceName() string {
+	return "glue-database"
+}
+
+// ResourceIdentifiers - The names of the Glue databases
+func (databases GlueDatabases) ResourceIdentifiers() []string {
+	return databases.Names
+}
+
+func (databases GlueDatabases) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (databases GlueDatabases) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllGlueDatabases(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// GlueDevEndpoints - represents all Glue dev endpoints
+type GlueDevEndpoints struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (endpoints GlueDevEndpoints) ResourceName() string {
+	return "glue-dev-endpoint"
+}
+
+// ResourceIdentifiers - The names of the Glue dev endpoints
+func (endpoints GlueDevEndpoints) ResourceIdentifiers() []string {
+	return endpoints.Names
+}
+
+func (endpoints GlueDevEndpoints) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (endpoints GlueDevEndpoints) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllGlueDevEndpoints(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type GlueCrawlerStopTimeoutError struct {
+	name string
+}
+
+func (e GlueCrawlerStopTimeoutError) Error() string {
+	return "Timed out waiting for Glue crawler " + e.name + " to stop"
+}
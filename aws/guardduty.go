@@ -9,12 +9,16 @@ import (
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/guardduty/guarddutyiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
 	"github.com/gruntwork-io/go-commons/errors"
 )
 
+// DisassociateMembers and DeleteMembers accept at most this many account IDs per call
+const guardDutyMembersBatchLimit = 50
+
 type DetectorOutputWithID struct {
 	ID     *string
 	Output *guardduty.GetDetectorOutput
@@ -93,6 +97,46 @@ func shouldIncludeDetector(detector *DetectorOutputWithID, excludeAfter time.Tim
 	return true
 }
 
+// removeGuardDutyMembers disassociates and deletes the member accounts of the detector, as the detector of an
+// administrator account can't be deleted while it still has members.
+func removeGuardDutyMembers(svc guarddutyiface.GuardDutyAPI, detectorId *string) error {
+	var accountIds []string
+	input := &guardduty.ListMembersInput{
+		DetectorId: detectorId,
+		// Also list the members that were invited but never accepted
+		OnlyAssociated: aws.String("false"),
+	}
+	err := svc.ListMembersPages(input, func(page *guardduty.ListMembersOutput, lastPage bool) bool {
+		for _, member := range page.Members {
+			accountIds = append(accountIds, aws.StringValue(member.AccountId))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, batch := range split(accountIds, guardDutyMembersBatchLimit) {
+		_, err := svc.DisassociateMembers(&guardduty.DisassociateMembersInput{
+			DetectorId: detectorId,
+			AccountIds: aws.StringSlice(batch),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		_, err = svc.DeleteMembers(&guardduty.DeleteMembersInput{
+			DetectorId: detectorId,
+			AccountIds: aws.StringSlice(batch),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Removed %d member account(s) from GuardDuty detector %s", len(batch), aws.StringValue(detectorId))
+	}
+	return nil
+}
+
 func nukeAllGuardDutyDetectors(session *session.Session, detectorIds []string) error {
 	svc := guardduty.New(session)
 
@@ -107,11 +151,14 @@ func nukeAllGuardDutyDetectors(session *session.Session, detectorIds []string) e
 	deletedIds := []string{}
 
 	for _, detectorId := range detectorIds {
-		params := &guardduty.DeleteDetectorInput{
-			DetectorId: aws.String(detectorId),
-		}
+		err := removeGuardDutyMembers(svc, aws.String(detectorId))
+		if err == nil {
+			params := &guardduty.DeleteDetectorInput{
+				DetectorId: aws.String(detectorId),
+			}
 
-		_, err := svc.DeleteDetector(params)
+			_, err = svc.DeleteDetector(params)
+		}
 
 		// Record status of this resource
 		e := report.Entry{
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	"strings"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/guardduty/guarddutyiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/go-commons/errors"
 	"github.com/stretchr/testify/assert"
@@ -176,3 +178,33 @@ func TestNukeGuardDutyDetectorMoreThanOne(t *testing.T) {
 	require.NoError(t, lookupErr)
 	require.Equal(t, 0, len(detectorIdsPostNuke2))
 }
+
+type mockedGuardDuty struct {
+	guarddutyiface.GuardDutyAPI
+	Calls []string
+}
+
+func (m *mockedGuardDuty) ListMembersPages(input *guardduty.ListMembersInput, fn func(*guardduty.ListMembersOutput, bool) bool) error {
+	fn(&guardduty.ListMembersOutput{Members: []*guardduty.Member{{AccountId: aws.String("111111111111")}}}, false)
+	fn(&guardduty.ListMembersOutput{Members: []*guardduty.Member{{AccountId: aws.String("222222222222")}}}, true)
+	return nil
+}
+
+func (m *mockedGuardDuty) DisassociateMembers(input *guardduty.DisassociateMembersInput) (*guardduty.DisassociateMembersOutput, error) {
+	m.Calls = append(m.Calls, "DisassociateMembers:"+strings.Join(aws.StringValueSlice(input.AccountIds), ","))
+	return &guardduty.DisassociateMembersOutput{}, nil
+}
+
+func (m *mockedGuardDuty) DeleteMembers(input *guardduty.DeleteMembersInput) (*guardduty.DeleteMembersOutput, error) {
+	m.Calls = append(m.Calls, "DeleteMembers:"+strings.Join(aws.StringValueSlice(input.AccountIds), ","))
+	return &guardduty.DeleteMembersOutput{}, nil
+}
+
+func TestRemoveGuardDutyMembersDisassociatesBeforeDeleting(t *testing.T) {
+	mock := &mockedGuardDuty{}
+	require.NoError(t, removeGuardDutyMembers(mock, aws.String("detector")))
+	assert.Equal(t, []string{
+		"DisassociateMembers:111111111111,222222222222",
+		"DeleteMembers:111111111111,222222222222",
+	}, mock.Calls)
+}
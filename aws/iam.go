@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
@@ -21,20 +23,33 @@ import (
 // List all IAM users in the AWS account and returns a slice of the UserNames
 func getAllIamUsers(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
 	svc := iam.New(session)
-	input := &iam.ListUsersInput{}
 
-	var userNames []*string
-
-	// TODO: Probably use ListUsers together with ListUsersPages in case there are lots of users
-	output, err := svc.ListUsers(input)
+	var users []*iam.User
+	err := svc.ListUsersPages(&iam.ListUsersInput{}, func(page *iam.ListUsersOutput, lastPage bool) bool {
+		for _, user := range page.Users {
+			if shouldIncludeIAMUser(user, excludeAfter, configObj) {
+				users = append(users, user)
+			}
+		}
+		return !lastPage
+	})
 	if err != nil {
 		return nil, errors.WithStackTrace(err)
 	}
 
-	for _, user := range output.Users {
-		if config.ShouldInclude(aws.StringValue(user.UserName), configObj.IAMUsers.IncludeRule.NamesRegExp, configObj.IAMUsers.ExcludeRule.NamesRegExp) && excludeAfter.After(*user.CreateDate) && !hasIAMExcludeTag(user) {
-			userNames = append(userNames, user.UserName)
-		} else if !hasIAMExcludeTag(user) {
+	var userNames []*string
+	for _, user := range users {
+		// ListUsers doesn't return the tags of the users
+		var tags []*iam.Tag
+		err := svc.ListUserTagsPages(&iam.ListUserTagsInput{UserName: user.UserName}, func(page *iam.ListUserTagsOutput, lastPage bool) bool {
+			tags = append(tags, page.Tags...)
+			return !lastPage
+		})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if !hasIAMExcludeTag(tags) {
 			userNames = append(userNames, user.UserName)
 		}
 	}
@@ -42,27 +57,48 @@ func getAllIamUsers(session *session.Session, excludeAfter time.Time, configObj
 	return userNames, nil
 }
 
+func shouldIncludeIAMUser(user *iam.User, excludeAfter time.Time, configObj config.Config) bool {
+	if user == nil {
+		return false
+	}
+
+	if user.CreateDate != nil && excludeAfter.Before(*user.CreateDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(user.UserName),
+		configObj.IAMUsers.IncludeRule.NamesRegExp,
+		configObj.IAMUsers.ExcludeRule.NamesRegExp,
+	)
+}
+
 // hasIAMExcludeTag checks whether the exlude tag is set for a resource to skip deleting it.
-func hasIAMExcludeTag(user *iam.User) bool {
-	// Exclude deletion of any buckets with cloud-nuke-excluded tags
-	for _, tag := range user.Tags {
-		if *tag.Key == AwsResourceExclusionTagKey && *tag.Value == "true" {
+func hasIAMExcludeTag(tags []*iam.Tag) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
 			return true
 		}
 	}
 	return false
 }
 
-func detachUserPolicies(svc *iam.IAM, userName *string) error {
-	policiesOutput, err := svc.ListAttachedUserPolicies(&iam.ListAttachedUserPoliciesInput{
-		UserName: userName,
-	})
+func detachUserPolicies(svc iamiface.IAMAPI, userName *string) error {
+	var policyArns []*string
+	err := svc.ListAttachedUserPoliciesPages(
+		&iam.ListAttachedUserPoliciesInput{UserName: userName},
+		func(page *iam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
+			for _, attachedPolicy := range page.AttachedPolicies {
+				policyArns = append(policyArns, attachedPolicy.PolicyArn)
+			}
+			return !lastPage
+		},
+	)
 	if err != nil {
 		return errors.WithStackTrace(err)
 	}
 
-	for _, attachedPolicy := range policiesOutput.AttachedPolicies {
-		arn := attachedPolicy.PolicyArn
+	for _, arn := range policyArns {
 		_, err = svc.DetachUserPolicy(&iam.DetachUserPolicyInput{
 			PolicyArn: arn,
 			UserName:  userName,
@@ -77,16 +113,21 @@ func detachUserPolicies(svc *iam.IAM, userName *string) error {
 	return nil
 }
 
-func deleteInlineUserPolicies(svc *iam.IAM, userName *string) error {
-	policyOutput, err := svc.ListUserPolicies(&iam.ListUserPoliciesInput{
-		UserName: userName,
-	})
+func deleteInlineUserPolicies(svc iamiface.IAMAPI, userName *string) error {
+	var policyNames []*string
+	err := svc.ListUserPoliciesPages(
+		&iam.ListUserPoliciesInput{UserName: userName},
+		func(page *iam.ListUserPoliciesOutput, lastPage bool) bool {
+			policyNames = append(policyNames, page.PolicyNames...)
+			return !lastPage
+		},
+	)
 	if err != nil {
 		logging.Logger.Errorf("[Failed] %s", err)
 		return errors.WithStackTrace(err)
 	}
 
-	for _, policyName := range policyOutput.PolicyNames {
+	for _, policyName := range policyNames {
 		_, err := svc.DeleteUserPolicy(&iam.DeleteUserPolicyInput{
 			PolicyName: policyName,
 			UserName:   userName,
@@ -101,15 +142,20 @@ func deleteInlineUserPolicies(svc *iam.IAM, userName *string) error {
 	return nil
 }
 
-func removeUserFromGroups(svc *iam.IAM, userName *string) error {
-	groupsOutput, err := svc.ListGroupsForUser(&iam.ListGroupsForUserInput{
-		UserName: userName,
-	})
+func removeUserFromGroups(svc iamiface.IAMAPI, userName *string) error {
+	var groups []*iam.Group
+	err := svc.ListGroupsForUserPages(
+		&iam.ListGroupsForUserInput{UserName: userName},
+		func(page *iam.ListGroupsForUserOutput, lastPage bool) bool {
+			groups = append(groups, page.Groups...)
+			return !lastPage
+		},
+	)
 	if err != nil {
 		return errors.WithStackTrace(err)
 	}
 
-	for _, group := range groupsOutput.Groups {
+	for _, group := range groups {
 		_, err := svc.RemoveUserFromGroup(&iam.RemoveUserFromGroupInput{
 			GroupName: group.GroupName,
 			UserName:  userName,
@@ -124,7 +170,7 @@ func removeUserFromGroups(svc *iam.IAM, userName *string) error {
 	return nil
 }
 
-func deleteLoginProfile(svc *iam.IAM, userName *string) error {
+func deleteLoginProfile(svc iamiface.IAMAPI, userName *string) error {
 	return retry.DoWithRetry(
 		logging.Logger,
 		"Delete Login Profile",
@@ -158,16 +204,21 @@ func deleteLoginProfile(svc *iam.IAM, userName *string) error {
 		})
 }
 
-func deleteAccessKeys(svc *iam.IAM, userName *string) error {
-	output, err := svc.ListAccessKeys(&iam.ListAccessKeysInput{
-		UserName: userName,
-	})
+func deleteAccessKeys(svc iamiface.IAMAPI, userName *string) error {
+	var accessKeys []*iam.AccessKeyMetadata
+	err := svc.ListAccessKeysPages(
+		&iam.ListAccessKeysInput{UserName: userName},
+		func(page *iam.ListAccessKeysOutput, lastPage bool) bool {
+			accessKeys = append(accessKeys, page.AccessKeyMetadata...)
+			return !lastPage
+		},
+	)
 	if err != nil {
 		logging.Logger.Debugf("[Failed] %s", err)
 		return errors.WithStackTrace(err)
 	}
 
-	for _, md := range output.AccessKeyMetadata {
+	for _, md := range accessKeys {
 		accessKeyId := md.AccessKeyId
 		_, err := svc.DeleteAccessKey(&iam.DeleteAccessKeyInput{
 			AccessKeyId: accessKeyId,
@@ -184,16 +235,21 @@ func deleteAccessKeys(svc *iam.IAM, userName *string) error {
 	return nil
 }
 
-func deleteSigningCertificate(svc *iam.IAM, userName *string) error {
-	output, err := svc.ListSigningCertificates(&iam.ListSigningCertificatesInput{
-		UserName: userName,
-	})
+func deleteSigningCertificate(svc iamiface.IAMAPI, userName *string) error {
+	var certificates []*iam.SigningCertificate
+	err := svc.ListSigningCertificatesPages(
+		&iam.ListSigningCertificatesInput{UserName: userName},
+		func(page *iam.ListSigningCertificatesOutput, lastPage bool) bool {
+			certificates = append(certificates, page.Certificates...)
+			return !lastPage
+		},
+	)
 	if err != nil {
 		logging.Logger.Errorf("[Failed] %s", err)
 		return errors.WithStackTrace(err)
 	}
 
-	for _, cert := range output.Certificates {
+	for _, cert := range certificates {
 		certificateId := cert.CertificateId
 		_, err := svc.DeleteSigningCertificate(&iam.DeleteSigningCertificateInput{
 			CertificateId: certificateId,
@@ -210,16 +266,21 @@ func deleteSigningCertificate(svc *iam.IAM, userName *string) error {
 	return nil
 }
 
-func deleteSSHPublicKeys(svc *iam.IAM, userName *string) error {
-	output, err := svc.ListSSHPublicKeys(&iam.ListSSHPublicKeysInput{
-		UserName: userName,
-	})
+func deleteSSHPublicKeys(svc iamiface.IAMAPI, userName *string) error {
+	var keys []*iam.SSHPublicKeyMetadata
+	err := svc.ListSSHPublicKeysPages(
+		&iam.ListSSHPublicKeysInput{UserName: userName},
+		func(page *iam.ListSSHPublicKeysOutput, lastPage bool) bool {
+			keys = append(keys, page.SSHPublicKeys...)
+			return !lastPage
+		},
+	)
 	if err != nil {
 		logging.Logger.Errorf("[Failed] %s", err)
 		return errors.WithStackTrace(err)
 	}
 
-	for _, key := range output.SSHPublicKeys {
+	for _, key := range keys {
 		keyId := key.SSHPublicKeyId
 		_, err := svc.DeleteSSHPublicKey(&iam.DeleteSSHPublicKeyInput{
 			SSHPublicKeyId: keyId,
@@ -236,7 +297,7 @@ func deleteSSHPublicKeys(svc *iam.IAM, userName *string) error {
 	return nil
 }
 
-func deleteServiceSpecificCredentials(svc *iam.IAM, userName *string) error {
+func deleteServiceSpecificCredentials(svc iamiface.IAMAPI, userName *string) error {
 	services := []string{
 		"cassandra.amazonaws.com",
 		"codecommit.amazonaws.com",
@@ -270,17 +331,22 @@ func deleteServiceSpecificCredentials(svc *iam.IAM, userName *string) error {
 	return nil
 }
 
-func deleteMFADevices(svc *iam.IAM, userName *string) error {
-	output, err := svc.ListMFADevices(&iam.ListMFADevicesInput{
-		UserName: userName,
-	})
+func deleteMFADevices(svc iamiface.IAMAPI, userName *string) error {
+	var devices []*iam.MFADevice
+	err := svc.ListMFADevicesPages(
+		&iam.ListMFADevicesInput{UserName: userName},
+		func(page *iam.ListMFADevicesOutput, lastPage bool) bool {
+			devices = append(devices, page.MFADevices...)
+			return !lastPage
+		},
+	)
 	if err != nil {
 		logging.Logger.Errorf("[Failed] %s", err)
 		return errors.WithStackTrace(err)
 	}
 
 	// First we need to deactivate the devices
-	for _, device := range output.MFADevices {
+	for _, device := range devices {
 		serialNumber := device.SerialNumber
 
 		_, err := svc.DeactivateMFADevice(&iam.DeactivateMFADeviceInput{
@@ -292,12 +358,16 @@ func deleteMFADevices(svc *iam.IAM, userName *string) error {
 			return errors.WithStackTrace(err)
 		}
 
-		logging.Logger.Debugf("Deactivated Virtual MFA Device with ID %s from user %s", aws.StringValue(serialNumber), aws.StringValue(userName))
+		logging.Logger.Debugf("Deactivated MFA Device with ID %s from user %s", aws.StringValue(serialNumber), aws.StringValue(userName))
 	}
 
-	// After their deactivation we can delete them
-	for _, device := range output.MFADevices {
+	// After their deactivation we can delete them. Hardware devices only need to be deactivated, and only virtual
+	// devices, whose serial number is an ARN, can be deleted.
+	for _, device := range devices {
 		serialNumber := device.SerialNumber
+		if !strings.HasPrefix(aws.StringValue(serialNumber), "arn:") {
+			continue
+		}
 
 		_, err := svc.DeleteVirtualMFADevice(&iam.DeleteVirtualMFADeviceInput{
 			SerialNumber: serialNumber,
@@ -313,7 +383,7 @@ func deleteMFADevices(svc *iam.IAM, userName *string) error {
 	return nil
 }
 
-func deleteUser(svc *iam.IAM, userName *string) error {
+func deleteUser(svc iamiface.IAMAPI, userName *string) error {
 	_, err := svc.DeleteUser(&iam.DeleteUserInput{
 		UserName: userName,
 	})
@@ -325,12 +395,12 @@ func deleteUser(svc *iam.IAM, userName *string) error {
 }
 
 // Nuke a single user
-func nukeUser(svc *iam.IAM, userName *string) error {
+func nukeUser(svc iamiface.IAMAPI, userName *string) error {
 	// Functions used to really nuke an IAM User as a user can have many attached
 	// items we need delete/detach them before actually deleting it.
 	// NOTE: The actual user deletion should always be the last one. This way we
 	// can guarantee that it will fail if we forgot to delete/detach an item.
-	functions := []func(svc *iam.IAM, userName *string) error{
+	functions := []func(svc iamiface.IAMAPI, userName *string) error{
 		detachUserPolicies, // TODO: Add CLI option to delete the Policy as policies exist independently of the user
 		deleteInlineUserPolicies,
 		removeUserFromGroups, // TODO: Add CLI option to delete groups as groups exist independently of the user
@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/collections"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// List the IAM instance profiles that are left without a role, either because they no longer contain one or because
+// all of their roles are among the given roles being nuked. Leftover instance profiles keep their name taken, which
+// prevents recreating a role with the same name along with its instance profile.
+func getAllIamInstanceProfiles(session *session.Session, excludeAfter time.Time, configObj config.Config, nukedRoleNames []string) ([]*string, error) {
+	svc := iam.New(session)
+
+	var profiles []*iam.InstanceProfile
+	err := svc.ListInstanceProfilesPages(
+		&iam.ListInstanceProfilesInput{},
+		func(page *iam.ListInstanceProfilesOutput, lastPage bool) bool {
+			for _, profile := range page.InstanceProfiles {
+				if shouldIncludeIamInstanceProfile(profile, excludeAfter, configObj, nukedRoleNames) {
+					profiles = append(profiles, profile)
+				}
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var profileNames []*string
+	for _, profile := range profiles {
+		// ListInstanceProfiles doesn't return the tags of the instance profiles
+		var tags []*iam.Tag
+		input := &iam.ListInstanceProfileTagsInput{InstanceProfileName: profile.InstanceProfileName}
+		for {
+			output, err := svc.ListInstanceProfileTags(input)
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			tags = append(tags, output.Tags...)
+
+			if !aws.BoolValue(output.IsTruncated) {
+				break
+			}
+			input.Marker = output.Marker
+		}
+
+		if !hasIAMExcludeTag(tags) {
+			profileNames = append(profileNames, profile.InstanceProfileName)
+		}
+	}
+	return profileNames, nil
+}
+
+func shouldIncludeIamInstanceProfile(profile *iam.InstanceProfile, excludeAfter time.Time, configObj config.Config, nukedRoleNames []string) bool {
+	if profile == nil {
+		return false
+	}
+
+	for _, role := range profile.Roles {
+		if !collections.ListContainsElement(nukedRoleNames, aws.StringValue(role.RoleName)) {
+			return false
+		}
+	}
+
+	if profile.CreateDate != nil && excludeAfter.Before(*profile.CreateDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(profile.InstanceProfileName),
+		configObj.IAMInstanceProfiles.IncludeRule.NamesRegExp,
+		configObj.IAMInstanceProfiles.ExcludeRule.NamesRegExp,
+	)
+}
+
+// deleteIamInstanceProfile removes the roles left in the instance profile and deletes it. Nuking a role already
+// deletes its instance profiles, so instance profiles that are gone by now are considered deleted.
+func deleteIamInstanceProfile(svc iamiface.IAMAPI, profileName *string) error {
+	output, err := svc.GetInstanceProfile(&iam.GetInstanceProfileInput{InstanceProfileName: profileName})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == iam.ErrCodeNoSuchEntityException {
+			return nil
+		}
+		return errors.WithStackTrace(err)
+	}
+
+	for _, role := range output.InstanceProfile.Roles {
+		_, err := svc.RemoveRoleFromInstanceProfile(&iam.RemoveRoleFromInstanceProfileInput{
+			InstanceProfileName: profileName,
+			RoleName:            role.RoleName,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Removed Role %s from InstanceProfile %s", aws.StringValue(role.RoleName), aws.StringValue(profileName))
+	}
+
+	_, err = svc.DeleteInstanceProfile(&iam.DeleteInstanceProfileInput{InstanceProfileName: profileName})
+	return errors.WithStackTrace(err)
+}
+
+// Delete all IAM instance profiles
+func nukeAllIamInstanceProfiles(session *session.Session, profileNames []*string) error {
+	if len(profileNames) == 0 {
+		logging.Logger.Debug("No IAM Instance Profiles to nuke")
+		return nil
+	}
+
+	logging.Logger.Debug("Deleting all IAM Instance Profiles")
+
+	svc := iam.New(session)
+	var deletedProfileNames []*string
+	var allErrs *multierror.Error
+
+	for _, profileName := range profileNames {
+		err := deleteIamInstanceProfile(svc, profileName)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(profileName),
+			ResourceType: "IAM Instance Profile",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking IAM Instance Profile",
+			}, map[string]interface{}{
+				"region": "global",
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedProfileNames = append(deletedProfileNames, profileName)
+			logging.Logger.Debugf("Deleted IAM Instance Profile: %s", aws.StringValue(profileName))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d IAM Instance Profile(s) deleted", len(deletedProfileNames))
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
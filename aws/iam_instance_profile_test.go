@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldIncludeIamInstanceProfile(t *testing.T) {
+	profile := func(roleNames ...string) *iam.InstanceProfile {
+		var roles []*iam.Role
+		for _, roleName := range roleNames {
+			roles = append(roles, &iam.Role{RoleName: awsgo.String(roleName)})
+		}
+		return &iam.InstanceProfile{
+			InstanceProfileName: awsgo.String("cloud-nuke-test"),
+			CreateDate:          awsgo.Time(time.Now()),
+			Roles:               roles,
+		}
+	}
+	nukedRoleNames := []string{"nuked-role"}
+
+	assert.True(t, shouldIncludeIamInstanceProfile(profile(), time.Now().Add(1*time.Hour), config.Config{}, nukedRoleNames))
+	assert.True(t, shouldIncludeIamInstanceProfile(profile("nuked-role"), time.Now().Add(1*time.Hour), config.Config{}, nukedRoleNames))
+	assert.False(t, shouldIncludeIamInstanceProfile(profile("nuked-role", "kept-role"), time.Now().Add(1*time.Hour), config.Config{}, nukedRoleNames))
+	assert.False(t, shouldIncludeIamInstanceProfile(profile(), time.Now().Add(-1*time.Hour), config.Config{}, nukedRoleNames))
+}
+
+type mockedIAMInstanceProfile struct {
+	iamiface.IAMAPI
+	Profiles map[string]*iam.InstanceProfile
+	Calls    []string
+}
+
+func (m *mockedIAMInstanceProfile) GetInstanceProfile(input *iam.GetInstanceProfileInput) (*iam.GetInstanceProfileOutput, error) {
+	profile, ok := m.Profiles[awsgo.StringValue(input.InstanceProfileName)]
+	if !ok {
+		return nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil)
+	}
+	return &iam.GetInstanceProfileOutput{InstanceProfile: profile}, nil
+}
+
+func (m *mockedIAMInstanceProfile) RemoveRoleFromInstanceProfile(input *iam.RemoveRoleFromInstanceProfileInput) (*iam.RemoveRoleFromInstanceProfileOutput, error) {
+	m.Calls = append(m.Calls, "remove "+awsgo.StringValue(input.RoleName))
+	return &iam.RemoveRoleFromInstanceProfileOutput{}, nil
+}
+
+func (m *mockedIAMInstanceProfile) DeleteInstanceProfile(input *iam.DeleteInstanceProfileInput) (*iam.DeleteInstanceProfileOutput, error) {
+	m.Calls = append(m.Calls, "delete "+awsgo.StringValue(input.InstanceProfileName))
+	return &iam.DeleteInstanceProfileOutput{}, nil
+}
+
+func TestDeleteIamInstanceProfile(t *testing.T) {
+	svc := &mockedIAMInstanceProfile{Profiles: map[string]*iam.InstanceProfile{
+		"with-role": {Roles: []*iam.Role{{RoleName: awsgo.String("nuked-role")}}},
+	}}
+
+	require.NoError(t, deleteIamInstanceProfile(svc, awsgo.String("with-role")))
+	// Instance profiles already deleted along with their role are skipped
+	require.NoError(t, deleteIamInstanceProfile(svc, awsgo.String("already-deleted")))
+	assert.Equal(t, []string{"remove nuked-role", "delete with-role"}, svc.Calls)
+}
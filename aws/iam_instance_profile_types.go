@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// IAMInstanceProfiles - represents all IAM instance profiles on the AWS account that are left without a role
+type IAMInstanceProfiles struct {
+	InstanceProfileNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (p IAMInstanceProfiles) ResourceName() string {
+	return "iam-instance-profile"
+}
+
+// ResourceIdentifiers - The IAM instance profile names
+func (p IAMInstanceProfiles) ResourceIdentifiers() []string {
+	return p.InstanceProfileNames
+}
+
+// Tentative batch size to ensure AWS doesn't throttle
+func (p IAMInstanceProfiles) MaxBatchSize() int {
+	return 20
+}
+
+// Nuke - nuke 'em all!!!
+func (p IAMInstanceProfiles) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllIamInstanceProfiles(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
@@ -46,6 +47,7 @@ func nukeAllIamPolicies(session *session.Session, policyArns []*string) error {
 
 	if len(policyArns) == 0 {
 		logging.Logger.Debug("No IAM Policies to nuke")
+		return nil
 	}
 
 	//Probably not required since pagination is handled by the caller
@@ -87,7 +89,7 @@ func nukeAllIamPolicies(session *session.Session, policyArns []*string) error {
 }
 
 // Removes an IAM Policy from AWS, designed to run as a goroutine
-func deleteIamPolicyAsync(wg *sync.WaitGroup, errChan chan error, svc *iam.IAM, policyArn *string) {
+func deleteIamPolicyAsync(wg *sync.WaitGroup, errChan chan error, svc iamiface.IAMAPI, policyArn *string) {
 	defer wg.Done()
 	var multierr *multierror.Error
 
@@ -97,6 +99,12 @@ func deleteIamPolicyAsync(wg *sync.WaitGroup, errChan chan error, svc *iam.IAM,
 		multierr = multierror.Append(multierr, err)
 	}
 
+	//Remove the policy from any entities it is the permissions boundary of
+	err = removePolicyPermissionsBoundaries(svc, policyArn)
+	if err != nil {
+		multierr = multierror.Append(multierr, err)
+	}
+
 	//Get Old Policy Versions
 	var versionsToRemove []*string
 	err = svc.ListPolicyVersionsPages(&iam.ListPolicyVersionsInput{PolicyArn: policyArn},
@@ -137,11 +145,15 @@ func deleteIamPolicyAsync(wg *sync.WaitGroup, errChan chan error, svc *iam.IAM,
 	errChan <- multierr.ErrorOrNil()
 }
 
-func detachPolicyEntities(svc *iam.IAM, policyArn *string) error {
+func detachPolicyEntities(svc iamiface.IAMAPI, policyArn *string) error {
 	var allPolicyGroups []*string
 	var allPolicyRoles []*string
 	var allPolicyUsers []*string
-	err := svc.ListEntitiesForPolicyPages(&iam.ListEntitiesForPolicyInput{PolicyArn: policyArn},
+	input := &iam.ListEntitiesForPolicyInput{
+		PolicyArn:         policyArn,
+		PolicyUsageFilter: aws.String(iam.PolicyUsageTypePermissionsPolicy),
+	}
+	err := svc.ListEntitiesForPolicyPages(input,
 		func(page *iam.ListEntitiesForPolicyOutput, lastPage bool) bool {
 			for _, group := range page.PolicyGroups {
 				allPolicyGroups = append(allPolicyGroups, group.GroupName)
@@ -194,6 +206,44 @@ func detachPolicyEntities(svc *iam.IAM, policyArn *string) error {
 	return err
 }
 
+// A policy used as the permissions boundary of a user or role can't be deleted either, but the boundary has to be
+// removed from the entity rather than detached from it. Groups can't have a permissions boundary.
+func removePolicyPermissionsBoundaries(svc iamiface.IAMAPI, policyArn *string) error {
+	var allBoundaryRoles []*string
+	var allBoundaryUsers []*string
+	input := &iam.ListEntitiesForPolicyInput{
+		PolicyArn:         policyArn,
+		PolicyUsageFilter: aws.String(iam.PolicyUsageTypePermissionsBoundary),
+	}
+	err := svc.ListEntitiesForPolicyPages(input,
+		func(page *iam.ListEntitiesForPolicyOutput, lastPage bool) bool {
+			for _, role := range page.PolicyRoles {
+				allBoundaryRoles = append(allBoundaryRoles, role.RoleName)
+			}
+			for _, user := range page.PolicyUsers {
+				allBoundaryUsers = append(allBoundaryUsers, user.UserName)
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return err
+	}
+	for _, userName := range allBoundaryUsers {
+		_, err = svc.DeleteUserPermissionsBoundary(&iam.DeleteUserPermissionsBoundaryInput{UserName: userName})
+		if err != nil {
+			return err
+		}
+	}
+	for _, roleName := range allBoundaryRoles {
+		_, err = svc.DeleteRolePermissionsBoundary(&iam.DeleteRolePermissionsBoundaryInput{RoleName: roleName})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func shouldIncludeIamPolicy(iamPolicy *iam.Policy, excludeAfter time.Time, configObj config.Config) bool {
 	if iamPolicy == nil {
 		return false
@@ -214,5 +264,5 @@ func shouldIncludeIamPolicy(iamPolicy *iam.Policy, excludeAfter time.Time, confi
 type TooManyIamPolicyErr struct{}
 
 func (err TooManyIamPolicyErr) Error() string {
-	return "Too many IAM Groups requested at once"
+	return "Too many IAM Policies requested at once"
 }
@@ -4,6 +4,7 @@ import (
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
@@ -210,3 +211,47 @@ func TestNukeIamPolicies(t *testing.T) {
 	assert.NotContains(t, awsgo.StringValueSlice(policyArns), emptyPolicyArn)
 	assert.NotContains(t, awsgo.StringValueSlice(policyArns), *entities.PolicyArn)
 }
+
+type mockedIAMPolicyEntities struct {
+	iamiface.IAMAPI
+	Calls []string
+}
+
+func (m *mockedIAMPolicyEntities) ListEntitiesForPolicyPages(input *iam.ListEntitiesForPolicyInput, fn func(*iam.ListEntitiesForPolicyOutput, bool) bool) error {
+	switch awsgo.StringValue(input.PolicyUsageFilter) {
+	case iam.PolicyUsageTypePermissionsPolicy:
+		fn(&iam.ListEntitiesForPolicyOutput{
+			PolicyRoles: []*iam.PolicyRole{{RoleName: awsgo.String("attached-role")}},
+		}, true)
+	case iam.PolicyUsageTypePermissionsBoundary:
+		fn(&iam.ListEntitiesForPolicyOutput{
+			PolicyRoles: []*iam.PolicyRole{{RoleName: awsgo.String("bounded-role")}},
+			PolicyUsers: []*iam.PolicyUser{{UserName: awsgo.String("bounded-user")}},
+		}, true)
+	}
+	return nil
+}
+
+func (m *mockedIAMPolicyEntities) DetachRolePolicy(input *iam.DetachRolePolicyInput) (*iam.DetachRolePolicyOutput, error) {
+	m.Calls = append(m.Calls, "detach "+awsgo.StringValue(input.RoleName))
+	return &iam.DetachRolePolicyOutput{}, nil
+}
+
+func (m *mockedIAMPolicyEntities) DeleteRolePermissionsBoundary(input *iam.DeleteRolePermissionsBoundaryInput) (*iam.DeleteRolePermissionsBoundaryOutput, error) {
+	m.Calls = append(m.Calls, "remove boundary "+awsgo.StringValue(input.RoleName))
+	return &iam.DeleteRolePermissionsBoundaryOutput{}, nil
+}
+
+func (m *mockedIAMPolicyEntities) DeleteUserPermissionsBoundary(input *iam.DeleteUserPermissionsBoundaryInput) (*iam.DeleteUserPermissionsBoundaryOutput, error) {
+	m.Calls = append(m.Calls, "remove boundary "+awsgo.StringValue(input.UserName))
+	return &iam.DeleteUserPermissionsBoundaryOutput{}, nil
+}
+
+func TestDetachPolicyEntitiesAndPermissionsBoundaries(t *testing.T) {
+	svc := &mockedIAMPolicyEntities{}
+	policyArn := awsgo.String("arn:aws:iam::123456789012:policy/cloud-nuke-test")
+
+	require.NoError(t, detachPolicyEntities(svc, policyArn))
+	require.NoError(t, removePolicyPermissionsBoundaries(svc, policyArn))
+	assert.Equal(t, []string{"detach attached-role", "remove boundary bounded-user", "remove boundary bounded-role"}, svc.Calls)
+}
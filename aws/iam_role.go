@@ -10,9 +10,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/collections"
 	"github.com/gruntwork-io/go-commons/errors"
 	"github.com/hashicorp/go-multierror"
 )
@@ -39,16 +41,22 @@ func getAllIamRoles(session *session.Session, excludeAfter time.Time, configObj
 	return allIAMRoles, nil
 }
 
-func deleteManagedRolePolicies(svc *iam.IAM, roleName *string) error {
-	policiesOutput, err := svc.ListAttachedRolePolicies(&iam.ListAttachedRolePoliciesInput{
-		RoleName: roleName,
-	})
+func deleteManagedRolePolicies(svc iamiface.IAMAPI, roleName *string) error {
+	var policyArns []*string
+	err := svc.ListAttachedRolePoliciesPages(
+		&iam.ListAttachedRolePoliciesInput{RoleName: roleName},
+		func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+			for _, attachedPolicy := range page.AttachedPolicies {
+				policyArns = append(policyArns, attachedPolicy.PolicyArn)
+			}
+			return !lastPage
+		},
+	)
 	if err != nil {
 		return errors.WithStackTrace(err)
 	}
 
-	for _, attachedPolicy := range policiesOutput.AttachedPolicies {
-		arn := attachedPolicy.PolicyArn
+	for _, arn := range policyArns {
 		_, err = svc.DetachRolePolicy(&iam.DetachRolePolicyInput{
 			PolicyArn: arn,
 			RoleName:  roleName,
@@ -63,16 +71,21 @@ func deleteManagedRolePolicies(svc *iam.IAM, roleName *string) error {
 	return nil
 }
 
-func deleteInlineRolePolicies(svc *iam.IAM, roleName *string) error {
-	policyOutput, err := svc.ListRolePolicies(&iam.ListRolePoliciesInput{
-		RoleName: roleName,
-	})
+func deleteInlineRolePolicies(svc iamiface.IAMAPI, roleName *string) error {
+	var policyNames []*string
+	err := svc.ListRolePoliciesPages(
+		&iam.ListRolePoliciesInput{RoleName: roleName},
+		func(page *iam.ListRolePoliciesOutput, lastPage bool) bool {
+			policyNames = append(policyNames, page.PolicyNames...)
+			return !lastPage
+		},
+	)
 	if err != nil {
 		logging.Logger.Debugf("[Failed] %s", err)
 		return errors.WithStackTrace(err)
 	}
 
-	for _, policyName := range policyOutput.PolicyNames {
+	for _, policyName := range policyNames {
 		_, err := svc.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
 			PolicyName: policyName,
 			RoleName:   roleName,
@@ -87,15 +100,20 @@ func deleteInlineRolePolicies(svc *iam.IAM, roleName *string) error {
 	return nil
 }
 
-func deleteInstanceProfilesFromRole(svc *iam.IAM, roleName *string) error {
-	profilesOutput, err := svc.ListInstanceProfilesForRole(&iam.ListInstanceProfilesForRoleInput{
-		RoleName: roleName,
-	})
+func deleteInstanceProfilesFromRole(svc iamiface.IAMAPI, roleName *string) error {
+	var profiles []*iam.InstanceProfile
+	err := svc.ListInstanceProfilesForRolePages(
+		&iam.ListInstanceProfilesForRoleInput{RoleName: roleName},
+		func(page *iam.ListInstanceProfilesForRoleOutput, lastPage bool) bool {
+			profiles = append(profiles, page.InstanceProfiles...)
+			return !lastPage
+		},
+	)
 	if err != nil {
 		return errors.WithStackTrace(err)
 	}
 
-	for _, profile := range profilesOutput.InstanceProfiles {
+	for _, profile := range profiles {
 
 		// Role needs to be removed from instance profile before it can be deleted
 		_, err := svc.RemoveRoleFromInstanceProfile(&iam.RemoveRoleFromInstanceProfileInput{
@@ -119,7 +137,7 @@ func deleteInstanceProfilesFromRole(svc *iam.IAM, roleName *string) error {
 	return nil
 }
 
-func deleteIamRole(svc *iam.IAM, roleName *string) error {
+func deleteIamRole(svc iamiface.IAMAPI, roleName *string) error {
 	_, err := svc.DeleteRole(&iam.DeleteRoleInput{
 		RoleName: roleName,
 	})
@@ -202,6 +220,16 @@ func shouldIncludeIAMRole(iamRole *iam.Role, excludeAfter time.Time, configObj c
 		return false
 	}
 
+	// Service-linked roles can only be deleted through the service that created them, which the
+	// iam-service-linked-role resource takes care of
+	if strings.Contains(aws.StringValue(iamRole.Arn), "aws-service-role") {
+		return false
+	}
+
+	if collections.ListContainsElement(configObj.IAMRoles.ProtectedNames, aws.StringValue(iamRole.RoleName)) {
+		return false
+	}
+
 	if excludeAfter.Before(*iamRole.CreateDate) {
 		return false
 	}
@@ -213,7 +241,7 @@ func shouldIncludeIAMRole(iamRole *iam.Role, excludeAfter time.Time, configObj c
 	)
 }
 
-func deleteIamRoleAsync(wg *sync.WaitGroup, errChan chan error, svc *iam.IAM, roleName *string) {
+func deleteIamRoleAsync(wg *sync.WaitGroup, errChan chan error, svc iamiface.IAMAPI, roleName *string) {
 	defer wg.Done()
 
 	var result *multierror.Error
@@ -222,7 +250,7 @@ func deleteIamRoleAsync(wg *sync.WaitGroup, errChan chan error, svc *iam.IAM, ro
 	// items we need delete/detach them before actually deleting it.
 	// NOTE: The actual role deletion should always be the last one. This way we
 	// can guarantee that it will fail if we forgot to delete/detach an item.
-	functions := []func(svc *iam.IAM, roleName *string) error{
+	functions := []func(svc iamiface.IAMAPI, roleName *string) error{
 		deleteInstanceProfilesFromRole,
 		deleteInlineRolePolicies,
 		deleteManagedRolePolicies,
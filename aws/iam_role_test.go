@@ -164,3 +164,21 @@ func TestTimeFilterExclusionNewlyCreatedIamRole(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotContains(t, awsgo.StringValueSlice(roleNames), name)
 }
+
+func TestShouldIncludeIAMRole(t *testing.T) {
+	role := func(name string, path string) *iam.Role {
+		return &iam.Role{
+			RoleName:   awsgo.String(name),
+			Arn:        awsgo.String("arn:aws:iam::123456789012:role" + path + name),
+			CreateDate: awsgo.Time(time.Now()),
+		}
+	}
+	protectedConfig := config.Config{
+		IAMRoles: config.IAMRolesResourceType{ProtectedNames: []string{"ci-deployer"}},
+	}
+
+	assert.True(t, shouldIncludeIAMRole(role("cloud-nuke-test", "/"), time.Now().Add(1*time.Hour), protectedConfig))
+	assert.False(t, shouldIncludeIAMRole(role("cloud-nuke-test", "/"), time.Now().Add(-1*time.Hour), protectedConfig))
+	assert.False(t, shouldIncludeIAMRole(role("ci-deployer", "/"), time.Now().Add(1*time.Hour), protectedConfig))
+	assert.False(t, shouldIncludeIAMRole(role("AWSServiceRoleForECS", "/aws-service-role/ecs.amazonaws.com/"), time.Now().Add(1*time.Hour), protectedConfig))
+}
@@ -22,6 +22,7 @@ import (
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/util"
@@ -478,3 +479,46 @@ func TestDeleteFullIamUser(t *testing.T) {
 	err = nukeAllIamUsers(session, []*string{userInfos.UserName})
 	require.NoError(t, err)
 }
+
+func TestShouldIncludeIAMUser(t *testing.T) {
+	user := &iam.User{
+		UserName:   awsgo.String("cloud-nuke-test"),
+		CreateDate: awsgo.Time(time.Now()),
+	}
+
+	assert.True(t, shouldIncludeIAMUser(user, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeIAMUser(user, time.Now().Add(-1*time.Hour), config.Config{}))
+}
+
+type mockedIAMUserMFADevices struct {
+	iamiface.IAMAPI
+	Calls []string
+}
+
+func (m *mockedIAMUserMFADevices) ListMFADevicesPages(input *iam.ListMFADevicesInput, fn func(*iam.ListMFADevicesOutput, bool) bool) error {
+	fn(&iam.ListMFADevicesOutput{MFADevices: []*iam.MFADevice{
+		{SerialNumber: awsgo.String("arn:aws:iam::123456789012:mfa/cloud-nuke-test")},
+		{SerialNumber: awsgo.String("GAHT12345678")},
+	}}, true)
+	return nil
+}
+
+func (m *mockedIAMUserMFADevices) DeactivateMFADevice(input *iam.DeactivateMFADeviceInput) (*iam.DeactivateMFADeviceOutput, error) {
+	m.Calls = append(m.Calls, "deactivate "+awsgo.StringValue(input.SerialNumber))
+	return &iam.DeactivateMFADeviceOutput{}, nil
+}
+
+func (m *mockedIAMUserMFADevices) DeleteVirtualMFADevice(input *iam.DeleteVirtualMFADeviceInput) (*iam.DeleteVirtualMFADeviceOutput, error) {
+	m.Calls = append(m.Calls, "delete "+awsgo.StringValue(input.SerialNumber))
+	return &iam.DeleteVirtualMFADeviceOutput{}, nil
+}
+
+func TestDeleteMFADevicesOnlyDeletesVirtualDevices(t *testing.T) {
+	svc := &mockedIAMUserMFADevices{}
+	require.NoError(t, deleteMFADevices(svc, awsgo.String("cloud-nuke-test")))
+	assert.Equal(t, []string{
+		"deactivate arn:aws:iam::123456789012:mfa/cloud-nuke-test",
+		"deactivate GAHT12345678",
+		"delete arn:aws:iam::123456789012:mfa/cloud-nuke-test",
+	}, svc.Calls)
+}
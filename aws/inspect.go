@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
@@ -31,6 +32,37 @@ func ExtractResourcesForPrinting(account *AwsAccountResources) []string {
 	return resources
 }
 
+// ExtractCLICommands renders the AWS CLI commands that would delete the resources found in the account, ordered by
+// region. Resource types that can't be rendered yet are called out with a comment, so the output never silently omits
+// a resource that a real nuke would delete.
+func ExtractCLICommands(account *AwsAccountResources) []string {
+	commands := []string{}
+
+	regions := make([]string, 0, len(account.Resources))
+	for region := range account.Resources {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	for _, region := range regions {
+		for _, foundResources := range account.Resources[region].Resources {
+			renderer, ok := foundResources.(CLICommandRenderer)
+			if !ok {
+				commands = append(commands, fmt.Sprintf(
+					"# %s: no CLI commands available for %d resource(s) in %s",
+					foundResources.ResourceName(),
+					len(foundResources.ResourceIdentifiers()),
+					region,
+				))
+				continue
+			}
+			commands = append(commands, renderer.CLICommands(region)...)
+		}
+	}
+
+	return commands
+}
+
 func ensureValidResourceTypes(resourceTypes []string) ([]string, error) {
 	invalidresourceTypes := []string{}
 	for _, resourceType := range resourceTypes {
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -93,3 +94,28 @@ func TestHandleResourceTypeSelectionsFiltering(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractCLICommands(t *testing.T) {
+	account := &AwsAccountResources{
+		Resources: map[string]AwsRegionResource{
+			"us-west-2": {
+				Resources: []AwsResources{
+					EBSVolumes{VolumeIds: []string{"vol-456"}},
+				},
+			},
+			"us-east-1": {
+				Resources: []AwsResources{
+					EBSVolumes{VolumeIds: []string{"vol-123", "vol-124"}},
+					EC2Instances{InstanceIds: []string{"i-123"}},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"aws ec2 delete-volume --volume-id vol-123 --region us-east-1",
+		"aws ec2 delete-volume --volume-id vol-124 --region us-east-1",
+		"# ec2: no CLI commands available for 1 resource(s) in us-east-1",
+		"aws ec2 delete-volume --volume-id vol-456 --region us-west-2",
+	}, ExtractCLICommands(account))
+}
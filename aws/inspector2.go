@@ -0,0 +1,152 @@
+package aws
+
+import (
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/aws/aws-sdk-go/service/inspector2/inspector2iface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// getEnabledInspector2ResourceTypes returns the resource types (EC2, ECR and Lambda) that Inspector2 is scanning, or
+// about to scan, for the given account
+func getEnabledInspector2ResourceTypes(svc inspector2iface.Inspector2API, accountId *string) ([]string, error) {
+	output, err := svc.BatchGetAccountStatus(&inspector2.BatchGetAccountStatusInput{AccountIds: []*string{accountId}})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var resourceTypes []string
+	for _, account := range output.Accounts {
+		if account.ResourceState == nil {
+			continue
+		}
+
+		states := map[string]*inspector2.State{
+			inspector2.ResourceScanTypeEc2:    account.ResourceState.Ec2,
+			inspector2.ResourceScanTypeEcr:    account.ResourceState.Ecr,
+			inspector2.ResourceScanTypeLambda: account.ResourceState.Lambda,
+		}
+		for _, resourceType := range inspector2.ResourceScanType_Values() {
+			state := states[resourceType]
+			if state == nil {
+				continue
+			}
+			status := aws.StringValue(state.Status)
+			if status == inspector2.StatusEnabled || status == inspector2.StatusEnabling {
+				resourceTypes = append(resourceTypes, resourceType)
+			}
+		}
+	}
+	return resourceTypes, nil
+}
+
+// getAllInspector2Accounts returns the ID of the current account if Inspector2 scans any of its resources in the region.
+// Unfortunately, the Inspector2 API doesn't provide the metadata information we'd need to implement the excludeAfter or
+// configObj patterns, so we currently can only accept a session
+func getAllInspector2Accounts(session *session.Session) ([]string, error) {
+	svc := inspector2.New(session)
+
+	identity, err := sts.New(session).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	resourceTypes, err := getEnabledInspector2ResourceTypes(svc, identity.Account)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	if len(resourceTypes) == 0 {
+		return nil, nil
+	}
+	return []string{aws.StringValue(identity.Account)}, nil
+}
+
+// deleteInspector2Filters deletes the finding filters and suppression rules owned by the given account
+func deleteInspector2Filters(svc inspector2iface.Inspector2API, accountId *string) error {
+	var filterArns []*string
+	err := svc.ListFiltersPages(&inspector2.ListFiltersInput{}, func(page *inspector2.ListFiltersOutput, lastPage bool) bool {
+		for _, filter := range page.Filters {
+			// Filters of the delegated administrator account are listed too, but can only be deleted by it
+			if aws.StringValue(filter.OwnerId) == aws.StringValue(accountId) {
+				filterArns = append(filterArns, filter.Arn)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, filterArn := range filterArns {
+		_, err := svc.DeleteFilter(&inspector2.DeleteFilterInput{Arn: filterArn})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted Inspector2 filter: %s", aws.StringValue(filterArn))
+	}
+	return nil
+}
+
+// disableInspector2 deletes the filters of the account and disables the scanning of all its resource types
+func disableInspector2(svc inspector2iface.Inspector2API, accountId *string) error {
+	if err := deleteInspector2Filters(svc, accountId); err != nil {
+		return err
+	}
+
+	resourceTypes, err := getEnabledInspector2ResourceTypes(svc, accountId)
+	if err != nil {
+		return err
+	}
+	if len(resourceTypes) == 0 {
+		return nil
+	}
+
+	_, err = svc.Disable(&inspector2.DisableInput{
+		AccountIds:    []*string{accountId},
+		ResourceTypes: aws.StringSlice(resourceTypes),
+	})
+	return errors.WithStackTrace(err)
+}
+
+// Disables Inspector2 scanning in the region, after deleting the filters of the account
+func nukeAllInspector2Accounts(session *session.Session, accountIds []string) error {
+	svc := inspector2.New(session)
+
+	if len(accountIds) == 0 {
+		logging.Logger.Debugf("No Inspector2 accounts to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	for _, accountId := range accountIds {
+		err := disableInspector2(svc, aws.String(accountId))
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   accountId,
+			ResourceType: "Inspector2 account",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Inspector2",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			return errors.WithStackTrace(err)
+		}
+
+		logging.Logger.Debugf("[OK] Inspector2 disabled for accountId %s in %s", accountId, *session.Config.Region)
+	}
+
+	return nil
+}
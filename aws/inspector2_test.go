@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/aws/aws-sdk-go/service/inspector2/inspector2iface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedInspector2 struct {
+	inspector2iface.Inspector2API
+	Calls []string
+}
+
+func (m *mockedInspector2) BatchGetAccountStatus(input *inspector2.BatchGetAccountStatusInput) (*inspector2.BatchGetAccountStatusOutput, error) {
+	return &inspector2.BatchGetAccountStatusOutput{Accounts: []*inspector2.AccountState{{
+		AccountId: input.AccountIds[0],
+		ResourceState: &inspector2.ResourceState{
+			Ec2:    &inspector2.State{Status: aws.String(inspector2.StatusEnabled)},
+			Ecr:    &inspector2.State{Status: aws.String(inspector2.StatusDisabled)},
+			Lambda: &inspector2.State{Status: aws.String(inspector2.StatusEnabling)},
+		},
+	}}}, nil
+}
+
+func (m *mockedInspector2) ListFiltersPages(input *inspector2.ListFiltersInput, fn func(*inspector2.ListFiltersOutput, bool) bool) error {
+	fn(&inspector2.ListFiltersOutput{Filters: []*inspector2.Filter{
+		{Arn: aws.String("own-filter"), OwnerId: aws.String("111111111111")},
+		{Arn: aws.String("administrator-filter"), OwnerId: aws.String("222222222222")},
+	}}, true)
+	return nil
+}
+
+func (m *mockedInspector2) DeleteFilter(input *inspector2.DeleteFilterInput) (*inspector2.DeleteFilterOutput, error) {
+	m.Calls = append(m.Calls, "DeleteFilter:"+aws.StringValue(input.Arn))
+	return &inspector2.DeleteFilterOutput{}, nil
+}
+
+func (m *mockedInspector2) Disable(input *inspector2.DisableInput) (*inspector2.DisableOutput, error) {
+	m.Calls = append(m.Calls, "Disable:"+strings.Join(aws.StringValueSlice(input.ResourceTypes), ","))
+	return &inspector2.DisableOutput{}, nil
+}
+
+func TestDisableInspector2DeletesOwnFiltersFirst(t *testing.T) {
+	mock := &mockedInspector2{}
+	require.NoError(t, disableInspector2(mock, aws.String("111111111111")))
+	assert.Equal(t, []string{
+		"DeleteFilter:own-filter",
+		"Disable:EC2,LAMBDA",
+	}, mock.Calls)
+}
@@ -0,0 +1,30 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// Inspector2 - represents Inspector2 scanning being enabled in a region, identified by the account ID
+type Inspector2 struct {
+	AccountIds []string
+}
+
+func (r Inspector2) ResourceName() string {
+	return "inspector2"
+}
+
+func (r Inspector2) ResourceIdentifiers() []string {
+	return r.AccountIds
+}
+
+func (r Inspector2) MaxBatchSize() int {
+	return 10
+}
+
+func (r Inspector2) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllInspector2Accounts(session, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
@@ -0,0 +1,427 @@
+package aws
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/iot/iotiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of IoT thing names. Things have no creation time and can't be tagged, so the time they
+// were first seen is kept in one of their attributes instead.
+func getAllIoTThings(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := iot.New(session)
+
+	var things []*iot.ThingAttribute
+	err := svc.ListThingsPages(&iot.ListThingsInput{}, func(page *iot.ListThingsOutput, lastPage bool) bool {
+		things = append(things, page.Things...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, thing := range things {
+		if aws.StringValue(thing.Attributes[AwsResourceExclusionTagKey]) == "true" {
+			continue
+		}
+		if !config.ShouldInclude(aws.StringValue(thing.ThingName), configObj.IoTThing.IncludeRule.NamesRegExp, configObj.IoTThing.ExcludeRule.NamesRegExp) {
+			continue
+		}
+
+		firstSeenTime, err := getOrSetFirstSeenIoTThingAttribute(svc, thing)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if excludeAfter.After(firstSeenTime) {
+			names = append(names, thing.ThingName)
+		}
+	}
+	return names, nil
+}
+
+func getOrSetFirstSeenIoTThingAttribute(svc iotiface.IoTAPI, thing *iot.ThingAttribute) (time.Time, error) {
+	if value, ok := thing.Attributes[firstSeenTagKey]; ok {
+		firstSeenTime, err := time.Parse(time.RFC3339, aws.StringValue(value))
+		if err != nil {
+			return time.Time{}, errors.WithStackTrace(err)
+		}
+		return firstSeenTime, nil
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.UpdateThing(&iot.UpdateThingInput{
+		ThingName: thing.ThingName,
+		AttributePayload: &iot.AttributePayload{
+			Attributes: map[string]*string{firstSeenTagKey: aws.String(now.Format(time.RFC3339))},
+			Merge:      aws.Bool(true),
+		},
+	})
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return now, nil
+}
+
+// Returns a formatted string of IoT thing group names, ordered so that child groups come before their parents
+func getAllIoTThingGroups(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := iot.New(session)
+
+	var groups []*iot.GroupNameAndArn
+	err := svc.ListThingGroupsPages(&iot.ListThingGroupsInput{}, func(page *iot.ListThingGroupsOutput, lastPage bool) bool {
+		groups = append(groups, page.ThingGroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	depths := map[string]int{}
+	var names []*string
+	for _, group := range groups {
+		// The creation time and parents are only returned when describing the thing group
+		output, err := svc.DescribeThingGroup(&iot.DescribeThingGroupInput{ThingGroupName: group.GroupName})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !shouldIncludeIoTThingGroup(output, excludeAfter, configObj) {
+			continue
+		}
+
+		exclude, err := hasIoTExcludeTag(svc, group.GroupArn)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !exclude {
+			if output.ThingGroupMetadata != nil {
+				depths[aws.StringValue(group.GroupName)] = len(output.ThingGroupMetadata.RootToParentThingGroups)
+			}
+			names = append(names, group.GroupName)
+		}
+	}
+
+	// A thing group can't be deleted while it still has child groups
+	sort.SliceStable(names, func(i, j int) bool {
+		return depths[aws.StringValue(names[i])] > depths[aws.StringValue(names[j])]
+	})
+	return names, nil
+}
+
+func shouldIncludeIoTThingGroup(group *iot.DescribeThingGroupOutput, excludeAfter time.Time, configObj config.Config) bool {
+	if group == nil {
+		return false
+	}
+
+	if group.ThingGroupMetadata != nil && group.ThingGroupMetadata.CreationDate != nil && excludeAfter.Before(*group.ThingGroupMetadata.CreationDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(group.ThingGroupName),
+		configObj.IoTThingGroup.IncludeRule.NamesRegExp,
+		configObj.IoTThingGroup.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Returns a formatted string of IoT certificate IDs
+func getAllIoTCertificates(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := iot.New(session)
+
+	var ids []*string
+	err := svc.ListCertificatesPages(&iot.ListCertificatesInput{}, func(page *iot.ListCertificatesOutput, lastPage bool) bool {
+		for _, certificate := range page.Certificates {
+			if shouldIncludeIoTCertificate(certificate, excludeAfter, configObj) {
+				ids = append(ids, certificate.CertificateId)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return ids, nil
+}
+
+func shouldIncludeIoTCertificate(certificate *iot.Certificate, excludeAfter time.Time, configObj config.Config) bool {
+	if certificate == nil {
+		return false
+	}
+
+	// Certificates that are being transferred to another account have to be accepted or rejected there first
+	if aws.StringValue(certificate.Status) == iot.CertificateStatusPendingTransfer {
+		return false
+	}
+
+	if certificate.CreationDate != nil && excludeAfter.Before(*certificate.CreationDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(certificate.CertificateId),
+		configObj.IoTCertificate.IncludeRule.NamesRegExp,
+		configObj.IoTCertificate.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Returns a formatted string of IoT policy names
+func getAllIoTPolicies(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := iot.New(session)
+
+	var policies []*iot.Policy
+	err := svc.ListPoliciesPages(&iot.ListPoliciesInput{}, func(page *iot.ListPoliciesOutput, lastPage bool) bool {
+		policies = append(policies, page.Policies...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, policy := range policies {
+		// The creation time is only returned when getting the policy
+		output, err := svc.GetPolicy(&iot.GetPolicyInput{PolicyName: policy.PolicyName})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !shouldIncludeIoTPolicy(output, excludeAfter, configObj) {
+			continue
+		}
+
+		exclude, err := hasIoTExcludeTag(svc, policy.PolicyArn)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !exclude {
+			names = append(names, policy.PolicyName)
+		}
+	}
+	return names, nil
+}
+
+func shouldIncludeIoTPolicy(policy *iot.GetPolicyOutput, excludeAfter time.Time, configObj config.Config) bool {
+	if policy == nil {
+		return false
+	}
+
+	if policy.CreationDate != nil && excludeAfter.Before(*policy.CreationDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(policy.PolicyName),
+		configObj.IoTPolicy.IncludeRule.NamesRegExp,
+		configObj.IoTPolicy.ExcludeRule.NamesRegExp,
+	)
+}
+
+func hasIoTExcludeTag(svc iotiface.IoTAPI, resourceArn *string) (bool, error) {
+	exclude := false
+	err := svc.ListTagsForResourcePages(&iot.ListTagsForResourceInput{ResourceArn: resourceArn}, func(page *iot.ListTagsForResourceOutput, lastPage bool) bool {
+		for _, tag := range page.Tags {
+			if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+				exclude = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+	return exclude, errors.WithStackTrace(err)
+}
+
+// deleteIoTThing detaches the principals (certificates) of the thing, which is required before it can be deleted
+func deleteIoTThing(svc iotiface.IoTAPI, thingName *string) error {
+	var principals []*string
+	err := svc.ListThingPrincipalsPages(&iot.ListThingPrincipalsInput{ThingName: thingName}, func(page *iot.ListThingPrincipalsOutput, lastPage bool) bool {
+		principals = append(principals, page.Principals...)
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, principal := range principals {
+		_, err := svc.DetachThingPrincipal(&iot.DetachThingPrincipalInput{ThingName: thingName, Principal: principal})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Detached principal %s from IoT thing %s", aws.StringValue(principal), aws.StringValue(thingName))
+	}
+
+	_, err = svc.DeleteThing(&iot.DeleteThingInput{ThingName: thingName})
+	return errors.WithStackTrace(err)
+}
+
+func deleteIoTThingGroup(svc iotiface.IoTAPI, groupName *string) error {
+	_, err := svc.DeleteThingGroup(&iot.DeleteThingGroupInput{ThingGroupName: groupName})
+	return errors.WithStackTrace(err)
+}
+
+// deleteIoTCertificate deactivates the certificate and detaches it from its things and policies, as only inactive,
+// unattached certificates can be deleted.
+func deleteIoTCertificate(svc iotiface.IoTAPI, certificateId *string) error {
+	output, err := svc.DescribeCertificate(&iot.DescribeCertificateInput{CertificateId: certificateId})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	certificate := output.CertificateDescription
+
+	if aws.StringValue(certificate.Status) == iot.CertificateStatusActive {
+		_, err := svc.UpdateCertificate(&iot.UpdateCertificateInput{
+			CertificateId: certificateId,
+			NewStatus:     aws.String(iot.CertificateStatusInactive),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deactivated IoT certificate %s", aws.StringValue(certificateId))
+	}
+
+	var thingNames []*string
+	err = svc.ListPrincipalThingsPages(&iot.ListPrincipalThingsInput{Principal: certificate.CertificateArn}, func(page *iot.ListPrincipalThingsOutput, lastPage bool) bool {
+		thingNames = append(thingNames, page.Things...)
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, thingName := range thingNames {
+		_, err := svc.DetachThingPrincipal(&iot.DetachThingPrincipalInput{ThingName: thingName, Principal: certificate.CertificateArn})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Detached IoT certificate %s from thing %s", aws.StringValue(certificateId), aws.StringValue(thingName))
+	}
+
+	var policyNames []*string
+	err = svc.ListAttachedPoliciesPages(&iot.ListAttachedPoliciesInput{Target: certificate.CertificateArn}, func(page *iot.ListAttachedPoliciesOutput, lastPage bool) bool {
+		for _, policy := range page.Policies {
+			policyNames = append(policyNames, policy.PolicyName)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, policyName := range policyNames {
+		_, err := svc.DetachPolicy(&iot.DetachPolicyInput{PolicyName: policyName, Target: certificate.CertificateArn})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Detached IoT policy %s from certificate %s", aws.StringValue(policyName), aws.StringValue(certificateId))
+	}
+
+	_, err = svc.DeleteCertificate(&iot.DeleteCertificateInput{CertificateId: certificateId})
+	return errors.WithStackTrace(err)
+}
+
+// deleteIoTPolicy detaches the policy from its targets and deletes its non-default versions before deleting the
+// policy itself.
+func deleteIoTPolicy(svc iotiface.IoTAPI, policyName *string) error {
+	var targets []*string
+	err := svc.ListTargetsForPolicyPages(&iot.ListTargetsForPolicyInput{PolicyName: policyName}, func(page *iot.ListTargetsForPolicyOutput, lastPage bool) bool {
+		targets = append(targets, page.Targets...)
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, target := range targets {
+		_, err := svc.DetachPolicy(&iot.DetachPolicyInput{PolicyName: policyName, Target: target})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Detached IoT policy %s from %s", aws.StringValue(policyName), aws.StringValue(target))
+	}
+
+	versions, err := svc.ListPolicyVersions(&iot.ListPolicyVersionsInput{PolicyName: policyName})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, version := range versions.PolicyVersions {
+		if aws.BoolValue(version.IsDefaultVersion) {
+			continue
+		}
+		_, err := svc.DeletePolicyVersion(&iot.DeletePolicyVersionInput{PolicyName: policyName, PolicyVersionId: version.VersionId})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	_, err = svc.DeletePolicy(&iot.DeletePolicyInput{PolicyName: policyName})
+	return errors.WithStackTrace(err)
+}
+
+// nukeIoTResources deletes the given IoT things, thing groups, certificates or policies using deleteFn, recording
+// the status of each of them.
+func nukeIoTResources(session *session.Session, resourceType string, identifiers []*string, deleteFn func(svc iotiface.IoTAPI, identifier *string) error) error {
+	svc := iot.New(session)
+
+	if len(identifiers) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedIdentifiers []*string
+	var allErrs *multierror.Error
+
+	for _, identifier := range identifiers {
+		err := deleteFn(svc, identifier)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(identifier),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIdentifiers = append(deletedIdentifiers, identifier)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(identifier))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedIdentifiers), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// Deletes all IoT certificates, deactivating and detaching them first
+func nukeAllIoTCertificates(session *session.Session, ids []*string) error {
+	return nukeIoTResources(session, "IoT Certificate", ids, deleteIoTCertificate)
+}
+
+// Deletes all IoT things
+func nukeAllIoTThings(session *session.Session, names []*string) error {
+	return nukeIoTResources(session, "IoT Thing", names, deleteIoTThing)
+}
+
+// Deletes all IoT thing groups
+func nukeAllIoTThingGroups(session *session.Session, names []*string) error {
+	return nukeIoTResources(session, "IoT Thing Group", names, deleteIoTThingGroup)
+}
+
+// Deletes all IoT policies
+func nukeAllIoTPolicies(session *session.Session, names []*string) error {
+	return nukeIoTResources(session, "IoT Policy", names, deleteIoTPolicy)
+}
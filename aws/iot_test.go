@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/iot/iotiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedIoT struct {
+	iotiface.IoTAPI
+	Calls []string
+}
+
+func (m *mockedIoT) DescribeCertificate(input *iot.DescribeCertificateInput) (*iot.DescribeCertificateOutput, error) {
+	return &iot.DescribeCertificateOutput{CertificateDescription: &iot.CertificateDescription{
+		CertificateArn: aws.String("cert-arn"),
+		CertificateId:  input.CertificateId,
+		Status:         aws.String(iot.CertificateStatusActive),
+	}}, nil
+}
+
+func (m *mockedIoT) UpdateCertificate(input *iot.UpdateCertificateInput) (*iot.UpdateCertificateOutput, error) {
+	m.Calls = append(m.Calls, "UpdateCertificate:"+aws.StringValue(input.NewStatus))
+	return &iot.UpdateCertificateOutput{}, nil
+}
+
+func (m *mockedIoT) ListPrincipalThingsPages(input *iot.ListPrincipalThingsInput, fn func(*iot.ListPrincipalThingsOutput, bool) bool) error {
+	fn(&iot.ListPrincipalThingsOutput{Things: []*string{aws.String("thing")}}, true)
+	return nil
+}
+
+func (m *mockedIoT) DetachThingPrincipal(input *iot.DetachThingPrincipalInput) (*iot.DetachThingPrincipalOutput, error) {
+	m.Calls = append(m.Calls, "DetachThingPrincipal:"+aws.StringValue(input.ThingName))
+	return &iot.DetachThingPrincipalOutput{}, nil
+}
+
+func (m *mockedIoT) ListAttachedPoliciesPages(input *iot.ListAttachedPoliciesInput, fn func(*iot.ListAttachedPoliciesOutput, bool) bool) error {
+	fn(&iot.ListAttachedPoliciesOutput{Policies: []*iot.Policy{{PolicyName: aws.String("policy")}}}, true)
+	return nil
+}
+
+func (m *mockedIoT) DetachPolicy(input *iot.DetachPolicyInput) (*iot.DetachPolicyOutput, error) {
+	m.Calls = append(m.Calls, "DetachPolicy:"+aws.StringValue(input.PolicyName))
+	return &iot.DetachPolicyOutput{}, nil
+}
+
+func (m *mockedIoT) DeleteCertificate(input *iot.DeleteCertificateInput) (*iot.DeleteCertificateOutput, error) {
+	m.Calls = append(m.Calls, "DeleteCertificate:"+aws.StringValue(input.CertificateId))
+	return &iot.DeleteCertificateOutput{}, nil
+}
+
+func (m *mockedIoT) UpdateThing(input *iot.UpdateThingInput) (*iot.UpdateThingOutput, error) {
+	m.Calls = append(m.Calls, "UpdateThing:"+aws.StringValue(input.ThingName))
+	return &iot.UpdateThingOutput{}, nil
+}
+
+func TestDeleteIoTCertificateDeactivatesAndDetachesFirst(t *testing.T) {
+	mock := &mockedIoT{}
+	require.NoError(t, deleteIoTCertificate(mock, aws.String("cert")))
+	assert.Equal(t, []string{
+		"UpdateCertificate:" + iot.CertificateStatusInactive,
+		"DetachThingPrincipal:thing",
+		"DetachPolicy:policy",
+		"DeleteCertificate:cert",
+	}, mock.Calls)
+}
+
+func TestGetOrSetFirstSeenIoTThingAttribute(t *testing.T) {
+	firstSeen := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Second)
+	mock := &mockedIoT{}
+
+	seen, err := getOrSetFirstSeenIoTThingAttribute(mock, &iot.ThingAttribute{
+		ThingName:  aws.String("seen"),
+		Attributes: map[string]*string{firstSeenTagKey: aws.String(firstSeen.Format(time.RFC3339))},
+	})
+	require.NoError(t, err)
+	assert.True(t, firstSeen.Equal(seen))
+	assert.Empty(t, mock.Calls)
+
+	_, err = getOrSetFirstSeenIoTThingAttribute(mock, &iot.ThingAttribute{ThingName: aws.String("new")})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"UpdateThing:new"}, mock.Calls)
+}
+
+func TestShouldIncludeIoTCertificate(t *testing.T) {
+	certificate := &iot.Certificate{
+		CertificateId: aws.String("cert"),
+		CreationDate:  aws.Time(time.Now()),
+		Status:        aws.String(iot.CertificateStatusActive),
+	}
+	pendingTransfer := &iot.Certificate{
+		CertificateId: aws.String("cert"),
+		CreationDate:  aws.Time(time.Now()),
+		Status:        aws.String(iot.CertificateStatusPendingTransfer),
+	}
+
+	assert.True(t, shouldIncludeIoTCertificate(certificate, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeIoTCertificate(certificate, time.Now().Add(1*time.Hour*-1), config.Config{}))
+	assert.False(t, shouldIncludeIoTCertificate(pendingTransfer, time.Now().Add(1*time.Hour), config.Config{}))
+}
@@ -0,0 +1,123 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// IoTCertificates - represents all IoT certificates
+type IoTCertificates struct {
+	CertificateIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (certificates IoTCertificates) ResourceName() string {
+	return "iot-certificate"
+}
+
+// ResourceIdentifiers - The IDs of the IoT certificates
+func (certificates IoTCertificates) ResourceIdentifiers() []string {
+	return certificates.CertificateIds
+}
+
+func (certificates IoTCertificates) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (certificates IoTCertificates) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllIoTCertificates(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// IoTThings - represents all IoT things
+type IoTThings struct {
+	ThingNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (things IoTThings) ResourceName() string {
+	return "iot-thing"
+}
+
+// ResourceIdentifiers - The names of the IoT things
+func (things IoTThings) ResourceIdentifiers() []string {
+	return things.ThingNames
+}
+
+func (things IoTThings) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (things IoTThings) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllIoTThings(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// IoTThingGroups - represents all IoT thing groups
+type IoTThingGroups struct {
+	GroupNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (groups IoTThingGroups) ResourceName() string {
+	return "iot-thing-group"
+}
+
+// ResourceIdentifiers - The names of the IoT thing groups
+func (groups IoTThingGroups) ResourceIdentifiers() []string {
+	return groups.GroupNames
+}
+
+func (groups IoTThingGroups) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (groups IoTThingGroups) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllIoTThingGroups(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// IoTPolicies - represents all IoT policies
+type IoTPolicies struct {
+	PolicyNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (policies IoTPolicies) ResourceName() string {
+	return "iot-policy"
+}
+
+// ResourceIdentifiers - The names of the IoT policies
+func (policies IoTPolicies) ResourceIdentifiers() []string {
+	return policies.PolicyNames
+}
+
+func (policies IoTPolicies) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (policies IoTPolicies) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllIoTPolicies(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/firehose/firehoseiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// getAllKinesisFirehoses returns the names of all the Kinesis Firehose delivery streams in the region.
+func getAllKinesisFirehoses(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	return listKinesisFirehoses(firehose.New(session), excludeAfter, configObj)
+}
+
+func listKinesisFirehoses(svc firehoseiface.FirehoseAPI, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	var streamNames []*string
+
+	// ListDeliveryStreams has no paginator in the SDK, so we page manually using the last returned stream name.
+	input := &firehose.ListDeliveryStreamsInput{}
+	for {
+		result, err := svc.ListDeliveryStreams(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, streamName := range result.DeliveryStreamNames {
+			// Since we already have the name here, avoid an extra API call by applying the name based config filter first.
+			if !config.ShouldInclude(
+				aws.StringValue(streamName),
+				configObj.KinesisFirehose.IncludeRule.NamesRegExp,
+				configObj.KinesisFirehose.ExcludeRule.NamesRegExp,
+			) {
+				continue
+			}
+
+			described, err := svc.DescribeDeliveryStream(&firehose.DescribeDeliveryStreamInput{
+				DeliveryStreamName: streamName,
+			})
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			if shouldIncludeKinesisFirehose(described.DeliveryStreamDescription, excludeAfter) {
+				streamNames = append(streamNames, streamName)
+			}
+		}
+
+		if !aws.BoolValue(result.HasMoreDeliveryStreams) || len(result.DeliveryStreamNames) == 0 {
+			break
+		}
+		input.ExclusiveStartDeliveryStreamName = result.DeliveryStreamNames[len(result.DeliveryStreamNames)-1]
+	}
+
+	return streamNames, nil
+}
+
+func shouldIncludeKinesisFirehose(stream *firehose.DeliveryStreamDescription, excludeAfter time.Time) bool {
+	if stream == nil {
+		return false
+	}
+
+	// Delivery streams that are already being deleted will be gone shortly, so there is nothing left to nuke. Note that
+	// streams in DELETING_FAILED are kept, so that they are deleted again.
+	if aws.StringValue(stream.DeliveryStreamStatus) == firehose.DeliveryStreamStatusDeleting {
+		return false
+	}
+
+	return stream.CreateTimestamp == nil || !excludeAfter.Before(*stream.CreateTimestamp)
+}
+
+// waitForKinesisFirehosesToBeDeleted polls the given delivery streams until they no longer exist, as there is no
+// waiter for delivery stream deletion in the SDK.
+func waitForKinesisFirehosesToBeDeleted(svc firehoseiface.FirehoseAPI, streamNames []*string) error {
+	remaining := streamNames
+	for i := 0; i < 30; i++ {
+		var stillDeleting []*string
+		for _, streamName := range remaining {
+			described, err := svc.DescribeDeliveryStream(&firehose.DescribeDeliveryStreamInput{
+				DeliveryStreamName: streamName,
+			})
+			if err != nil {
+				if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == firehose.ErrCodeResourceNotFoundException {
+					continue
+				}
+				return errors.WithStackTrace(err)
+			}
+			if aws.StringValue(described.DeliveryStreamDescription.DeliveryStreamStatus) == firehose.DeliveryStreamStatusDeletingFailed {
+				return errors.WithStackTrace(KinesisFirehoseDeleteFailedError{name: aws.StringValue(streamName)})
+			}
+			stillDeleting = append(stillDeleting, streamName)
+		}
+
+		if len(stillDeleting) == 0 {
+			return nil
+		}
+		remaining = stillDeleting
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debug("Waiting for Kinesis Firehose delivery streams to be deleted...")
+	}
+
+	return KinesisFirehoseDeleteTimeoutError{}
+}
+
+func nukeAllKinesisFirehoses(session *session.Session, streamNames []*string) error {
+	svc := firehose.New(session)
+
+	if len(streamNames) == 0 {
+		logging.Logger.Debugf("No Kinesis Firehose delivery streams to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Kinesis Firehose delivery streams in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, streamName := range streamNames {
+		// Force deletion so that streams whose KMS grant can't be retired are deleted as well, which is also what
+		// AWS recommends for streams stuck in DELETING_FAILED
+		_, err := svc.DeleteDeliveryStream(&firehose.DeleteDeliveryStreamInput{
+			DeliveryStreamName: streamName,
+			AllowForceDelete:   aws.Bool(true),
+		})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(streamName),
+			ResourceType: "Kinesis Firehose",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Kinesis Firehose",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, streamName)
+			logging.Logger.Debugf("Deleted Kinesis Firehose delivery stream: %s", aws.StringValue(streamName))
+		}
+	}
+
+	if len(deletedNames) > 0 {
+		if err := waitForKinesisFirehosesToBeDeleted(svc, deletedNames); err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			allErrs = multierror.Append(allErrs, err)
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Kinesis Firehose delivery stream(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/firehose/firehoseiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedKinesisFirehose serves ListDeliveryStreams one page at a time, and describes streams by name. Streams missing
+// from Streams are reported as not found.
+type mockedKinesisFirehose struct {
+	firehoseiface.FirehoseAPI
+	Pages   []*firehose.ListDeliveryStreamsOutput
+	Inputs  []*firehose.ListDeliveryStreamsInput
+	Streams map[string]*firehose.DeliveryStreamDescription
+}
+
+func (m *mockedKinesisFirehose) ListDeliveryStreams(input *firehose.ListDeliveryStreamsInput) (*firehose.ListDeliveryStreamsOutput, error) {
+	copied := *input
+	m.Inputs = append(m.Inputs, &copied)
+	page := m.Pages[0]
+	m.Pages = m.Pages[1:]
+	return page, nil
+}
+
+func (m *mockedKinesisFirehose) DescribeDeliveryStream(input *firehose.DescribeDeliveryStreamInput) (*firehose.DescribeDeliveryStreamOutput, error) {
+	stream, ok := m.Streams[aws.StringValue(input.DeliveryStreamName)]
+	if !ok {
+		return nil, awserr.New(firehose.ErrCodeResourceNotFoundException, "not found", nil)
+	}
+	return &firehose.DescribeDeliveryStreamOutput{DeliveryStreamDescription: stream}, nil
+}
+
+func TestListKinesisFirehoses(t *testing.T) {
+	t.Parallel()
+
+	old := time.Now().Add(-48 * time.Hour)
+	stream := func(status string, createdAt time.Time) *firehose.DeliveryStreamDescription {
+		return &firehose.DeliveryStreamDescription{DeliveryStreamStatus: aws.String(status), CreateTimestamp: aws.Time(createdAt)}
+	}
+	mock := &mockedKinesisFirehose{
+		Pages: []*firehose.ListDeliveryStreamsOutput{
+			{DeliveryStreamNames: aws.StringSlice([]string{"active", "deleting"}), HasMoreDeliveryStreams: aws.Bool(true)},
+			{DeliveryStreamNames: aws.StringSlice([]string{"failed", "recent"}), HasMoreDeliveryStreams: aws.Bool(false)},
+		},
+		Streams: map[string]*firehose.DeliveryStreamDescription{
+			"active":   stream(firehose.DeliveryStreamStatusActive, old),
+			"deleting": stream(firehose.DeliveryStreamStatusDeleting, old),
+			"failed":   stream(firehose.DeliveryStreamStatusDeletingFailed, old),
+			"recent":   stream(firehose.DeliveryStreamStatusActive, time.Now()),
+		},
+	}
+
+	names, err := listKinesisFirehoses(mock, time.Now().Add(-24*time.Hour), config.Config{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"active", "failed"}, aws.StringValueSlice(names))
+
+	require.Len(t, mock.Inputs, 2)
+	assert.Nil(t, mock.Inputs[0].ExclusiveStartDeliveryStreamName)
+	assert.Equal(t, "deleting", aws.StringValue(mock.Inputs[1].ExclusiveStartDeliveryStreamName))
+}
+
+func TestWaitForKinesisFirehosesToBeDeleted(t *testing.T) {
+	t.Parallel()
+
+	deleted := &mockedKinesisFirehose{Streams: map[string]*firehose.DeliveryStreamDescription{}}
+	assert.NoError(t, waitForKinesisFirehosesToBeDeleted(deleted, aws.StringSlice([]string{"gone"})))
+
+	failed := &mockedKinesisFirehose{
+		Streams: map[string]*firehose.DeliveryStreamDescription{
+			"stuck": {DeliveryStreamStatus: aws.String(firehose.DeliveryStreamStatusDeletingFailed)},
+		},
+	}
+	assert.Error(t, waitForKinesisFirehosesToBeDeleted(failed, aws.StringSlice([]string{"gone", "stuck"})))
+}
@@ -0,0 +1,50 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// KinesisFirehoses - represents all Kinesis Firehose delivery streams
+type KinesisFirehoses struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (firehoses KinesisFirehoses) ResourceName() string {
+	return "kinesis-firehose"
+}
+
+// ResourceIdentifiers - The names of the delivery streams
+func (firehoses KinesisFirehoses) ResourceIdentifiers() []string {
+	return firehoses.Names
+}
+
+func (firehoses KinesisFirehoses) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (firehoses KinesisFirehoses) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllKinesisFirehoses(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type KinesisFirehoseDeleteFailedError struct {
+	name string
+}
+
+func (e KinesisFirehoseDeleteFailedError) Error() string {
+	return "Kinesis Firehose delivery stream " + e.name + " is in DELETING_FAILED state"
+}
+
+type KinesisFirehoseDeleteTimeoutError struct{}
+
+func (e KinesisFirehoseDeleteTimeoutError) Error() string {
+	return "Timed out waiting for Kinesis Firehose delivery streams to be successfully deleted"
+}
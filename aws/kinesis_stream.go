@@ -2,15 +2,17 @@ package aws
 
 import (
 	"context"
+	goerror "errors"
 	"sync"
+	"time"
 
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
 
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
@@ -19,7 +21,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 )
 
-func getAllKinesisStreams(session *session.Session, configObj config.Config) ([]*string, error) {
+func getAllKinesisStreams(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
 	cfg, err := awsconfig.LoadDefaultConfig(context.TODO(), awsconfig.WithRegion(aws.StringValue(session.Config.Region)))
 	if err != nil {
 		return []*string{}, errors.WithStackTrace(err)
@@ -36,15 +38,30 @@ func getAllKinesisStreams(session *session.Session, configObj config.Config) ([]
 			return []*string{}, errors.WithStackTrace(err)
 		}
 		for _, stream := range resp.StreamNames {
-			if shouldIncludeKinesisStream(aws.String(stream), configObj) {
-				allStreams = append(allStreams, aws.String(stream))
+			// Since we already have the name here, avoid an extra API call by applying the name based config filter first.
+			if !shouldIncludeKinesisStream(aws.String(stream), configObj) {
+				continue
 			}
+
+			summary, err := svc.DescribeStreamSummary(context.TODO(), &kinesis.DescribeStreamSummaryInput{
+				StreamName: aws.String(stream),
+			})
+			if err != nil {
+				return []*string{}, errors.WithStackTrace(err)
+			}
+			description := summary.StreamDescriptionSummary
+
+			// Streams that are already being deleted have nothing left to nuke
+			if description.StreamStatus == types.StreamStatusDeleting {
+				continue
+			}
+			if description.StreamCreationTimestamp != nil && excludeAfter.Before(*description.StreamCreationTimestamp) {
+				continue
+			}
+			allStreams = append(allStreams, aws.String(stream))
 		}
 	}
 
-	if err != nil {
-		return nil, errors.WithStackTrace(err)
-	}
 	return allStreams, nil
 }
 
@@ -94,19 +111,21 @@ func nukeAllKinesisStreams(session *session.Session, identifiers []*string) erro
 	wg.Wait()
 
 	// Collect all the errors from the async delete calls into a single error struct.
-	// NOTE: We ignore OperationAbortedException which is thrown when there is an eventual consistency issue, where
-	// cloud-nuke picks up a Stream that is already requested to be deleted.
+	// NOTE: We ignore ResourceNotFoundException which is thrown when there is an eventual consistency issue, where
+	// cloud-nuke picks up a Stream that has been deleted in the meantime.
 	var allErrs *multierror.Error
 	for _, errChan := range errChans {
 		if err := <-errChan; err != nil {
-			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() != "OperationAbortedException" {
-				telemetry.TrackEvent(commonTelemetry.EventContext{
-					EventName: "Error Nuking Kinesis Stream",
-				}, map[string]interface{}{
-					"region": *session.Config.Region,
-				})
-				allErrs = multierror.Append(allErrs, err)
+			var notFoundErr *types.ResourceNotFoundException
+			if goerror.As(err, &notFoundErr) {
+				continue
 			}
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Kinesis Stream",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
 		}
 	}
 	finalErr := allErrs.ErrorOrNil()
@@ -124,7 +143,11 @@ func deleteKinesisStreamAsync(
 	region string,
 ) {
 	defer wg.Done()
-	input := &kinesis.DeleteStreamInput{StreamName: streamName}
+	// Registered enhanced fan-out consumers make DeleteStream fail unless they are deleted along with the stream
+	input := &kinesis.DeleteStreamInput{
+		StreamName:              streamName,
+		EnforceConsumerDeletion: aws.Bool(true),
+	}
 	_, err := svc.DeleteStream(context.TODO(), input)
 
 	// Record status of this resource
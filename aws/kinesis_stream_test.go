@@ -38,9 +38,13 @@ func TestListKinesisStreams(t *testing.T) {
 	sName := createKinesisStream(t, svc)
 	defer deleteKinesisStream(t, svc, sName, true)
 
-	sNames, err := getAllKinesisStreams(session, config.Config{})
+	sNames, err := getAllKinesisStreams(session, time.Now().Add(1*time.Hour), config.Config{})
 	require.NoError(t, err)
 	assert.Contains(t, aws.StringValueSlice(sNames), aws.StringValue(sName))
+
+	sNames, err = getAllKinesisStreams(session, time.Now().Add(-1*time.Hour), config.Config{})
+	require.NoError(t, err)
+	assert.NotContains(t, aws.StringValueSlice(sNames), aws.StringValue(sName))
 }
 
 func TestNukeKinesisStreamOne(t *testing.T) {
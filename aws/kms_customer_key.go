@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/go-commons/errors"
 	"github.com/hashicorp/go-multierror"
@@ -145,7 +146,9 @@ func listKeyAliases(svc *kms.KMS, batchSize int) (map[string][]string, error) {
 	return aliases, nil
 }
 
-func nukeAllCustomerManagedKmsKeys(session *session.Session, keyIds []*string, keyAliases map[string][]string) error {
+// nukeAllCustomerManagedKmsKeys disables the keys and schedules their deletion after the pending window, deleting
+// their aliases right away so the alias names can be reused.
+func nukeAllCustomerManagedKmsKeys(session *session.Session, keyIds []*string, keyAliases map[string][]string, pendingWindowInDays int64) error {
 	region := aws.StringValue(session.Config.Region)
 	if len(keyIds) == 0 {
 		logging.Logger.Debugf("No Customer Keys to nuke in region %s", region)
@@ -161,7 +164,7 @@ func nukeAllCustomerManagedKmsKeys(session *session.Session, keyIds []*string, k
 	errChans := make([]chan error, len(keyIds))
 	for i, secretID := range keyIds {
 		errChans[i] = make(chan error, 1)
-		go requestKeyDeletion(wg, errChans[i], svc, secretID)
+		go requestKeyDeletion(wg, errChans[i], svc, secretID, pendingWindowInDays)
 	}
 	wg.Wait()
 
@@ -203,10 +206,9 @@ func deleteAliases(wg *sync.WaitGroup, svc *kms.KMS, aliases []string) {
 	}
 }
 
-func requestKeyDeletion(wg *sync.WaitGroup, errChan chan error, svc *kms.KMS, key *string) {
+func requestKeyDeletion(wg *sync.WaitGroup, errChan chan error, svc *kms.KMS, key *string, pendingWindowInDays int64) {
 	defer wg.Done()
-	input := &kms.ScheduleKeyDeletionInput{KeyId: key, PendingWindowInDays: aws.Int64(int64(kmsRemovalWindow))}
-	_, err := svc.ScheduleKeyDeletion(input)
+	err := disableAndScheduleKeyDeletion(svc, key, pendingWindowInDays)
 
 	// Record status of this resource
 	e := report.Entry{
@@ -218,3 +220,19 @@ func requestKeyDeletion(wg *sync.WaitGroup, errChan chan error, svc *kms.KMS, ke
 
 	errChan <- err
 }
+
+// disableAndScheduleKeyDeletion disables the key, so it can't be used anymore, and schedules its deletion. The default
+// pending window is used when none is given.
+func disableAndScheduleKeyDeletion(svc kmsiface.KMSAPI, key *string, pendingWindowInDays int64) error {
+	if pendingWindowInDays == 0 {
+		pendingWindowInDays = kmsRemovalWindow
+	}
+
+	if _, err := svc.DisableKey(&kms.DisableKeyInput{KeyId: key}); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	input := &kms.ScheduleKeyDeletionInput{KeyId: key, PendingWindowInDays: aws.Int64(pendingWindowInDays)}
+	_, err := svc.ScheduleKeyDeletion(input)
+	return errors.WithStackTrace(err)
+}
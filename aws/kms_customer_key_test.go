@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -46,13 +47,13 @@ func TestListKmsUserKeys(t *testing.T) {
 
 	// test if matching by regexp works
 	keys, aliases, err = getAllKmsUserKeys(session, KmsCustomerKeys{}.MaxBatchSize(), time.Now(), config.Config{
-		KMSCustomerKeys: config.ResourceType{
+		KMSCustomerKeys: config.KMSCustomerKeysResourceType{ResourceType: config.ResourceType{
 			IncludeRule: config.FilterRule{
 				NamesRegExp: []config.Expression{
 					{RE: *regexp.MustCompile(fmt.Sprintf("^%s", keyAlias))},
 				},
 			},
-		},
+		}},
 	})
 	require.NoError(t, err)
 	assert.Contains(t, aws.StringValueSlice(keys), createdKeyId)
@@ -61,13 +62,13 @@ func TestListKmsUserKeys(t *testing.T) {
 
 	// test if exclusion by regexp works
 	keys, aliases, err = getAllKmsUserKeys(session, KmsCustomerKeys{}.MaxBatchSize(), time.Now(), config.Config{
-		KMSCustomerKeys: config.ResourceType{
+		KMSCustomerKeys: config.KMSCustomerKeysResourceType{ResourceType: config.ResourceType{
 			ExcludeRule: config.FilterRule{
 				NamesRegExp: []config.Expression{
 					{RE: *regexp.MustCompile(fmt.Sprintf("^%s", keyAlias))},
 				},
 			},
-		},
+		}},
 	})
 	require.NoError(t, err)
 	assert.NotContains(t, aws.StringValueSlice(keys), createdKeyId)
@@ -87,7 +88,7 @@ func TestRemoveKmsUserKeys(t *testing.T) {
 	keyAlias := "alias/cloud-nuke-test-" + util.UniqueID()
 	createdKeyId := createKmsCustomerManagedKey(t, session, keyAlias)
 
-	err = nukeAllCustomerManagedKmsKeys(session, []*string{&createdKeyId}, map[string][]string{"keyid": {keyAlias}})
+	err = nukeAllCustomerManagedKmsKeys(session, []*string{&createdKeyId}, map[string][]string{"keyid": {keyAlias}}, 0)
 	require.NoError(t, err)
 
 	// test if key is not included for removal second time, after being marked for deletion
@@ -131,3 +132,32 @@ func listAliasesForKey(session *session.Session, keyId *string) ([]string, error
 
 	return aliases, err
 }
+
+type mockedKmsKeyDeletion struct {
+	kmsiface.KMSAPI
+	Calls []string
+}
+
+func (m *mockedKmsKeyDeletion) DisableKey(input *kms.DisableKeyInput) (*kms.DisableKeyOutput, error) {
+	m.Calls = append(m.Calls, "disable:"+aws.StringValue(input.KeyId))
+	return &kms.DisableKeyOutput{}, nil
+}
+
+func (m *mockedKmsKeyDeletion) ScheduleKeyDeletion(input *kms.ScheduleKeyDeletionInput) (*kms.ScheduleKeyDeletionOutput, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("schedule:%s:%d", aws.StringValue(input.KeyId), aws.Int64Value(input.PendingWindowInDays)))
+	return &kms.ScheduleKeyDeletionOutput{}, nil
+}
+
+func TestDisableAndScheduleKeyDeletion(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedKmsKeyDeletion{}
+	require.NoError(t, disableAndScheduleKeyDeletion(mock, aws.String("key-1"), 0))
+	require.NoError(t, disableAndScheduleKeyDeletion(mock, aws.String("key-2"), 30))
+	assert.Equal(t, []string{
+		"disable:key-1",
+		fmt.Sprintf("schedule:key-1:%d", kmsRemovalWindow),
+		"disable:key-2",
+		"schedule:key-2:30",
+	}, mock.Calls)
+}
@@ -7,12 +7,14 @@ import (
 )
 
 // https://docs.aws.amazon.com/sdk-for-go/api/service/kms/#ScheduleKeyDeletionInput
-// must be between 7 and 30, inclusive
+// must be between 7 and 30, inclusive. Used when no pending window is configured.
 const kmsRemovalWindow = 7
 
 type KmsCustomerKeys struct {
 	KeyIds     []string
 	KeyAliases map[string][]string
+	// PendingWindowInDays is the number of days after which the keys are deleted
+	PendingWindowInDays int64
 }
 
 // ResourceName - the simple name of the aws resource
@@ -32,7 +34,7 @@ func (r KmsCustomerKeys) MaxBatchSize() int {
 
 // Nuke - remove all customer managed keys
 func (c KmsCustomerKeys) Nuke(session *session.Session, keyIds []string) error {
-	if err := nukeAllCustomerManagedKmsKeys(session, awsgo.StringSlice(keyIds), c.KeyAliases); err != nil {
+	if err := nukeAllCustomerManagedKmsKeys(session, awsgo.StringSlice(keyIds), c.KeyAliases, c.PendingWindowInDays); err != nil {
 		return errors.WithStackTrace(err)
 	}
 
@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// getAllLambdaLayerVersions returns the ARNs of all versions of all the Lambda layers in the region.
+func getAllLambdaLayerVersions(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	return listLambdaLayerVersions(lambda.New(session), excludeAfter, configObj)
+}
+
+func listLambdaLayerVersions(svc lambdaiface.LambdaAPI, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	var layerNames []*string
+	err := svc.ListLayersPages(&lambda.ListLayersInput{}, func(page *lambda.ListLayersOutput, lastPage bool) bool {
+		for _, layer := range page.Layers {
+			layerNames = append(layerNames, layer.LayerName)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	// ListLayers only reports the latest version of each layer, so the versions have to be listed per layer
+	var versionArns []*string
+	for _, layerName := range layerNames {
+		if !config.ShouldInclude(
+			aws.StringValue(layerName),
+			configObj.LambdaLayer.IncludeRule.NamesRegExp,
+			configObj.LambdaLayer.ExcludeRule.NamesRegExp,
+		) {
+			continue
+		}
+
+		err := svc.ListLayerVersionsPages(&lambda.ListLayerVersionsInput{
+			LayerName: layerName,
+		}, func(page *lambda.ListLayerVersionsOutput, lastPage bool) bool {
+			for _, version := range page.LayerVersions {
+				if shouldIncludeLambdaLayerVersion(version, excludeAfter) {
+					versionArns = append(versionArns, version.LayerVersionArn)
+				}
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+	}
+
+	return versionArns, nil
+}
+
+func shouldIncludeLambdaLayerVersion(version *lambda.LayerVersionsListItem, excludeAfter time.Time) bool {
+	if version == nil {
+		return false
+	}
+
+	createdDate := aws.StringValue(version.CreatedDate)
+	layout := "2006-01-02T15:04:05.000+0000"
+	createdDateTime, err := time.Parse(layout, createdDate)
+	if err != nil {
+		logging.Logger.Debugf("Could not parse creation timestamp (%s) of Lambda layer version %s. Excluding from delete.", createdDate, aws.StringValue(version.LayerVersionArn))
+		return false
+	}
+
+	return !excludeAfter.Before(createdDateTime)
+}
+
+// parseLambdaLayerVersionArn splits a layer version ARN (arn:aws:lambda:<region>:<account>:layer:<name>:<version>)
+// into the layer name and version number expected by DeleteLayerVersion.
+func parseLambdaLayerVersionArn(versionArn string) (string, int64, error) {
+	idx := strings.LastIndex(versionArn, ":")
+	if idx == -1 {
+		return "", 0, errors.WithStackTrace(InvalidLambdaLayerVersionArnError{arn: versionArn})
+	}
+	version, err := strconv.ParseInt(versionArn[idx+1:], 10, 64)
+	if err != nil {
+		return "", 0, errors.WithStackTrace(InvalidLambdaLayerVersionArnError{arn: versionArn})
+	}
+	return versionArn[:idx], version, nil
+}
+
+func nukeAllLambdaLayerVersions(session *session.Session, versionArns []*string) error {
+	svc := lambda.New(session)
+
+	if len(versionArns) == 0 {
+		logging.Logger.Debugf("No Lambda layer versions to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Lambda layer versions in region %s", *session.Config.Region)
+	var deletedArns []*string
+	var allErrs *multierror.Error
+
+	for _, versionArn := range versionArns {
+		// DeleteLayerVersion accepts the layer ARN in place of the layer name
+		layerArn, version, err := parseLambdaLayerVersionArn(aws.StringValue(versionArn))
+		if err == nil {
+			_, err = svc.DeleteLayerVersion(&lambda.DeleteLayerVersionInput{
+				LayerName:     aws.String(layerArn),
+				VersionNumber: aws.Int64(version),
+			})
+		}
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(versionArn),
+			ResourceType: "Lambda layer version",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Lambda Layer Version",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedArns = append(deletedArns, versionArn)
+			logging.Logger.Debugf("Deleted Lambda layer version: %s", aws.StringValue(versionArn))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Lambda layer version(s) deleted in %s", len(deletedArns), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedLambdaLayers serves a fixed set of layers, each with its own list of versions
+type mockedLambdaLayers struct {
+	lambdaiface.LambdaAPI
+	Versions map[string][]*lambda.LayerVersionsListItem
+}
+
+func (m mockedLambdaLayers) ListLayersPages(input *lambda.ListLayersInput, fn func(*lambda.ListLayersOutput, bool) bool) error {
+	output := &lambda.ListLayersOutput{}
+	for name := range m.Versions {
+		output.Layers = append(output.Layers, &lambda.LayersListItem{LayerName: aws.String(name)})
+	}
+	fn(output, true)
+	return nil
+}
+
+func (m mockedLambdaLayers) ListLayerVersionsPages(input *lambda.ListLayerVersionsInput, fn func(*lambda.ListLayerVersionsOutput, bool) bool) error {
+	fn(&lambda.ListLayerVersionsOutput{LayerVersions: m.Versions[aws.StringValue(input.LayerName)]}, true)
+	return nil
+}
+
+func TestListLambdaLayerVersions(t *testing.T) {
+	t.Parallel()
+
+	layout := "2006-01-02T15:04:05.000+0000"
+	old := time.Now().Add(-48 * time.Hour).UTC().Format(layout)
+	recent := time.Now().UTC().Format(layout)
+	version := func(name string, number string, createdDate string) *lambda.LayerVersionsListItem {
+		return &lambda.LayerVersionsListItem{
+			LayerVersionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:layer:" + name + ":" + number),
+			CreatedDate:     aws.String(createdDate),
+		}
+	}
+	mock := mockedLambdaLayers{
+		Versions: map[string][]*lambda.LayerVersionsListItem{
+			"cloud-nuke-test": {version("cloud-nuke-test", "1", old), version("cloud-nuke-test", "2", recent)},
+			"keep-me":         {version("keep-me", "1", old)},
+		},
+	}
+	excludeConfig := config.Config{
+		LambdaLayer: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^keep-.*")},
+				},
+			},
+		},
+	}
+
+	arns, err := listLambdaLayerVersions(mock, time.Now().Add(-24*time.Hour), excludeConfig)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"arn:aws:lambda:us-east-1:123456789012:layer:cloud-nuke-test:1"}, aws.StringValueSlice(arns))
+
+	arns, err = listLambdaLayerVersions(mock, time.Now().Add(1*time.Hour), config.Config{})
+	require.NoError(t, err)
+	assert.Len(t, arns, 3)
+}
+
+func TestParseLambdaLayerVersionArn(t *testing.T) {
+	layerArn, version, err := parseLambdaLayerVersionArn("arn:aws:lambda:us-east-1:123456789012:layer:cloud-nuke-test:12")
+	require.NoError(t, err)
+	assert.Equal(t, "arn:aws:lambda:us-east-1:123456789012:layer:cloud-nuke-test", layerArn)
+	assert.Equal(t, int64(12), version)
+
+	_, _, err = parseLambdaLayerVersionArn("arn:aws:lambda:us-east-1:123456789012:layer:cloud-nuke-test")
+	assert.Error(t, err)
+}
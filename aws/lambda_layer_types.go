@@ -0,0 +1,44 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// LambdaLayerVersions - represents all versions of the Lambda layers
+type LambdaLayerVersions struct {
+	LayerVersionArns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (layers LambdaLayerVersions) ResourceName() string {
+	return "lambda-layer"
+}
+
+// ResourceIdentifiers - The ARNs of the Lambda layer versions
+func (layers LambdaLayerVersions) ResourceIdentifiers() []string {
+	return layers.LayerVersionArns
+}
+
+func (layers LambdaLayerVersions) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (layers LambdaLayerVersions) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllLambdaLayerVersions(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type InvalidLambdaLayerVersionArnError struct {
+	arn string
+}
+
+func (e InvalidLambdaLayerVersionArnError) Error() string {
+	return "Invalid Lambda layer version ARN: " + e.arn
+}
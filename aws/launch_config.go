@@ -15,19 +15,31 @@ import (
 	"github.com/gruntwork-io/go-commons/errors"
 )
 
-// Returns a formatted string of Launch config Names
-func getAllLaunchConfigurations(session *session.Session, region string, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+// Returns a formatted string of Launch config Names, leaving out those used by auto scaling groups other than the given
+// groups being nuked
+func getAllLaunchConfigurations(session *session.Session, region string, excludeAfter time.Time, configObj config.Config, nukedGroupNames []string) ([]*string, error) {
 	svc := autoscaling.New(session)
-	result, err := svc.DescribeLaunchConfigurations(&autoscaling.DescribeLaunchConfigurationsInput{})
+
+	// Launch configurations can't be deleted while an auto scaling group uses them
+	references, err := getAutoScalingGroupLaunchReferences(svc, nukedGroupNames)
 	if err != nil {
-		return nil, errors.WithStackTrace(err)
+		return nil, err
 	}
 
 	var configNames []*string
-	for _, config := range result.LaunchConfigurations {
-		if shouldIncludeLaunchConfiguration(config, excludeAfter, configObj) {
-			configNames = append(configNames, config.LaunchConfigurationName)
+	err = svc.DescribeLaunchConfigurationsPages(&autoscaling.DescribeLaunchConfigurationsInput{}, func(page *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool {
+		for _, config := range page.LaunchConfigurations {
+			if references.launchConfigurationNames[awsgo.StringValue(config.LaunchConfigurationName)] {
+				continue
+			}
+			if shouldIncludeLaunchConfiguration(config, excludeAfter, configObj) {
+				configNames = append(configNames, config.LaunchConfigurationName)
+			}
 		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
 	}
 
 	return configNames, nil
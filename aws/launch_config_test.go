@@ -59,14 +59,14 @@ func TestListLaunchConfigurations(t *testing.T) {
 	defer nukeAllLaunchConfigurations(session, []*string{&uniqueTestID})
 	defer nukeAllEc2Instances(session, findEC2InstancesByNameTag(t, session, uniqueTestID))
 
-	configNames, err := getAllLaunchConfigurations(session, region, time.Now().Add(1*time.Hour*-1), config.Config{})
+	configNames, err := getAllLaunchConfigurations(session, region, time.Now().Add(1*time.Hour*-1), config.Config{}, nil)
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of Launch Configurations")
 	}
 
 	assert.NotContains(t, awsgo.StringValueSlice(configNames), uniqueTestID)
 
-	configNames, err = getAllLaunchConfigurations(session, region, time.Now().Add(1*time.Hour), config.Config{})
+	configNames, err = getAllLaunchConfigurations(session, region, time.Now().Add(1*time.Hour), config.Config{}, nil)
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of Launch Configurations")
 	}
@@ -110,7 +110,7 @@ func TestNukeLaunchConfigurations(t *testing.T) {
 		assert.Fail(t, errors.WithStackTrace(err).Error())
 	}
 
-	groupNames, err := getAllLaunchConfigurations(session, region, time.Now().Add(1*time.Hour), config.Config{})
+	groupNames, err := getAllLaunchConfigurations(session, region, time.Now().Add(1*time.Hour), config.Config{}, nil)
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of Launch Configurations")
 	}
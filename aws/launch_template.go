@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
@@ -15,19 +16,31 @@ import (
 	"github.com/gruntwork-io/go-commons/errors"
 )
 
-// Returns a formatted string of Launch Template Names
-func getAllLaunchTemplates(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+// Returns a formatted string of Launch Template Names, leaving out those used by auto scaling groups other than the
+// given groups being nuked
+func getAllLaunchTemplates(session *session.Session, excludeAfter time.Time, configObj config.Config, nukedGroupNames []string) ([]*string, error) {
 	svc := ec2.New(session)
-	result, err := svc.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{})
+
+	// EC2 lets launch templates be deleted while an auto scaling group uses them, which would break the group
+	references, err := getAutoScalingGroupLaunchReferences(autoscaling.New(session), nukedGroupNames)
 	if err != nil {
-		return nil, errors.WithStackTrace(err)
+		return nil, err
 	}
 
 	var templateNames []*string
-	for _, template := range result.LaunchTemplates {
-		if shouldIncludeLaunchTemplate(template, excludeAfter, configObj) {
-			templateNames = append(templateNames, template.LaunchTemplateName)
+	err = svc.DescribeLaunchTemplatesPages(&ec2.DescribeLaunchTemplatesInput{}, func(page *ec2.DescribeLaunchTemplatesOutput, lastPage bool) bool {
+		for _, template := range page.LaunchTemplates {
+			if references.launchTemplates[awsgo.StringValue(template.LaunchTemplateId)] || references.launchTemplates[awsgo.StringValue(template.LaunchTemplateName)] {
+				continue
+			}
+			if shouldIncludeLaunchTemplate(template, excludeAfter, configObj) {
+				templateNames = append(templateNames, template.LaunchTemplateName)
+			}
 		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
 	}
 
 	return templateNames, nil
@@ -49,7 +62,7 @@ func shouldIncludeLaunchTemplate(lt *ec2.LaunchTemplate, excludeAfter time.Time,
 	)
 }
 
-// Deletes all Launch Templates
+// Deletes all Launch Templates, along with all their versions
 func nukeAllLaunchTemplates(session *session.Session, templateNames []*string) error {
 	svc := ec2.New(session)
 
@@ -52,14 +52,14 @@ func TestListLaunchTemplates(t *testing.T) {
 	// clean up after this test
 	defer nukeAllLaunchTemplates(session, []*string{&uniqueTestID})
 
-	templateNames, err := getAllLaunchTemplates(session, time.Now().Add(1*time.Hour*-1), config.Config{})
+	templateNames, err := getAllLaunchTemplates(session, time.Now().Add(1*time.Hour*-1), config.Config{}, nil)
 
 	assert.NoError(t, err, "Unable to fetch list of Launch Templates")
 
 	// Template should not be in the list due to the time filter
 	assert.NotContains(t, awsgo.StringValueSlice(templateNames), uniqueTestID)
 
-	templateNames, err = getAllLaunchTemplates(session, time.Now().Add(1*time.Hour), config.Config{})
+	templateNames, err = getAllLaunchTemplates(session, time.Now().Add(1*time.Hour), config.Config{}, nil)
 
 	assert.NoError(t, err, "Unable to fetch list of Launch Templates")
 
@@ -90,7 +90,7 @@ func TestNukeLaunchTemplates(t *testing.T) {
 
 	assert.NoError(t, nukeAllLaunchTemplates(session, []*string{&uniqueTestID}))
 
-	groupNames, err := getAllLaunchTemplates(session, time.Now().Add(1*time.Hour), config.Config{})
+	groupNames, err := getAllLaunchTemplates(session, time.Now().Add(1*time.Hour), config.Config{}, nil)
 	assert.NoError(t, err, "Unable to fetch list of Launch Templates")
 
 	assert.NotContains(t, awsgo.StringValueSlice(groupNames), uniqueTestID)
@@ -0,0 +1,217 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/lightsail/lightsailiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// shouldIncludeLightsailResource applies the excludeAfter filter, the exclusion tag and the name filters of the given
+// resource type, which are shared by all Lightsail resources.
+func shouldIncludeLightsailResource(name string, createdAt *time.Time, tags []*lightsail.Tag, excludeAfter time.Time, resourceType config.ResourceType) bool {
+	if name == "" {
+		return false
+	}
+
+	if createdAt != nil && excludeAfter.Before(*createdAt) {
+		return false
+	}
+
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return false
+		}
+	}
+
+	return config.ShouldInclude(
+		name,
+		resourceType.IncludeRule.NamesRegExp,
+		resourceType.ExcludeRule.NamesRegExp,
+	)
+}
+
+func getAllLightsailInstances(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := lightsail.New(session)
+
+	var names []*string
+	input := &lightsail.GetInstancesInput{}
+	for {
+		output, err := svc.GetInstances(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, instance := range output.Instances {
+			if shouldIncludeLightsailResource(aws.StringValue(instance.Name), instance.CreatedAt, instance.Tags, excludeAfter, configObj.LightsailInstance) {
+				names = append(names, instance.Name)
+			}
+		}
+
+		if aws.StringValue(output.NextPageToken) == "" {
+			break
+		}
+		input.PageToken = output.NextPageToken
+	}
+	return names, nil
+}
+
+func getAllLightsailDatabases(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := lightsail.New(session)
+
+	var names []*string
+	input := &lightsail.GetRelationalDatabasesInput{}
+	for {
+		output, err := svc.GetRelationalDatabases(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, database := range output.RelationalDatabases {
+			if shouldIncludeLightsailResource(aws.StringValue(database.Name), database.CreatedAt, database.Tags, excludeAfter, configObj.LightsailDatabase) {
+				names = append(names, database.Name)
+			}
+		}
+
+		if aws.StringValue(output.NextPageToken) == "" {
+			break
+		}
+		input.PageToken = output.NextPageToken
+	}
+	return names, nil
+}
+
+// getAllLightsailStaticIps returns the names of the static IPs. Static IPs can't be tagged, so only the excludeAfter
+// and name filters apply.
+func getAllLightsailStaticIps(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := lightsail.New(session)
+
+	var names []*string
+	input := &lightsail.GetStaticIpsInput{}
+	for {
+		output, err := svc.GetStaticIps(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, staticIp := range output.StaticIps {
+			if shouldIncludeLightsailResource(aws.StringValue(staticIp.Name), staticIp.CreatedAt, nil, excludeAfter, configObj.LightsailStaticIp) {
+				names = append(names, staticIp.Name)
+			}
+		}
+
+		if aws.StringValue(output.NextPageToken) == "" {
+			break
+		}
+		input.PageToken = output.NextPageToken
+	}
+	return names, nil
+}
+
+func getAllLightsailLoadBalancers(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := lightsail.New(session)
+
+	var names []*string
+	input := &lightsail.GetLoadBalancersInput{}
+	for {
+		output, err := svc.GetLoadBalancers(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, loadBalancer := range output.LoadBalancers {
+			if shouldIncludeLightsailResource(aws.StringValue(loadBalancer.Name), loadBalancer.CreatedAt, loadBalancer.Tags, excludeAfter, configObj.LightsailLoadBalancer) {
+				names = append(names, loadBalancer.Name)
+			}
+		}
+
+		if aws.StringValue(output.NextPageToken) == "" {
+			break
+		}
+		input.PageToken = output.NextPageToken
+	}
+	return names, nil
+}
+
+// nukeAllLightsailInstances deletes the instances along with their add-ons, such as automatic snapshots. Static IPs
+// attached to the instances are detached, but not released.
+func nukeAllLightsailInstances(session *session.Session, names []*string) error {
+	return nukeLightsailResources(session, "Lightsail Instance", names, func(svc lightsailiface.LightsailAPI, name *string) error {
+		_, err := svc.DeleteInstance(&lightsail.DeleteInstanceInput{InstanceName: name, ForceDeleteAddOns: aws.Bool(true)})
+		return err
+	})
+}
+
+func nukeAllLightsailDatabases(session *session.Session, names []*string) error {
+	return nukeLightsailResources(session, "Lightsail Database", names, func(svc lightsailiface.LightsailAPI, name *string) error {
+		_, err := svc.DeleteRelationalDatabase(&lightsail.DeleteRelationalDatabaseInput{RelationalDatabaseName: name, SkipFinalSnapshot: aws.Bool(true)})
+		return err
+	})
+}
+
+func nukeAllLightsailStaticIps(session *session.Session, names []*string) error {
+	return nukeLightsailResources(session, "Lightsail Static IP", names, func(svc lightsailiface.LightsailAPI, name *string) error {
+		_, err := svc.ReleaseStaticIp(&lightsail.ReleaseStaticIpInput{StaticIpName: name})
+		return err
+	})
+}
+
+func nukeAllLightsailLoadBalancers(session *session.Session, names []*string) error {
+	return nukeLightsailResources(session, "Lightsail Load Balancer", names, func(svc lightsailiface.LightsailAPI, name *string) error {
+		_, err := svc.DeleteLoadBalancer(&lightsail.DeleteLoadBalancerInput{LoadBalancerName: name})
+		return err
+	})
+}
+
+// nukeLightsailResources deletes the given Lightsail resources one by one using deleteFn, recording each of them in the
+// report.
+func nukeLightsailResources(session *session.Session, resourceType string, names []*string, deleteFn func(svc lightsailiface.LightsailAPI, name *string) error) error {
+	svc := lightsail.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		err := deleteFn(svc, name)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedNames), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,28 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldIncludeLightsailResource(t *testing.T) {
+	createdAt := time.Now().Add(-1 * time.Hour)
+	excludeTags := []*lightsail.Tag{{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}}
+	excludeConfig := config.ResourceType{
+		ExcludeRule: config.FilterRule{
+			NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^prod-")}},
+		},
+	}
+
+	assert.True(t, shouldIncludeLightsailResource("cloud-nuke-test", &createdAt, nil, time.Now(), config.ResourceType{}))
+	assert.False(t, shouldIncludeLightsailResource("cloud-nuke-test", &createdAt, nil, createdAt.Add(-1*time.Minute), config.ResourceType{}))
+	assert.False(t, shouldIncludeLightsailResource("cloud-nuke-test", &createdAt, excludeTags, time.Now(), config.ResourceType{}))
+	assert.False(t, shouldIncludeLightsailResource("prod-web", &createdAt, nil, time.Now(), excludeConfig))
+	assert.False(t, shouldIncludeLightsailResource("", &createdAt, nil, time.Now(), config.ResourceType{}))
+}
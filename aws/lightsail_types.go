@@ -0,0 +1,123 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// LightsailLoadBalancers - represents all Lightsail load balancers
+type LightsailLoadBalancers struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (loadBalancers LightsailLoadBalancers) ResourceName() string {
+	return "lightsail-load-balancer"
+}
+
+// ResourceIdentifiers - The names of the Lightsail load balancers
+func (loadBalancers LightsailLoadBalancers) ResourceIdentifiers() []string {
+	return loadBalancers.Names
+}
+
+func (loadBalancers LightsailLoadBalancers) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (loadBalancers LightsailLoadBalancers) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllLightsailLoadBalancers(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// LightsailInstances - represents all Lightsail instances
+type LightsailInstances struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (instances LightsailInstances) ResourceName() string {
+	return "lightsail-instance"
+}
+
+// ResourceIdentifiers - The names of the Lightsail instances
+func (instances LightsailInstances) ResourceIdentifiers() []string {
+	return instances.Names
+}
+
+func (instances LightsailInstances) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (instances LightsailInstances) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllLightsailInstances(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// LightsailStaticIps - represents all Lightsail static IPs
+type LightsailStaticIps struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (staticIps LightsailStaticIps) ResourceName() string {
+	return "lightsail-static-ip"
+}
+
+// ResourceIdentifiers - The names of the Lightsail static IPs
+func (staticIps LightsailStaticIps) ResourceIdentifiers() []string {
+	return staticIps.Names
+}
+
+func (staticIps LightsailStaticIps) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (staticIps LightsailStaticIps) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllLightsailStaticIps(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// LightsailDatabases - represents all Lightsail managed databases
+type LightsailDatabases struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (databases LightsailDatabases) ResourceName() string {
+	return "lightsail-database"
+}
+
+// ResourceIdentifiers - The names of the Lightsail managed databases
+func (databases LightsailDatabases) ResourceIdentifiers() []string {
+	return databases.Names
+}
+
+func (databases LightsailDatabases) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (databases LightsailDatabases) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllLightsailDatabases(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
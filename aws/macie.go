@@ -2,6 +2,8 @@ package aws
 
 import (
 	goerror "errors"
+	"time"
+
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
 
@@ -9,11 +11,30 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
 	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
 )
 
+// isMacieNotEnabledError returns whether the error is one of those AWS returns when Macie isn't enabled in the region
+func isMacieNotEnabledError(err error) bool {
+	var ade *macie2.AccessDeniedException
+	var rnfe *macie2.ResourceNotFoundException
+
+	switch {
+	case goerror.As(err, &ade):
+		logging.Logger.Debugf("Macie AccessDeniedException means macie is not enabled in account, so skipping")
+		return true
+	case goerror.As(err, &rnfe):
+		logging.Logger.Debugf("Macie ResourceNotFoundException means macie is not enabled in account, so skipping")
+		return true
+	default:
+		return false
+	}
+}
+
 // getAllMacieMemberAccounts will find and return any Macie accounts that were created via accepting an invite from another AWS Account
 // Unfortunately, the Macie API doesn't provide the metadata information we'd need to implement the excludeAfter or configObj patterns, so we
 // currently can only accept a session
@@ -27,19 +48,10 @@ func getAllMacieMemberAccounts(session *session.Session) ([]string, error) {
 		// There are several different errors that AWS may return when you attempt to call Macie operations on an account
 		// that doesn't yet have Macie enabled. For our purposes, this is fine, as we're only looking for those accounts and
 		// regions where Macie is enabled. Therefore, we ignore only these expected errors, and return any other error that might occur
-		var ade *macie2.AccessDeniedException
-		var rnfe *macie2.ResourceNotFoundException
-
-		switch {
-		case goerror.As(err, &ade):
-			logging.Logger.Debugf("Macie AccessDeniedException means macie is not enabled in account, so skipping")
+		if isMacieNotEnabledError(err) {
 			return allMacieAccounts, nil
-		case goerror.As(err, &rnfe):
-			logging.Logger.Debugf("Macie ResourceNotFoundException means macie is not enabled in account, so skipping")
-			return allMacieAccounts, nil
-		default:
-			return allMacieAccounts, errors.WithStackTrace(err)
 		}
+		return allMacieAccounts, errors.WithStackTrace(err)
 	}
 	// If the current account does have an Administrator account relationship, and it is enabled, then we consider this a macie member account
 	if output.Administrator != nil && output.Administrator.RelationshipStatus != nil {
@@ -107,3 +119,159 @@ func nukeAllMacieMemberAccounts(session *session.Session, identifiers []string)
 
 	return nil
 }
+
+// getAllMacieClassificationJobs returns the IDs of the sensitive data discovery jobs that are still running or scheduled
+func getAllMacieClassificationJobs(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := macie2.New(session)
+
+	var jobIds []*string
+	err := svc.ListClassificationJobsPages(&macie2.ListClassificationJobsInput{}, func(page *macie2.ListClassificationJobsOutput, lastPage bool) bool {
+		for _, job := range page.Items {
+			if shouldIncludeMacieClassificationJob(job, excludeAfter, configObj) {
+				jobIds = append(jobIds, job.JobId)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		if isMacieNotEnabledError(err) {
+			return nil, nil
+		}
+		return nil, errors.WithStackTrace(err)
+	}
+	return jobIds, nil
+}
+
+func shouldIncludeMacieClassificationJob(job *macie2.JobSummary, excludeAfter time.Time, configObj config.Config) bool {
+	if job == nil {
+		return false
+	}
+
+	// Jobs can't be deleted, so there is nothing left to do for those that are done
+	status := aws.StringValue(job.JobStatus)
+	if status == macie2.JobStatusCancelled || status == macie2.JobStatusComplete {
+		return false
+	}
+
+	if job.CreatedAt != nil && excludeAfter.Before(*job.CreatedAt) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(job.Name),
+		configObj.MacieClassificationJob.IncludeRule.NamesRegExp,
+		configObj.MacieClassificationJob.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Cancels all Macie classification jobs
+func nukeAllMacieClassificationJobs(session *session.Session, jobIds []*string) error {
+	svc := macie2.New(session)
+
+	if len(jobIds) == 0 {
+		logging.Logger.Debugf("No Macie classification jobs to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Cancelling all Macie classification jobs in region %s", *session.Config.Region)
+	var cancelledJobIds []*string
+	var allErrs *multierror.Error
+
+	for _, jobId := range jobIds {
+		_, err := svc.UpdateClassificationJob(&macie2.UpdateClassificationJobInput{
+			JobId:     jobId,
+			JobStatus: aws.String(macie2.JobStatusCancelled),
+		})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(jobId),
+			ResourceType: "Macie classification job",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Macie Classification Job",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			cancelledJobIds = append(cancelledJobIds, jobId)
+			logging.Logger.Debugf("Cancelled Macie classification job: %s", aws.StringValue(jobId))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Macie classification job(s) cancelled in %s", len(cancelledJobIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// getMacieSession returns the ID of the current account if Macie was enabled in the region before excludeAfter.
+// Member accounts are left to macie-member, as they have to leave their administrator account first.
+func getMacieSession(session *session.Session, excludeAfter time.Time) ([]string, error) {
+	svc := macie2.New(session)
+
+	output, err := svc.GetMacieSession(&macie2.GetMacieSessionInput{})
+	if err != nil {
+		if isMacieNotEnabledError(err) {
+			return nil, nil
+		}
+		return nil, errors.WithStackTrace(err)
+	}
+	if output.CreatedAt != nil && excludeAfter.Before(*output.CreatedAt) {
+		return nil, nil
+	}
+
+	administrator, err := svc.GetAdministratorAccount(&macie2.GetAdministratorAccountInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	if administrator.Administrator != nil && aws.StringValue(administrator.Administrator.RelationshipStatus) == macie2.RelationshipStatusEnabled {
+		return nil, nil
+	}
+
+	identity, err := sts.New(session).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return []string{aws.StringValue(identity.Account)}, nil
+}
+
+// Disables Macie in the region, which also deletes its findings and classification jobs
+func nukeMacieSession(session *session.Session, identifiers []string) error {
+	svc := macie2.New(session)
+
+	if len(identifiers) == 0 {
+		logging.Logger.Debugf("No Macie session to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	for _, accountId := range identifiers {
+		_, err := svc.DisableMacie(&macie2.DisableMacieInput{})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   accountId,
+			ResourceType: "Macie session",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Macie Session",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			return errors.WithStackTrace(err)
+		}
+
+		logging.Logger.Debugf("[OK] Macie disabled for accountId %s in %s", accountId, *session.Config.Region)
+	}
+
+	return nil
+}
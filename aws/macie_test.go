@@ -1,5 +1,15 @@
 package aws
 
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
 //func TestListMacieAccounts(t *testing.T) {
 //	// Currently we hardcode to region us-east-1, because this is where our "standing" test invite exists
 //	region := "us-east-1"
@@ -44,3 +54,20 @@ package aws
 //	_, acceptInviteErr := svc.AcceptInvitation(acceptInviteInput)
 //	require.NoError(t, acceptInviteErr)
 //}
+
+func TestShouldIncludeMacieClassificationJob(t *testing.T) {
+	job := &macie2.JobSummary{
+		Name:      aws.String("cloud-nuke-test"),
+		CreatedAt: aws.Time(time.Now()),
+		JobStatus: aws.String(macie2.JobStatusRunning),
+	}
+	completedJob := &macie2.JobSummary{
+		Name:      aws.String("cloud-nuke-test"),
+		CreatedAt: aws.Time(time.Now()),
+		JobStatus: aws.String(macie2.JobStatusComplete),
+	}
+
+	assert.True(t, shouldIncludeMacieClassificationJob(job, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeMacieClassificationJob(job, time.Now().Add(1*time.Hour*-1), config.Config{}))
+	assert.False(t, shouldIncludeMacieClassificationJob(completedJob, time.Now().Add(1*time.Hour), config.Config{}))
+}
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/gruntwork-io/go-commons/errors"
 )
@@ -27,3 +28,50 @@ func (r MacieMember) Nuke(session *session.Session, identifiers []string) error
 	}
 	return nil
 }
+
+type MacieClassificationJobs struct {
+	JobIds []string
+}
+
+func (r MacieClassificationJobs) ResourceName() string {
+	return "macie-classification-job"
+}
+
+func (r MacieClassificationJobs) ResourceIdentifiers() []string {
+	return r.JobIds
+}
+
+func (r MacieClassificationJobs) MaxBatchSize() int {
+	return 49
+}
+
+func (r MacieClassificationJobs) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllMacieClassificationJobs(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
+
+// MacieSession - represents Macie being enabled in a region, identified by the account ID
+type MacieSession struct {
+	AccountIds []string
+}
+
+func (r MacieSession) ResourceName() string {
+	return "macie-session"
+}
+
+func (r MacieSession) ResourceIdentifiers() []string {
+	return r.AccountIds
+}
+
+func (r MacieSession) MaxBatchSize() int {
+	return 10
+}
+
+func (r MacieSession) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeMacieSession(session, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
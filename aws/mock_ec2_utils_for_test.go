@@ -249,12 +249,12 @@ func getDescribeEndpointsInput(vpcId string) *ec2.DescribeVpcEndpointsInput {
 	}
 }
 
-func getDescribeEndpointsWaitForDeletionInput(vpcId string) *ec2.DescribeVpcEndpointsInput {
+func getDescribeEndpointsWaitForDeletionInput(endpointIds []string) *ec2.DescribeVpcEndpointsInput {
 	return &ec2.DescribeVpcEndpointsInput{
 		Filters: []*ec2.Filter{
 			{
-				Name:   awsgo.String("vpc-id"),
-				Values: []*string{awsgo.String(vpcId)},
+				Name:   awsgo.String("vpc-endpoint-id"),
+				Values: awsgo.StringSlice(endpointIds),
 			},
 			{
 				Name:   awsgo.String("vpc-endpoint-state"),
@@ -264,6 +264,17 @@ func getDescribeEndpointsWaitForDeletionInput(vpcId string) *ec2.DescribeVpcEndp
 	}
 }
 
+func getDescribeVpcPeeringConnectionsInput(filterName, vpcId string) *ec2.DescribeVpcPeeringConnectionsInput {
+	return &ec2.DescribeVpcPeeringConnectionsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   awsgo.String(filterName),
+				Values: []*string{awsgo.String(vpcId)},
+			},
+		},
+	}
+}
+
 func getDescribeEndpointsOutput(endpointIds []string) *ec2.DescribeVpcEndpointsOutput {
 	var endpoints []*ec2.VpcEndpoint
 	for _, endpointId := range endpointIds {
@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of Amazon MQ broker IDs
+func getAllMQBrokers(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := mq.New(session)
+
+	var candidates []*mq.BrokerSummary
+	err := svc.ListBrokersPages(&mq.ListBrokersInput{}, func(page *mq.ListBrokersResponse, lastPage bool) bool {
+		for _, broker := range page.BrokerSummaries {
+			if shouldIncludeMQBroker(broker, excludeAfter, configObj) {
+				candidates = append(candidates, broker)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var brokerIds []*string
+	for _, broker := range candidates {
+		tags, err := svc.ListTags(&mq.ListTagsInput{ResourceArn: broker.BrokerArn})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if aws.StringValue(tags.Tags[AwsResourceExclusionTagKey]) != "true" {
+			brokerIds = append(brokerIds, broker.BrokerId)
+		}
+	}
+	return brokerIds, nil
+}
+
+func shouldIncludeMQBroker(broker *mq.BrokerSummary, excludeAfter time.Time, configObj config.Config) bool {
+	if broker == nil {
+		return false
+	}
+
+	// Brokers that are already being deleted will be gone shortly
+	if aws.StringValue(broker.BrokerState) == mq.BrokerStateDeletionInProgress {
+		return false
+	}
+
+	if broker.Created != nil && excludeAfter.Before(*broker.Created) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(broker.BrokerName),
+		configObj.MQBroker.IncludeRule.NamesRegExp,
+		configObj.MQBroker.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Deletes all Amazon MQ brokers
+func nukeAllMQBrokers(session *session.Session, brokerIds []*string) error {
+	svc := mq.New(session)
+
+	if len(brokerIds) == 0 {
+		logging.Logger.Debugf("No Amazon MQ brokers to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Amazon MQ brokers in region %s", *session.Config.Region)
+	var deletedIds []*string
+	var allErrs *multierror.Error
+
+	for _, brokerId := range brokerIds {
+		_, err := svc.DeleteBroker(&mq.DeleteBrokerInput{BrokerId: brokerId})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(brokerId),
+			ResourceType: "Amazon MQ Broker",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Amazon MQ Broker",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, brokerId)
+			logging.Logger.Debugf("Deleted Amazon MQ broker: %s", aws.StringValue(brokerId))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Amazon MQ broker(s) deleted in %s", len(deletedIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,28 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldIncludeMQBroker(t *testing.T) {
+	broker := &mq.BrokerSummary{
+		BrokerName:  aws.String("cloud-nuke-test"),
+		BrokerState: aws.String(mq.BrokerStateRunning),
+		Created:     aws.Time(time.Now()),
+	}
+	deleting := &mq.BrokerSummary{
+		BrokerName:  aws.String("cloud-nuke-test"),
+		BrokerState: aws.String(mq.BrokerStateDeletionInProgress),
+		Created:     aws.Time(time.Now()),
+	}
+
+	assert.True(t, shouldIncludeMQBroker(broker, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeMQBroker(broker, time.Now().Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeMQBroker(deleting, time.Now().Add(1*time.Hour), config.Config{}))
+}
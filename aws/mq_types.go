@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// MQBrokers - represents all Amazon MQ (ActiveMQ and RabbitMQ) brokers
+type MQBrokers struct {
+	BrokerIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (brokers MQBrokers) ResourceName() string {
+	return "mq-broker"
+}
+
+// ResourceIdentifiers - The IDs of the Amazon MQ brokers
+func (brokers MQBrokers) ResourceIdentifiers() []string {
+	return brokers.BrokerIds
+}
+
+func (brokers MQBrokers) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (brokers MQBrokers) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllMQBrokers(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kafka/kafkaiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// getAllMSKClusters returns the ARNs of all the provisioned and serverless MSK clusters in the region.
+func getAllMSKClusters(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := kafka.New(session)
+
+	var clusterArns []*string
+	// ListClustersV2 lists both provisioned and serverless clusters, unlike ListClusters which only lists provisioned ones
+	err := svc.ListClustersV2Pages(&kafka.ListClustersV2Input{}, func(page *kafka.ListClustersV2Output, lastPage bool) bool {
+		for _, cluster := range page.ClusterInfoList {
+			if shouldIncludeMSKCluster(cluster, excludeAfter, configObj) {
+				clusterArns = append(clusterArns, cluster.ClusterArn)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return clusterArns, nil
+}
+
+func shouldIncludeMSKCluster(cluster *kafka.Cluster, excludeAfter time.Time, configObj config.Config) bool {
+	if cluster == nil {
+		return false
+	}
+
+	// Clusters that are already being deleted will be gone shortly
+	if aws.StringValue(cluster.State) == kafka.ClusterStateDeleting {
+		return false
+	}
+
+	if cluster.CreationTime != nil && excludeAfter.Before(*cluster.CreationTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(cluster.ClusterName),
+		configObj.MSKCluster.IncludeRule.NamesRegExp,
+		configObj.MSKCluster.ExcludeRule.NamesRegExp,
+	)
+}
+
+// waitForMSKClustersToBeDeleted polls the given clusters until they no longer exist, as there is no waiter for
+// cluster deletion in the SDK. Deleting an MSK cluster usually takes several minutes.
+func waitForMSKClustersToBeDeleted(svc kafkaiface.KafkaAPI, clusterArns []*string) error {
+	remaining := clusterArns
+	for i := 0; i < 60; i++ {
+		var stillDeleting []*string
+		for _, clusterArn := range remaining {
+			_, err := svc.DescribeClusterV2(&kafka.DescribeClusterV2Input{
+				ClusterArn: clusterArn,
+			})
+			if err != nil {
+				if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == kafka.ErrCodeNotFoundException {
+					continue
+				}
+				return errors.WithStackTrace(err)
+			}
+			stillDeleting = append(stillDeleting, clusterArn)
+		}
+
+		if len(stillDeleting) == 0 {
+			return nil
+		}
+		remaining = stillDeleting
+
+		time.Sleep(20 * time.Second)
+		logging.Logger.Debug("Waiting for MSK clusters to be deleted...")
+	}
+
+	return MSKClusterDeleteTimeoutError{}
+}
+
+func nukeAllMSKClusters(session *session.Session, clusterArns []*string) error {
+	svc := kafka.New(session)
+
+	if len(clusterArns) == 0 {
+		logging.Logger.Debugf("No MSK clusters to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all MSK clusters in region %s", *session.Config.Region)
+	var deletedArns []*string
+	var allErrs *multierror.Error
+
+	for _, clusterArn := range clusterArns {
+		_, err := svc.DeleteCluster(&kafka.DeleteClusterInput{
+			ClusterArn: clusterArn,
+		})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(clusterArn),
+			ResourceType: "MSK Cluster",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking MSK Cluster",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedArns = append(deletedArns, clusterArn)
+			logging.Logger.Debugf("Deleted MSK cluster: %s", aws.StringValue(clusterArn))
+		}
+	}
+
+	if len(deletedArns) > 0 {
+		if err := waitForMSKClustersToBeDeleted(svc, deletedArns); err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			allErrs = multierror.Append(allErrs, err)
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d MSK cluster(s) deleted in %s", len(deletedArns), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldIncludeMSKCluster(t *testing.T) {
+	now := time.Now()
+	excludeConfig := config.Config{
+		MSKCluster: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^keep-.*")},
+				},
+			},
+		},
+	}
+	cluster := func(name string, state string, createdAt time.Time) *kafka.Cluster {
+		return &kafka.Cluster{ClusterName: aws.String(name), State: aws.String(state), CreationTime: aws.Time(createdAt)}
+	}
+
+	cases := []struct {
+		Name         string
+		Cluster      *kafka.Cluster
+		Config       config.Config
+		ExcludeAfter time.Time
+		Expected     bool
+	}{
+		{
+			Name:         "Active",
+			Cluster:      cluster("cloud-nuke-test", kafka.ClusterStateActive, now),
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     true,
+		},
+		{
+			Name:         "Failed",
+			Cluster:      cluster("cloud-nuke-test", kafka.ClusterStateFailed, now),
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     true,
+		},
+		{
+			Name:         "Deleting",
+			Cluster:      cluster("cloud-nuke-test", kafka.ClusterStateDeleting, now),
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "NotOlderThan",
+			Cluster:      cluster("cloud-nuke-test", kafka.ClusterStateActive, now),
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(-1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "ConfigExclude",
+			Cluster:      cluster("keep-me", kafka.ClusterStateActive, now),
+			Config:       excludeConfig,
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludeMSKCluster(c.Cluster, c.ExcludeAfter, c.Config))
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// MSKClusters - represents all provisioned and serverless MSK clusters
+type MSKClusters struct {
+	ClusterArns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (clusters MSKClusters) ResourceName() string {
+	return "msk-cluster"
+}
+
+// ResourceIdentifiers - The ARNs of the MSK clusters
+func (clusters MSKClusters) ResourceIdentifiers() []string {
+	return clusters.ClusterArns
+}
+
+func (clusters MSKClusters) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (clusters MSKClusters) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllMSKClusters(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type MSKClusterDeleteTimeoutError struct{}
+
+func (e MSKClusterDeleteTimeoutError) Error() string {
+	return "Timed out waiting for MSK clusters to be successfully deleted"
+}
@@ -59,10 +59,6 @@ func shouldIncludeNatGateway(ngw *ec2.NatGateway, excludeAfter time.Time, config
 		return false
 	}
 
-	if aws.StringValue(ngw.State) == ec2.NatGatewayStateDeleted {
-		return false
-	}
-
 	return config.ShouldInclude(
 		getNatGatewayName(ngw),
 		configObj.NatGateway.IncludeRule.NamesRegExp,
@@ -120,9 +116,12 @@ func nukeAllNatGateways(session *session.Session, identifiers []*string) error {
 	}
 	wg.Wait()
 
-	// Collect all the errors from the async delete calls into a single error struct.
+	// Collect all the errors from the async delete calls into a single error struct. NAT gateways that were
+	// successfully scheduled for deletion are still waited on, so that a single failure does not leave the rest of the
+	// batch in the deleting state when we return.
 	var allErrs *multierror.Error
-	for _, errChan := range errChans {
+	deletedIDs := []*string{}
+	for i, errChan := range errChans {
 		if err := <-errChan; err != nil {
 			allErrs = multierror.Append(allErrs, err)
 			logging.Logger.Debugf("[Failed] %s", err)
@@ -131,37 +130,39 @@ func nukeAllNatGateways(session *session.Session, identifiers []*string) error {
 			}, map[string]interface{}{
 				"region": *session.Config.Region,
 			})
+		} else {
+			deletedIDs = append(deletedIDs, identifiers[i])
 		}
 	}
-	finalErr := allErrs.ErrorOrNil()
-	if finalErr != nil {
-		return errors.WithStackTrace(finalErr)
-	}
 
-	// Now wait until the NAT gateways are deleted
-	err := retry.DoWithRetry(
-		logging.Logger,
-		"Waiting for all NAT gateways to be deleted.",
-		// Wait a maximum of 5 minutes: 10 seconds in between, up to 30 times
-		30, 10*time.Second,
-		func() error {
-			areDeleted, err := areAllNatGatewaysDeleted(svc, identifiers)
-			if err != nil {
-				return errors.WithStackTrace(retry.FatalError{Underlying: err})
-			}
-			if areDeleted {
-				return nil
+	if len(deletedIDs) > 0 {
+		// Now wait until the NAT gateways are deleted
+		err := retry.DoWithRetry(
+			logging.Logger,
+			"Waiting for all NAT gateways to be deleted.",
+			// Wait a maximum of 5 minutes: 10 seconds in between, up to 30 times
+			30, 10*time.Second,
+			func() error {
+				areDeleted, err := areAllNatGatewaysDeleted(svc, deletedIDs)
+				if err != nil {
+					return errors.WithStackTrace(retry.FatalError{Underlying: err})
+				}
+				if areDeleted {
+					return nil
+				}
+				return fmt.Errorf("Not all NAT gateways deleted.")
+			},
+		)
+		if err != nil {
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			for _, ngwID := range deletedIDs {
+				logging.Logger.Debugf("[OK] NAT Gateway %s was deleted in %s", aws.StringValue(ngwID), region)
 			}
-			return fmt.Errorf("Not all NAT gateways deleted.")
-		},
-	)
-	if err != nil {
-		return errors.WithStackTrace(err)
-	}
-	for _, ngwID := range identifiers {
-		logging.Logger.Debugf("[OK] NAT Gateway %s was deleted in %s", aws.StringValue(ngwID), region)
+		}
 	}
-	return nil
+
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
 }
 
 // areAllNatGatewaysDeleted returns true if all the requested NAT gateways have been deleted. This is determined by
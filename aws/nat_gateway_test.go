@@ -107,6 +107,97 @@ func TestTimeFilterExclusionNewlyCreatedNatGateway(t *testing.T) {
 	assert.NotContains(t, aws.StringValueSlice(natGatewayIDsOlder), aws.StringValue(ngwID))
 }
 
+func TestShouldIncludeNatGateway(t *testing.T) {
+	now := time.Now()
+	nameTag := func(name string) []*ec2.Tag {
+		return []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String(name)}}
+	}
+	includeConfig := config.Config{
+		NatGateway: config.ResourceType{
+			IncludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^cloud-nuke-test-include-.*")},
+				},
+			},
+		},
+	}
+	excludeConfig := config.Config{
+		NatGateway: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^cloud-nuke-test-exclude-.*")},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Ngw      *ec2.NatGateway
+		Config   config.Config
+		Expected bool
+	}{
+		{
+			Name:     "Available",
+			Ngw:      &ec2.NatGateway{State: aws.String(ec2.NatGatewayStateAvailable), CreateTime: aws.Time(now)},
+			Config:   config.Config{},
+			Expected: true,
+		},
+		{
+			Name:     "Deleting",
+			Ngw:      &ec2.NatGateway{State: aws.String(ec2.NatGatewayStateDeleting), CreateTime: aws.Time(now)},
+			Config:   config.Config{},
+			Expected: false,
+		},
+		{
+			Name:     "Deleted",
+			Ngw:      &ec2.NatGateway{State: aws.String(ec2.NatGatewayStateDeleted), CreateTime: aws.Time(now)},
+			Config:   config.Config{},
+			Expected: false,
+		},
+		{
+			Name:     "NewerThanExcludeAfter",
+			Ngw:      &ec2.NatGateway{State: aws.String(ec2.NatGatewayStateAvailable), CreateTime: aws.Time(now.Add(2 * time.Hour))},
+			Config:   config.Config{},
+			Expected: false,
+		},
+		{
+			Name: "ExclusionTag",
+			Ngw: &ec2.NatGateway{
+				State:      aws.String(ec2.NatGatewayStateAvailable),
+				CreateTime: aws.Time(now),
+				Tags:       []*ec2.Tag{{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}},
+			},
+			Config:   config.Config{},
+			Expected: false,
+		},
+		{
+			Name:     "IncludeByName",
+			Ngw:      &ec2.NatGateway{State: aws.String(ec2.NatGatewayStateAvailable), CreateTime: aws.Time(now), Tags: nameTag("cloud-nuke-test-include-1")},
+			Config:   includeConfig,
+			Expected: true,
+		},
+		{
+			Name:     "NotIncludedByName",
+			Ngw:      &ec2.NatGateway{State: aws.String(ec2.NatGatewayStateAvailable), CreateTime: aws.Time(now), Tags: nameTag("other")},
+			Config:   includeConfig,
+			Expected: false,
+		},
+		{
+			Name:     "ExcludeByName",
+			Ngw:      &ec2.NatGateway{State: aws.String(ec2.NatGatewayStateAvailable), CreateTime: aws.Time(now), Tags: nameTag("cloud-nuke-test-exclude-1")},
+			Config:   excludeConfig,
+			Expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludeNatGateway(c.Ngw, now.Add(1*time.Hour), c.Config))
+		})
+	}
+}
+
 func TestNukeNatGatewayOne(t *testing.T) {
 	t.Parallel()
 
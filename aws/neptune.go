@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/neptune/neptuneiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of Neptune DB cluster identifiers
+func getAllNeptuneClusters(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := neptune.New(session)
+
+	// The Neptune API shares its backend with RDS, so only ask for Neptune clusters
+	input := &neptune.DescribeDBClustersInput{
+		Filters: []*neptune.Filter{
+			{
+				Name:   aws.String("engine"),
+				Values: aws.StringSlice([]string{"neptune"}),
+			},
+		},
+	}
+
+	var candidates []*neptune.DBCluster
+	err := svc.DescribeDBClustersPages(input, func(page *neptune.DescribeDBClustersOutput, lastPage bool) bool {
+		for _, cluster := range page.DBClusters {
+			if shouldIncludeNeptuneCluster(cluster, excludeAfter, configObj) {
+				candidates = append(candidates, cluster)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var identifiers []*string
+	for _, cluster := range candidates {
+		tags, err := svc.ListTagsForResource(&neptune.ListTagsForResourceInput{ResourceName: cluster.DBClusterArn})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !hasNeptuneExcludeTag(tags.TagList) {
+			identifiers = append(identifiers, cluster.DBClusterIdentifier)
+		}
+	}
+	return identifiers, nil
+}
+
+func shouldIncludeNeptuneCluster(cluster *neptune.DBCluster, excludeAfter time.Time, configObj config.Config) bool {
+	if cluster == nil {
+		return false
+	}
+
+	if cluster.ClusterCreateTime != nil && excludeAfter.Before(*cluster.ClusterCreateTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(cluster.DBClusterIdentifier),
+		configObj.NeptuneCluster.IncludeRule.NamesRegExp,
+		configObj.NeptuneCluster.ExcludeRule.NamesRegExp,
+	)
+}
+
+func hasNeptuneExcludeTag(tags []*neptune.Tag) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// nukeNeptuneCluster deletes the instances of the cluster, as a cluster can't be deleted while it still has
+// instances, and then the cluster itself. No final snapshot is taken of either.
+func nukeNeptuneCluster(svc neptuneiface.NeptuneAPI, identifier *string) error {
+	output, err := svc.DescribeDBClusters(&neptune.DescribeDBClustersInput{DBClusterIdentifier: identifier})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(output.DBClusters) == 0 {
+		return nil
+	}
+
+	for _, member := range output.DBClusters[0].DBClusterMembers {
+		_, err := svc.DeleteDBInstance(&neptune.DeleteDBInstanceInput{
+			DBInstanceIdentifier: member.DBInstanceIdentifier,
+			SkipFinalSnapshot:    aws.Bool(true),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted Neptune DB instance: %s", aws.StringValue(member.DBInstanceIdentifier))
+	}
+
+	for _, member := range output.DBClusters[0].DBClusterMembers {
+		err := svc.WaitUntilDBInstanceDeleted(&neptune.DescribeDBInstancesInput{
+			DBInstanceIdentifier: member.DBInstanceIdentifier,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	_, err = svc.DeleteDBCluster(&neptune.DeleteDBClusterInput{
+		DBClusterIdentifier: identifier,
+		SkipFinalSnapshot:   aws.Bool(true),
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return waitUntilNeptuneClusterDeleted(svc, identifier)
+}
+
+func waitUntilNeptuneClusterDeleted(svc neptuneiface.NeptuneAPI, identifier *string) error {
+	// wait up to 15 minutes
+	for i := 0; i < 90; i++ {
+		_, err := svc.DescribeDBClusters(&neptune.DescribeDBClustersInput{DBClusterIdentifier: identifier})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == neptune.ErrCodeDBClusterNotFoundFault {
+				return nil
+			}
+
+			return errors.WithStackTrace(err)
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for Neptune DB cluster %s to be deleted", aws.StringValue(identifier))
+	}
+
+	return NeptuneClusterDeleteTimeoutError{identifier: aws.StringValue(identifier)}
+}
+
+// Deletes all Neptune DB clusters, along with their instances
+func nukeAllNeptuneClusters(session *session.Session, identifiers []*string) error {
+	svc := neptune.New(session)
+
+	if len(identifiers) == 0 {
+		logging.Logger.Debugf("No Neptune DB clusters to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Neptune DB clusters in region %s", *session.Config.Region)
+	var deletedIdentifiers []*string
+	var allErrs *multierror.Error
+
+	for _, identifier := range identifiers {
+		err := nukeNeptuneCluster(svc, identifier)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(identifier),
+			ResourceType: "Neptune DB Cluster",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Neptune DB Cluster",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIdentifiers = append(deletedIdentifiers, identifier)
+			logging.Logger.Debugf("Deleted Neptune DB cluster: %s", aws.StringValue(identifier))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Neptune DB cluster(s) deleted in %s", len(deletedIdentifiers), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
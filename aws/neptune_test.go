@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/neptune/neptuneiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedNeptuneCluster struct {
+	neptuneiface.NeptuneAPI
+	Members []string
+	Deleted bool
+	Calls   []string
+}
+
+func (m *mockedNeptuneCluster) DescribeDBClusters(input *neptune.DescribeDBClustersInput) (*neptune.DescribeDBClustersOutput, error) {
+	if m.Deleted {
+		return nil, awserr.New(neptune.ErrCodeDBClusterNotFoundFault, "", nil)
+	}
+
+	var members []*neptune.DBClusterMember
+	for _, member := range m.Members {
+		members = append(members, &neptune.DBClusterMember{DBInstanceIdentifier: aws.String(member)})
+	}
+	return &neptune.DescribeDBClustersOutput{DBClusters: []*neptune.DBCluster{
+		{DBClusterIdentifier: input.DBClusterIdentifier, DBClusterMembers: members},
+	}}, nil
+}
+
+func (m *mockedNeptuneCluster) DeleteDBInstance(input *neptune.DeleteDBInstanceInput) (*neptune.DeleteDBInstanceOutput, error) {
+	m.Calls = append(m.Calls, "delete-instance/"+aws.StringValue(input.DBInstanceIdentifier))
+	return &neptune.DeleteDBInstanceOutput{}, nil
+}
+
+func (m *mockedNeptuneCluster) WaitUntilDBInstanceDeleted(input *neptune.DescribeDBInstancesInput) error {
+	m.Calls = append(m.Calls, "wait-instance/"+aws.StringValue(input.DBInstanceIdentifier))
+	return nil
+}
+
+func (m *mockedNeptuneCluster) DeleteDBCluster(input *neptune.DeleteDBClusterInput) (*neptune.DeleteDBClusterOutput, error) {
+	m.Calls = append(m.Calls, "delete-cluster/"+aws.StringValue(input.DBClusterIdentifier))
+	m.Deleted = true
+	return &neptune.DeleteDBClusterOutput{}, nil
+}
+
+func TestNukeNeptuneClusterDeletesInstancesFirst(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedNeptuneCluster{Members: []string{"writer", "reader"}}
+	require.NoError(t, nukeNeptuneCluster(mock, aws.String("cluster")))
+	assert.Equal(t, []string{
+		"delete-instance/writer",
+		"delete-instance/reader",
+		"wait-instance/writer",
+		"wait-instance/reader",
+		"delete-cluster/cluster",
+	}, mock.Calls)
+}
+
+func TestShouldIncludeNeptuneCluster(t *testing.T) {
+	cluster := &neptune.DBCluster{
+		DBClusterIdentifier: aws.String("cloud-nuke-test"),
+		ClusterCreateTime:   aws.Time(time.Now()),
+	}
+
+	assert.True(t, shouldIncludeNeptuneCluster(cluster, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeNeptuneCluster(cluster, time.Now().Add(-1*time.Hour), config.Config{}))
+}
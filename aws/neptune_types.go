@@ -0,0 +1,44 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// NeptuneClusters - represents all Neptune DB clusters
+type NeptuneClusters struct {
+	ClusterIdentifiers []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (clusters NeptuneClusters) ResourceName() string {
+	return "neptune-cluster"
+}
+
+// ResourceIdentifiers - The identifiers of the Neptune DB clusters
+func (clusters NeptuneClusters) ResourceIdentifiers() []string {
+	return clusters.ClusterIdentifiers
+}
+
+func (clusters NeptuneClusters) MaxBatchSize() int {
+	// Clusters are deleted one by one, each waiting for its instances and then itself to be gone, which takes minutes
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (clusters NeptuneClusters) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllNeptuneClusters(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type NeptuneClusterDeleteTimeoutError struct {
+	identifier string
+}
+
+func (e NeptuneClusterDeleteTimeoutError) Error() string {
+	return "Timed out waiting for Neptune DB cluster " + e.identifier + " to be deleted"
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/aws/aws-sdk-go/service/opensearchservice/opensearchserviceiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
@@ -18,6 +19,9 @@ import (
 	"github.com/hashicorp/go-multierror"
 )
 
+// DescribeDomains accepts at most this many domain names per call
+const openSearchDescribeDomainsLimit = 5
+
 // getOpenSearchDomainsToNuke queries AWS for all active domains in the account that meet the nuking criteria based on
 // the excludeAfter and configObj configurations. Note that OpenSearch Domains do not have resource timestamps, so we
 // use the first-seen tagging pattern to track which OpenSearch Domains should be nuked based on time. This routine will
@@ -30,6 +34,13 @@ func getOpenSearchDomainsToNuke(awsSession *session.Session, excludeAfter time.T
 
 	domainsToNuke := []*string{}
 	for _, domain := range domains {
+		hasExcludeTag, err := hasOpenSearchDomainExcludeTag(awsSession, domain.ARN)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if hasExcludeTag {
+			continue
+		}
 
 		firstSeenTime, err := getFirstSeenOpenSearchDomainTag(awsSession, domain.ARN)
 		if err != nil {
@@ -63,15 +74,14 @@ func getAllActiveOpenSearchDomains(awsSession *session.Session) ([]*opensearchse
 		allDomains = append(allDomains, domain.DomainName)
 	}
 
-	input := &opensearchservice.DescribeDomainsInput{DomainNames: allDomains}
-	describedDomains, describeErr := svc.DescribeDomains(input)
+	describedDomains, describeErr := describeOpenSearchDomains(svc, allDomains)
 	if describeErr != nil {
-		logging.Logger.Errorf("Error describing Domains from input %s: ", input)
+		logging.Logger.Errorf("Error describing OpenSearch Domains")
 		return nil, errors.WithStackTrace(describeErr)
 	}
 
 	filteredDomains := []*opensearchservice.DomainStatus{}
-	for _, domain := range describedDomains.DomainStatusList {
+	for _, domain := range describedDomains {
 		if aws.BoolValue(domain.Created) && aws.BoolValue(domain.Deleted) == false {
 			filteredDomains = append(filteredDomains, domain)
 		}
@@ -79,6 +89,20 @@ func getAllActiveOpenSearchDomains(awsSession *session.Session) ([]*opensearchse
 	return filteredDomains, nil
 }
 
+// describeOpenSearchDomains describes the given domains, a few at a time since DescribeDomains accepts at most 5
+// domain names per call.
+func describeOpenSearchDomains(svc opensearchserviceiface.OpenSearchServiceAPI, domainNames []*string) ([]*opensearchservice.DomainStatus, error) {
+	domains := []*opensearchservice.DomainStatus{}
+	for _, batch := range split(aws.StringValueSlice(domainNames), openSearchDescribeDomainsLimit) {
+		resp, err := svc.DescribeDomains(&opensearchservice.DescribeDomainsInput{DomainNames: aws.StringSlice(batch)})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		domains = append(domains, resp.DomainStatusList...)
+	}
+	return domains, nil
+}
+
 // shouldIncludeOpenSearchDomain determines if an OpenSearch Domain should be nuked based on the first seen timestamp
 // and config rules about excluding domain names.
 func shouldIncludeOpenSearchDomain(domain *opensearchservice.DomainStatus, firstSeenTime time.Time, excludeAfter time.Time, configObj config.Config) bool {
@@ -120,6 +144,24 @@ func tagOpenSearchDomainWhenFirstSeen(awsSession *session.Session, domainARN *st
 	return nil
 }
 
+// hasOpenSearchDomainExcludeTag checks whether the OpenSearch Domain identified by the given ARN carries the
+// cloud-nuke exclusion tag
+func hasOpenSearchDomainExcludeTag(awsSession *session.Session, domainARN *string) (bool, error) {
+	svc := opensearchservice.New(awsSession)
+	domainTags, err := svc.ListTags(&opensearchservice.ListTagsInput{ARN: domainARN})
+	if err != nil {
+		logging.Logger.Errorf("Error getting the tags for OpenSearch Domain with ARN %s", aws.StringValue(domainARN))
+		return false, errors.WithStackTrace(err)
+	}
+
+	for _, tag := range domainTags.TagList {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // getFirstSeenOpenSearchDomainTag gets the `cloud-nuke-first-seen` tag value for a given OpenSearch Domain
 func getFirstSeenOpenSearchDomainTag(awsSession *session.Session, domainARN *string) (time.Time, error) {
 	var firstSeenTime time.Time
@@ -201,14 +243,15 @@ func nukeAllOpenSearchDomains(session *session.Session, identifiers []*string) e
 	err := retry.DoWithRetry(
 		logging.Logger,
 		"Waiting for all OpenSearch Domains to be deleted.",
-		// Wait a maximum of 5 minutes: 10 seconds in between, up to 30 times
-		30, 10*time.Second,
+		// Deleting a domain usually takes 10 to 15 minutes, so wait a maximum of 20 minutes: 10 seconds in between, up
+		// to 120 times
+		120, 10*time.Second,
 		func() error {
-			resp, err := svc.DescribeDomains(&opensearchservice.DescribeDomainsInput{DomainNames: identifiers})
+			domains, err := describeOpenSearchDomains(svc, identifiers)
 			if err != nil {
 				return errors.WithStackTrace(retry.FatalError{Underlying: err})
 			}
-			if len(resp.DomainStatusList) == 0 {
+			if len(domains) == 0 {
 				return nil
 			}
 			return fmt.Errorf("Not all OpenSearch domains are deleted.")
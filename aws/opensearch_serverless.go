@@ -0,0 +1,397 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless/opensearchserverlessiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// BatchGetCollection accepts at most this many collection names per call
+const openSearchServerlessBatchGetCollectionLimit = 100
+
+// The kinds of policies that can be attached to OpenSearch Serverless collections. Encryption and network policies are
+// security policies, while data access policies are access policies.
+var openSearchServerlessPolicyTypes = []string{
+	opensearchserverless.SecurityPolicyTypeEncryption,
+	opensearchserverless.SecurityPolicyTypeNetwork,
+	opensearchserverless.AccessPolicyTypeData,
+}
+
+// Returns a formatted string of OpenSearch Serverless collection names
+func getAllOpenSearchServerlessCollections(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := opensearchserverless.New(session)
+
+	var allNames []*string
+	err := svc.ListCollectionsPages(&opensearchserverless.ListCollectionsInput{}, func(page *opensearchserverless.ListCollectionsOutput, lastPage bool) bool {
+		for _, collection := range page.CollectionSummaries {
+			// Collections being deleted will be gone shortly
+			if aws.StringValue(collection.Status) != opensearchserverless.CollectionStatusDeleting {
+				allNames = append(allNames, collection.Name)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, batch := range split(aws.StringValueSlice(allNames), openSearchServerlessBatchGetCollectionLimit) {
+		// The creation time is only returned when describing the collections
+		output, err := svc.BatchGetCollection(&opensearchserverless.BatchGetCollectionInput{Names: aws.StringSlice(batch)})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, collection := range output.CollectionDetails {
+			if !shouldIncludeOpenSearchServerlessCollection(collection, excludeAfter, configObj) {
+				continue
+			}
+
+			tags, err := svc.ListTagsForResource(&opensearchserverless.ListTagsForResourceInput{ResourceArn: collection.Arn})
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			if !hasOpenSearchServerlessExcludeTag(tags.Tags) {
+				names = append(names, collection.Name)
+			}
+		}
+	}
+	return names, nil
+}
+
+func shouldIncludeOpenSearchServerlessCollection(collection *opensearchserverless.CollectionDetail, excludeAfter time.Time, configObj config.Config) bool {
+	if collection == nil {
+		return false
+	}
+
+	if collection.CreatedDate != nil {
+		createdDate := time.Unix(0, aws.Int64Value(collection.CreatedDate)*int64(time.Millisecond))
+		if excludeAfter.Before(createdDate) {
+			return false
+		}
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(collection.Name),
+		configObj.OpenSearchServerlessCollection.IncludeRule.NamesRegExp,
+		configObj.OpenSearchServerlessCollection.ExcludeRule.NamesRegExp,
+	)
+}
+
+func hasOpenSearchServerlessExcludeTag(tags []*opensearchserverless.Tag) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteOpenSearchServerlessCollection looks up the ID of the named collection, which is what DeleteCollection expects,
+// and deletes it.
+func deleteOpenSearchServerlessCollection(svc opensearchserverlessiface.OpenSearchServerlessAPI, name *string) error {
+	output, err := svc.BatchGetCollection(&opensearchserverless.BatchGetCollectionInput{Names: []*string{name}})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(output.CollectionDetails) == 0 {
+		return errors.WithStackTrace(OpenSearchServerlessCollectionNotFoundError{name: aws.StringValue(name)})
+	}
+
+	_, err = svc.DeleteCollection(&opensearchserverless.DeleteCollectionInput{Id: output.CollectionDetails[0].Id})
+	return errors.WithStackTrace(err)
+}
+
+// waitForOpenSearchServerlessCollectionsToBeDeleted waits until none of the given collections can be found anymore.
+// Encryption policies can't be deleted while a collection still refers to them.
+func waitForOpenSearchServerlessCollectionsToBeDeleted(svc opensearchserverlessiface.OpenSearchServerlessAPI, names []*string) error {
+	for i := 0; i < 60; i++ {
+		output, err := svc.BatchGetCollection(&opensearchserverless.BatchGetCollectionInput{Names: names})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		if len(output.CollectionDetails) == 0 {
+			return nil
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for %d OpenSearch Serverless collection(s) to be deleted", len(output.CollectionDetails))
+	}
+
+	return OpenSearchServerlessCollectionsDeleteTimeoutError{}
+}
+
+// listOpenSearchServerlessPolicies returns the names of the policies of the given type that apply to the named
+// collection
+func listOpenSearchServerlessPolicies(svc opensearchserverlessiface.OpenSearchServerlessAPI, policyType string, collectionName string) ([]string, error) {
+	resource := aws.StringSlice([]string{fmt.Sprintf("collection/%s", collectionName)})
+
+	var names []string
+	if policyType == opensearchserverless.AccessPolicyTypeData {
+		input := &opensearchserverless.ListAccessPoliciesInput{Type: aws.String(policyType), Resource: resource}
+		err := svc.ListAccessPoliciesPages(input, func(page *opensearchserverless.ListAccessPoliciesOutput, lastPage bool) bool {
+			for _, policy := range page.AccessPolicySummaries {
+				names = append(names, aws.StringValue(policy.Name))
+			}
+			return !lastPage
+		})
+		return names, errors.WithStackTrace(err)
+	}
+
+	input := &opensearchserverless.ListSecurityPoliciesInput{Type: aws.String(policyType), Resource: resource}
+	err := svc.ListSecurityPoliciesPages(input, func(page *opensearchserverless.ListSecurityPoliciesOutput, lastPage bool) bool {
+		for _, policy := range page.SecurityPolicySummaries {
+			names = append(names, aws.StringValue(policy.Name))
+		}
+		return !lastPage
+	})
+	return names, errors.WithStackTrace(err)
+}
+
+func deleteOpenSearchServerlessPolicy(svc opensearchserverlessiface.OpenSearchServerlessAPI, policyType string, name string) error {
+	if policyType == opensearchserverless.AccessPolicyTypeData {
+		_, err := svc.DeleteAccessPolicy(&opensearchserverless.DeleteAccessPolicyInput{
+			Name: aws.String(name),
+			Type: aws.String(policyType),
+		})
+		return errors.WithStackTrace(err)
+	}
+
+	_, err := svc.DeleteSecurityPolicy(&opensearchserverless.DeleteSecurityPolicyInput{
+		Name: aws.String(name),
+		Type: aws.String(policyType),
+	})
+	return errors.WithStackTrace(err)
+}
+
+// getOpenSearchServerlessPolicyDocument returns the document of the given policy. The aws-sdk-go version in use doesn't
+// model the document, so it is read from the raw response instead.
+func getOpenSearchServerlessPolicyDocument(svc opensearchserverlessiface.OpenSearchServerlessAPI, policyType string, name string) (json.RawMessage, error) {
+	type policyDetail struct {
+		Policy json.RawMessage `json:"policy"`
+	}
+	var response struct {
+		AccessPolicyDetail   *policyDetail `json:"accessPolicyDetail"`
+		SecurityPolicyDetail *policyDetail `json:"securityPolicyDetail"`
+	}
+
+	var req *request.Request
+	if policyType == opensearchserverless.AccessPolicyTypeData {
+		req, _ = svc.GetAccessPolicyRequest(&opensearchserverless.GetAccessPolicyInput{
+			Name: aws.String(name),
+			Type: aws.String(policyType),
+		})
+	} else {
+		req, _ = svc.GetSecurityPolicyRequest(&opensearchserverless.GetSecurityPolicyInput{
+			Name: aws.String(name),
+			Type: aws.String(policyType),
+		})
+	}
+	req.Handlers.Unmarshal.Clear()
+	req.Handlers.Unmarshal.PushBack(func(r *request.Request) {
+		defer r.HTTPResponse.Body.Close()
+		if err := json.NewDecoder(r.HTTPResponse.Body).Decode(&response); err != nil {
+			r.Error = awserr.New(request.ErrCodeSerialization, "failed to decode OpenSearch Serverless policy", err)
+		}
+	})
+	if err := req.Send(); err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	detail := response.SecurityPolicyDetail
+	if policyType == opensearchserverless.AccessPolicyTypeData {
+		detail = response.AccessPolicyDetail
+	}
+	if detail == nil {
+		return nil, nil
+	}
+
+	// The document may be returned as a JSON string holding the policy rather than as the policy itself
+	var document string
+	if err := json.Unmarshal(detail.Policy, &document); err == nil {
+		return json.RawMessage(document), nil
+	}
+	return detail.Policy, nil
+}
+
+// hasOpenSearchServerlessPolicyWildcards returns whether the rules of the policy document match collections by a
+// wildcard, such as collection/* or index/logs-*/*. Those policies are meant to cover collections that don't exist yet,
+// so they are kept even when no remaining collection uses them. Encryption policies hold a single statement, while
+// network and data access policies hold a list of them.
+func hasOpenSearchServerlessPolicyWildcards(document json.RawMessage) (bool, error) {
+	type policyStatement struct {
+		Rules []struct {
+			Resource []string `json:"Resource"`
+		} `json:"Rules"`
+	}
+
+	var statements []policyStatement
+	if err := json.Unmarshal(document, &statements); err != nil {
+		var statement policyStatement
+		if err := json.Unmarshal(document, &statement); err != nil {
+			return false, errors.WithStackTrace(err)
+		}
+		statements = []policyStatement{statement}
+	}
+
+	for _, statement := range statements {
+		for _, rule := range statement.Rules {
+			for _, resource := range rule.Resource {
+				// Resources look like collection/<collection> or index/<collection>/<index>. Anything else is treated
+				// like a wildcard, to be on the safe side.
+				parts := strings.SplitN(resource, "/", 3)
+				if len(parts) < 2 || strings.Contains(parts[1], "*") {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+// deleteOpenSearchServerlessPolicies deletes the encryption, network and data access policies of the given (deleted)
+// collections. A policy can cover several collections, so the ones that still apply to a remaining collection are
+// kept, as are the ones matching collections by a wildcard.
+func deleteOpenSearchServerlessPolicies(svc opensearchserverlessiface.OpenSearchServerlessAPI, deletedNames []*string) error {
+	var remainingNames []string
+	err := svc.ListCollectionsPages(&opensearchserverless.ListCollectionsInput{}, func(page *opensearchserverless.ListCollectionsOutput, lastPage bool) bool {
+		for _, collection := range page.CollectionSummaries {
+			remainingNames = append(remainingNames, aws.StringValue(collection.Name))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	var allErrs *multierror.Error
+	for _, policyType := range openSearchServerlessPolicyTypes {
+		inUse := map[string]bool{}
+		for _, name := range remainingNames {
+			policyNames, err := listOpenSearchServerlessPolicies(svc, policyType, name)
+			if err != nil {
+				return errors.WithStackTrace(err)
+			}
+			for _, policyName := range policyNames {
+				inUse[policyName] = true
+			}
+		}
+
+		deleted := map[string]bool{}
+		for _, name := range deletedNames {
+			policyNames, err := listOpenSearchServerlessPolicies(svc, policyType, aws.StringValue(name))
+			if err != nil {
+				return errors.WithStackTrace(err)
+			}
+
+			for _, policyName := range policyNames {
+				if inUse[policyName] || deleted[policyName] {
+					continue
+				}
+
+				document, err := getOpenSearchServerlessPolicyDocument(svc, policyType, policyName)
+				if err != nil {
+					logging.Logger.Debugf("[Failed] %s", err)
+					allErrs = multierror.Append(allErrs, err)
+					continue
+				}
+				hasWildcards, err := hasOpenSearchServerlessPolicyWildcards(document)
+				if err != nil {
+					logging.Logger.Debugf("[Failed] %s", err)
+					allErrs = multierror.Append(allErrs, err)
+					continue
+				}
+				if hasWildcards {
+					logging.Logger.Debugf("Keeping OpenSearch Serverless %s policy %s, its rules match collections by a wildcard", policyType, policyName)
+					// Don't fetch the document again for the next deleted collection the policy applies to
+					inUse[policyName] = true
+					continue
+				}
+
+				if err := deleteOpenSearchServerlessPolicy(svc, policyType, policyName); err != nil {
+					logging.Logger.Debugf("[Failed] %s", err)
+					allErrs = multierror.Append(allErrs, err)
+					continue
+				}
+				deleted[policyName] = true
+				logging.Logger.Debugf("Deleted OpenSearch Serverless %s policy: %s", policyType, policyName)
+			}
+		}
+	}
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// Deletes all OpenSearch Serverless collections, along with the policies that only apply to them
+func nukeAllOpenSearchServerlessCollections(session *session.Session, names []*string) error {
+	svc := opensearchserverless.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No OpenSearch Serverless collections to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all OpenSearch Serverless collections in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		err := deleteOpenSearchServerlessCollection(svc, name)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: "OpenSearch Serverless Collection",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking OpenSearch Serverless Collection",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted OpenSearch Serverless collection: %s", aws.StringValue(name))
+		}
+	}
+
+	if len(deletedNames) > 0 {
+		err := waitForOpenSearchServerlessCollectionsToBeDeleted(svc, deletedNames)
+		if err == nil {
+			err = deleteOpenSearchServerlessPolicies(svc, deletedNames)
+		}
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking OpenSearch Serverless Policies",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d OpenSearch Serverless collection(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
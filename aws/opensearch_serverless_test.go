@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless/opensearchserverlessiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedOpenSearchServerlessPolicies struct {
+	opensearchserverlessiface.OpenSearchServerlessAPI
+	RemainingCollections []string
+	// Policy names by policy type and collection resource
+	Policies map[string]map[string][]string
+	// Policy documents by policy type and name, policies without one match their collections by name
+	Documents       map[string]string
+	DeletedPolicies []string
+}
+
+// policyDocumentRequest returns a request that responds with the document of the given policy, the way
+// GetSecurityPolicy and GetAccessPolicy do
+func (m *mockedOpenSearchServerlessPolicies) policyDocumentRequest(policyType string, name string, detailKey string) *request.Request {
+	document, ok := m.Documents[policyType+"/"+name]
+	if !ok {
+		document = `{"Rules":[{"ResourceType":"collection","Resource":["collection/` + name + `"]}]}`
+	}
+	req := request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{HTTPMethod: "POST", HTTPPath: "/"}, nil, nil)
+	req.Handlers.Send.PushBack(func(r *request.Request) {
+		r.HTTPResponse = &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader(`{"` + detailKey + `":{"name":"` + name + `","policy":` + document + `}}`)),
+		}
+	})
+	return req
+}
+
+func (m *mockedOpenSearchServerlessPolicies) GetSecurityPolicyRequest(input *opensearchserverless.GetSecurityPolicyInput) (*request.Request, *opensearchserverless.GetSecurityPolicyOutput) {
+	return m.policyDocumentRequest(aws.StringValue(input.Type), aws.StringValue(input.Name), "securityPolicyDetail"), nil
+}
+
+func (m *mockedOpenSearchServerlessPolicies) GetAccessPolicyRequest(input *opensearchserverless.GetAccessPolicyInput) (*request.Request, *opensearchserverless.GetAccessPolicyOutput) {
+	return m.policyDocumentRequest(aws.StringValue(input.Type), aws.StringValue(input.Name), "accessPolicyDetail"), nil
+}
+
+func (m *mockedOpenSearchServerlessPolicies) ListCollectionsPages(input *opensearchserverless.ListCollectionsInput, fn func(*opensearchserverless.ListCollectionsOutput, bool) bool) error {
+	var collections []*opensearchserverless.CollectionSummary
+	for _, name := range m.RemainingCollections {
+		collections = append(collections, &opensearchserverless.CollectionSummary{Name: aws.String(name)})
+	}
+	fn(&opensearchserverless.ListCollectionsOutput{CollectionSummaries: collections}, true)
+	return nil
+}
+
+func (m *mockedOpenSearchServerlessPolicies) ListSecurityPoliciesPages(input *opensearchserverless.ListSecurityPoliciesInput, fn func(*opensearchserverless.ListSecurityPoliciesOutput, bool) bool) error {
+	var policies []*opensearchserverless.SecurityPolicySummary
+	for _, name := range m.Policies[aws.StringValue(input.Type)][aws.StringValue(input.Resource[0])] {
+		policies = append(policies, &opensearchserverless.SecurityPolicySummary{Name: aws.String(name)})
+	}
+	fn(&opensearchserverless.ListSecurityPoliciesOutput{SecurityPolicySummaries: policies}, true)
+	return nil
+}
+
+func (m *mockedOpenSearchServerlessPolicies) ListAccessPoliciesPages(input *opensearchserverless.ListAccessPoliciesInput, fn func(*opensearchserverless.ListAccessPoliciesOutput, bool) bool) error {
+	var policies []*opensearchserverless.AccessPolicySummary
+	for _, name := range m.Policies[aws.StringValue(input.Type)][aws.StringValue(input.Resource[0])] {
+		policies = append(policies, &opensearchserverless.AccessPolicySummary{Name: aws.String(name)})
+	}
+	fn(&opensearchserverless.ListAccessPoliciesOutput{AccessPolicySummaries: policies}, true)
+	return nil
+}
+
+func (m *mockedOpenSearchServerlessPolicies) DeleteSecurityPolicy(input *opensearchserverless.DeleteSecurityPolicyInput) (*opensearchserverless.DeleteSecurityPolicyOutput, error) {
+	m.DeletedPolicies = append(m.DeletedPolicies, aws.StringValue(input.Type)+"/"+aws.StringValue(input.Name))
+	return &opensearchserverless.DeleteSecurityPolicyOutput{}, nil
+}
+
+func (m *mockedOpenSearchServerlessPolicies) DeleteAccessPolicy(input *opensearchserverless.DeleteAccessPolicyInput) (*opensearchserverless.DeleteAccessPolicyOutput, error) {
+	m.DeletedPolicies = append(m.DeletedPolicies, aws.StringValue(input.Type)+"/"+aws.StringValue(input.Name))
+	return &opensearchserverless.DeleteAccessPolicyOutput{}, nil
+}
+
+func TestDeleteOpenSearchServerlessPoliciesKeepsSharedPolicies(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedOpenSearchServerlessPolicies{
+		RemainingCollections: []string{"kept"},
+		Policies: map[string]map[string][]string{
+			"encryption": {
+				"collection/nuked-1": {"nuked-1-encryption", "shared-encryption"},
+				"collection/nuked-2": {"shared-encryption"},
+				"collection/kept":    {"shared-encryption"},
+			},
+			"network": {
+				"collection/nuked-1": {"nuked-network", "wildcard-network"},
+				"collection/nuked-2": {"nuked-network"},
+			},
+			"data": {
+				"collection/nuked-2": {"nuked-2-access", "wildcard-access"},
+				"collection/kept":    {"kept-access"},
+			},
+		},
+		Documents: map[string]string{
+			"network/wildcard-network": `[{"Rules":[{"ResourceType":"collection","Resource":["collection/nuked-*"]}],"AllowFromPublic":true}]`,
+			"data/nuked-2-access":      `[{"Rules":[{"ResourceType":"index","Resource":["index/nuked-2/*"],"Permission":["aoss:*"]}],"Principal":["arn:aws:iam::123456789012:root"]}]`,
+			"data/wildcard-access":     `[{"Rules":[{"ResourceType":"index","Resource":["index/*/*"],"Permission":["aoss:*"]}],"Principal":["arn:aws:iam::123456789012:root"]}]`,
+		},
+	}
+
+	err := deleteOpenSearchServerlessPolicies(mock, aws.StringSlice([]string{"nuked-1", "nuked-2"}))
+	require.NoError(t, err)
+	// Policies matching collections by a wildcard are kept, wildcards matching the indexes of a collection are fine
+	assert.Equal(t, []string{"encryption/nuked-1-encryption", "network/nuked-network", "data/nuked-2-access"}, mock.DeletedPolicies)
+}
+
+func TestHasOpenSearchServerlessPolicyWildcards(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Name     string
+		Document string
+		Expected bool
+	}{
+		{"EncryptionByName", `{"Rules":[{"ResourceType":"collection","Resource":["collection/logs"]}],"AWSOwnedKey":true}`, false},
+		{"EncryptionWildcard", `{"Rules":[{"ResourceType":"collection","Resource":["collection/logs-*"]}],"AWSOwnedKey":true}`, true},
+		{"NetworkWildcard", `[{"Rules":[{"ResourceType":"collection","Resource":["collection/*"]}],"AllowFromPublic":true}]`, true},
+		{"DataIndexWildcard", `[{"Rules":[{"ResourceType":"index","Resource":["index/logs/*"]}]}]`, false},
+		{"DataCollectionWildcard", `[{"Rules":[{"ResourceType":"index","Resource":["index/logs*/*"]}]}]`, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			hasWildcards, err := hasOpenSearchServerlessPolicyWildcards([]byte(c.Document))
+			require.NoError(t, err)
+			assert.Equal(t, c.Expected, hasWildcards)
+		})
+	}
+}
+
+func TestShouldIncludeOpenSearchServerlessCollection(t *testing.T) {
+	collection := &opensearchserverless.CollectionDetail{
+		Name:        aws.String("cloud-nuke-test"),
+		CreatedDate: aws.Int64(time.Now().UnixNano() / int64(time.Millisecond)),
+	}
+
+	assert.True(t, shouldIncludeOpenSearchServerlessCollection(collection, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeOpenSearchServerlessCollection(collection, time.Now().Add(-1*time.Hour), config.Config{}))
+}
@@ -0,0 +1,51 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// OpenSearchServerlessCollections - represents all OpenSearch Serverless collections
+type OpenSearchServerlessCollections struct {
+	CollectionNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (collections OpenSearchServerlessCollections) ResourceName() string {
+	return "opensearch-serverless"
+}
+
+// ResourceIdentifiers - The names of the OpenSearch Serverless collections
+func (collections OpenSearchServerlessCollections) ResourceIdentifiers() []string {
+	return collections.CollectionNames
+}
+
+func (collections OpenSearchServerlessCollections) MaxBatchSize() int {
+	// Collections take a few minutes to be deleted, which is awaited before deleting their policies, so keep batches
+	// small.
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (collections OpenSearchServerlessCollections) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllOpenSearchServerlessCollections(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type OpenSearchServerlessCollectionNotFoundError struct {
+	name string
+}
+
+func (e OpenSearchServerlessCollectionNotFoundError) Error() string {
+	return "Unable to find OpenSearch Serverless collection " + e.name
+}
+
+type OpenSearchServerlessCollectionsDeleteTimeoutError struct{}
+
+func (e OpenSearchServerlessCollectionsDeleteTimeoutError) Error() string {
+	return "Timed out waiting for OpenSearch Serverless collections to be deleted"
+}
@@ -9,6 +9,7 @@ import (
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/aws/aws-sdk-go/service/opensearchservice/opensearchserviceiface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/stretchr/testify/assert"
@@ -147,3 +148,28 @@ func deleteOpenSearchDomain(t *testing.T, awsSession *session.Session, domainNam
 func newOpenSearchDomainName() string {
 	return "cloud-nuke-test-" + strings.ToLower(util.UniqueID())
 }
+
+type mockedOpenSearchDescribeDomains struct {
+	opensearchserviceiface.OpenSearchServiceAPI
+	RequestedBatches [][]string
+}
+
+func (m *mockedOpenSearchDescribeDomains) DescribeDomains(input *opensearchservice.DescribeDomainsInput) (*opensearchservice.DescribeDomainsOutput, error) {
+	m.RequestedBatches = append(m.RequestedBatches, awsgo.StringValueSlice(input.DomainNames))
+	var domains []*opensearchservice.DomainStatus
+	for _, domainName := range input.DomainNames {
+		domains = append(domains, &opensearchservice.DomainStatus{DomainName: domainName})
+	}
+	return &opensearchservice.DescribeDomainsOutput{DomainStatusList: domains}, nil
+}
+
+func TestDescribeOpenSearchDomainsInBatchesOfFive(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedOpenSearchDescribeDomains{}
+	domainNames := awsgo.StringSlice([]string{"d1", "d2", "d3", "d4", "d5", "d6", "d7"})
+	domains, err := describeOpenSearchDomains(mock, domainNames)
+	require.NoError(t, err)
+	assert.Len(t, domains, 7)
+	assert.Equal(t, [][]string{{"d1", "d2", "d3", "d4", "d5"}, {"d6", "d7"}}, mock.RequestedBatches)
+}
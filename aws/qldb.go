@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/aws/aws-sdk-go/service/qldb/qldbiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of QLDB ledger names
+func getAllQLDBLedgers(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := qldb.New(session)
+
+	var candidates []*string
+	err := svc.ListLedgersPages(&qldb.ListLedgersInput{}, func(page *qldb.ListLedgersOutput, lastPage bool) bool {
+		for _, ledger := range page.Ledgers {
+			if shouldIncludeQLDBLedger(ledger, excludeAfter, configObj) {
+				candidates = append(candidates, ledger.Name)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, name := range candidates {
+		// The ARN, needed to look up the tags, is only returned when describing the ledger
+		ledger, err := svc.DescribeLedger(&qldb.DescribeLedgerInput{Name: name})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		tags, err := svc.ListTagsForResource(&qldb.ListTagsForResourceInput{ResourceArn: ledger.Arn})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if aws.StringValue(tags.Tags[AwsResourceExclusionTagKey]) != "true" {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func shouldIncludeQLDBLedger(ledger *qldb.LedgerSummary, excludeAfter time.Time, configObj config.Config) bool {
+	if ledger == nil {
+		return false
+	}
+
+	// Ledgers that are already on their way out will be gone shortly
+	state := aws.StringValue(ledger.State)
+	if state == qldb.LedgerStateDeleting || state == qldb.LedgerStateDeleted {
+		return false
+	}
+
+	if ledger.CreationDateTime != nil && excludeAfter.Before(*ledger.CreationDateTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(ledger.Name),
+		configObj.QLDBLedger.IncludeRule.NamesRegExp,
+		configObj.QLDBLedger.ExcludeRule.NamesRegExp,
+	)
+}
+
+// deleteQLDBLedger lifts the deletion protection of the ledger, which is enabled by default, and deletes it
+func deleteQLDBLedger(svc qldbiface.QLDBAPI, name *string) error {
+	ledger, err := svc.DescribeLedger(&qldb.DescribeLedgerInput{Name: name})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	if aws.BoolValue(ledger.DeletionProtection) {
+		_, err := svc.UpdateLedger(&qldb.UpdateLedgerInput{
+			Name:               name,
+			DeletionProtection: aws.Bool(false),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Disabled deletion protection of QLDB ledger %s", aws.StringValue(name))
+	}
+
+	_, err = svc.DeleteLedger(&qldb.DeleteLedgerInput{Name: name})
+	return errors.WithStackTrace(err)
+}
+
+// waitUntilQLDBLedgerDeleted waits for the ledger to leave the DELETING state
+func waitUntilQLDBLedgerDeleted(svc qldbiface.QLDBAPI, name *string) error {
+	for i := 0; i < 30; i++ {
+		ledger, err := svc.DescribeLedger(&qldb.DescribeLedgerInput{Name: name})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == qldb.ErrCodeResourceNotFoundException {
+				return nil
+			}
+
+			return errors.WithStackTrace(err)
+		}
+		if aws.StringValue(ledger.State) != qldb.LedgerStateDeleting {
+			return nil
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for QLDB ledger %s to be deleted", aws.StringValue(name))
+	}
+
+	return QLDBLedgerDeleteTimeoutError{name: aws.StringValue(name)}
+}
+
+// Deletes all QLDB ledgers
+func nukeAllQLDBLedgers(session *session.Session, names []*string) error {
+	svc := qldb.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No QLDB ledgers to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all QLDB ledgers in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		err := deleteQLDBLedger(svc, name)
+		if err == nil {
+			err = waitUntilQLDBLedgerDeleted(svc, name)
+		}
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: "QLDB Ledger",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking QLDB Ledger",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted QLDB ledger: %s", aws.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d QLDB ledger(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
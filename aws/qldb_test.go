@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/aws/aws-sdk-go/service/qldb/qldbiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedQLDBLedger struct {
+	qldbiface.QLDBAPI
+	DeletionProtection bool
+	Calls              []string
+}
+
+func (m *mockedQLDBLedger) DescribeLedger(input *qldb.DescribeLedgerInput) (*qldb.DescribeLedgerOutput, error) {
+	return &qldb.DescribeLedgerOutput{
+		Name:               input.Name,
+		DeletionProtection: aws.Bool(m.DeletionProtection),
+	}, nil
+}
+
+func (m *mockedQLDBLedger) UpdateLedger(input *qldb.UpdateLedgerInput) (*qldb.UpdateLedgerOutput, error) {
+	m.Calls = append(m.Calls, "unprotect")
+	m.DeletionProtection = aws.BoolValue(input.DeletionProtection)
+	return &qldb.UpdateLedgerOutput{}, nil
+}
+
+func (m *mockedQLDBLedger) DeleteLedger(input *qldb.DeleteLedgerInput) (*qldb.DeleteLedgerOutput, error) {
+	m.Calls = append(m.Calls, "delete")
+	return &qldb.DeleteLedgerOutput{}, nil
+}
+
+func TestDeleteQLDBLedgerLiftsDeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	protected := &mockedQLDBLedger{DeletionProtection: true}
+	require.NoError(t, deleteQLDBLedger(protected, aws.String("protected")))
+	assert.Equal(t, []string{"unprotect", "delete"}, protected.Calls)
+
+	unprotected := &mockedQLDBLedger{}
+	require.NoError(t, deleteQLDBLedger(unprotected, aws.String("unprotected")))
+	assert.Equal(t, []string{"delete"}, unprotected.Calls)
+}
+
+func TestShouldIncludeQLDBLedger(t *testing.T) {
+	ledger := &qldb.LedgerSummary{
+		Name:             aws.String("cloud-nuke-test"),
+		CreationDateTime: aws.Time(time.Now()),
+		State:            aws.String(qldb.LedgerStateActive),
+	}
+	deleting := &qldb.LedgerSummary{
+		Name:             aws.String("cloud-nuke-test"),
+		CreationDateTime: aws.Time(time.Now()),
+		State:            aws.String(qldb.LedgerStateDeleting),
+	}
+
+	assert.True(t, shouldIncludeQLDBLedger(ledger, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeQLDBLedger(ledger, time.Now().Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeQLDBLedger(deleting, time.Now().Add(1*time.Hour), config.Config{}))
+}
@@ -0,0 +1,44 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// QLDBLedgers - represents all QLDB ledgers
+type QLDBLedgers struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (ledgers QLDBLedgers) ResourceName() string {
+	return "qldb-ledger"
+}
+
+// ResourceIdentifiers - The names of the QLDB ledgers
+func (ledgers QLDBLedgers) ResourceIdentifiers() []string {
+	return ledgers.Names
+}
+
+func (ledgers QLDBLedgers) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (ledgers QLDBLedgers) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllQLDBLedgers(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type QLDBLedgerDeleteTimeoutError struct {
+	name string
+}
+
+func (e QLDBLedgerDeleteTimeoutError) Error() string {
+	return "Timed out waiting for QLDB ledger " + e.name + " to be deleted"
+}
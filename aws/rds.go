@@ -7,8 +7,6 @@ import (
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
 
-	"github.com/gruntwork-io/cloud-nuke/config"
-
 	"github.com/aws/aws-sdk-go/aws"
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -48,6 +46,16 @@ func hasRDSExcludeTag(database *rds.DBInstance) bool {
 	return false
 }
 
+// hasRDSTagsExcludeTag checks whether the exlude tag is set in the tags of an RDS resource to skip deleting it.
+func hasRDSTagsExcludeTag(tags []*rds.Tag) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
 func shouldIncludeDbInstance(database *rds.DBInstance, excludeAfter time.Time, configObj config.Config) bool {
 	if database == nil || database.InstanceCreateTime == nil {
 		return false
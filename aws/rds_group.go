@@ -0,0 +1,313 @@
+package aws
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// The default DB subnet group is created by RDS itself, and can't be told apart from others by anything but its name
+const rdsDefaultSubnetGroupName = "default"
+
+// rdsGroupsInUse holds the names of the parameter, option and subnet groups that DB instances or clusters still
+// refer to. RDS refuses to delete those.
+type rdsGroupsInUse struct {
+	parameterGroups map[string]bool
+	optionGroups    map[string]bool
+	subnetGroups    map[string]bool
+}
+
+// getRdsGroupsInUse collects the groups the DB instances and clusters in the region refer to. Neptune and DocumentDB
+// instances are returned by the RDS API as well, so their groups are covered too. The given DB instances and clusters
+// being nuked are skipped, since they are deleted before their groups.
+func getRdsGroupsInUse(svc rdsiface.RDSAPI, nukedInstanceIds []string, nukedClusterIds []string) (rdsGroupsInUse, error) {
+	inUse := rdsGroupsInUse{
+		parameterGroups: map[string]bool{},
+		optionGroups:    map[string]bool{},
+		subnetGroups:    map[string]bool{},
+	}
+
+	nukedInstances := map[string]bool{}
+	for _, id := range nukedInstanceIds {
+		nukedInstances[id] = true
+	}
+	nukedClusters := map[string]bool{}
+	for _, id := range nukedClusterIds {
+		nukedClusters[id] = true
+	}
+
+	err := svc.DescribeDBInstancesPages(&rds.DescribeDBInstancesInput{}, func(page *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+		for _, instance := range page.DBInstances {
+			if nukedInstances[aws.StringValue(instance.DBInstanceIdentifier)] {
+				continue
+			}
+			for _, parameterGroup := range instance.DBParameterGroups {
+				inUse.parameterGroups[aws.StringValue(parameterGroup.DBParameterGroupName)] = true
+			}
+			for _, optionGroup := range instance.OptionGroupMemberships {
+				inUse.optionGroups[aws.StringValue(optionGroup.OptionGroupName)] = true
+			}
+			if instance.DBSubnetGroup != nil {
+				inUse.subnetGroups[aws.StringValue(instance.DBSubnetGroup.DBSubnetGroupName)] = true
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return inUse, errors.WithStackTrace(err)
+	}
+
+	err = svc.DescribeDBClustersPages(&rds.DescribeDBClustersInput{}, func(page *rds.DescribeDBClustersOutput, lastPage bool) bool {
+		for _, cluster := range page.DBClusters {
+			if nukedClusters[aws.StringValue(cluster.DBClusterIdentifier)] {
+				continue
+			}
+			for _, optionGroup := range cluster.DBClusterOptionGroupMemberships {
+				inUse.optionGroups[aws.StringValue(optionGroup.DBClusterOptionGroupName)] = true
+			}
+			if cluster.DBSubnetGroup != nil {
+				inUse.subnetGroups[aws.StringValue(cluster.DBSubnetGroup)] = true
+			}
+		}
+		return !lastPage
+	})
+	return inUse, errors.WithStackTrace(err)
+}
+
+// getOrSetFirstSeenRdsTag returns when cloud-nuke first saw the RDS resource, tagging it now if it wasn't seen before.
+// Parameter, option and subnet groups don't expose when they were created.
+func getOrSetFirstSeenRdsTag(svc rdsiface.RDSAPI, resourceArn *string, tags []*rds.Tag) (time.Time, error) {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == firstSeenTagKey {
+			firstSeenTime, err := time.Parse(time.RFC3339, aws.StringValue(tag.Value))
+			if err != nil {
+				return time.Time{}, errors.WithStackTrace(err)
+			}
+			return firstSeenTime, nil
+		}
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.AddTagsToResource(&rds.AddTagsToResourceInput{
+		ResourceName: resourceArn,
+		Tags:         []*rds.Tag{{Key: aws.String(firstSeenTagKey), Value: aws.String(now.Format(time.RFC3339))}},
+	})
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return now, nil
+}
+
+// shouldIncludeRdsGroup checks the name of the group against the config, and its tags for the exclusion tag and
+// whether it was first seen before excludeAfter
+func shouldIncludeRdsGroup(svc rdsiface.RDSAPI, name *string, arn *string, excludeAfter time.Time, resourceType config.ResourceType) (bool, error) {
+	if !config.ShouldInclude(aws.StringValue(name), resourceType.IncludeRule.NamesRegExp, resourceType.ExcludeRule.NamesRegExp) {
+		return false, nil
+	}
+
+	tags, err := svc.ListTagsForResource(&rds.ListTagsForResourceInput{ResourceName: arn})
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+	if hasRDSTagsExcludeTag(tags.TagList) {
+		return false, nil
+	}
+
+	firstSeenTime, err := getOrSetFirstSeenRdsTag(svc, arn, tags.TagList)
+	if err != nil {
+		return false, err
+	}
+	return excludeAfter.After(firstSeenTime), nil
+}
+
+// getAllRdsParameterGroups returns the names of the non-default DB parameter groups that no DB instance uses, other
+// than the given DB instances and clusters being nuked
+func getAllRdsParameterGroups(session *session.Session, excludeAfter time.Time, configObj config.Config, nukedInstanceIds []string, nukedClusterIds []string) ([]*string, error) {
+	svc := rds.New(session)
+
+	inUse, err := getRdsGroupsInUse(svc, nukedInstanceIds, nukedClusterIds)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []*rds.DBParameterGroup
+	err = svc.DescribeDBParameterGroupsPages(&rds.DescribeDBParameterGroupsInput{}, func(page *rds.DescribeDBParameterGroupsOutput, lastPage bool) bool {
+		for _, group := range page.DBParameterGroups {
+			name := aws.StringValue(group.DBParameterGroupName)
+			// Default parameter groups are managed by RDS and can't be deleted
+			if !strings.HasPrefix(name, "default.") && !inUse.parameterGroups[name] {
+				groups = append(groups, group)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, group := range groups {
+		include, err := shouldIncludeRdsGroup(svc, group.DBParameterGroupName, group.DBParameterGroupArn, excludeAfter, configObj.RdsParameterGroup)
+		if err != nil {
+			return nil, err
+		}
+		if include {
+			names = append(names, group.DBParameterGroupName)
+		}
+	}
+	return names, nil
+}
+
+// getAllRdsOptionGroups returns the names of the non-default option groups that no DB instance or cluster uses, other
+// than the given DB instances and clusters being nuked
+func getAllRdsOptionGroups(session *session.Session, excludeAfter time.Time, configObj config.Config, nukedInstanceIds []string, nukedClusterIds []string) ([]*string, error) {
+	svc := rds.New(session)
+
+	inUse, err := getRdsGroupsInUse(svc, nukedInstanceIds, nukedClusterIds)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []*rds.OptionGroup
+	err = svc.DescribeOptionGroupsPages(&rds.DescribeOptionGroupsInput{}, func(page *rds.DescribeOptionGroupsOutput, lastPage bool) bool {
+		for _, group := range page.OptionGroupsList {
+			name := aws.StringValue(group.OptionGroupName)
+			// Default option groups are managed by RDS and can't be deleted
+			if !strings.HasPrefix(name, "default:") && !inUse.optionGroups[name] {
+				groups = append(groups, group)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, group := range groups {
+		include, err := shouldIncludeRdsGroup(svc, group.OptionGroupName, group.OptionGroupArn, excludeAfter, configObj.RdsOptionGroup)
+		if err != nil {
+			return nil, err
+		}
+		if include {
+			names = append(names, group.OptionGroupName)
+		}
+	}
+	return names, nil
+}
+
+// getAllRdsSubnetGroups returns the names of the non-default DB subnet groups that no DB instance or cluster uses,
+// other than the given DB instances and clusters being nuked
+func getAllRdsSubnetGroups(session *session.Session, excludeAfter time.Time, configObj config.Config, nukedInstanceIds []string, nukedClusterIds []string) ([]*string, error) {
+	svc := rds.New(session)
+
+	inUse, err := getRdsGroupsInUse(svc, nukedInstanceIds, nukedClusterIds)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []*rds.DBSubnetGroup
+	err = svc.DescribeDBSubnetGroupsPages(&rds.DescribeDBSubnetGroupsInput{}, func(page *rds.DescribeDBSubnetGroupsOutput, lastPage bool) bool {
+		for _, group := range page.DBSubnetGroups {
+			name := aws.StringValue(group.DBSubnetGroupName)
+			if name != rdsDefaultSubnetGroupName && !inUse.subnetGroups[name] {
+				groups = append(groups, group)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, group := range groups {
+		include, err := shouldIncludeRdsGroup(svc, group.DBSubnetGroupName, group.DBSubnetGroupArn, excludeAfter, configObj.RdsSubnetGroup)
+		if err != nil {
+			return nil, err
+		}
+		if include {
+			names = append(names, group.DBSubnetGroupName)
+		}
+	}
+	return names, nil
+}
+
+// nukeRdsGroups deletes the given parameter, option or subnet groups using deleteFn, recording the status of each of
+// them.
+func nukeRdsGroups(session *session.Session, resourceType string, names []*string, deleteFn func(svc rdsiface.RDSAPI, name *string) error) error {
+	svc := rds.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		err := deleteFn(svc, name)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedNames), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// Deletes all DB parameter groups
+func nukeAllRdsParameterGroups(session *session.Session, names []*string) error {
+	return nukeRdsGroups(session, "RDS Parameter Group", names, func(svc rdsiface.RDSAPI, name *string) error {
+		_, err := svc.DeleteDBParameterGroup(&rds.DeleteDBParameterGroupInput{DBParameterGroupName: name})
+		return errors.WithStackTrace(err)
+	})
+}
+
+// Deletes all option groups
+func nukeAllRdsOptionGroups(session *session.Session, names []*string) error {
+	return nukeRdsGroups(session, "RDS Option Group", names, func(svc rdsiface.RDSAPI, name *string) error {
+		_, err := svc.DeleteOptionGroup(&rds.DeleteOptionGroupInput{OptionGroupName: name})
+		return errors.WithStackTrace(err)
+	})
+}
+
+// Deletes all DB subnet groups
+func nukeAllRdsSubnetGroups(session *session.Session, names []*string) error {
+	return nukeRdsGroups(session, "RDS Subnet Group", names, func(svc rdsiface.RDSAPI, name *string) error {
+		_, err := svc.DeleteDBSubnetGroup(&rds.DeleteDBSubnetGroupInput{DBSubnetGroupName: name})
+		return errors.WithStackTrace(err)
+	})
+}
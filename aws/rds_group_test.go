@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedRdsGroups struct {
+	rdsiface.RDSAPI
+	Tags        map[string][]*rds.Tag
+	TaggedNames []string
+}
+
+func (m *mockedRdsGroups) DescribeDBInstancesPages(input *rds.DescribeDBInstancesInput, fn func(*rds.DescribeDBInstancesOutput, bool) bool) error {
+	fn(&rds.DescribeDBInstancesOutput{DBInstances: []*rds.DBInstance{
+		{
+			DBInstanceIdentifier:   aws.String("instance"),
+			DBParameterGroups:      []*rds.DBParameterGroupStatus{{DBParameterGroupName: aws.String("instance-parameters")}},
+			OptionGroupMemberships: []*rds.OptionGroupMembership{{OptionGroupName: aws.String("instance-options")}},
+			DBSubnetGroup:          &rds.DBSubnetGroup{DBSubnetGroupName: aws.String("instance-subnets")},
+		},
+		{
+			DBInstanceIdentifier:   aws.String("nuked-instance"),
+			DBParameterGroups:      []*rds.DBParameterGroupStatus{{DBParameterGroupName: aws.String("nuked-instance-parameters")}},
+			OptionGroupMemberships: []*rds.OptionGroupMembership{{OptionGroupName: aws.String("nuked-instance-options")}},
+			DBSubnetGroup:          &rds.DBSubnetGroup{DBSubnetGroupName: aws.String("nuked-instance-subnets")},
+		},
+	}}, true)
+	return nil
+}
+
+func (m *mockedRdsGroups) DescribeDBClustersPages(input *rds.DescribeDBClustersInput, fn func(*rds.DescribeDBClustersOutput, bool) bool) error {
+	fn(&rds.DescribeDBClustersOutput{DBClusters: []*rds.DBCluster{
+		{
+			DBClusterIdentifier:             aws.String("cluster"),
+			DBClusterOptionGroupMemberships: []*rds.DBClusterOptionGroupStatus{{DBClusterOptionGroupName: aws.String("cluster-options")}},
+			DBSubnetGroup:                   aws.String("cluster-subnets"),
+		},
+		{
+			DBClusterIdentifier:             aws.String("nuked-cluster"),
+			DBClusterOptionGroupMemberships: []*rds.DBClusterOptionGroupStatus{{DBClusterOptionGroupName: aws.String("nuked-cluster-options")}},
+			DBSubnetGroup:                   aws.String("nuked-cluster-subnets"),
+		},
+	}}, true)
+	return nil
+}
+
+func (m *mockedRdsGroups) ListTagsForResource(input *rds.ListTagsForResourceInput) (*rds.ListTagsForResourceOutput, error) {
+	return &rds.ListTagsForResourceOutput{TagList: m.Tags[aws.StringValue(input.ResourceName)]}, nil
+}
+
+func (m *mockedRdsGroups) AddTagsToResource(input *rds.AddTagsToResourceInput) (*rds.AddTagsToResourceOutput, error) {
+	m.TaggedNames = append(m.TaggedNames, aws.StringValue(input.ResourceName))
+	return &rds.AddTagsToResourceOutput{}, nil
+}
+
+func TestGetRdsGroupsInUse(t *testing.T) {
+	// The groups of the DB instances and clusters nuked in the same run don't count as used
+	inUse, err := getRdsGroupsInUse(&mockedRdsGroups{}, []string{"nuked-instance"}, []string{"nuked-cluster"})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]bool{"instance-parameters": true}, inUse.parameterGroups)
+	assert.Equal(t, map[string]bool{"instance-options": true, "cluster-options": true}, inUse.optionGroups)
+	assert.Equal(t, map[string]bool{"instance-subnets": true, "cluster-subnets": true}, inUse.subnetGroups)
+}
+
+func TestShouldIncludeRdsGroup(t *testing.T) {
+	firstSeen := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+	mock := &mockedRdsGroups{Tags: map[string][]*rds.Tag{
+		"seen":     {{Key: aws.String(firstSeenTagKey), Value: aws.String(firstSeen)}},
+		"excluded": {{Key: aws.String(firstSeenTagKey), Value: aws.String(firstSeen)}, {Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}},
+	}}
+	excludeConfig := config.ResourceType{
+		ExcludeRule: config.FilterRule{
+			NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^keep-.*")}},
+		},
+	}
+
+	cases := []struct {
+		Name         string
+		GroupName    string
+		Arn          string
+		Config       config.ResourceType
+		ExcludeAfter time.Time
+		Expected     bool
+	}{
+		{"SeenBefore", "cloud-nuke-test", "seen", config.ResourceType{}, time.Now().Add(-1 * time.Hour), true},
+		{"SeenAfter", "cloud-nuke-test", "seen", config.ResourceType{}, time.Now().Add(-3 * time.Hour), false},
+		{"NotSeenYet", "cloud-nuke-test", "new", config.ResourceType{}, time.Now().Add(-1 * time.Hour), false},
+		{"ExcludeTag", "cloud-nuke-test", "excluded", config.ResourceType{}, time.Now().Add(-1 * time.Hour), false},
+		{"ConfigExclude", "keep-me", "seen", excludeConfig, time.Now().Add(-1 * time.Hour), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			include, err := shouldIncludeRdsGroup(mock, aws.String(c.GroupName), aws.String(c.Arn), c.ExcludeAfter, c.Config)
+			require.NoError(t, err)
+			assert.Equal(t, c.Expected, include)
+		})
+	}
+	assert.Equal(t, []string{"new"}, mock.TaggedNames)
+}
@@ -0,0 +1,94 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// RdsParameterGroups - represents all non-default DB parameter groups that no DB instance uses
+type RdsParameterGroups struct {
+	GroupNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (groups RdsParameterGroups) ResourceName() string {
+	return "rds-parameter-group"
+}
+
+// ResourceIdentifiers - The names of the DB parameter groups
+func (groups RdsParameterGroups) ResourceIdentifiers() []string {
+	return groups.GroupNames
+}
+
+func (groups RdsParameterGroups) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (groups RdsParameterGroups) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllRdsParameterGroups(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// RdsOptionGroups - represents all non-default option groups that no DB instance or cluster uses
+type RdsOptionGroups struct {
+	GroupNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (groups RdsOptionGroups) ResourceName() string {
+	return "rds-option-group"
+}
+
+// ResourceIdentifiers - The names of the option groups
+func (groups RdsOptionGroups) ResourceIdentifiers() []string {
+	return groups.GroupNames
+}
+
+func (groups RdsOptionGroups) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (groups RdsOptionGroups) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllRdsOptionGroups(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// RdsSubnetGroups - represents all non-default DB subnet groups that no DB instance or cluster uses
+type RdsSubnetGroups struct {
+	GroupNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (groups RdsSubnetGroups) ResourceName() string {
+	return "rds-subnet-group"
+}
+
+// ResourceIdentifiers - The names of the DB subnet groups
+func (groups RdsSubnetGroups) ResourceIdentifiers() []string {
+	return groups.GroupNames
+}
+
+func (groups RdsSubnetGroups) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (groups RdsSubnetGroups) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllRdsSubnetGroups(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// getAllRdsProxies returns the names of all RDS proxies created before excludeAfter
+func getAllRdsProxies(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := rds.New(session)
+
+	var proxies []*rds.DBProxy
+	err := svc.DescribeDBProxiesPages(&rds.DescribeDBProxiesInput{}, func(page *rds.DescribeDBProxiesOutput, lastPage bool) bool {
+		for _, proxy := range page.DBProxies {
+			if shouldIncludeRdsProxy(proxy, excludeAfter, configObj) {
+				proxies = append(proxies, proxy)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, proxy := range proxies {
+		// The tags of a proxy aren't returned when describing it
+		tags, err := svc.ListTagsForResource(&rds.ListTagsForResourceInput{ResourceName: proxy.DBProxyArn})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !hasRDSTagsExcludeTag(tags.TagList) {
+			names = append(names, proxy.DBProxyName)
+		}
+	}
+
+	return names, nil
+}
+
+func shouldIncludeRdsProxy(proxy *rds.DBProxy, excludeAfter time.Time, configObj config.Config) bool {
+	if proxy == nil {
+		return false
+	}
+
+	// Proxies being deleted will be gone shortly
+	if aws.StringValue(proxy.Status) == rds.DBProxyStatusDeleting {
+		return false
+	}
+
+	if proxy.CreatedDate != nil && excludeAfter.Before(*proxy.CreatedDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(proxy.DBProxyName),
+		configObj.RdsProxy.IncludeRule.NamesRegExp,
+		configObj.RdsProxy.ExcludeRule.NamesRegExp,
+	)
+}
+
+// waitUntilRdsProxyDeleted waits until the proxy can't be found anymore, as its network interfaces are only released
+// then
+func waitUntilRdsProxyDeleted(svc *rds.RDS, name *string) error {
+	for i := 0; i < 60; i++ {
+		_, err := svc.DescribeDBProxies(&rds.DescribeDBProxiesInput{DBProxyName: name})
+		if err != nil {
+			if awsErr, isAwsErr := err.(awserr.Error); isAwsErr && awsErr.Code() == rds.ErrCodeDBProxyNotFoundFault {
+				return nil
+			}
+
+			return errors.WithStackTrace(err)
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for RDS proxy %s to be deleted", aws.StringValue(name))
+	}
+
+	return RdsProxyDeleteTimeoutError{name: aws.StringValue(name)}
+}
+
+func nukeAllRdsProxies(session *session.Session, names []*string) error {
+	svc := rds.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No RDS proxies to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all RDS proxies in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		_, err := svc.DeleteDBProxy(&rds.DeleteDBProxyInput{DBProxyName: name})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: "RDS Proxy",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking RDS Proxy",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted RDS proxy: %s", aws.StringValue(name))
+		}
+	}
+
+	for _, name := range deletedNames {
+		if err := waitUntilRdsProxyDeleted(svc, name); err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			allErrs = multierror.Append(allErrs, err)
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d RDS proxy(ies) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,29 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldIncludeRdsProxy(t *testing.T) {
+	now := time.Now()
+	proxy := &rds.DBProxy{
+		DBProxyName: aws.String("cloud-nuke-test"),
+		Status:      aws.String(rds.DBProxyStatusAvailable),
+		CreatedDate: aws.Time(now),
+	}
+	deletingProxy := &rds.DBProxy{
+		DBProxyName: aws.String("cloud-nuke-test"),
+		Status:      aws.String(rds.DBProxyStatusDeleting),
+		CreatedDate: aws.Time(now),
+	}
+
+	assert.True(t, shouldIncludeRdsProxy(proxy, now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeRdsProxy(proxy, now.Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeRdsProxy(deletingProxy, now.Add(1*time.Hour), config.Config{}))
+}
@@ -0,0 +1,44 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// RdsProxies - represents all RDS proxies
+type RdsProxies struct {
+	ProxyNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (proxies RdsProxies) ResourceName() string {
+	return "rds-proxy"
+}
+
+// ResourceIdentifiers - The names of the RDS proxies
+func (proxies RdsProxies) ResourceIdentifiers() []string {
+	return proxies.ProxyNames
+}
+
+func (proxies RdsProxies) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (proxies RdsProxies) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllRdsProxies(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type RdsProxyDeleteTimeoutError struct {
+	name string
+}
+
+func (e RdsProxyDeleteTimeoutError) Error() string {
+	return "Timed out waiting for RDS proxy " + e.name + " to be deleted"
+}
@@ -0,0 +1,197 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+const rdsSnapshotStatusAvailable = "available"
+
+// getAllRdsSnapshots returns the identifiers of all the manual RDS DB snapshots of the current account. Automated
+// snapshots are removed by RDS itself, and shared snapshots can only be deleted by their owner.
+func getAllRdsSnapshots(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := rds.New(session)
+
+	var snapshotIds []*string
+	input := &rds.DescribeDBSnapshotsInput{SnapshotType: aws.String("manual")}
+	err := svc.DescribeDBSnapshotsPages(input, func(page *rds.DescribeDBSnapshotsOutput, lastPage bool) bool {
+		for _, snapshot := range page.DBSnapshots {
+			if shouldIncludeRdsSnapshot(snapshot, excludeAfter, configObj) {
+				snapshotIds = append(snapshotIds, snapshot.DBSnapshotIdentifier)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return snapshotIds, nil
+}
+
+func shouldIncludeRdsSnapshot(snapshot *rds.DBSnapshot, excludeAfter time.Time, configObj config.Config) bool {
+	if snapshot == nil {
+		return false
+	}
+
+	// Snapshots that are still being created can't be deleted
+	if aws.StringValue(snapshot.Status) != rdsSnapshotStatusAvailable {
+		return false
+	}
+
+	if snapshot.SnapshotCreateTime != nil && excludeAfter.Before(*snapshot.SnapshotCreateTime) {
+		return false
+	}
+
+	if hasRDSTagsExcludeTag(snapshot.TagList) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(snapshot.DBSnapshotIdentifier),
+		configObj.RdsSnapshot.IncludeRule.NamesRegExp,
+		configObj.RdsSnapshot.ExcludeRule.NamesRegExp,
+	)
+}
+
+func nukeAllRdsSnapshots(session *session.Session, snapshotIds []*string) error {
+	svc := rds.New(session)
+
+	if len(snapshotIds) == 0 {
+		logging.Logger.Debugf("No RDS snapshots to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all RDS snapshots in region %s", *session.Config.Region)
+	var deletedIds []*string
+	var allErrs *multierror.Error
+
+	for _, snapshotId := range snapshotIds {
+		_, err := svc.DeleteDBSnapshot(&rds.DeleteDBSnapshotInput{
+			DBSnapshotIdentifier: snapshotId,
+		})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(snapshotId),
+			ResourceType: "RDS Snapshot",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking RDS Snapshot",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, snapshotId)
+			logging.Logger.Debugf("Deleted RDS snapshot: %s", aws.StringValue(snapshotId))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d RDS snapshot(s) deleted in %s", len(deletedIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// getAllRdsClusterSnapshots returns the identifiers of all the manual Aurora cluster snapshots of the current account
+func getAllRdsClusterSnapshots(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := rds.New(session)
+
+	var snapshotIds []*string
+	input := &rds.DescribeDBClusterSnapshotsInput{SnapshotType: aws.String("manual")}
+	err := svc.DescribeDBClusterSnapshotsPages(input, func(page *rds.DescribeDBClusterSnapshotsOutput, lastPage bool) bool {
+		for _, snapshot := range page.DBClusterSnapshots {
+			if shouldIncludeRdsClusterSnapshot(snapshot, excludeAfter, configObj) {
+				snapshotIds = append(snapshotIds, snapshot.DBClusterSnapshotIdentifier)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return snapshotIds, nil
+}
+
+func shouldIncludeRdsClusterSnapshot(snapshot *rds.DBClusterSnapshot, excludeAfter time.Time, configObj config.Config) bool {
+	if snapshot == nil {
+		return false
+	}
+
+	if aws.StringValue(snapshot.Status) != rdsSnapshotStatusAvailable {
+		return false
+	}
+
+	if snapshot.SnapshotCreateTime != nil && excludeAfter.Before(*snapshot.SnapshotCreateTime) {
+		return false
+	}
+
+	if hasRDSTagsExcludeTag(snapshot.TagList) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(snapshot.DBClusterSnapshotIdentifier),
+		configObj.RdsClusterSnapshot.IncludeRule.NamesRegExp,
+		configObj.RdsClusterSnapshot.ExcludeRule.NamesRegExp,
+	)
+}
+
+func nukeAllRdsClusterSnapshots(session *session.Session, snapshotIds []*string) error {
+	svc := rds.New(session)
+
+	if len(snapshotIds) == 0 {
+		logging.Logger.Debugf("No RDS cluster snapshots to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all RDS cluster snapshots in region %s", *session.Config.Region)
+	var deletedIds []*string
+	var allErrs *multierror.Error
+
+	for _, snapshotId := range snapshotIds {
+		_, err := svc.DeleteDBClusterSnapshot(&rds.DeleteDBClusterSnapshotInput{
+			DBClusterSnapshotIdentifier: snapshotId,
+		})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(snapshotId),
+			ResourceType: "RDS Cluster Snapshot",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking RDS Cluster Snapshot",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, snapshotId)
+			logging.Logger.Debugf("Deleted RDS cluster snapshot: %s", aws.StringValue(snapshotId))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d RDS cluster snapshot(s) deleted in %s", len(deletedIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldIncludeRdsSnapshot(t *testing.T) {
+	now := time.Now()
+	excludeConfig := config.Config{
+		RdsSnapshot: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^keep-.*")},
+				},
+			},
+		},
+	}
+	snapshot := func(id string, status string, createdAt time.Time) *rds.DBSnapshot {
+		return &rds.DBSnapshot{DBSnapshotIdentifier: aws.String(id), Status: aws.String(status), SnapshotCreateTime: aws.Time(createdAt)}
+	}
+	taggedSnapshot := snapshot("cloud-nuke-test", "available", now)
+	taggedSnapshot.TagList = []*rds.Tag{{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}}
+
+	cases := []struct {
+		Name         string
+		Snapshot     *rds.DBSnapshot
+		Config       config.Config
+		ExcludeAfter time.Time
+		Expected     bool
+	}{
+		{
+			Name:         "Available",
+			Snapshot:     snapshot("cloud-nuke-test", "available", now),
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     true,
+		},
+		{
+			Name:         "Creating",
+			Snapshot:     snapshot("cloud-nuke-test", "creating", now),
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "NotOlderThan",
+			Snapshot:     snapshot("cloud-nuke-test", "available", now),
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(-1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "ConfigExclude",
+			Snapshot:     snapshot("keep-me", "available", now),
+			Config:       excludeConfig,
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "ExcludeTag",
+			Snapshot:     taggedSnapshot,
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludeRdsSnapshot(c.Snapshot, c.ExcludeAfter, c.Config))
+		})
+	}
+}
+
+func TestShouldIncludeRdsClusterSnapshot(t *testing.T) {
+	now := time.Now()
+	snapshot := &rds.DBClusterSnapshot{
+		DBClusterSnapshotIdentifier: aws.String("cloud-nuke-test"),
+		Status:                      aws.String("available"),
+		SnapshotCreateTime:          aws.Time(now),
+	}
+
+	assert.True(t, shouldIncludeRdsClusterSnapshot(snapshot, now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeRdsClusterSnapshot(snapshot, now.Add(-1*time.Hour), config.Config{}))
+}
@@ -0,0 +1,65 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// RdsSnapshots - represents all manual RDS DB snapshots
+type RdsSnapshots struct {
+	SnapshotIdentifiers []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (snapshots RdsSnapshots) ResourceName() string {
+	return "rds-snapshot"
+}
+
+// ResourceIdentifiers - The identifiers of the RDS DB snapshots
+func (snapshots RdsSnapshots) ResourceIdentifiers() []string {
+	return snapshots.SnapshotIdentifiers
+}
+
+func (snapshots RdsSnapshots) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (snapshots RdsSnapshots) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllRdsSnapshots(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// RdsClusterSnapshots - represents all manual Aurora cluster snapshots
+type RdsClusterSnapshots struct {
+	SnapshotIdentifiers []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (snapshots RdsClusterSnapshots) ResourceName() string {
+	return "rds-cluster-snapshot"
+}
+
+// ResourceIdentifiers - The identifiers of the Aurora cluster snapshots
+func (snapshots RdsClusterSnapshots) ResourceIdentifiers() []string {
+	return snapshots.SnapshotIdentifiers
+}
+
+func (snapshots RdsClusterSnapshots) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (snapshots RdsClusterSnapshots) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllRdsClusterSnapshots(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
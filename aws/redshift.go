@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	redshiftClusterStatusPaused   = "paused"
+	redshiftClusterStatusDeleting = "deleting"
+)
+
+// getAllRedshiftClusters returns the identifiers of all the Redshift clusters in the region.
+func getAllRedshiftClusters(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := redshift.New(session)
+
+	var clusterIds []*string
+	err := svc.DescribeClustersPages(&redshift.DescribeClustersInput{}, func(page *redshift.DescribeClustersOutput, lastPage bool) bool {
+		for _, cluster := range page.Clusters {
+			if shouldIncludeRedshiftCluster(cluster, excludeAfter, configObj) {
+				clusterIds = append(clusterIds, cluster.ClusterIdentifier)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return clusterIds, nil
+}
+
+func shouldIncludeRedshiftCluster(cluster *redshift.Cluster, excludeAfter time.Time, configObj config.Config) bool {
+	if cluster == nil {
+		return false
+	}
+
+	// Clusters that are already being deleted will be gone shortly
+	if aws.StringValue(cluster.ClusterStatus) == redshiftClusterStatusDeleting {
+		return false
+	}
+
+	if cluster.ClusterCreateTime != nil && excludeAfter.Before(*cluster.ClusterCreateTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(cluster.ClusterIdentifier),
+		configObj.Redshift.IncludeRule.NamesRegExp,
+		configObj.Redshift.ExcludeRule.NamesRegExp,
+	)
+}
+
+// resumeRedshiftClusterIfPaused resumes the cluster and waits for it to be available if it's paused, as paused
+// clusters can't be deleted.
+func resumeRedshiftClusterIfPaused(svc redshiftiface.RedshiftAPI, clusterId *string) error {
+	result, err := svc.DescribeClusters(&redshift.DescribeClustersInput{
+		ClusterIdentifier: clusterId,
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(result.Clusters) == 0 || aws.StringValue(result.Clusters[0].ClusterStatus) != redshiftClusterStatusPaused {
+		return nil
+	}
+
+	logging.Logger.Debugf("Resuming paused Redshift cluster %s so it can be deleted", aws.StringValue(clusterId))
+	if _, err := svc.ResumeCluster(&redshift.ResumeClusterInput{ClusterIdentifier: clusterId}); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return errors.WithStackTrace(svc.WaitUntilClusterAvailable(&redshift.DescribeClustersInput{
+		ClusterIdentifier: clusterId,
+	}))
+}
+
+func nukeAllRedshiftClusters(session *session.Session, clusterIds []*string) error {
+	svc := redshift.New(session)
+
+	if len(clusterIds) == 0 {
+		logging.Logger.Debugf("No Redshift clusters to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Redshift clusters in region %s", *session.Config.Region)
+	var requestedDeletes []*string
+	var allErrs *multierror.Error
+
+	for _, clusterId := range clusterIds {
+		err := resumeRedshiftClusterIfPaused(svc, clusterId)
+		if err == nil {
+			_, err = svc.DeleteCluster(&redshift.DeleteClusterInput{
+				ClusterIdentifier:        clusterId,
+				SkipFinalClusterSnapshot: aws.Bool(true),
+			})
+		}
+		if err != nil {
+			report.Record(report.Entry{
+				Identifier:   aws.StringValue(clusterId),
+				ResourceType: "Redshift Cluster",
+				Error:        err,
+			})
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Redshift Cluster",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+			continue
+		}
+		requestedDeletes = append(requestedDeletes, clusterId)
+	}
+
+	// Deleting a cluster takes several minutes, so wait for them once all the deletes have been requested
+	var deletedIds []*string
+	for _, clusterId := range requestedDeletes {
+		err := svc.WaitUntilClusterDeleted(&redshift.DescribeClustersInput{
+			ClusterIdentifier: clusterId,
+		})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(clusterId),
+			ResourceType: "Redshift Cluster",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Redshift Cluster",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, clusterId)
+			logging.Logger.Debugf("Deleted Redshift cluster: %s", aws.StringValue(clusterId))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Redshift cluster(s) deleted in %s", len(deletedIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/aws/aws-sdk-go/service/redshiftserverless/redshiftserverlessiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// getAllRedshiftServerlessNamespaces returns the names of all the Redshift Serverless namespaces in the region. The
+// workgroups of each namespace are nuked along with it.
+func getAllRedshiftServerlessNamespaces(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := redshiftserverless.New(session)
+
+	var namespaceNames []*string
+	err := svc.ListNamespacesPages(&redshiftserverless.ListNamespacesInput{}, func(page *redshiftserverless.ListNamespacesOutput, lastPage bool) bool {
+		for _, namespace := range page.Namespaces {
+			if shouldIncludeRedshiftServerlessNamespace(namespace, excludeAfter, configObj) {
+				namespaceNames = append(namespaceNames, namespace.NamespaceName)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return namespaceNames, nil
+}
+
+func shouldIncludeRedshiftServerlessNamespace(namespace *redshiftserverless.Namespace, excludeAfter time.Time, configObj config.Config) bool {
+	if namespace == nil {
+		return false
+	}
+
+	if aws.StringValue(namespace.Status) == redshiftserverless.NamespaceStatusDeleting {
+		return false
+	}
+
+	if namespace.CreationDate != nil && excludeAfter.Before(*namespace.CreationDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(namespace.NamespaceName),
+		configObj.RedshiftServerless.IncludeRule.NamesRegExp,
+		configObj.RedshiftServerless.ExcludeRule.NamesRegExp,
+	)
+}
+
+// deleteRedshiftServerlessWorkgroups deletes all the workgroups of the namespace and waits for them to be gone, as a
+// namespace can't be deleted while a workgroup references it.
+func deleteRedshiftServerlessWorkgroups(svc redshiftserverlessiface.RedshiftServerlessAPI, namespaceName *string) error {
+	var workgroupNames []*string
+	err := svc.ListWorkgroupsPages(&redshiftserverless.ListWorkgroupsInput{}, func(page *redshiftserverless.ListWorkgroupsOutput, lastPage bool) bool {
+		for _, workgroup := range page.Workgroups {
+			if aws.StringValue(workgroup.NamespaceName) == aws.StringValue(namespaceName) {
+				workgroupNames = append(workgroupNames, workgroup.WorkgroupName)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, workgroupName := range workgroupNames {
+		_, err := svc.DeleteWorkgroup(&redshiftserverless.DeleteWorkgroupInput{
+			WorkgroupName: workgroupName,
+		})
+		if err != nil && !isRedshiftServerlessNotFoundErr(err) {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Requested deletion of workgroup %s of namespace %s", aws.StringValue(workgroupName), aws.StringValue(namespaceName))
+	}
+
+	return waitForRedshiftServerlessWorkgroupsToBeDeleted(svc, workgroupNames)
+}
+
+// waitForRedshiftServerlessWorkgroupsToBeDeleted polls the given workgroups until they no longer exist, as there is no
+// waiter for workgroup deletion in the SDK.
+func waitForRedshiftServerlessWorkgroupsToBeDeleted(svc redshiftserverlessiface.RedshiftServerlessAPI, workgroupNames []*string) error {
+	remaining := workgroupNames
+	for i := 0; i < 60; i++ {
+		var stillDeleting []*string
+		for _, workgroupName := range remaining {
+			_, err := svc.GetWorkgroup(&redshiftserverless.GetWorkgroupInput{
+				WorkgroupName: workgroupName,
+			})
+			if err != nil {
+				if isRedshiftServerlessNotFoundErr(err) {
+					continue
+				}
+				return errors.WithStackTrace(err)
+			}
+			stillDeleting = append(stillDeleting, workgroupName)
+		}
+
+		if len(stillDeleting) == 0 {
+			return nil
+		}
+		remaining = stillDeleting
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debug("Waiting for Redshift Serverless workgroups to be deleted...")
+	}
+
+	return RedshiftServerlessWorkgroupDeleteTimeoutError{}
+}
+
+func isRedshiftServerlessNotFoundErr(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == redshiftserverless.ErrCodeResourceNotFoundException
+}
+
+func nukeAllRedshiftServerlessNamespaces(session *session.Session, namespaceNames []*string) error {
+	svc := redshiftserverless.New(session)
+
+	if len(namespaceNames) == 0 {
+		logging.Logger.Debugf("No Redshift Serverless namespaces to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Redshift Serverless namespaces in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, namespaceName := range namespaceNames {
+		err := deleteRedshiftServerlessWorkgroups(svc, namespaceName)
+		if err == nil {
+			_, err = svc.DeleteNamespace(&redshiftserverless.DeleteNamespaceInput{
+				NamespaceName: namespaceName,
+			})
+		}
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(namespaceName),
+			ResourceType: "Redshift Serverless Namespace",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Redshift Serverless Namespace",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, namespaceName)
+			logging.Logger.Debugf("Deleted Redshift Serverless namespace: %s", aws.StringValue(namespaceName))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Redshift Serverless namespace(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/aws/aws-sdk-go/service/redshiftserverless/redshiftserverlessiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedRedshiftServerless serves a fixed set of workgroups, which are gone as soon as they are deleted
+type mockedRedshiftServerless struct {
+	redshiftserverlessiface.RedshiftServerlessAPI
+	Workgroups []*redshiftserverless.Workgroup
+	Deleted    []string
+}
+
+func (m *mockedRedshiftServerless) ListWorkgroupsPages(input *redshiftserverless.ListWorkgroupsInput, fn func(*redshiftserverless.ListWorkgroupsOutput, bool) bool) error {
+	fn(&redshiftserverless.ListWorkgroupsOutput{Workgroups: m.Workgroups}, true)
+	return nil
+}
+
+func (m *mockedRedshiftServerless) DeleteWorkgroup(input *redshiftserverless.DeleteWorkgroupInput) (*redshiftserverless.DeleteWorkgroupOutput, error) {
+	m.Deleted = append(m.Deleted, aws.StringValue(input.WorkgroupName))
+	return &redshiftserverless.DeleteWorkgroupOutput{}, nil
+}
+
+func (m *mockedRedshiftServerless) GetWorkgroup(input *redshiftserverless.GetWorkgroupInput) (*redshiftserverless.GetWorkgroupOutput, error) {
+	return nil, awserr.New(redshiftserverless.ErrCodeResourceNotFoundException, "not found", nil)
+}
+
+func TestDeleteRedshiftServerlessWorkgroups(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedRedshiftServerless{
+		Workgroups: []*redshiftserverless.Workgroup{
+			{WorkgroupName: aws.String("workgroup-1"), NamespaceName: aws.String("cloud-nuke-test")},
+			{WorkgroupName: aws.String("workgroup-2"), NamespaceName: aws.String("other")},
+			{WorkgroupName: aws.String("workgroup-3"), NamespaceName: aws.String("cloud-nuke-test")},
+		},
+	}
+	require.NoError(t, deleteRedshiftServerlessWorkgroups(mock, aws.String("cloud-nuke-test")))
+	assert.Equal(t, []string{"workgroup-1", "workgroup-3"}, mock.Deleted)
+}
+
+func TestShouldIncludeRedshiftServerlessNamespace(t *testing.T) {
+	now := time.Now()
+	namespace := func(status string, createdAt time.Time) *redshiftserverless.Namespace {
+		return &redshiftserverless.Namespace{NamespaceName: aws.String("cloud-nuke-test"), Status: aws.String(status), CreationDate: aws.Time(createdAt)}
+	}
+
+	assert.True(t, shouldIncludeRedshiftServerlessNamespace(namespace(redshiftserverless.NamespaceStatusAvailable, now), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeRedshiftServerlessNamespace(namespace(redshiftserverless.NamespaceStatusDeleting, now), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeRedshiftServerlessNamespace(namespace(redshiftserverless.NamespaceStatusAvailable, now), now.Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeRedshiftServerlessNamespace(nil, now, config.Config{}))
+}
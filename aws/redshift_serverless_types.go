@@ -0,0 +1,42 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// RedshiftServerlessNamespaces - represents all Redshift Serverless namespaces, along with their workgroups
+type RedshiftServerlessNamespaces struct {
+	NamespaceNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (namespaces RedshiftServerlessNamespaces) ResourceName() string {
+	return "redshift-serverless"
+}
+
+// ResourceIdentifiers - The names of the Redshift Serverless namespaces
+func (namespaces RedshiftServerlessNamespaces) ResourceIdentifiers() []string {
+	return namespaces.NamespaceNames
+}
+
+func (namespaces RedshiftServerlessNamespaces) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (namespaces RedshiftServerlessNamespaces) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllRedshiftServerlessNamespaces(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type RedshiftServerlessWorkgroupDeleteTimeoutError struct{}
+
+func (e RedshiftServerlessWorkgroupDeleteTimeoutError) Error() string {
+	return "Timed out waiting for Redshift Serverless workgroups to be successfully deleted"
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+const redshiftSnapshotStatusAvailable = "available"
+
+// getAllRedshiftSnapshots returns the identifiers of all the manual Redshift snapshots owned by the current account.
+// Automated snapshots are removed by Redshift itself, once their retention period expires or their cluster is deleted.
+func getAllRedshiftSnapshots(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := redshift.New(session)
+
+	// Snapshots shared with the account are listed as well, but can only be deleted by their owner
+	identity, err := sts.New(session).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var snapshotIds []*string
+	input := &redshift.DescribeClusterSnapshotsInput{
+		OwnerAccount: identity.Account,
+		SnapshotType: aws.String("manual"),
+	}
+	err = svc.DescribeClusterSnapshotsPages(input, func(page *redshift.DescribeClusterSnapshotsOutput, lastPage bool) bool {
+		for _, snapshot := range page.Snapshots {
+			if shouldIncludeRedshiftSnapshot(snapshot, excludeAfter, configObj) {
+				snapshotIds = append(snapshotIds, snapshot.SnapshotIdentifier)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return snapshotIds, nil
+}
+
+func shouldIncludeRedshiftSnapshot(snapshot *redshift.Snapshot, excludeAfter time.Time, configObj config.Config) bool {
+	if snapshot == nil {
+		return false
+	}
+
+	// Snapshots that are still being created can't be deleted
+	if aws.StringValue(snapshot.Status) != redshiftSnapshotStatusAvailable {
+		return false
+	}
+
+	if snapshot.SnapshotCreateTime != nil && excludeAfter.Before(*snapshot.SnapshotCreateTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(snapshot.SnapshotIdentifier),
+		configObj.RedshiftSnapshot.IncludeRule.NamesRegExp,
+		configObj.RedshiftSnapshot.ExcludeRule.NamesRegExp,
+	)
+}
+
+func nukeAllRedshiftSnapshots(session *session.Session, snapshotIds []*string) error {
+	svc := redshift.New(session)
+
+	if len(snapshotIds) == 0 {
+		logging.Logger.Debugf("No Redshift snapshots to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Redshift snapshots in region %s", *session.Config.Region)
+	var deletedIds []*string
+	var allErrs *multierror.Error
+
+	for _, snapshotId := range snapshotIds {
+		_, err := svc.DeleteClusterSnapshot(&redshift.DeleteClusterSnapshotInput{
+			SnapshotIdentifier: snapshotId,
+		})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(snapshotId),
+			ResourceType: "Redshift Snapshot",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Redshift Snapshot",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, snapshotId)
+			logging.Logger.Debugf("Deleted Redshift snapshot: %s", aws.StringValue(snapshotId))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Redshift snapshot(s) deleted in %s", len(deletedIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
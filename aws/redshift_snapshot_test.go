@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldIncludeRedshiftSnapshot(t *testing.T) {
+	now := time.Now()
+	excludeConfig := config.Config{
+		RedshiftSnapshot: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^keep-.*")},
+				},
+			},
+		},
+	}
+	snapshot := func(id string, status string, createdAt time.Time) *redshift.Snapshot {
+		return &redshift.Snapshot{SnapshotIdentifier: aws.String(id), Status: aws.String(status), SnapshotCreateTime: aws.Time(createdAt)}
+	}
+
+	cases := []struct {
+		Name         string
+		Snapshot     *redshift.Snapshot
+		Config       config.Config
+		ExcludeAfter time.Time
+		Expected     bool
+	}{
+		{
+			Name:         "Available",
+			Snapshot:     snapshot("cloud-nuke-test", "available", now),
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     true,
+		},
+		{
+			Name:         "Creating",
+			Snapshot:     snapshot("cloud-nuke-test", "creating", now),
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "NotOlderThan",
+			Snapshot:     snapshot("cloud-nuke-test", "available", now),
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(-1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "ConfigExclude",
+			Snapshot:     snapshot("keep-me", "available", now),
+			Config:       excludeConfig,
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludeRedshiftSnapshot(c.Snapshot, c.ExcludeAfter, c.Config))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// RedshiftSnapshots - represents all manual Redshift snapshots
+type RedshiftSnapshots struct {
+	SnapshotIdentifiers []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (snapshots RedshiftSnapshots) ResourceName() string {
+	return "redshift-snapshot"
+}
+
+// ResourceIdentifiers - The identifiers of the Redshift snapshots
+func (snapshots RedshiftSnapshots) ResourceIdentifiers() []string {
+	return snapshots.SnapshotIdentifiers
+}
+
+func (snapshots RedshiftSnapshots) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (snapshots RedshiftSnapshots) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllRedshiftSnapshots(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedRedshift serves a single cluster in the given status
+type mockedRedshift struct {
+	redshiftiface.RedshiftAPI
+	Status  string
+	Resumed bool
+	Waited  bool
+}
+
+func (m *mockedRedshift) DescribeClusters(input *redshift.DescribeClustersInput) (*redshift.DescribeClustersOutput, error) {
+	return &redshift.DescribeClustersOutput{
+		Clusters: []*redshift.Cluster{{ClusterIdentifier: input.ClusterIdentifier, ClusterStatus: aws.String(m.Status)}},
+	}, nil
+}
+
+func (m *mockedRedshift) ResumeCluster(input *redshift.ResumeClusterInput) (*redshift.ResumeClusterOutput, error) {
+	m.Resumed = true
+	return &redshift.ResumeClusterOutput{}, nil
+}
+
+func (m *mockedRedshift) WaitUntilClusterAvailable(input *redshift.DescribeClustersInput) error {
+	m.Waited = true
+	return nil
+}
+
+func TestResumeRedshiftClusterIfPaused(t *testing.T) {
+	t.Parallel()
+
+	paused := &mockedRedshift{Status: "paused"}
+	require.NoError(t, resumeRedshiftClusterIfPaused(paused, aws.String("cloud-nuke-test")))
+	assert.True(t, paused.Resumed)
+	assert.True(t, paused.Waited)
+
+	available := &mockedRedshift{Status: "available"}
+	require.NoError(t, resumeRedshiftClusterIfPaused(available, aws.String("cloud-nuke-test")))
+	assert.False(t, available.Resumed)
+	assert.False(t, available.Waited)
+}
+
+func TestShouldIncludeRedshiftCluster(t *testing.T) {
+	now := time.Now()
+	excludeConfig := config.Config{
+		Redshift: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^keep-.*")},
+				},
+			},
+		},
+	}
+	cluster := func(id string, status string, createdAt time.Time) *redshift.Cluster {
+		return &redshift.Cluster{ClusterIdentifier: aws.String(id), ClusterStatus: aws.String(status), ClusterCreateTime: aws.Time(createdAt)}
+	}
+
+	cases := []struct {
+		Name         string
+		Cluster      *redshift.Cluster
+		Config       config.Config
+		ExcludeAfter time.Time
+		Expected     bool
+	}{
+		{
+			Name:         "Available",
+			Cluster:      cluster("cloud-nuke-test", "available", now),
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     true,
+		},
+		{
+			Name:         "Paused",
+			Cluster:      cluster("cloud-nuke-test", "paused", now),
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     true,
+		},
+		{
+			Name:         "Deleting",
+			Cluster:      cluster("cloud-nuke-test", "deleting", now),
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "NotOlderThan",
+			Cluster:      cluster("cloud-nuke-test", "available", now),
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(-1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "ConfigExclude",
+			Cluster:      cluster("keep-me", "available", now),
+			Config:       excludeConfig,
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludeRedshiftCluster(c.Cluster, c.ExcludeAfter, c.Config))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// RedshiftClusters - represents all Redshift clusters
+type RedshiftClusters struct {
+	ClusterIdentifiers []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (clusters RedshiftClusters) ResourceName() string {
+	return "redshift"
+}
+
+// ResourceIdentifiers - The identifiers of the Redshift clusters
+func (clusters RedshiftClusters) ResourceIdentifiers() []string {
+	return clusters.ClusterIdentifiers
+}
+
+func (clusters RedshiftClusters) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (clusters RedshiftClusters) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllRedshiftClusters(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
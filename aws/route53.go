@@ -0,0 +1,246 @@
+package aws
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// ChangeResourceRecordSets accepts up to 1000 changes per request, we stay well below that to keep requests small.
+const route53RecordSetChangeBatchSize = 100
+
+// getAllRoute53HostedZones returns the IDs of all the public and private hosted zones that match the configured
+// filters and were first seen before excludeAfter.
+func getAllRoute53HostedZones(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := route53.New(session)
+
+	var zones []*route53.HostedZone
+	err := svc.ListHostedZonesPages(
+		&route53.ListHostedZonesInput{},
+		func(page *route53.ListHostedZonesOutput, lastPage bool) bool {
+			zones = append(zones, page.HostedZones...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var ids []*string
+	for _, zone := range zones {
+		include, err := shouldIncludeRoute53HostedZone(svc, zone, excludeAfter, configObj)
+		if err != nil {
+			return nil, err
+		}
+		if include {
+			ids = append(ids, zone.Id)
+		}
+	}
+
+	return ids, nil
+}
+
+// shouldIncludeRoute53HostedZone checks the name of the zone against the config, and its tags for the exclusion tag
+// and whether it was first seen before excludeAfter
+func shouldIncludeRoute53HostedZone(svc route53iface.Route53API, zone *route53.HostedZone, excludeAfter time.Time, configObj config.Config) (bool, error) {
+	if zone == nil {
+		return false, nil
+	}
+
+	// Zones created by another service (e.g. Cloud Map namespaces) can only be deleted through that service
+	if zone.LinkedService != nil {
+		return false, nil
+	}
+
+	if !config.ShouldInclude(
+		getRoute53HostedZoneName(zone),
+		configObj.Route53HostedZone.IncludeRule.NamesRegExp,
+		configObj.Route53HostedZone.ExcludeRule.NamesRegExp,
+	) {
+		return false, nil
+	}
+
+	zoneId := getRoute53HostedZoneTagId(zone.Id)
+	tags, err := svc.ListTagsForResource(&route53.ListTagsForResourceInput{
+		ResourceId:   zoneId,
+		ResourceType: awsgo.String(route53.TagResourceTypeHostedzone),
+	})
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+
+	var tagList []*route53.Tag
+	if tags.ResourceTagSet != nil {
+		tagList = tags.ResourceTagSet.Tags
+	}
+	if hasRoute53ExcludeTag(tagList) {
+		return false, nil
+	}
+
+	// Hosted zones don't have a creation time, so we rely on the first seen tag instead
+	firstSeenTime, err := getOrSetFirstSeenRoute53Tag(svc, zoneId, tagList)
+	if err != nil {
+		return false, err
+	}
+	return excludeAfter.After(firstSeenTime), nil
+}
+
+// getRoute53HostedZoneTagId returns the ID of the zone without the /hostedzone/ prefix returned by ListHostedZones,
+// which is the form the tagging API expects.
+func getRoute53HostedZoneTagId(zoneId *string) *string {
+	return awsgo.String(strings.TrimPrefix(awsgo.StringValue(zoneId), "/hostedzone/"))
+}
+
+func hasRoute53ExcludeTag(tags []*route53.Tag) bool {
+	for _, tag := range tags {
+		if awsgo.StringValue(tag.Key) == AwsResourceExclusionTagKey && awsgo.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// getOrSetFirstSeenRoute53Tag returns when cloud-nuke first saw the hosted zone, tagging it now if it wasn't seen
+// before.
+func getOrSetFirstSeenRoute53Tag(svc route53iface.Route53API, zoneId *string, tags []*route53.Tag) (time.Time, error) {
+	for _, tag := range tags {
+		if awsgo.StringValue(tag.Key) == firstSeenTagKey {
+			firstSeenTime, err := time.Parse(time.RFC3339, awsgo.StringValue(tag.Value))
+			if err != nil {
+				return time.Time{}, errors.WithStackTrace(err)
+			}
+			return firstSeenTime, nil
+		}
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.ChangeTagsForResource(&route53.ChangeTagsForResourceInput{
+		ResourceId:   zoneId,
+		ResourceType: awsgo.String(route53.TagResourceTypeHostedzone),
+		AddTags:      []*route53.Tag{{Key: awsgo.String(firstSeenTagKey), Value: awsgo.String(now.Format(time.RFC3339))}},
+	})
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return now, nil
+}
+
+// getRoute53HostedZoneName returns the domain name of the zone without the trailing dot returned by the API, so that
+// regular expressions in the config file can be written against the plain domain name.
+func getRoute53HostedZoneName(zone *route53.HostedZone) string {
+	return strings.TrimSuffix(awsgo.StringValue(zone.Name), ".")
+}
+
+// isDefaultRoute53RecordSet returns true for the SOA and NS records at the zone apex, which are created with the zone
+// and are removed together with it.
+func isDefaultRoute53RecordSet(zoneName string, recordSet *route53.ResourceRecordSet) bool {
+	if strings.TrimSuffix(awsgo.StringValue(recordSet.Name), ".") != strings.TrimSuffix(zoneName, ".") {
+		return false
+	}
+	recordType := awsgo.StringValue(recordSet.Type)
+	return recordType == route53.RRTypeSoa || recordType == route53.RRTypeNs
+}
+
+// deleteRoute53RecordSets deletes all the non-default record sets of the given zone, since a hosted zone can only be
+// deleted once it only contains its SOA and NS records.
+func deleteRoute53RecordSets(svc route53iface.Route53API, zoneId *string) error {
+	zone, err := svc.GetHostedZone(&route53.GetHostedZoneInput{Id: zoneId})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	zoneName := awsgo.StringValue(zone.HostedZone.Name)
+
+	var changes []*route53.Change
+	err = svc.ListResourceRecordSetsPages(
+		&route53.ListResourceRecordSetsInput{HostedZoneId: zoneId},
+		func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+			for _, recordSet := range page.ResourceRecordSets {
+				if isDefaultRoute53RecordSet(zoneName, recordSet) {
+					continue
+				}
+				changes = append(changes, &route53.Change{
+					Action:            awsgo.String(route53.ChangeActionDelete),
+					ResourceRecordSet: recordSet,
+				})
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for len(changes) > 0 {
+		batchSize := route53RecordSetChangeBatchSize
+		if len(changes) < batchSize {
+			batchSize = len(changes)
+		}
+
+		_, err := svc.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: zoneId,
+			ChangeBatch:  &route53.ChangeBatch{Changes: changes[:batchSize]},
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted %d record set(s) from hosted zone %s", batchSize, awsgo.StringValue(zoneId))
+		changes = changes[batchSize:]
+	}
+
+	return nil
+}
+
+// nukeAllRoute53HostedZones deletes the given hosted zones, together with all the record sets they contain.
+func nukeAllRoute53HostedZones(session *session.Session, ids []*string) error {
+	svc := route53.New(session)
+
+	if len(ids) == 0 {
+		logging.Logger.Debug("No Route53 hosted zones to nuke")
+		return nil
+	}
+
+	logging.Logger.Debug("Deleting all Route53 hosted zones")
+
+	var deletedIds []*string
+	var allErrs *multierror.Error
+	for _, id := range ids {
+		err := deleteRoute53RecordSets(svc, id)
+		if err == nil {
+			_, err = svc.DeleteHostedZone(&route53.DeleteHostedZoneInput{Id: id})
+		}
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   awsgo.StringValue(id),
+			ResourceType: "Route53 Hosted Zone",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Route53 Hosted Zone",
+			}, map[string]interface{}{})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, id)
+			logging.Logger.Debugf("Deleted Route53 hosted zone: %s", awsgo.StringValue(id))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Route53 hosted zone(s) deleted", len(deletedIds))
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
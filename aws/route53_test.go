@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedRoute53 serves a single hosted zone and records the record set changes requested for it
+type mockedRoute53 struct {
+	route53iface.Route53API
+	Zone       *route53.HostedZone
+	RecordSets []*route53.ResourceRecordSet
+	Changes    [][]*route53.Change
+	Tags       map[string][]*route53.Tag
+	TaggedIds  []string
+}
+
+func (m *mockedRoute53) ListTagsForResource(input *route53.ListTagsForResourceInput) (*route53.ListTagsForResourceOutput, error) {
+	return &route53.ListTagsForResourceOutput{
+		ResourceTagSet: &route53.ResourceTagSet{Tags: m.Tags[awsgo.StringValue(input.ResourceId)]},
+	}, nil
+}
+
+func (m *mockedRoute53) ChangeTagsForResource(input *route53.ChangeTagsForResourceInput) (*route53.ChangeTagsForResourceOutput, error) {
+	m.TaggedIds = append(m.TaggedIds, awsgo.StringValue(input.ResourceId))
+	return &route53.ChangeTagsForResourceOutput{}, nil
+}
+
+func (m *mockedRoute53) GetHostedZone(input *route53.GetHostedZoneInput) (*route53.GetHostedZoneOutput, error) {
+	return &route53.GetHostedZoneOutput{HostedZone: m.Zone}, nil
+}
+
+func (m *mockedRoute53) ListResourceRecordSetsPages(input *route53.ListResourceRecordSetsInput, fn func(*route53.ListResourceRecordSetsOutput, bool) bool) error {
+	fn(&route53.ListResourceRecordSetsOutput{ResourceRecordSets: m.RecordSets}, true)
+	return nil
+}
+
+func (m *mockedRoute53) ChangeResourceRecordSets(input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+	m.Changes = append(m.Changes, input.ChangeBatch.Changes)
+	return &route53.ChangeResourceRecordSetsOutput{}, nil
+}
+
+func TestShouldIncludeRoute53HostedZone(t *testing.T) {
+	firstSeen := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+	mock := &mockedRoute53{Tags: map[string][]*route53.Tag{
+		"SEEN":     {{Key: awsgo.String(firstSeenTagKey), Value: awsgo.String(firstSeen)}},
+		"EXCLUDED": {{Key: awsgo.String(firstSeenTagKey), Value: awsgo.String(firstSeen)}, {Key: awsgo.String(AwsResourceExclusionTagKey), Value: awsgo.String("true")}},
+	}}
+	excludeConfig := config.Config{
+		Route53HostedZone: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile(`^prod\.example\.com$`)},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name         string
+		Zone         *route53.HostedZone
+		Config       config.Config
+		ExcludeAfter time.Time
+		Expected     bool
+	}{
+		{
+			Name:         "SeenBefore",
+			Zone:         &route53.HostedZone{Id: awsgo.String("/hostedzone/SEEN"), Name: awsgo.String("test.example.com.")},
+			ExcludeAfter: time.Now().Add(-1 * time.Hour),
+			Expected:     true,
+		},
+		{
+			Name:         "SeenAfter",
+			Zone:         &route53.HostedZone{Id: awsgo.String("/hostedzone/SEEN"), Name: awsgo.String("test.example.com.")},
+			ExcludeAfter: time.Now().Add(-3 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "NotSeenYet",
+			Zone:         &route53.HostedZone{Id: awsgo.String("/hostedzone/NEW"), Name: awsgo.String("test.example.com.")},
+			ExcludeAfter: time.Now().Add(-1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "ExcludeTag",
+			Zone:         &route53.HostedZone{Id: awsgo.String("/hostedzone/EXCLUDED"), Name: awsgo.String("test.example.com.")},
+			ExcludeAfter: time.Now().Add(-1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "ConfigExcludeWithoutTrailingDot",
+			Zone:         &route53.HostedZone{Id: awsgo.String("/hostedzone/SEEN"), Name: awsgo.String("prod.example.com.")},
+			Config:       excludeConfig,
+			ExcludeAfter: time.Now().Add(-1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name: "LinkedService",
+			Zone: &route53.HostedZone{
+				Id:            awsgo.String("/hostedzone/SEEN"),
+				Name:          awsgo.String("namespace.local."),
+				LinkedService: &route53.LinkedService{ServicePrincipal: awsgo.String("servicediscovery.amazonaws.com")},
+			},
+			ExcludeAfter: time.Now().Add(-1 * time.Hour),
+			Expected:     false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			include, err := shouldIncludeRoute53HostedZone(mock, c.Zone, c.ExcludeAfter, c.Config)
+			require.NoError(t, err)
+			assert.Equal(t, c.Expected, include)
+		})
+	}
+	// Only the zone that wasn't seen before gets tagged, without the /hostedzone/ prefix
+	assert.Equal(t, []string{"NEW"}, mock.TaggedIds)
+}
+
+func TestDeleteRoute53RecordSetsKeepsDefaultRecords(t *testing.T) {
+	t.Parallel()
+
+	recordSets := []*route53.ResourceRecordSet{
+		{Name: awsgo.String("example.com."), Type: awsgo.String(route53.RRTypeSoa)},
+		{Name: awsgo.String("example.com."), Type: awsgo.String(route53.RRTypeNs)},
+		// NS records below the apex delegate a subdomain and must be deleted
+		{Name: awsgo.String("sub.example.com."), Type: awsgo.String(route53.RRTypeNs)},
+		{Name: awsgo.String("example.com."), Type: awsgo.String(route53.RRTypeA)},
+	}
+	// Enough records to need more than one change batch
+	for i := 0; i < route53RecordSetChangeBatchSize; i++ {
+		recordSets = append(recordSets, &route53.ResourceRecordSet{
+			Name: awsgo.String(fmt.Sprintf("host-%d.example.com.", i)),
+			Type: awsgo.String(route53.RRTypeCname),
+		})
+	}
+
+	mock := &mockedRoute53{
+		Zone:       &route53.HostedZone{Id: awsgo.String("/hostedzone/Z123"), Name: awsgo.String("example.com.")},
+		RecordSets: recordSets,
+	}
+	require.NoError(t, deleteRoute53RecordSets(mock, mock.Zone.Id))
+
+	require.Len(t, mock.Changes, 2)
+	deleted := 0
+	for _, batch := range mock.Changes {
+		for _, change := range batch {
+			assert.Equal(t, route53.ChangeActionDelete, awsgo.StringValue(change.Action))
+			assert.False(t, isDefaultRoute53RecordSet("example.com.", change.ResourceRecordSet))
+			deleted++
+		}
+	}
+	assert.Equal(t, len(recordSets)-2, deleted)
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// Route53HostedZones - represents all public and private Route53 hosted zones
+type Route53HostedZones struct {
+	HostedZoneIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (zones Route53HostedZones) ResourceName() string {
+	return "route53-hosted-zone"
+}
+
+// ResourceIdentifiers - The IDs of the hosted zones
+func (zones Route53HostedZones) ResourceIdentifiers() []string {
+	return zones.HostedZoneIds
+}
+
+func (zones Route53HostedZones) MaxBatchSize() int {
+	// Route53 has a low API rate limit (5 requests per second per account), and each zone takes several calls
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (zones Route53HostedZones) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllRoute53HostedZones(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
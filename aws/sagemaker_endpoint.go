@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/sagemaker/sagemakeriface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/collections"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of SageMaker endpoint names
+func getAllSageMakerEndpoints(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := sagemaker.New(session)
+
+	var names []*string
+	err := svc.ListEndpointsPages(&sagemaker.ListEndpointsInput{}, func(page *sagemaker.ListEndpointsOutput, lastPage bool) bool {
+		for _, endpoint := range page.Endpoints {
+			if shouldIncludeSageMakerEndpoint(endpoint, excludeAfter, configObj) {
+				names = append(names, endpoint.EndpointName)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return names, nil
+}
+
+func shouldIncludeSageMakerEndpoint(endpoint *sagemaker.EndpointSummary, excludeAfter time.Time, configObj config.Config) bool {
+	if endpoint == nil {
+		return false
+	}
+
+	// Endpoints that are already being deleted will be gone shortly
+	if aws.StringValue(endpoint.EndpointStatus) == sagemaker.EndpointStatusDeleting {
+		return false
+	}
+
+	if endpoint.CreationTime != nil && excludeAfter.Before(*endpoint.CreationTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(endpoint.EndpointName),
+		configObj.SageMakerEndpoint.IncludeRule.NamesRegExp,
+		configObj.SageMakerEndpoint.ExcludeRule.NamesRegExp,
+	)
+}
+
+// deleteSageMakerEndpoint deletes the endpoint and returns the name of the endpoint config it was deployed from.
+func deleteSageMakerEndpoint(svc sagemakeriface.SageMakerAPI, name *string) (*string, error) {
+	endpoint, err := svc.DescribeEndpoint(&sagemaker.DescribeEndpointInput{EndpointName: name})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	if _, err := svc.DeleteEndpoint(&sagemaker.DeleteEndpointInput{EndpointName: name}); err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return endpoint.EndpointConfigName, nil
+}
+
+// Deletes all SageMaker endpoints, followed by the endpoint configs they were deployed from
+func nukeAllSageMakerEndpoints(session *session.Session, names []*string) error {
+	svc := sagemaker.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No SageMaker endpoints to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all SageMaker endpoints in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var endpointConfigNames []string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		endpointConfigName, err := deleteSageMakerEndpoint(svc, name)
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking SageMaker Endpoint",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			report.Record(report.Entry{
+				Identifier:   aws.StringValue(name),
+				ResourceType: "SageMaker Endpoint",
+				Error:        err,
+			})
+			allErrs = multierror.Append(allErrs, err)
+			continue
+		}
+
+		deletedNames = append(deletedNames, name)
+		if endpointConfigName != nil && !collections.ListContainsElement(endpointConfigNames, aws.StringValue(endpointConfigName)) {
+			endpointConfigNames = append(endpointConfigNames, aws.StringValue(endpointConfigName))
+		}
+		logging.Logger.Debugf("Deleted SageMaker endpoint: %s", aws.StringValue(name))
+	}
+
+	for _, name := range deletedNames {
+		err := svc.WaitUntilEndpointDeleted(&sagemaker.DescribeEndpointInput{EndpointName: name})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: "SageMaker Endpoint",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			allErrs = multierror.Append(allErrs, errors.WithStackTrace(err))
+		}
+	}
+
+	// Endpoint configs can only be deleted once no endpoint uses them anymore
+	for _, endpointConfigName := range endpointConfigNames {
+		_, err := svc.DeleteEndpointConfig(&sagemaker.DeleteEndpointConfigInput{EndpointConfigName: aws.String(endpointConfigName)})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   endpointConfigName,
+			ResourceType: "SageMaker Endpoint Config",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking SageMaker Endpoint Config",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, errors.WithStackTrace(err))
+		} else {
+			logging.Logger.Debugf("Deleted SageMaker endpoint config: %s", endpointConfigName)
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d SageMaker endpoint(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
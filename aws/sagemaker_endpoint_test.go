@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/sagemaker/sagemakeriface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedSageMakerEndpoint struct {
+	sagemakeriface.SageMakerAPI
+	Deleted []string
+}
+
+func (m *mockedSageMakerEndpoint) DescribeEndpoint(input *sagemaker.DescribeEndpointInput) (*sagemaker.DescribeEndpointOutput, error) {
+	return &sagemaker.DescribeEndpointOutput{
+		EndpointName:       input.EndpointName,
+		EndpointConfigName: aws.String(aws.StringValue(input.EndpointName) + "-config"),
+	}, nil
+}
+
+func (m *mockedSageMakerEndpoint) DeleteEndpoint(input *sagemaker.DeleteEndpointInput) (*sagemaker.DeleteEndpointOutput, error) {
+	m.Deleted = append(m.Deleted, aws.StringValue(input.EndpointName))
+	return &sagemaker.DeleteEndpointOutput{}, nil
+}
+
+func TestDeleteSageMakerEndpointReturnsEndpointConfig(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedSageMakerEndpoint{}
+	endpointConfigName, err := deleteSageMakerEndpoint(mock, aws.String("cloud-nuke-test"))
+	require.NoError(t, err)
+	assert.Equal(t, "cloud-nuke-test-config", aws.StringValue(endpointConfigName))
+	assert.Equal(t, []string{"cloud-nuke-test"}, mock.Deleted)
+}
+
+func TestShouldIncludeSageMakerEndpoint(t *testing.T) {
+	now := time.Now()
+	endpoint := func(status string) *sagemaker.EndpointSummary {
+		return &sagemaker.EndpointSummary{EndpointName: aws.String("cloud-nuke-test"), EndpointStatus: aws.String(status), CreationTime: aws.Time(now)}
+	}
+
+	assert.True(t, shouldIncludeSageMakerEndpoint(endpoint(sagemaker.EndpointStatusInService), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSageMakerEndpoint(endpoint(sagemaker.EndpointStatusDeleting), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSageMakerEndpoint(endpoint(sagemaker.EndpointStatusInService), now.Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSageMakerEndpoint(nil, now, config.Config{}))
+}
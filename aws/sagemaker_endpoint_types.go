@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// SageMakerEndpoints - represents all SageMaker endpoints
+type SageMakerEndpoints struct {
+	EndpointNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (endpoints SageMakerEndpoints) ResourceName() string {
+	return "sagemaker-endpoint"
+}
+
+// ResourceIdentifiers - The names of the SageMaker endpoints
+func (endpoints SageMakerEndpoints) ResourceIdentifiers() []string {
+	return endpoints.EndpointNames
+}
+
+func (endpoints SageMakerEndpoints) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (endpoints SageMakerEndpoints) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSageMakerEndpoints(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
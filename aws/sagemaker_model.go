@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of SageMaker model names
+func getAllSageMakerModels(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := sagemaker.New(session)
+
+	var names []*string
+	err := svc.ListModelsPages(&sagemaker.ListModelsInput{}, func(page *sagemaker.ListModelsOutput, lastPage bool) bool {
+		for _, model := range page.Models {
+			if shouldIncludeSageMakerModel(model, excludeAfter, configObj) {
+				names = append(names, model.ModelName)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return names, nil
+}
+
+func shouldIncludeSageMakerModel(model *sagemaker.ModelSummary, excludeAfter time.Time, configObj config.Config) bool {
+	if model == nil {
+		return false
+	}
+
+	if model.CreationTime != nil && excludeAfter.Before(*model.CreationTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(model.ModelName),
+		configObj.SageMakerModel.IncludeRule.NamesRegExp,
+		configObj.SageMakerModel.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Deletes all SageMaker models
+func nukeAllSageMakerModels(session *session.Session, names []*string) error {
+	svc := sagemaker.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No SageMaker models to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all SageMaker models in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		_, err := svc.DeleteModel(&sagemaker.DeleteModelInput{ModelName: name})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: "SageMaker Model",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking SageMaker Model",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted SageMaker model: %s", aws.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d SageMaker model(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
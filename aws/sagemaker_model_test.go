@@ -0,0 +1,29 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldIncludeSageMakerModel(t *testing.T) {
+	now := time.Now()
+	model := &sagemaker.ModelSummary{ModelName: aws.String("cloud-nuke-test"), CreationTime: aws.Time(now)}
+	excludeConfig := config.Config{
+		SageMakerModel: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^cloud-nuke-")}},
+			},
+		},
+	}
+
+	assert.True(t, shouldIncludeSageMakerModel(model, now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSageMakerModel(model, now.Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSageMakerModel(model, now.Add(1*time.Hour), excludeConfig))
+	assert.False(t, shouldIncludeSageMakerModel(nil, now, config.Config{}))
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// SageMakerModels - represents all SageMaker models
+type SageMakerModels struct {
+	ModelNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (models SageMakerModels) ResourceName() string {
+	return "sagemaker-model"
+}
+
+// ResourceIdentifiers - The names of the SageMaker models
+func (models SageMakerModels) ResourceIdentifiers() []string {
+	return models.ModelNames
+}
+
+func (models SageMakerModels) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (models SageMakerModels) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSageMakerModels(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
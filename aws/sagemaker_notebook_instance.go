@@ -9,36 +9,82 @@ import (
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/sagemaker/sagemakeriface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
 	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
 )
 
 func getAllNotebookInstances(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
 	svc := sagemaker.New(session)
 
-	result, err := svc.ListNotebookInstances(&sagemaker.ListNotebookInstancesInput{})
+	var names []*string
+	err := svc.ListNotebookInstancesPages(&sagemaker.ListNotebookInstancesInput{}, func(page *sagemaker.ListNotebookInstancesOutput, lastPage bool) bool {
+		for _, notebook := range page.NotebookInstances {
+			if shouldIncludeNotebookInstance(notebook, excludeAfter, configObj) {
+				names = append(names, notebook.NotebookInstanceName)
+			}
+		}
+		return !lastPage
+	})
 	if err != nil {
 		return nil, errors.WithStackTrace(err)
 	}
 
-	var names []*string
+	return names, nil
+}
 
-	for _, notebook := range result.NotebookInstances {
-		if notebook.CreationTime == nil {
-			continue
-		}
-		if !excludeAfter.After(awsgo.TimeValue(notebook.CreationTime)) {
-			continue
+func shouldIncludeNotebookInstance(notebook *sagemaker.NotebookInstanceSummary, excludeAfter time.Time, configObj config.Config) bool {
+	if notebook == nil || notebook.CreationTime == nil {
+		return false
+	}
+	if !excludeAfter.After(awsgo.TimeValue(notebook.CreationTime)) {
+		return false
+	}
+	// Instances that are already being deleted will be gone shortly
+	if awsgo.StringValue(notebook.NotebookInstanceStatus) == sagemaker.NotebookInstanceStatusDeleting {
+		return false
+	}
+	return config.ShouldInclude(
+		awsgo.StringValue(notebook.NotebookInstanceName),
+		configObj.SageMakerNotebook.IncludeRule.NamesRegExp,
+		configObj.SageMakerNotebook.ExcludeRule.NamesRegExp,
+	)
+}
+
+// stopNotebookInstanceIfRunning stops the notebook instance and waits for it to be stopped, as only stopped or failed
+// instances can be deleted.
+func stopNotebookInstanceIfRunning(svc sagemakeriface.SageMakerAPI, name *string) error {
+	instance, err := svc.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: name,
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	input := &sagemaker.DescribeNotebookInstanceInput{NotebookInstanceName: name}
+
+	switch awsgo.StringValue(instance.NotebookInstanceStatus) {
+	case sagemaker.NotebookInstanceStatusStopped, sagemaker.NotebookInstanceStatusFailed:
+		return nil
+	case sagemaker.NotebookInstanceStatusPending, sagemaker.NotebookInstanceStatusUpdating:
+		// Instances can only be stopped once they are in service
+		if err := svc.WaitUntilNotebookInstanceInService(input); err != nil {
+			return errors.WithStackTrace(err)
 		}
-		if !config.ShouldInclude(awsgo.StringValue(notebook.NotebookInstanceName), configObj.S3.IncludeRule.NamesRegExp, configObj.S3.ExcludeRule.NamesRegExp) {
-			continue
+		fallthrough
+	case sagemaker.NotebookInstanceStatusInService:
+		_, err := svc.StopNotebookInstance(&sagemaker.StopNotebookInstanceInput{
+			NotebookInstanceName: name,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
 		}
-		names = append(names, notebook.NotebookInstanceName)
 	}
 
-	return names, nil
+	return errors.WithStackTrace(svc.WaitUntilNotebookInstanceStopped(input))
 }
 
 func nukeAllNotebookInstances(session *session.Session, names []*string) error {
@@ -51,83 +97,61 @@ func nukeAllNotebookInstances(session *session.Session, names []*string) error {
 
 	logging.Logger.Debugf("Deleting all Sagemaker Notebook Instances in region %s", *session.Config.Region)
 	deletedNames := []*string{}
+	var allErrs *multierror.Error
 
 	for _, name := range names {
-		params := &sagemaker.DeleteNotebookInstanceInput{
-			NotebookInstanceName: name,
+		err := stopNotebookInstanceIfRunning(svc, name)
+		if err == nil {
+			_, err = svc.DeleteNotebookInstance(&sagemaker.DeleteNotebookInstanceInput{
+				NotebookInstanceName: name,
+			})
 		}
 
-		_, err := svc.StopNotebookInstance(&sagemaker.StopNotebookInstanceInput{
-			NotebookInstanceName: name,
-		})
 		if err != nil {
 			logging.Logger.Errorf("[Failed] %s: %s", *name, err)
 			telemetry.TrackEvent(commonTelemetry.EventContext{
 				EventName: "Error Nuking Sagemaker Notebook Instance",
 			}, map[string]interface{}{
 				"region": *session.Config.Region,
-				"reason": "Failed to Stop Notebook",
+				"reason": "Failed to Delete Notebook",
 			})
+			report.Record(report.Entry{
+				Identifier:   aws.StringValue(name),
+				ResourceType: "SageMaker Notebook Instance",
+				Error:        err,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted Sagemaker Notebook Instance: %s", awsgo.StringValue(name))
 		}
+	}
 
-		err = svc.WaitUntilNotebookInstanceStopped(&sagemaker.DescribeNotebookInstanceInput{
+	for _, name := range deletedNames {
+		err := svc.WaitUntilNotebookInstanceDeleted(&sagemaker.DescribeNotebookInstanceInput{
 			NotebookInstanceName: name,
 		})
 
-		if err != nil {
-			logging.Logger.Errorf("[Failed] %s", err)
-			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Error Nuking Sagemaker Notebook Instance",
-			}, map[string]interface{}{
-				"region": *session.Config.Region,
-				"reason": "Failed waiting for notebook to stop",
-			})
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: "SageMaker Notebook Instance",
+			Error:        err,
 		}
-
-		_, err = svc.DeleteNotebookInstance(params)
+		report.Record(e)
 
 		if err != nil {
-			logging.Logger.Errorf("[Failed] %s: %s", *name, err)
+			logging.Logger.Errorf("[Failed] %s", err)
 			telemetry.TrackEvent(commonTelemetry.EventContext{
 				EventName: "Error Nuking Sagemaker Notebook Instance",
 			}, map[string]interface{}{
 				"region": *session.Config.Region,
-				"reason": "Failed to Delete Notebook",
+				"reason": "Failed waiting for notebook instance to delete",
 			})
-		} else {
-			deletedNames = append(deletedNames, name)
-			logging.Logger.Debugf("Deleted Sagemaker Notebook Instance: %s", awsgo.StringValue(name))
-		}
-	}
-
-	if len(deletedNames) > 0 {
-		for _, name := range deletedNames {
-
-			err := svc.WaitUntilNotebookInstanceDeleted(&sagemaker.DescribeNotebookInstanceInput{
-				NotebookInstanceName: name,
-			})
-
-			// Record status of this resource
-			e := report.Entry{
-				Identifier:   aws.StringValue(name),
-				ResourceType: "SageMaker Notebook Instance",
-				Error:        err,
-			}
-			report.Record(e)
-
-			if err != nil {
-				logging.Logger.Errorf("[Failed] %s", err)
-				telemetry.TrackEvent(commonTelemetry.EventContext{
-					EventName: "Error Nuking Sagemaker Notebook Instance",
-				}, map[string]interface{}{
-					"region": *session.Config.Region,
-					"reason": "Failed waiting for notebook instance to delete",
-				})
-				return errors.WithStackTrace(err)
-			}
+			allErrs = multierror.Append(allErrs, err)
 		}
 	}
 
 	logging.Logger.Debugf("[OK] %d Sagemaker Notebook Instance(s) deleted in %s", len(deletedNames), *session.Config.Region)
-	return nil
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
 }
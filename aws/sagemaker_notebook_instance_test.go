@@ -9,6 +9,7 @@ import (
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/sagemaker/sagemakeriface"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/util"
@@ -102,3 +103,45 @@ func TestNukeNotebookInstance(t *testing.T) {
 	assert.Contains(t, awsgo.StringValueSlice(instances), notebookName)
 
 }
+
+type mockedSageMakerNotebookInstance struct {
+	sagemakeriface.SageMakerAPI
+	Status  string
+	Stopped bool
+}
+
+func (m *mockedSageMakerNotebookInstance) DescribeNotebookInstance(input *sagemaker.DescribeNotebookInstanceInput) (*sagemaker.DescribeNotebookInstanceOutput, error) {
+	return &sagemaker.DescribeNotebookInstanceOutput{NotebookInstanceStatus: awsgo.String(m.Status)}, nil
+}
+
+func (m *mockedSageMakerNotebookInstance) StopNotebookInstance(input *sagemaker.StopNotebookInstanceInput) (*sagemaker.StopNotebookInstanceOutput, error) {
+	m.Stopped = true
+	return &sagemaker.StopNotebookInstanceOutput{}, nil
+}
+
+func (m *mockedSageMakerNotebookInstance) WaitUntilNotebookInstanceStopped(input *sagemaker.DescribeNotebookInstanceInput) error {
+	return nil
+}
+
+func TestStopNotebookInstanceIfRunning(t *testing.T) {
+	t.Parallel()
+
+	stopped := &mockedSageMakerNotebookInstance{Status: sagemaker.NotebookInstanceStatusStopped}
+	require.NoError(t, stopNotebookInstanceIfRunning(stopped, awsgo.String("cloud-nuke-test")))
+	assert.False(t, stopped.Stopped)
+
+	running := &mockedSageMakerNotebookInstance{Status: sagemaker.NotebookInstanceStatusInService}
+	require.NoError(t, stopNotebookInstanceIfRunning(running, awsgo.String("cloud-nuke-test")))
+	assert.True(t, running.Stopped)
+}
+
+func TestShouldIncludeNotebookInstance(t *testing.T) {
+	now := time.Now()
+	notebook := func(status string) *sagemaker.NotebookInstanceSummary {
+		return &sagemaker.NotebookInstanceSummary{NotebookInstanceName: awsgo.String("cloud-nuke-test"), NotebookInstanceStatus: awsgo.String(status), CreationTime: awsgo.Time(now)}
+	}
+
+	assert.True(t, shouldIncludeNotebookInstance(notebook(sagemaker.NotebookInstanceStatusInService), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeNotebookInstance(notebook(sagemaker.NotebookInstanceStatusDeleting), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeNotebookInstance(notebook(sagemaker.NotebookInstanceStatusInService), now.Add(-1*time.Hour), config.Config{}))
+}
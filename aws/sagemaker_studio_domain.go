@@ -0,0 +1,285 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/sagemaker/sagemakeriface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of SageMaker Studio domain IDs
+func getAllSageMakerStudioDomains(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := sagemaker.New(session)
+
+	var domainIds []*string
+	err := svc.ListDomainsPages(&sagemaker.ListDomainsInput{}, func(page *sagemaker.ListDomainsOutput, lastPage bool) bool {
+		for _, domain := range page.Domains {
+			if shouldIncludeSageMakerStudioDomain(domain, excludeAfter, configObj) {
+				domainIds = append(domainIds, domain.DomainId)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return domainIds, nil
+}
+
+func shouldIncludeSageMakerStudioDomain(domain *sagemaker.DomainDetails, excludeAfter time.Time, configObj config.Config) bool {
+	if domain == nil {
+		return false
+	}
+
+	// Domains that are already being deleted will be gone shortly
+	if aws.StringValue(domain.Status) == sagemaker.DomainStatusDeleting {
+		return false
+	}
+
+	if domain.CreationTime != nil && excludeAfter.Before(*domain.CreationTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(domain.DomainName),
+		configObj.SageMakerStudioDomain.IncludeRule.NamesRegExp,
+		configObj.SageMakerStudioDomain.ExcludeRule.NamesRegExp,
+	)
+}
+
+func isSageMakerNotFoundErr(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == sagemaker.ErrCodeResourceNotFound
+}
+
+// waitForSageMakerStudioResourceDeleted polls isDeleted until it reports the resource as gone.
+func waitForSageMakerStudioResourceDeleted(description string, isDeleted func() (bool, error)) error {
+	for i := 0; i < 90; i++ {
+		deleted, err := isDeleted()
+		if err != nil {
+			return err
+		}
+		if deleted {
+			return nil
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for SageMaker Studio %s to be deleted...", description)
+	}
+
+	return SageMakerStudioDeleteTimeoutError{description: description}
+}
+
+// deleteSageMakerStudioApps deletes the apps of all the user profiles and spaces of the domain and waits for them to
+// be deleted. Deleted apps keep being listed for a while with the Deleted status, so those are skipped.
+func deleteSageMakerStudioApps(svc sagemakeriface.SageMakerAPI, domainId *string) error {
+	var apps []*sagemaker.AppDetails
+	err := svc.ListAppsPages(&sagemaker.ListAppsInput{DomainIdEquals: domainId}, func(page *sagemaker.ListAppsOutput, lastPage bool) bool {
+		for _, app := range page.Apps {
+			if aws.StringValue(app.Status) != sagemaker.AppStatusDeleted {
+				apps = append(apps, app)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, app := range apps {
+		if aws.StringValue(app.Status) == sagemaker.AppStatusDeleting {
+			continue
+		}
+		_, err := svc.DeleteApp(&sagemaker.DeleteAppInput{
+			DomainId:        domainId,
+			AppName:         app.AppName,
+			AppType:         app.AppType,
+			UserProfileName: app.UserProfileName,
+			SpaceName:       app.SpaceName,
+		})
+		if err != nil && !isSageMakerNotFoundErr(err) {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	for _, app := range apps {
+		app := app
+		err := waitForSageMakerStudioResourceDeleted("app "+aws.StringValue(app.AppName), func() (bool, error) {
+			result, err := svc.DescribeApp(&sagemaker.DescribeAppInput{
+				DomainId:        domainId,
+				AppName:         app.AppName,
+				AppType:         app.AppType,
+				UserProfileName: app.UserProfileName,
+				SpaceName:       app.SpaceName,
+			})
+			if isSageMakerNotFoundErr(err) {
+				return true, nil
+			}
+			if err != nil {
+				return false, errors.WithStackTrace(err)
+			}
+			return aws.StringValue(result.Status) == sagemaker.AppStatusDeleted, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteSageMakerStudioSpaces deletes the shared spaces of the domain and waits for them to be deleted.
+func deleteSageMakerStudioSpaces(svc sagemakeriface.SageMakerAPI, domainId *string) error {
+	var spaceNames []*string
+	err := svc.ListSpacesPages(&sagemaker.ListSpacesInput{DomainIdEquals: domainId}, func(page *sagemaker.ListSpacesOutput, lastPage bool) bool {
+		for _, space := range page.Spaces {
+			spaceNames = append(spaceNames, space.SpaceName)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, spaceName := range spaceNames {
+		_, err := svc.DeleteSpace(&sagemaker.DeleteSpaceInput{DomainId: domainId, SpaceName: spaceName})
+		if err != nil && !isSageMakerNotFoundErr(err) {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	for _, spaceName := range spaceNames {
+		spaceName := spaceName
+		err := waitForSageMakerStudioResourceDeleted("space "+aws.StringValue(spaceName), func() (bool, error) {
+			_, err := svc.DescribeSpace(&sagemaker.DescribeSpaceInput{DomainId: domainId, SpaceName: spaceName})
+			if isSageMakerNotFoundErr(err) {
+				return true, nil
+			}
+			return false, errors.WithStackTrace(err)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteSageMakerStudioUserProfiles deletes the user profiles of the domain and waits for them to be deleted.
+func deleteSageMakerStudioUserProfiles(svc sagemakeriface.SageMakerAPI, domainId *string) error {
+	var userProfileNames []*string
+	err := svc.ListUserProfilesPages(&sagemaker.ListUserProfilesInput{DomainIdEquals: domainId}, func(page *sagemaker.ListUserProfilesOutput, lastPage bool) bool {
+		for _, userProfile := range page.UserProfiles {
+			userProfileNames = append(userProfileNames, userProfile.UserProfileName)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, userProfileName := range userProfileNames {
+		_, err := svc.DeleteUserProfile(&sagemaker.DeleteUserProfileInput{DomainId: domainId, UserProfileName: userProfileName})
+		if err != nil && !isSageMakerNotFoundErr(err) {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	for _, userProfileName := range userProfileNames {
+		userProfileName := userProfileName
+		err := waitForSageMakerStudioResourceDeleted("user profile "+aws.StringValue(userProfileName), func() (bool, error) {
+			_, err := svc.DescribeUserProfile(&sagemaker.DescribeUserProfileInput{DomainId: domainId, UserProfileName: userProfileName})
+			if isSageMakerNotFoundErr(err) {
+				return true, nil
+			}
+			return false, errors.WithStackTrace(err)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nukeSageMakerStudioDomain tears down the domain in the only order AWS allows: apps, then spaces and user profiles,
+// then the domain itself along with its home EFS file system.
+func nukeSageMakerStudioDomain(svc sagemakeriface.SageMakerAPI, domainId *string) error {
+	if err := deleteSageMakerStudioApps(svc, domainId); err != nil {
+		return err
+	}
+	if err := deleteSageMakerStudioSpaces(svc, domainId); err != nil {
+		return err
+	}
+	if err := deleteSageMakerStudioUserProfiles(svc, domainId); err != nil {
+		return err
+	}
+
+	_, err := svc.DeleteDomain(&sagemaker.DeleteDomainInput{
+		DomainId: domainId,
+		RetentionPolicy: &sagemaker.RetentionPolicy{
+			HomeEfsFileSystem: aws.String(sagemaker.RetentionTypeDelete),
+		},
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return waitForSageMakerStudioResourceDeleted("domain "+aws.StringValue(domainId), func() (bool, error) {
+		_, err := svc.DescribeDomain(&sagemaker.DescribeDomainInput{DomainId: domainId})
+		if isSageMakerNotFoundErr(err) {
+			return true, nil
+		}
+		return false, errors.WithStackTrace(err)
+	})
+}
+
+// Deletes all SageMaker Studio domains
+func nukeAllSageMakerStudioDomains(session *session.Session, domainIds []*string) error {
+	svc := sagemaker.New(session)
+
+	if len(domainIds) == 0 {
+		logging.Logger.Debugf("No SageMaker Studio domains to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all SageMaker Studio domains in region %s", *session.Config.Region)
+	var deletedDomainIds []*string
+	var allErrs *multierror.Error
+
+	for _, domainId := range domainIds {
+		err := nukeSageMakerStudioDomain(svc, domainId)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(domainId),
+			ResourceType: "SageMaker Studio Domain",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking SageMaker Studio Domain",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedDomainIds = append(deletedDomainIds, domainId)
+			logging.Logger.Debugf("Deleted SageMaker Studio domain: %s", aws.StringValue(domainId))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d SageMaker Studio domain(s) deleted in %s", len(deletedDomainIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
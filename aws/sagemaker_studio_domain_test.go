@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/sagemaker/sagemakeriface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedSageMakerStudioDomain records the order of delete calls; everything it deletes is gone right away
+type mockedSageMakerStudioDomain struct {
+	sagemakeriface.SageMakerAPI
+	Calls []string
+}
+
+func (m *mockedSageMakerStudioDomain) notFound() error {
+	return awserr.New(sagemaker.ErrCodeResourceNotFound, "not found", nil)
+}
+
+func (m *mockedSageMakerStudioDomain) ListAppsPages(input *sagemaker.ListAppsInput, fn func(*sagemaker.ListAppsOutput, bool) bool) error {
+	fn(&sagemaker.ListAppsOutput{Apps: []*sagemaker.AppDetails{
+		{AppName: aws.String("default"), AppType: aws.String(sagemaker.AppTypeJupyterServer), UserProfileName: aws.String("user"), Status: aws.String(sagemaker.AppStatusInService)},
+		{AppName: aws.String("old"), AppType: aws.String(sagemaker.AppTypeKernelGateway), UserProfileName: aws.String("user"), Status: aws.String(sagemaker.AppStatusDeleted)},
+	}}, true)
+	return nil
+}
+
+func (m *mockedSageMakerStudioDomain) DeleteApp(input *sagemaker.DeleteAppInput) (*sagemaker.DeleteAppOutput, error) {
+	m.Calls = append(m.Calls, "app:"+aws.StringValue(input.AppName))
+	return &sagemaker.DeleteAppOutput{}, nil
+}
+
+func (m *mockedSageMakerStudioDomain) DescribeApp(input *sagemaker.DescribeAppInput) (*sagemaker.DescribeAppOutput, error) {
+	return &sagemaker.DescribeAppOutput{Status: aws.String(sagemaker.AppStatusDeleted)}, nil
+}
+
+func (m *mockedSageMakerStudioDomain) ListSpacesPages(input *sagemaker.ListSpacesInput, fn func(*sagemaker.ListSpacesOutput, bool) bool) error {
+	fn(&sagemaker.ListSpacesOutput{Spaces: []*sagemaker.SpaceDetails{{SpaceName: aws.String("shared")}}}, true)
+	return nil
+}
+
+func (m *mockedSageMakerStudioDomain) DeleteSpace(input *sagemaker.DeleteSpaceInput) (*sagemaker.DeleteSpaceOutput, error) {
+	m.Calls = append(m.Calls, "space:"+aws.StringValue(input.SpaceName))
+	return &sagemaker.DeleteSpaceOutput{}, nil
+}
+
+func (m *mockedSageMakerStudioDomain) DescribeSpace(input *sagemaker.DescribeSpaceInput) (*sagemaker.DescribeSpaceOutput, error) {
+	return nil, m.notFound()
+}
+
+func (m *mockedSageMakerStudioDomain) ListUserProfilesPages(input *sagemaker.ListUserProfilesInput, fn func(*sagemaker.ListUserProfilesOutput, bool) bool) error {
+	fn(&sagemaker.ListUserProfilesOutput{UserProfiles: []*sagemaker.UserProfileDetails{{UserProfileName: aws.String("user")}}}, true)
+	return nil
+}
+
+func (m *mockedSageMakerStudioDomain) DeleteUserProfile(input *sagemaker.DeleteUserProfileInput) (*sagemaker.DeleteUserProfileOutput, error) {
+	m.Calls = append(m.Calls, "user-profile:"+aws.StringValue(input.UserProfileName))
+	return &sagemaker.DeleteUserProfileOutput{}, nil
+}
+
+func (m *mockedSageMakerStudioDomain) DescribeUserProfile(input *sagemaker.DescribeUserProfileInput) (*sagemaker.DescribeUserProfileOutput, error) {
+	return nil, m.notFound()
+}
+
+func (m *mockedSageMakerStudioDomain) DeleteDomain(input *sagemaker.DeleteDomainInput) (*sagemaker.DeleteDomainOutput, error) {
+	m.Calls = append(m.Calls, "domain:"+aws.StringValue(input.DomainId))
+	return &sagemaker.DeleteDomainOutput{}, nil
+}
+
+func (m *mockedSageMakerStudioDomain) DescribeDomain(input *sagemaker.DescribeDomainInput) (*sagemaker.DescribeDomainOutput, error) {
+	return nil, m.notFound()
+}
+
+func TestNukeSageMakerStudioDomainDeletesInOrder(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedSageMakerStudioDomain{}
+	require.NoError(t, nukeSageMakerStudioDomain(mock, aws.String("d-cloudnuketest")))
+	assert.Equal(t, []string{"app:default", "space:shared", "user-profile:user", "domain:d-cloudnuketest"}, mock.Calls)
+}
+
+func TestShouldIncludeSageMakerStudioDomain(t *testing.T) {
+	now := time.Now()
+	domain := func(status string) *sagemaker.DomainDetails {
+		return &sagemaker.DomainDetails{DomainId: aws.String("d-cloudnuketest"), DomainName: aws.String("cloud-nuke-test"), Status: aws.String(status), CreationTime: aws.Time(now)}
+	}
+
+	assert.True(t, shouldIncludeSageMakerStudioDomain(domain(sagemaker.DomainStatusInService), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSageMakerStudioDomain(domain(sagemaker.DomainStatusDeleting), now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSageMakerStudioDomain(domain(sagemaker.DomainStatusInService), now.Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSageMakerStudioDomain(nil, now, config.Config{}))
+}
@@ -0,0 +1,44 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// SageMakerStudioDomains - represents all SageMaker Studio domains
+type SageMakerStudioDomains struct {
+	DomainIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (domains SageMakerStudioDomains) ResourceName() string {
+	return "sagemaker-studio-domain"
+}
+
+// ResourceIdentifiers - The IDs of the SageMaker Studio domains
+func (domains SageMakerStudioDomains) ResourceIdentifiers() []string {
+	return domains.DomainIds
+}
+
+func (domains SageMakerStudioDomains) MaxBatchSize() int {
+	// Deleting a domain waits on each of its apps, spaces and user profiles, so keep batches small
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (domains SageMakerStudioDomains) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSageMakerStudioDomains(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type SageMakerStudioDeleteTimeoutError struct {
+	description string
+}
+
+func (e SageMakerStudioDeleteTimeoutError) Error() string {
+	return "Timed out waiting for SageMaker Studio " + e.description + " to be deleted"
+}
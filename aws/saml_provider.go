@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// getAllSAMLProviders will list all the SAML Providers in an account, filtering out those that do not match the
+// requested rules (older-than, config file settings and the exclude tag).
+func getAllSAMLProviders(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := iam.New(session)
+
+	output, err := svc.ListSAMLProviders(&iam.ListSAMLProvidersInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	providerARNs := []*string{}
+	for _, provider := range output.SAMLProviderList {
+		if !shouldIncludeSAMLProvider(provider, excludeAfter, configObj) {
+			continue
+		}
+
+		// ListSAMLProviders doesn't return the tags of the providers
+		var tags []*iam.Tag
+		input := &iam.ListSAMLProviderTagsInput{SAMLProviderArn: provider.Arn}
+		for {
+			tagsOutput, err := svc.ListSAMLProviderTags(input)
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			tags = append(tags, tagsOutput.Tags...)
+
+			if !aws.BoolValue(tagsOutput.IsTruncated) {
+				break
+			}
+			input.Marker = tagsOutput.Marker
+		}
+
+		if !hasIAMExcludeTag(tags) {
+			providerARNs = append(providerARNs, provider.Arn)
+		}
+	}
+	return providerARNs, nil
+}
+
+// getSAMLProviderName returns the name of the SAML Provider, which is the last part of its ARN
+// (arn:aws:iam::<account>:saml-provider/<name>).
+func getSAMLProviderName(providerARN string) string {
+	return providerARN[strings.LastIndex(providerARN, "/")+1:]
+}
+
+func shouldIncludeSAMLProvider(provider *iam.SAMLProviderListEntry, excludeAfter time.Time, configObj config.Config) bool {
+	if provider == nil {
+		return false
+	}
+
+	if excludeAfter.Before(aws.TimeValue(provider.CreateDate)) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		getSAMLProviderName(aws.StringValue(provider.Arn)),
+		configObj.SAMLProvider.IncludeRule.NamesRegExp,
+		configObj.SAMLProvider.ExcludeRule.NamesRegExp,
+	)
+}
+
+// nukeAllSAMLProviders will delete all the given SAML Providers
+func nukeAllSAMLProviders(session *session.Session, identifiers []*string) error {
+	svc := iam.New(session)
+
+	if len(identifiers) == 0 {
+		logging.Logger.Debugf("No SAML Providers to nuke")
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting SAML Providers")
+	var deletedARNs []*string
+	var allErrs *multierror.Error
+
+	for _, providerARN := range identifiers {
+		_, err := svc.DeleteSAMLProvider(&iam.DeleteSAMLProviderInput{SAMLProviderArn: providerARN})
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(providerARN),
+			ResourceType: "SAML Provider",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking SAML Provider",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedARNs = append(deletedARNs, providerARN)
+			logging.Logger.Debugf("[OK] SAML Provider %s was deleted", aws.StringValue(providerARN))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d SAML Provider(s) deleted", len(deletedARNs))
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
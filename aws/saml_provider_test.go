@@ -0,0 +1,30 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldIncludeSAMLProvider(t *testing.T) {
+	provider := &iam.SAMLProviderListEntry{
+		Arn:        aws.String("arn:aws:iam::123456789012:saml-provider/cloud-nuke-test"),
+		CreateDate: aws.Time(time.Now()),
+	}
+	excludeConfig := config.Config{
+		SAMLProvider: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^cloud-nuke-")}},
+			},
+		},
+	}
+
+	assert.True(t, shouldIncludeSAMLProvider(provider, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSAMLProvider(provider, time.Now().Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSAMLProvider(provider, time.Now().Add(1*time.Hour), excludeConfig))
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// SAMLProviders - represents all AWS SAML providers that should be deleted.
+type SAMLProviders struct {
+	ProviderARNs []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (samlprovider SAMLProviders) ResourceName() string {
+	return "samlprovider"
+}
+
+// ResourceIdentifiers - The ARNs of the SAML providers.
+func (samlprovider SAMLProviders) ResourceIdentifiers() []string {
+	return samlprovider.ProviderARNs
+}
+
+func (samlprovider SAMLProviders) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (samlprovider SAMLProviders) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSAMLProviders(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 	multierror "github.com/hashicorp/go-multierror"
 
 	"github.com/gruntwork-io/cloud-nuke/config"
@@ -53,6 +54,12 @@ func shouldIncludeSecret(secret *secretsmanager.SecretListEntry, excludeAfter ti
 		return false
 	}
 
+	for _, tag := range secret.Tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return false
+		}
+	}
+
 	return config.ShouldInclude(
 		aws.StringValue(secret.Name),
 		configObj.SecretsManagerSecrets.IncludeRule.NamesRegExp,
@@ -60,7 +67,9 @@ func shouldIncludeSecret(secret *secretsmanager.SecretListEntry, excludeAfter ti
 	)
 }
 
-func nukeAllSecretsManagerSecrets(session *session.Session, identifiers []*string) error {
+// nukeAllSecretsManagerSecrets deletes the given secrets. Secrets are deleted immediately without the possibility of
+// recovery, unless a recovery window is given, in which case they are scheduled for deletion after that many days.
+func nukeAllSecretsManagerSecrets(session *session.Session, identifiers []*string, recoveryWindowInDays int64) error {
 	region := aws.StringValue(session.Config.Region)
 
 	svc := secretsmanager.New(session)
@@ -77,7 +86,7 @@ func nukeAllSecretsManagerSecrets(session *session.Session, identifiers []*strin
 	errChans := make([]chan error, len(identifiers))
 	for i, secretID := range identifiers {
 		errChans[i] = make(chan error, 1)
-		go deleteSecretAsync(wg, errChans[i], svc, secretID)
+		go deleteSecretAsync(wg, errChans[i], svc, secretID, recoveryWindowInDays)
 	}
 	wg.Wait()
 
@@ -99,14 +108,32 @@ func nukeAllSecretsManagerSecrets(session *session.Session, identifiers []*strin
 
 // deleteSecretAsync deletes the provided secrets manager secret. Intended to be run in a goroutine, using wait groups
 // and a return channel for errors.
-func deleteSecretAsync(wg *sync.WaitGroup, errChan chan error, svc *secretsmanager.SecretsManager, secretID *string) {
+func deleteSecretAsync(wg *sync.WaitGroup, errChan chan error, svc *secretsmanager.SecretsManager, secretID *string, recoveryWindowInDays int64) {
 	defer wg.Done()
 
+	err := deleteSecret(svc, secretID, recoveryWindowInDays)
+
+	// Record status of this resource
+	e := report.Entry{
+		Identifier:   aws.StringValue(secretID),
+		ResourceType: "Secrets Manager Secret",
+		Error:        err,
+	}
+	report.Record(e)
+
+	errChan <- err
+}
+
+// deleteSecret removes the replicas of the secret, if any, and then deletes or schedules the deletion of the secret.
+func deleteSecret(svc secretsmanageriface.SecretsManagerAPI, secretID *string, recoveryWindowInDays int64) error {
 	// If this region's secret is primary, and it has replicated secrets, remove replication first.
 	// Get replications
 	secret, err := svc.DescribeSecret(&secretsmanager.DescribeSecretInput{
 		SecretId: secretID,
 	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
 
 	// Delete replications
 	if len(secret.ReplicationStatus) > 0 {
@@ -121,21 +148,27 @@ func deleteSecretAsync(wg *sync.WaitGroup, errChan chan error, svc *secretsmanag
 			SecretId:             secretID,
 			RemoveReplicaRegions: replicationRegion,
 		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
 	}
 
-	input := &secretsmanager.DeleteSecretInput{
-		ForceDeleteWithoutRecovery: aws.Bool(true),
-		SecretId:                   secretID,
-	}
-	_, err = svc.DeleteSecret(input)
+	_, err = svc.DeleteSecret(getSecretsManagerDeleteSecretInput(secretID, recoveryWindowInDays))
+	return errors.WithStackTrace(err)
+}
 
-	// Record status of this resource
-	e := report.Entry{
-		Identifier:   aws.StringValue(secretID),
-		ResourceType: "Secrets Manager Secret",
-		Error:        err,
+// getSecretsManagerDeleteSecretInput returns the input to force delete the secret, or, when a recovery window is
+// given, to schedule its deletion at the end of the window.
+func getSecretsManagerDeleteSecretInput(secretID *string, recoveryWindowInDays int64) *secretsmanager.DeleteSecretInput {
+	if recoveryWindowInDays > 0 {
+		return &secretsmanager.DeleteSecretInput{
+			RecoveryWindowInDays: aws.Int64(recoveryWindowInDays),
+			SecretId:             secretID,
+		}
 	}
-	report.Record(e)
 
-	errChan <- err
+	return &secretsmanager.DeleteSecretInput{
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+		SecretId:                   secretID,
+	}
 }
@@ -90,7 +90,7 @@ func TestNukeSecretOne(t *testing.T) {
 
 	require.NoError(
 		t,
-		nukeAllSecretsManagerSecrets(session, aws.StringSlice([]string{arn})),
+		nukeAllSecretsManagerSecrets(session, aws.StringSlice([]string{arn}), 0),
 	)
 
 	// Make sure the secret is deleted.
@@ -119,7 +119,7 @@ func TestNukeSecretMoreThanOne(t *testing.T) {
 
 	require.NoError(
 		t,
-		nukeAllSecretsManagerSecrets(session, aws.StringSlice(secretArns)),
+		nukeAllSecretsManagerSecrets(session, aws.StringSlice(secretArns), 0),
 	)
 
 	// Make sure the secret is deleted.
@@ -145,7 +145,7 @@ func TestNukeSecretReplica(t *testing.T) {
 
 	require.NoError(
 		t,
-		nukeAllSecretsManagerSecrets(session, aws.StringSlice([]string{arn})),
+		nukeAllSecretsManagerSecrets(session, aws.StringSlice([]string{arn}), 0),
 	)
 
 	// Make sure the secret is deleted.
@@ -231,3 +231,26 @@ func createSecretStringReplicaWithDefaultKey(t *testing.T, awsRegion string, nam
 	require.NoError(t, err)
 	return arn
 }
+
+func TestShouldIncludeSecretHonoursExcludeTag(t *testing.T) {
+	secret := &secretsmanager.SecretListEntry{
+		Name:        aws.String("cloud-nuke-test"),
+		CreatedDate: aws.Time(time.Now().Add(-1 * time.Hour)),
+	}
+	assert.True(t, shouldIncludeSecret(secret, time.Now(), config.Config{}))
+
+	secret.Tags = []*secretsmanager.Tag{{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}}
+	assert.False(t, shouldIncludeSecret(secret, time.Now(), config.Config{}))
+}
+
+func TestGetSecretsManagerDeleteSecretInput(t *testing.T) {
+	secretID := aws.String("cloud-nuke-test")
+
+	forceInput := getSecretsManagerDeleteSecretInput(secretID, 0)
+	assert.True(t, aws.BoolValue(forceInput.ForceDeleteWithoutRecovery))
+	assert.Nil(t, forceInput.RecoveryWindowInDays)
+
+	scheduledInput := getSecretsManagerDeleteSecretInput(secretID, 7)
+	assert.Nil(t, scheduledInput.ForceDeleteWithoutRecovery)
+	assert.Equal(t, int64(7), aws.Int64Value(scheduledInput.RecoveryWindowInDays))
+}
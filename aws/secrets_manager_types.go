@@ -9,6 +9,8 @@ import (
 // SecretsManagerSecrets - represents all AWS secrets manager secrets that should be deleted.
 type SecretsManagerSecrets struct {
 	SecretIDs []string
+	// RecoveryWindowInDays schedules the deletion of the secrets instead of deleting them immediately
+	RecoveryWindowInDays int64
 }
 
 // ResourceName - the simple name of the aws resource
@@ -30,7 +32,7 @@ func (secret SecretsManagerSecrets) MaxBatchSize() int {
 
 // Nuke - nuke 'em all!!!
 func (secret SecretsManagerSecrets) Nuke(session *session.Session, identifiers []string) error {
-	if err := nukeAllSecretsManagerSecrets(session, awsgo.StringSlice(identifiers)); err != nil {
+	if err := nukeAllSecretsManagerSecrets(session, awsgo.StringSlice(identifiers), secret.RecoveryWindowInDays); err != nil {
 		return errors.WithStackTrace(err)
 	}
 
@@ -0,0 +1,185 @@
+package aws
+
+import (
+	goerror "errors"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/securityhub/securityhubiface"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// BatchDisableStandards accepts at most this many standards subscriptions per call
+const securityHubStandardsBatchLimit = 25
+
+// DisassociateMembers and DeleteMembers accept at most this many account IDs per call
+const securityHubMembersBatchLimit = 50
+
+// getAllSecurityHubs returns the ARN of the hub if Security Hub was enabled in the region before excludeAfter.
+// Security Hub doesn't have a name or tags that could be used to implement the configObj pattern.
+func getAllSecurityHubs(session *session.Session, excludeAfter time.Time) ([]string, error) {
+	svc := securityhub.New(session)
+
+	output, err := svc.DescribeHub(&securityhub.DescribeHubInput{})
+	if err != nil {
+		// AWS returns an InvalidAccessException when the account isn't subscribed to Security Hub in the region
+		var iae *securityhub.InvalidAccessException
+		if goerror.As(err, &iae) {
+			logging.Logger.Debugf("Security Hub InvalidAccessException means security hub is not enabled in region, so skipping")
+			return nil, nil
+		}
+		return nil, errors.WithStackTrace(err)
+	}
+
+	if !shouldIncludeSecurityHub(output, excludeAfter) {
+		return nil, nil
+	}
+	return []string{aws.StringValue(output.HubArn)}, nil
+}
+
+func shouldIncludeSecurityHub(hub *securityhub.DescribeHubOutput, excludeAfter time.Time) bool {
+	if hub == nil || hub.HubArn == nil {
+		return false
+	}
+
+	if hub.SubscribedAt != nil {
+		subscribedAt, err := time.Parse(time.RFC3339, aws.StringValue(hub.SubscribedAt))
+		if err != nil {
+			logging.Logger.Debugf("Could not parse the Security Hub subscription date %s: %s", aws.StringValue(hub.SubscribedAt), err)
+			return false
+		}
+		if excludeAfter.Before(subscribedAt) {
+			return false
+		}
+	}
+	return true
+}
+
+// disableSecurityHubStandards disables all the standards the hub is subscribed to, along with their controls
+func disableSecurityHubStandards(svc securityhubiface.SecurityHubAPI) error {
+	var subscriptionArns []string
+	err := svc.GetEnabledStandardsPages(&securityhub.GetEnabledStandardsInput{}, func(page *securityhub.GetEnabledStandardsOutput, lastPage bool) bool {
+		for _, subscription := range page.StandardsSubscriptions {
+			// Standards that are being disabled already will be gone shortly
+			if aws.StringValue(subscription.StandardsStatus) != securityhub.StandardsStatusDeleting {
+				subscriptionArns = append(subscriptionArns, aws.StringValue(subscription.StandardsSubscriptionArn))
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, batch := range split(subscriptionArns, securityHubStandardsBatchLimit) {
+		_, err := svc.BatchDisableStandards(&securityhub.BatchDisableStandardsInput{
+			StandardsSubscriptionArns: aws.StringSlice(batch),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Disabled %d Security Hub standard(s)", len(batch))
+	}
+	return nil
+}
+
+// removeSecurityHubMembers disassociates and deletes the member accounts of the hub, and disassociates the hub from
+// its own administrator account, as Security Hub can't be disabled while either relationship exists.
+func removeSecurityHubMembers(svc securityhubiface.SecurityHubAPI) error {
+	var accountIds []string
+	input := &securityhub.ListMembersInput{
+		// Also list the members that were invited but never accepted
+		OnlyAssociated: aws.Bool(false),
+	}
+	err := svc.ListMembersPages(input, func(page *securityhub.ListMembersOutput, lastPage bool) bool {
+		for _, member := range page.Members {
+			accountIds = append(accountIds, aws.StringValue(member.AccountId))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, batch := range split(accountIds, securityHubMembersBatchLimit) {
+		_, err := svc.DisassociateMembers(&securityhub.DisassociateMembersInput{AccountIds: aws.StringSlice(batch)})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		_, err = svc.DeleteMembers(&securityhub.DeleteMembersInput{AccountIds: aws.StringSlice(batch)})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Removed %d member account(s) from Security Hub", len(batch))
+	}
+
+	administrator, err := svc.GetAdministratorAccount(&securityhub.GetAdministratorAccountInput{})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if administrator.Administrator != nil && administrator.Administrator.AccountId != nil {
+		_, err := svc.DisassociateFromAdministratorAccount(&securityhub.DisassociateFromAdministratorAccountInput{})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Disassociated Security Hub from administrator account %s", aws.StringValue(administrator.Administrator.AccountId))
+	}
+	return nil
+}
+
+// disableSecurityHub disables the standards, removes the members and finally disables Security Hub itself
+func disableSecurityHub(svc securityhubiface.SecurityHubAPI) error {
+	if err := disableSecurityHubStandards(svc); err != nil {
+		return err
+	}
+	if err := removeSecurityHubMembers(svc); err != nil {
+		return err
+	}
+
+	_, err := svc.DisableSecurityHub(&securityhub.DisableSecurityHubInput{})
+	return errors.WithStackTrace(err)
+}
+
+// Disables Security Hub in the region
+func nukeAllSecurityHubs(session *session.Session, hubArns []string) error {
+	svc := securityhub.New(session)
+
+	if len(hubArns) == 0 {
+		logging.Logger.Debugf("No Security Hub to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	for _, hubArn := range hubArns {
+		err := disableSecurityHub(svc)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   hubArn,
+			ResourceType: "Security Hub",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Security Hub",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			return errors.WithStackTrace(err)
+		}
+
+		logging.Logger.Debugf("[OK] Security Hub %s disabled in %s", hubArn, *session.Config.Region)
+	}
+
+	return nil
+}
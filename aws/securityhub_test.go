@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/securityhub/securityhubiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedSecurityHub struct {
+	securityhubiface.SecurityHubAPI
+	Calls []string
+}
+
+func (m *mockedSecurityHub) GetEnabledStandardsPages(input *securityhub.GetEnabledStandardsInput, fn func(*securityhub.GetEnabledStandardsOutput, bool) bool) error {
+	fn(&securityhub.GetEnabledStandardsOutput{StandardsSubscriptions: []*securityhub.StandardsSubscription{
+		{StandardsSubscriptionArn: aws.String("cis"), StandardsStatus: aws.String(securityhub.StandardsStatusReady)},
+		{StandardsSubscriptionArn: aws.String("pci"), StandardsStatus: aws.String(securityhub.StandardsStatusDeleting)},
+	}}, true)
+	return nil
+}
+
+func (m *mockedSecurityHub) BatchDisableStandards(input *securityhub.BatchDisableStandardsInput) (*securityhub.BatchDisableStandardsOutput, error) {
+	m.Calls = append(m.Calls, "BatchDisableStandards:"+strings.Join(aws.StringValueSlice(input.StandardsSubscriptionArns), ","))
+	return &securityhub.BatchDisableStandardsOutput{}, nil
+}
+
+func (m *mockedSecurityHub) ListMembersPages(input *securityhub.ListMembersInput, fn func(*securityhub.ListMembersOutput, bool) bool) error {
+	fn(&securityhub.ListMembersOutput{Members: []*securityhub.Member{{AccountId: aws.String("111111111111")}}}, true)
+	return nil
+}
+
+func (m *mockedSecurityHub) DisassociateMembers(input *securityhub.DisassociateMembersInput) (*securityhub.DisassociateMembersOutput, error) {
+	m.Calls = append(m.Calls, "DisassociateMembers:"+strings.Join(aws.StringValueSlice(input.AccountIds), ","))
+	return &securityhub.DisassociateMembersOutput{}, nil
+}
+
+func (m *mockedSecurityHub) DeleteMembers(input *securityhub.DeleteMembersInput) (*securityhub.DeleteMembersOutput, error) {
+	m.Calls = append(m.Calls, "DeleteMembers:"+strings.Join(aws.StringValueSlice(input.AccountIds), ","))
+	return &securityhub.DeleteMembersOutput{}, nil
+}
+
+func (m *mockedSecurityHub) GetAdministratorAccount(input *securityhub.GetAdministratorAccountInput) (*securityhub.GetAdministratorAccountOutput, error) {
+	return &securityhub.GetAdministratorAccountOutput{Administrator: &securityhub.Invitation{AccountId: aws.String("222222222222")}}, nil
+}
+
+func (m *mockedSecurityHub) DisassociateFromAdministratorAccount(input *securityhub.DisassociateFromAdministratorAccountInput) (*securityhub.DisassociateFromAdministratorAccountOutput, error) {
+	m.Calls = append(m.Calls, "DisassociateFromAdministratorAccount")
+	return &securityhub.DisassociateFromAdministratorAccountOutput{}, nil
+}
+
+func (m *mockedSecurityHub) DisableSecurityHub(input *securityhub.DisableSecurityHubInput) (*securityhub.DisableSecurityHubOutput, error) {
+	m.Calls = append(m.Calls, "DisableSecurityHub")
+	return &securityhub.DisableSecurityHubOutput{}, nil
+}
+
+func TestDisableSecurityHubCleansUpFirst(t *testing.T) {
+	mock := &mockedSecurityHub{}
+	require.NoError(t, disableSecurityHub(mock))
+	assert.Equal(t, []string{
+		"BatchDisableStandards:cis",
+		"DisassociateMembers:111111111111",
+		"DeleteMembers:111111111111",
+		"DisassociateFromAdministratorAccount",
+		"DisableSecurityHub",
+	}, mock.Calls)
+}
+
+func TestShouldIncludeSecurityHub(t *testing.T) {
+	hub := &securityhub.DescribeHubOutput{
+		HubArn:       aws.String("arn:aws:securityhub:us-east-1:111111111111:hub/default"),
+		SubscribedAt: aws.String(time.Now().Format(time.RFC3339)),
+	}
+
+	assert.True(t, shouldIncludeSecurityHub(hub, time.Now().Add(1*time.Hour)))
+	assert.False(t, shouldIncludeSecurityHub(hub, time.Now().Add(1*time.Hour*-1)))
+}
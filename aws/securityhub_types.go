@@ -0,0 +1,30 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// SecurityHub - represents Security Hub being enabled in a region, identified by the ARN of the hub
+type SecurityHub struct {
+	HubArns []string
+}
+
+func (r SecurityHub) ResourceName() string {
+	return "security-hub"
+}
+
+func (r SecurityHub) ResourceIdentifiers() []string {
+	return r.HubArns
+}
+
+func (r SecurityHub) MaxBatchSize() int {
+	return 10
+}
+
+func (r SecurityHub) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSecurityHubs(session, identifiers); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
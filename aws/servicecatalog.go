@@ -0,0 +1,377 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/aws/aws-sdk-go/service/servicecatalog/servicecatalogiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of the IDs of the Service Catalog provisioned products in the account
+func getAllServiceCatalogProvisionedProducts(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := servicecatalog.New(session)
+
+	var ids []*string
+	input := &servicecatalog.SearchProvisionedProductsInput{
+		// By default only the provisioned products launched by the caller are returned
+		AccessLevelFilter: &servicecatalog.AccessLevelFilter{
+			Key:   aws.String(servicecatalog.AccessLevelFilterKeyAccount),
+			Value: aws.String("self"),
+		},
+	}
+	err := svc.SearchProvisionedProductsPages(input, func(page *servicecatalog.SearchProvisionedProductsOutput, lastPage bool) bool {
+		for _, product := range page.ProvisionedProducts {
+			if shouldIncludeServiceCatalogProvisionedProduct(product, excludeAfter, configObj) {
+				ids = append(ids, product.Id)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return ids, nil
+}
+
+func shouldIncludeServiceCatalogProvisionedProduct(product *servicecatalog.ProvisionedProductAttribute, excludeAfter time.Time, configObj config.Config) bool {
+	if product == nil {
+		return false
+	}
+
+	// Provisioned products that are being changed can't be terminated until the change is done
+	if aws.StringValue(product.Status) == servicecatalog.ProvisionedProductStatusUnderChange {
+		return false
+	}
+
+	if product.CreatedTime != nil && excludeAfter.Before(*product.CreatedTime) {
+		return false
+	}
+
+	if hasServiceCatalogExcludeTag(product.Tags) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(product.Name),
+		configObj.ServiceCatalogProvisionedProduct.IncludeRule.NamesRegExp,
+		configObj.ServiceCatalogProvisionedProduct.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Returns a formatted string of the IDs of the Service Catalog products created in the account
+func getAllServiceCatalogProducts(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := servicecatalog.New(session)
+
+	var products []*servicecatalog.ProductViewDetail
+	err := svc.SearchProductsAsAdminPages(&servicecatalog.SearchProductsAsAdminInput{}, func(page *servicecatalog.SearchProductsAsAdminOutput, lastPage bool) bool {
+		for _, product := range page.ProductViewDetails {
+			if shouldIncludeServiceCatalogProduct(product, excludeAfter, configObj) {
+				products = append(products, product)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var ids []*string
+	for _, product := range products {
+		// The tags are only returned when describing the product
+		output, err := svc.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{Id: product.ProductViewSummary.ProductId})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !hasServiceCatalogExcludeTag(output.Tags) {
+			ids = append(ids, product.ProductViewSummary.ProductId)
+		}
+	}
+	return ids, nil
+}
+
+func shouldIncludeServiceCatalogProduct(product *servicecatalog.ProductViewDetail, excludeAfter time.Time, configObj config.Config) bool {
+	if product == nil || product.ProductViewSummary == nil {
+		return false
+	}
+
+	if product.CreatedTime != nil && excludeAfter.Before(*product.CreatedTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(product.ProductViewSummary.Name),
+		configObj.ServiceCatalogProduct.IncludeRule.NamesRegExp,
+		configObj.ServiceCatalogProduct.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Returns a formatted string of the IDs of the Service Catalog portfolios created in the account
+func getAllServiceCatalogPortfolios(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := servicecatalog.New(session)
+
+	var portfolios []*servicecatalog.PortfolioDetail
+	err := svc.ListPortfoliosPages(&servicecatalog.ListPortfoliosInput{}, func(page *servicecatalog.ListPortfoliosOutput, lastPage bool) bool {
+		for _, portfolio := range page.PortfolioDetails {
+			if shouldIncludeServiceCatalogPortfolio(portfolio, excludeAfter, configObj) {
+				portfolios = append(portfolios, portfolio)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var ids []*string
+	for _, portfolio := range portfolios {
+		// The tags are only returned when describing the portfolio
+		output, err := svc.DescribePortfolio(&servicecatalog.DescribePortfolioInput{Id: portfolio.Id})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !hasServiceCatalogExcludeTag(output.Tags) {
+			ids = append(ids, portfolio.Id)
+		}
+	}
+	return ids, nil
+}
+
+func shouldIncludeServiceCatalogPortfolio(portfolio *servicecatalog.PortfolioDetail, excludeAfter time.Time, configObj config.Config) bool {
+	if portfolio == nil {
+		return false
+	}
+
+	if portfolio.CreatedTime != nil && excludeAfter.Before(*portfolio.CreatedTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(portfolio.DisplayName),
+		configObj.ServiceCatalogPortfolio.IncludeRule.NamesRegExp,
+		configObj.ServiceCatalogPortfolio.ExcludeRule.NamesRegExp,
+	)
+}
+
+func hasServiceCatalogExcludeTag(tags []*servicecatalog.Tag) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// terminateServiceCatalogProvisionedProduct terminates the provisioned product and waits for it to be gone, as the
+// product it was launched from can't be deleted before that.
+func terminateServiceCatalogProvisionedProduct(svc servicecatalogiface.ServiceCatalogAPI, id *string) error {
+	_, err := svc.TerminateProvisionedProduct(&servicecatalog.TerminateProvisionedProductInput{ProvisionedProductId: id})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for i := 0; i < 60; i++ {
+		output, err := svc.DescribeProvisionedProduct(&servicecatalog.DescribeProvisionedProductInput{Id: id})
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == servicecatalog.ErrCodeResourceNotFoundException {
+			return nil
+		}
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		detail := output.ProvisionedProductDetail
+		if aws.StringValue(detail.Status) == servicecatalog.ProvisionedProductStatusError {
+			return ServiceCatalogProvisionedProductTerminateError{id: aws.StringValue(id), message: aws.StringValue(detail.StatusMessage)}
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for Service Catalog provisioned product %s to be terminated", aws.StringValue(id))
+	}
+
+	return ServiceCatalogProvisionedProductTerminateTimeoutError{id: aws.StringValue(id)}
+}
+
+// removeServiceCatalogProductFromPortfolio deletes the constraints the portfolio sets on the product, and then
+// disassociates the product from the portfolio.
+func removeServiceCatalogProductFromPortfolio(svc servicecatalogiface.ServiceCatalogAPI, portfolioId *string, productId *string) error {
+	var constraintIds []*string
+	input := &servicecatalog.ListConstraintsForPortfolioInput{PortfolioId: portfolioId, ProductId: productId}
+	err := svc.ListConstraintsForPortfolioPages(input, func(page *servicecatalog.ListConstraintsForPortfolioOutput, lastPage bool) bool {
+		for _, constraint := range page.ConstraintDetails {
+			constraintIds = append(constraintIds, constraint.ConstraintId)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, constraintId := range constraintIds {
+		_, err := svc.DeleteConstraint(&servicecatalog.DeleteConstraintInput{Id: constraintId})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted Service Catalog constraint %s", aws.StringValue(constraintId))
+	}
+
+	_, err = svc.DisassociateProductFromPortfolio(&servicecatalog.DisassociateProductFromPortfolioInput{
+		PortfolioId: portfolioId,
+		ProductId:   productId,
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	logging.Logger.Debugf("Disassociated Service Catalog product %s from portfolio %s", aws.StringValue(productId), aws.StringValue(portfolioId))
+	return nil
+}
+
+// deleteServiceCatalogProduct removes the product from all its portfolios, as products in a portfolio can't be
+// deleted.
+func deleteServiceCatalogProduct(svc servicecatalogiface.ServiceCatalogAPI, productId *string) error {
+	var portfolioIds []*string
+	err := svc.ListPortfoliosForProductPages(&servicecatalog.ListPortfoliosForProductInput{ProductId: productId}, func(page *servicecatalog.ListPortfoliosForProductOutput, lastPage bool) bool {
+		for _, portfolio := range page.PortfolioDetails {
+			portfolioIds = append(portfolioIds, portfolio.Id)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, portfolioId := range portfolioIds {
+		if err := removeServiceCatalogProductFromPortfolio(svc, portfolioId, productId); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	_, err = svc.DeleteProduct(&servicecatalog.DeleteProductInput{Id: productId})
+	return errors.WithStackTrace(err)
+}
+
+// deleteServiceCatalogPortfolio removes the products, principals and account shares of the portfolio, as only
+// portfolios without any of them can be deleted.
+func deleteServiceCatalogPortfolio(svc servicecatalogiface.ServiceCatalogAPI, portfolioId *string) error {
+	var productIds []*string
+	err := svc.SearchProductsAsAdminPages(&servicecatalog.SearchProductsAsAdminInput{PortfolioId: portfolioId}, func(page *servicecatalog.SearchProductsAsAdminOutput, lastPage bool) bool {
+		for _, product := range page.ProductViewDetails {
+			productIds = append(productIds, product.ProductViewSummary.ProductId)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, productId := range productIds {
+		if err := removeServiceCatalogProductFromPortfolio(svc, portfolioId, productId); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	var principals []*servicecatalog.Principal
+	err = svc.ListPrincipalsForPortfolioPages(&servicecatalog.ListPrincipalsForPortfolioInput{PortfolioId: portfolioId}, func(page *servicecatalog.ListPrincipalsForPortfolioOutput, lastPage bool) bool {
+		principals = append(principals, page.Principals...)
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, principal := range principals {
+		_, err := svc.DisassociatePrincipalFromPortfolio(&servicecatalog.DisassociatePrincipalFromPortfolioInput{
+			PortfolioId:   portfolioId,
+			PrincipalARN:  principal.PrincipalARN,
+			PrincipalType: principal.PrincipalType,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Disassociated principal %s from Service Catalog portfolio %s", aws.StringValue(principal.PrincipalARN), aws.StringValue(portfolioId))
+	}
+
+	var accountIds []*string
+	err = svc.ListPortfolioAccessPages(&servicecatalog.ListPortfolioAccessInput{PortfolioId: portfolioId}, func(page *servicecatalog.ListPortfolioAccessOutput, lastPage bool) bool {
+		accountIds = append(accountIds, page.AccountIds...)
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, accountId := range accountIds {
+		_, err := svc.DeletePortfolioShare(&servicecatalog.DeletePortfolioShareInput{PortfolioId: portfolioId, AccountId: accountId})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Stopped sharing Service Catalog portfolio %s with account %s", aws.StringValue(portfolioId), aws.StringValue(accountId))
+	}
+
+	_, err = svc.DeletePortfolio(&servicecatalog.DeletePortfolioInput{Id: portfolioId})
+	return errors.WithStackTrace(err)
+}
+
+// nukeServiceCatalogResources deletes the given Service Catalog provisioned products, products or portfolios using
+// deleteFn, recording the status of each of them.
+func nukeServiceCatalogResources(session *session.Session, resourceType string, ids []*string, deleteFn func(svc servicecatalogiface.ServiceCatalogAPI, id *string) error) error {
+	svc := servicecatalog.New(session)
+
+	if len(ids) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedIds []*string
+	var allErrs *multierror.Error
+
+	for _, id := range ids {
+		err := deleteFn(svc, id)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(id),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, id)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(id))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedIds), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// Terminates all Service Catalog provisioned products, waiting for each of them to be gone
+func nukeAllServiceCatalogProvisionedProducts(session *session.Session, ids []*string) error {
+	return nukeServiceCatalogResources(session, "Service Catalog Provisioned Product", ids, terminateServiceCatalogProvisionedProduct)
+}
+
+// Deletes all Service Catalog products, removing them from their portfolios first
+func nukeAllServiceCatalogProducts(session *session.Session, ids []*string) error {
+	return nukeServiceCatalogResources(session, "Service Catalog Product", ids, deleteServiceCatalogProduct)
+}
+
+// Deletes all Service Catalog portfolios, removing their products, principals and shares first
+func nukeAllServiceCatalogPortfolios(session *session.Session, ids []*string) error {
+	return nukeServiceCatalogResources(session, "Service Catalog Portfolio", ids, deleteServiceCatalogPortfolio)
+}
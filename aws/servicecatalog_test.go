@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/aws/aws-sdk-go/service/servicecatalog/servicecatalogiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedServiceCatalog struct {
+	servicecatalogiface.ServiceCatalogAPI
+	Calls []string
+}
+
+func (m *mockedServiceCatalog) ListPortfoliosForProductPages(input *servicecatalog.ListPortfoliosForProductInput, fn func(*servicecatalog.ListPortfoliosForProductOutput, bool) bool) error {
+	fn(&servicecatalog.ListPortfoliosForProductOutput{PortfolioDetails: []*servicecatalog.PortfolioDetail{{Id: aws.String("port-1")}}}, true)
+	return nil
+}
+
+func (m *mockedServiceCatalog) ListConstraintsForPortfolioPages(input *servicecatalog.ListConstraintsForPortfolioInput, fn func(*servicecatalog.ListConstraintsForPortfolioOutput, bool) bool) error {
+	fn(&servicecatalog.ListConstraintsForPortfolioOutput{ConstraintDetails: []*servicecatalog.ConstraintDetail{{ConstraintId: aws.String("cons-1")}}}, true)
+	return nil
+}
+
+func (m *mockedServiceCatalog) DeleteConstraint(input *servicecatalog.DeleteConstraintInput) (*servicecatalog.DeleteConstraintOutput, error) {
+	m.Calls = append(m.Calls, "DeleteConstraint:"+aws.StringValue(input.Id))
+	return &servicecatalog.DeleteConstraintOutput{}, nil
+}
+
+func (m *mockedServiceCatalog) DisassociateProductFromPortfolio(input *servicecatalog.DisassociateProductFromPortfolioInput) (*servicecatalog.DisassociateProductFromPortfolioOutput, error) {
+	m.Calls = append(m.Calls, "DisassociateProductFromPortfolio:"+aws.StringValue(input.PortfolioId))
+	return &servicecatalog.DisassociateProductFromPortfolioOutput{}, nil
+}
+
+func (m *mockedServiceCatalog) DeleteProduct(input *servicecatalog.DeleteProductInput) (*servicecatalog.DeleteProductOutput, error) {
+	m.Calls = append(m.Calls, "DeleteProduct:"+aws.StringValue(input.Id))
+	return &servicecatalog.DeleteProductOutput{}, nil
+}
+
+func TestDeleteServiceCatalogProductRemovesItFromPortfoliosFirst(t *testing.T) {
+	mock := &mockedServiceCatalog{}
+	require.NoError(t, deleteServiceCatalogProduct(mock, aws.String("prod-1")))
+	assert.Equal(t, []string{"DeleteConstraint:cons-1", "DisassociateProductFromPortfolio:port-1", "DeleteProduct:prod-1"}, mock.Calls)
+}
+
+func TestShouldIncludeServiceCatalogProvisionedProduct(t *testing.T) {
+	product := &servicecatalog.ProvisionedProductAttribute{
+		Name:        aws.String("cloud-nuke-test"),
+		CreatedTime: aws.Time(time.Now()),
+		Status:      aws.String(servicecatalog.ProvisionedProductStatusAvailable),
+	}
+	underChange := &servicecatalog.ProvisionedProductAttribute{
+		Name:        aws.String("cloud-nuke-test"),
+		CreatedTime: aws.Time(time.Now()),
+		Status:      aws.String(servicecatalog.ProvisionedProductStatusUnderChange),
+	}
+	excluded := &servicecatalog.ProvisionedProductAttribute{
+		Name:        aws.String("cloud-nuke-test"),
+		CreatedTime: aws.Time(time.Now()),
+		Status:      aws.String(servicecatalog.ProvisionedProductStatusAvailable),
+		Tags:        []*servicecatalog.Tag{{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}},
+	}
+
+	assert.True(t, shouldIncludeServiceCatalogProvisionedProduct(product, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeServiceCatalogProvisionedProduct(product, time.Now().Add(1*time.Hour*-1), config.Config{}))
+	assert.False(t, shouldIncludeServiceCatalogProvisionedProduct(underChange, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeServiceCatalogProvisionedProduct(excluded, time.Now().Add(1*time.Hour), config.Config{}))
+}
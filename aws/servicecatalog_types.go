@@ -0,0 +1,112 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// ServiceCatalogProvisionedProducts - represents all Service Catalog provisioned products
+type ServiceCatalogProvisionedProducts struct {
+	Ids []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (products ServiceCatalogProvisionedProducts) ResourceName() string {
+	return "servicecatalog-provisioned-product"
+}
+
+// ResourceIdentifiers - The IDs of the Service Catalog provisioned products
+func (products ServiceCatalogProvisionedProducts) ResourceIdentifiers() []string {
+	return products.Ids
+}
+
+func (products ServiceCatalogProvisionedProducts) MaxBatchSize() int {
+	// Terminating a provisioned product deletes its CloudFormation stack, which takes a while, so we keep the batches
+	// small
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (products ServiceCatalogProvisionedProducts) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllServiceCatalogProvisionedProducts(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// ServiceCatalogProducts - represents all Service Catalog products
+type ServiceCatalogProducts struct {
+	Ids []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (products ServiceCatalogProducts) ResourceName() string {
+	return "servicecatalog-product"
+}
+
+// ResourceIdentifiers - The IDs of the Service Catalog products
+func (products ServiceCatalogProducts) ResourceIdentifiers() []string {
+	return products.Ids
+}
+
+func (products ServiceCatalogProducts) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (products ServiceCatalogProducts) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllServiceCatalogProducts(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// ServiceCatalogPortfolios - represents all Service Catalog portfolios
+type ServiceCatalogPortfolios struct {
+	Ids []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (portfolios ServiceCatalogPortfolios) ResourceName() string {
+	return "servicecatalog-portfolio"
+}
+
+// ResourceIdentifiers - The IDs of the Service Catalog portfolios
+func (portfolios ServiceCatalogPortfolios) ResourceIdentifiers() []string {
+	return portfolios.Ids
+}
+
+func (portfolios ServiceCatalogPortfolios) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (portfolios ServiceCatalogPortfolios) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllServiceCatalogPortfolios(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type ServiceCatalogProvisionedProductTerminateError struct {
+	id      string
+	message string
+}
+
+func (e ServiceCatalogProvisionedProductTerminateError) Error() string {
+	return "Failed to terminate Service Catalog provisioned product " + e.id + ": " + e.message
+}
+
+type ServiceCatalogProvisionedProductTerminateTimeoutError struct {
+	id string
+}
+
+func (e ServiceCatalogProvisionedProductTerminateTimeoutError) Error() string {
+	return "Timed out waiting for Service Catalog provisioned product " + e.id + " to be terminated"
+}
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// getAllSfnStateMachines returns the ARNs of all the Step Functions state machines in the region.
+func getAllSfnStateMachines(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := sfn.New(session)
+
+	var stateMachineArns []*string
+	err := svc.ListStateMachinesPages(&sfn.ListStateMachinesInput{}, func(page *sfn.ListStateMachinesOutput, lastPage bool) bool {
+		for _, stateMachine := range page.StateMachines {
+			if shouldIncludeSfnStateMachine(stateMachine, excludeAfter, configObj) {
+				stateMachineArns = append(stateMachineArns, stateMachine.StateMachineArn)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return stateMachineArns, nil
+}
+
+func shouldIncludeSfnStateMachine(stateMachine *sfn.StateMachineListItem, excludeAfter time.Time, configObj config.Config) bool {
+	if stateMachine == nil {
+		return false
+	}
+
+	if stateMachine.CreationDate != nil && excludeAfter.Before(*stateMachine.CreationDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(stateMachine.Name),
+		configObj.SfnStateMachine.IncludeRule.NamesRegExp,
+		configObj.SfnStateMachine.ExcludeRule.NamesRegExp,
+	)
+}
+
+// stopSfnStateMachineExecutions stops all the running executions of a standard state machine. Executions of express
+// state machines can't be listed or stopped; AWS stops them when the state machine is deleted.
+func stopSfnStateMachineExecutions(svc sfniface.SFNAPI, stateMachineArn *string) error {
+	stateMachine, err := svc.DescribeStateMachine(&sfn.DescribeStateMachineInput{
+		StateMachineArn: stateMachineArn,
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if aws.StringValue(stateMachine.Type) != sfn.StateMachineTypeStandard {
+		return nil
+	}
+
+	var executionArns []*string
+	err = svc.ListExecutionsPages(&sfn.ListExecutionsInput{
+		StateMachineArn: stateMachineArn,
+		StatusFilter:    aws.String(sfn.ExecutionStatusRunning),
+	}, func(page *sfn.ListExecutionsOutput, lastPage bool) bool {
+		for _, execution := range page.Executions {
+			executionArns = append(executionArns, execution.ExecutionArn)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, executionArn := range executionArns {
+		_, err := svc.StopExecution(&sfn.StopExecutionInput{
+			ExecutionArn: executionArn,
+			Cause:        aws.String("Stopped by cloud-nuke"),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Stopped execution %s of state machine %s", aws.StringValue(executionArn), aws.StringValue(stateMachineArn))
+	}
+	return nil
+}
+
+func nukeAllSfnStateMachines(session *session.Session, stateMachineArns []*string) error {
+	svc := sfn.New(session)
+
+	if len(stateMachineArns) == 0 {
+		logging.Logger.Debugf("No Step Functions state machines to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Step Functions state machines in region %s", *session.Config.Region)
+	var deletedArns []*string
+	var allErrs *multierror.Error
+
+	for _, stateMachineArn := range stateMachineArns {
+		err := stopSfnStateMachineExecutions(svc, stateMachineArn)
+		if err == nil {
+			_, err = svc.DeleteStateMachine(&sfn.DeleteStateMachineInput{
+				StateMachineArn: stateMachineArn,
+			})
+		}
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(stateMachineArn),
+			ResourceType: "Step Functions State Machine",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Step Functions State Machine",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedArns = append(deletedArns, stateMachineArn)
+			logging.Logger.Debugf("Deleted Step Functions state machine: %s", aws.StringValue(stateMachineArn))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Step Functions state machine(s) deleted in %s", len(deletedArns), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedSfnExecutions serves a single state machine of the given type with running executions
+type mockedSfnExecutions struct {
+	sfniface.SFNAPI
+	Type              string
+	RunningExecutions []*string
+	Stopped           []string
+	Listed            bool
+}
+
+func (m *mockedSfnExecutions) DescribeStateMachine(input *sfn.DescribeStateMachineInput) (*sfn.DescribeStateMachineOutput, error) {
+	return &sfn.DescribeStateMachineOutput{StateMachineArn: input.StateMachineArn, Type: aws.String(m.Type)}, nil
+}
+
+func (m *mockedSfnExecutions) ListExecutionsPages(input *sfn.ListExecutionsInput, fn func(*sfn.ListExecutionsOutput, bool) bool) error {
+	m.Listed = true
+	output := &sfn.ListExecutionsOutput{}
+	for _, arn := range m.RunningExecutions {
+		output.Executions = append(output.Executions, &sfn.ExecutionListItem{ExecutionArn: arn})
+	}
+	fn(output, true)
+	return nil
+}
+
+func (m *mockedSfnExecutions) StopExecution(input *sfn.StopExecutionInput) (*sfn.StopExecutionOutput, error) {
+	m.Stopped = append(m.Stopped, aws.StringValue(input.ExecutionArn))
+	return &sfn.StopExecutionOutput{}, nil
+}
+
+func TestStopSfnStateMachineExecutions(t *testing.T) {
+	t.Parallel()
+
+	standard := &mockedSfnExecutions{
+		Type:              sfn.StateMachineTypeStandard,
+		RunningExecutions: aws.StringSlice([]string{"execution-1", "execution-2"}),
+	}
+	require.NoError(t, stopSfnStateMachineExecutions(standard, aws.String("state-machine")))
+	assert.Equal(t, []string{"execution-1", "execution-2"}, standard.Stopped)
+
+	express := &mockedSfnExecutions{
+		Type:              sfn.StateMachineTypeExpress,
+		RunningExecutions: aws.StringSlice([]string{"execution-1"}),
+	}
+	require.NoError(t, stopSfnStateMachineExecutions(express, aws.String("state-machine")))
+	assert.False(t, express.Listed)
+	assert.Empty(t, express.Stopped)
+}
+
+func TestShouldIncludeSfnStateMachine(t *testing.T) {
+	now := time.Now()
+	excludeConfig := config.Config{
+		SfnStateMachine: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^keep-.*")},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name         string
+		StateMachine *sfn.StateMachineListItem
+		Config       config.Config
+		ExcludeAfter time.Time
+		Expected     bool
+	}{
+		{
+			Name:         "OlderThan",
+			StateMachine: &sfn.StateMachineListItem{Name: aws.String("cloud-nuke-test"), CreationDate: aws.Time(now)},
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     true,
+		},
+		{
+			Name:         "NotOlderThan",
+			StateMachine: &sfn.StateMachineListItem{Name: aws.String("cloud-nuke-test"), CreationDate: aws.Time(now)},
+			Config:       config.Config{},
+			ExcludeAfter: now.Add(-1 * time.Hour),
+			Expected:     false,
+		},
+		{
+			Name:         "ConfigExclude",
+			StateMachine: &sfn.StateMachineListItem{Name: aws.String("keep-me"), CreationDate: aws.Time(now)},
+			Config:       excludeConfig,
+			ExcludeAfter: now.Add(1 * time.Hour),
+			Expected:     false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludeSfnStateMachine(c.StateMachine, c.ExcludeAfter, c.Config))
+		})
+	}
+}
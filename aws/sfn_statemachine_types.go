@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// SfnStateMachines - represents all Step Functions state machines
+type SfnStateMachines struct {
+	StateMachineArns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (stateMachines SfnStateMachines) ResourceName() string {
+	return "sfn-statemachine"
+}
+
+// ResourceIdentifiers - The ARNs of the state machines
+func (stateMachines SfnStateMachines) ResourceIdentifiers() []string {
+	return stateMachines.StateMachineArns
+}
+
+func (stateMachines SfnStateMachines) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (stateMachines SfnStateMachines) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSfnStateMachines(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -10,13 +10,14 @@ import (
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
 	"github.com/gruntwork-io/go-commons/errors"
 )
 
 // Returns a formatted string of Snapshot snapshot ids
-func getAllSnapshots(session *session.Session, region string, excludeAfter time.Time) ([]*string, error) {
+func getAllSnapshots(session *session.Session, region string, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
 	svc := ec2.New(session)
 
 	// status - The status of the snapshot (pending | completed | error).
@@ -30,23 +31,80 @@ func getAllSnapshots(session *session.Session, region string, excludeAfter time.
 		Filters:  []*ec2.Filter{&status_filter},
 	}
 
-	output, err := svc.DescribeSnapshots(params)
+	// Snapshots backing a registered AMI can't be deleted until the AMI is deregistered
+	amiSnapshotIds, err := getSnapshotIdsReferencedByAMIs(svc)
 	if err != nil {
-		return nil, errors.WithStackTrace(err)
+		return nil, err
 	}
 
 	var snapshotIds []*string
-	for _, snapshot := range output.Snapshots {
-		if excludeAfter.After(*snapshot.StartTime) && !hasEBSSnapExcludeTag(snapshot) && !SnapshotHasAWSBackupTag(snapshot.Tags) {
-			snapshotIds = append(snapshotIds, snapshot.SnapshotId)
-		} else if !hasEBSSnapExcludeTag(snapshot) {
-			snapshotIds = append(snapshotIds, snapshot.SnapshotId)
+	err = svc.DescribeSnapshotsPages(params, func(page *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
+		for _, snapshot := range page.Snapshots {
+			if amiSnapshotIds[aws.StringValue(snapshot.SnapshotId)] {
+				logging.Logger.Debugf("Snapshot %s is referenced by a registered AMI, skipping", aws.StringValue(snapshot.SnapshotId))
+				continue
+			}
+			if shouldIncludeSnapshot(snapshot, excludeAfter, configObj) {
+				snapshotIds = append(snapshotIds, snapshot.SnapshotId)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	return snapshotIds, nil
+}
+
+// getSnapshotIdsReferencedByAMIs returns the set of snapshot ids used by the block device mappings of AMIs owned by
+// the account
+func getSnapshotIdsReferencedByAMIs(svc *ec2.EC2) (map[string]bool, error) {
+	output, err := svc.DescribeImages(&ec2.DescribeImagesInput{
+		Owners: []*string{awsgo.String("self")},
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	snapshotIds := map[string]bool{}
+	for _, image := range output.Images {
+		for _, mapping := range image.BlockDeviceMappings {
+			if mapping.Ebs != nil && mapping.Ebs.SnapshotId != nil {
+				snapshotIds[aws.StringValue(mapping.Ebs.SnapshotId)] = true
+			}
 		}
 	}
 
 	return snapshotIds, nil
 }
 
+func shouldIncludeSnapshot(snapshot *ec2.Snapshot, excludeAfter time.Time, configObj config.Config) bool {
+	if snapshot == nil {
+		return false
+	}
+
+	if excludeAfter.Before(aws.TimeValue(snapshot.StartTime)) {
+		return false
+	}
+
+	if hasEBSSnapExcludeTag(snapshot) || SnapshotHasAWSBackupTag(snapshot.Tags) {
+		return false
+	}
+
+	name := ""
+	for _, tag := range snapshot.Tags {
+		if tag != nil && aws.StringValue(tag.Key) == "Name" {
+			name = aws.StringValue(tag.Value)
+		}
+	}
+	return config.ShouldInclude(
+		name,
+		configObj.EBSSnapshot.IncludeRule.NamesRegExp,
+		configObj.EBSSnapshot.ExcludeRule.NamesRegExp,
+	)
+}
+
 // hasEBSSnapExcludeTag checks whether the exlude tag is set for a resource to skip deleting it.
 func hasEBSSnapExcludeTag(snapshot *ec2.Snapshot) bool {
 	// Exclude deletion of any buckets with cloud-nuke-excluded tags
@@ -2,12 +2,14 @@ package aws
 
 import (
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	"regexp"
 	"testing"
 	"time"
 
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/gruntwork-io/go-commons/errors"
 	"github.com/stretchr/testify/assert"
@@ -62,14 +64,14 @@ func TestListSnapshots(t *testing.T) {
 	defer nukeAllSnapshots(session, []*string{snapshot.SnapshotId})
 	defer nukeAllEbsVolumes(session, findEBSVolumesByNameTag(t, session, uniqueTestID))
 
-	snapshots, err := getAllSnapshots(session, region, time.Now().Add(1*time.Hour*-1))
+	snapshots, err := getAllSnapshots(session, region, time.Now().Add(1*time.Hour*-1), config.Config{})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of Snapshots")
 	}
 
 	assert.NotContains(t, awsgo.StringValueSlice(snapshots), *snapshot.SnapshotId)
 
-	snapshots, err = getAllSnapshots(session, region, time.Now().Add(1*time.Hour))
+	snapshots, err = getAllSnapshots(session, region, time.Now().Add(1*time.Hour), config.Config{})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of Snapshots")
 	}
@@ -113,10 +115,52 @@ func TestNukeSnapshots(t *testing.T) {
 		assert.Fail(t, errors.WithStackTrace(err).Error())
 	}
 
-	snapshots, err := getAllSnapshots(session, region, time.Now().Add(1*time.Hour))
+	snapshots, err := getAllSnapshots(session, region, time.Now().Add(1*time.Hour), config.Config{})
 	if err != nil {
 		assert.Fail(t, "Unable to fetch list of Snapshots")
 	}
 
 	assert.NotContains(t, awsgo.StringValueSlice(snapshots), *snapshot.SnapshotId)
 }
+
+func TestShouldIncludeSnapshot(t *testing.T) {
+	telemetry.InitTelemetry("cloud-nuke", "", "")
+	t.Parallel()
+
+	createdAt := time.Now().Add(-2 * time.Hour)
+	snapshot := func(tags ...*ec2.Tag) *ec2.Snapshot {
+		return &ec2.Snapshot{
+			SnapshotId: awsgo.String("snap-test"),
+			StartTime:  awsgo.Time(createdAt),
+			Tags:       tags,
+		}
+	}
+	nameTag := &ec2.Tag{Key: awsgo.String("Name"), Value: awsgo.String("cloud-nuke-test-snapshot")}
+	excludeConfig := config.Config{
+		EBSSnapshot: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^cloud-nuke-test-.*")}},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name         string
+		Snapshot     *ec2.Snapshot
+		Config       config.Config
+		ExcludeAfter time.Time
+		Expected     bool
+	}{
+		{"OlderThan", snapshot(nameTag), config.Config{}, time.Now(), true},
+		{"NotOlderThan", snapshot(nameTag), config.Config{}, createdAt.Add(-1 * time.Hour), false},
+		{"ConfigExclude", snapshot(nameTag), excludeConfig, time.Now(), false},
+		{"ExclusionTag", snapshot(nameTag, &ec2.Tag{Key: awsgo.String(AwsResourceExclusionTagKey), Value: awsgo.String("true")}), config.Config{}, time.Now(), false},
+		{"AWSBackupTag", snapshot(&ec2.Tag{Key: awsgo.String("aws:backup:source-resource"), Value: awsgo.String("vol-123")}), config.Config{}, time.Now(), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, shouldIncludeSnapshot(c.Snapshot, c.ExcludeAfter, c.Config))
+		})
+	}
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+	"strings"
 	"sync"
 	"time"
 
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/gruntwork-io/cloud-nuke/config"
@@ -35,12 +37,44 @@ func getAllSNSTopics(session *session.Session, excludeAfter time.Time, configObj
 			return []*string{}, errors.WithStackTrace(err)
 		}
 		for _, topic := range resp.Topics {
-			allSNSTopics = append(allSNSTopics, topic.TopicArn)
+			tagsOutput, err := svc.ListTagsForResource(context.TODO(), &sns.ListTagsForResourceInput{
+				ResourceArn: topic.TopicArn,
+			})
+			if err != nil {
+				return []*string{}, errors.WithStackTrace(err)
+			}
+
+			if shouldIncludeSNSTopic(aws.StringValue(topic.TopicArn), tagsOutput.Tags, configObj) {
+				allSNSTopics = append(allSNSTopics, topic.TopicArn)
+			}
 		}
 	}
 	return allSNSTopics, nil
 }
 
+// getSNSTopicName returns the topic name, which is the last segment of the topic ARN
+func getSNSTopicName(topicArn string) string {
+	return topicArn[strings.LastIndex(topicArn, ":")+1:]
+}
+
+func shouldIncludeSNSTopic(topicArn string, tags []types.Tag, configObj config.Config) bool {
+	if topicArn == "" {
+		return false
+	}
+
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return false
+		}
+	}
+
+	return config.ShouldInclude(
+		getSNSTopicName(topicArn),
+		configObj.SNS.IncludeRule.NamesRegExp,
+		configObj.SNS.ExcludeRule.NamesRegExp,
+	)
+}
+
 func nukeAllSNSTopics(session *session.Session, identifiers []*string) error {
 	region := aws.StringValue(session.Config.Region)
 
@@ -98,7 +132,10 @@ func deleteSNSTopicAsync(wg *sync.WaitGroup, errChan chan error, svc *sns.Client
 
 	logging.Logger.Debugf("Deleting SNS Topic (arn=%s) in region: %s", aws.StringValue(topicArn), region)
 
-	_, err := svc.DeleteTopic(context.TODO(), deleteParam)
+	err := deleteSNSTopicSubscriptions(svc, topicArn)
+	if err == nil {
+		_, err = svc.DeleteTopic(context.TODO(), deleteParam)
+	}
 
 	errChan <- err
 
@@ -116,3 +153,38 @@ func deleteSNSTopicAsync(wg *sync.WaitGroup, errChan chan error, svc *sns.Client
 		logging.Logger.Debugf("[Failed] Error deleting SNS Topic (arn=%s) in %s", aws.StringValue(topicArn), region)
 	}
 }
+
+// deleteSNSTopicSubscriptions unsubscribes all confirmed subscriptions of the given topic. Subscriptions pending
+// confirmation have no ARN yet and can not be unsubscribed; they are removed by AWS along with the topic.
+func deleteSNSTopicSubscriptions(svc *sns.Client, topicArn *string) error {
+	paginator := sns.NewListSubscriptionsByTopicPaginator(svc, &sns.ListSubscriptionsByTopicInput{
+		TopicArn: topicArn,
+	})
+
+	for paginator.HasMorePages() {
+		resp, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		for _, subscription := range resp.Subscriptions {
+			if !isConfirmedSNSSubscription(aws.StringValue(subscription.SubscriptionArn)) {
+				continue
+			}
+
+			_, err := svc.Unsubscribe(context.TODO(), &sns.UnsubscribeInput{
+				SubscriptionArn: subscription.SubscriptionArn,
+			})
+			if err != nil {
+				return errors.WithStackTrace(err)
+			}
+			logging.Logger.Debugf("Deleted SNS Subscription (arn=%s) of topic %s", aws.StringValue(subscription.SubscriptionArn), aws.StringValue(topicArn))
+		}
+	}
+	return nil
+}
+
+// isConfirmedSNSSubscription returns true if the subscription ARN refers to an actual subscription. AWS reports
+// placeholders such as "PendingConfirmation" or "Deleted" for subscriptions that can not be unsubscribed.
+func isConfirmedSNSSubscription(subscriptionArn string) bool {
+	return strings.HasPrefix(subscriptionArn, "arn:")
+}
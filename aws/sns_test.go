@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	"math/rand"
+	"regexp"
 	"testing"
 	"time"
 
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
 	"github.com/aws/aws-sdk-go/aws"
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -144,3 +146,89 @@ func TestNukeSNSTopicMoreThanOne(t *testing.T) {
 	assert.NotContains(t, aws.StringValueSlice(snsTopicArns), aws.StringValue(testSNSTopic.Arn))
 	assert.NotContains(t, aws.StringValueSlice(snsTopicArns), aws.StringValue(testSNSTopic2.Arn))
 }
+
+func TestShouldIncludeSNSTopic(t *testing.T) {
+	mockTopicArn := "arn:aws:sns:us-east-1:123456789012:cloud-nuke-test"
+
+	mockExpression, err := regexp.Compile("^cloud-nuke-*")
+	require.NoError(t, err)
+
+	mockExcludeConfig := config.Config{
+		SNS: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{
+						RE: *mockExpression,
+					},
+				},
+			},
+		},
+	}
+
+	mockIncludeConfig := config.Config{
+		SNS: config.ResourceType{
+			IncludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{
+						RE: *mockExpression,
+					},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		TopicArn string
+		Tags     []types.Tag
+		Config   config.Config
+		Expected bool
+	}{
+		{
+			Name:     "NoFilters",
+			TopicArn: mockTopicArn,
+			Config:   config.Config{},
+			Expected: true,
+		},
+		{
+			Name:     "ConfigExclude",
+			TopicArn: mockTopicArn,
+			Config:   mockExcludeConfig,
+			Expected: false,
+		},
+		{
+			Name:     "ConfigInclude",
+			TopicArn: mockTopicArn,
+			Config:   mockIncludeConfig,
+			Expected: true,
+		},
+		{
+			Name:     "ConfigIncludeNotMatching",
+			TopicArn: "arn:aws:sns:us-east-1:123456789012:other-topic",
+			Config:   mockIncludeConfig,
+			Expected: false,
+		},
+		{
+			Name:     "ExcludeTag",
+			TopicArn: mockTopicArn,
+			Tags: []types.Tag{
+				{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")},
+			},
+			Config:   config.Config{},
+			Expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			result := shouldIncludeSNSTopic(c.TopicArn, c.Tags, c.Config)
+			assert.Equal(t, c.Expected, result)
+		})
+	}
+}
+
+func TestIsConfirmedSNSSubscription(t *testing.T) {
+	assert.True(t, isConfirmedSNSSubscription("arn:aws:sns:us-east-1:123456789012:cloud-nuke-test:2bcfbf39-05c3-41de-beaa-fcfcc21c8f55"))
+	assert.False(t, isConfirmedSNSSubscription("PendingConfirmation"))
+	assert.False(t, isConfirmedSNSSubscription("Deleted"))
+}
@@ -1,22 +1,26 @@
 package aws
 
 import (
-	"github.com/gruntwork-io/cloud-nuke/telemetry"
-	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+	"path"
 	"strconv"
 	"time"
 
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
 	"github.com/aws/aws-sdk-go/aws"
 	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
 	"github.com/gruntwork-io/go-commons/errors"
 )
 
 // Returns a formatted string of SQS Queue URLs
-func getAllSqsQueue(session *session.Session, region string, excludeAfter time.Time) ([]*string, error) {
+func getAllSqsQueue(session *session.Session, region string, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
 	svc := sqs.New(session)
 
 	result := []*string{}
@@ -41,6 +45,11 @@ func getAllSqsQueue(session *session.Session, region string, excludeAfter time.T
 			AttributeNames: awsgo.StringSlice([]string{"CreatedTimestamp"}),
 		}
 		queueAttributes, err := svc.GetQueueAttributes(param)
+		if isSqsQueueDoesNotExistErr(err) {
+			// Queues deleted in the last 60 seconds can still show up in ListQueues
+			logging.Logger.Debugf("SQS Queue %s was deleted recently, skipping", aws.StringValue(queue))
+			continue
+		}
 		if err != nil {
 			return nil, errors.WithStackTrace(err)
 		}
@@ -52,8 +61,7 @@ func getAllSqsQueue(session *session.Session, region string, excludeAfter time.T
 			return nil, errors.WithStackTrace(err)
 		}
 
-		// Compare time as int64
-		if excludeAfter.Unix() > createdAtInt {
+		if shouldIncludeSqsQueue(queue, time.Unix(createdAtInt, 0), excludeAfter, configObj) {
 			urls = append(urls, queue)
 		}
 	}
@@ -61,6 +69,36 @@ func getAllSqsQueue(session *session.Session, region string, excludeAfter time.T
 	return urls, nil
 }
 
+func shouldIncludeSqsQueue(queueUrl *string, createdAt time.Time, excludeAfter time.Time, configObj config.Config) bool {
+	if queueUrl == nil {
+		return false
+	}
+
+	// The creation timestamp only has a resolution of seconds, so compare as such
+	if excludeAfter.Unix() <= createdAt.Unix() {
+		return false
+	}
+
+	return config.ShouldInclude(
+		getSqsQueueName(aws.StringValue(queueUrl)),
+		configObj.SQS.IncludeRule.NamesRegExp,
+		configObj.SQS.ExcludeRule.NamesRegExp,
+	)
+}
+
+// getSqsQueueName returns the name of a queue, which is the last path segment of its URL.
+func getSqsQueueName(queueUrl string) string {
+	return path.Base(queueUrl)
+}
+
+// isSqsQueueDoesNotExistErr returns true if the error means that the queue has already been deleted.
+func isSqsQueueDoesNotExistErr(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == sqs.ErrCodeQueueDoesNotExist
+	}
+	return false
+}
+
 // Deletes all Elastic Load Balancers
 func nukeAllSqsQueues(session *session.Session, urls []*string) error {
 	svc := sqs.New(session)
@@ -79,6 +117,12 @@ func nukeAllSqsQueues(session *session.Session, urls []*string) error {
 		}
 
 		_, err := svc.DeleteQueue(params)
+		if isSqsQueueDoesNotExistErr(err) {
+			// A queue can take up to 60 seconds to disappear after being deleted, so it may have been listed even though
+			// it was already deleted by a previous run. There is nothing left to nuke, so this is not a failure.
+			logging.Logger.Debugf("SQS Queue %s was already deleted", aws.StringValue(url))
+			err = nil
+		}
 
 		// Record status of this resource
 		e := report.Entry{
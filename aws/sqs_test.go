@@ -1,16 +1,20 @@
 package aws
 
 import (
-	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	"regexp"
 	"testing"
 	"time"
 
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/go-commons/retry"
 
 	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -74,14 +78,14 @@ func TestListSqsQueue(t *testing.T) {
 	oneHourAgo := time.Now().Add(1 * time.Hour * -1)
 	oneHourFromNow := time.Now().Add(1 * time.Hour)
 
-	urls, err := getAllSqsQueue(session, region, oneHourAgo)
+	urls, err := getAllSqsQueue(session, region, oneHourAgo, config.Config{})
 	require.NoError(t, err)
 
 	for _, queue := range queueList {
 		assert.NotContains(t, awsgo.StringValueSlice(urls), awsgo.StringValue(queue))
 	}
 
-	urls, err = getAllSqsQueue(session, region, oneHourFromNow)
+	urls, err = getAllSqsQueue(session, region, oneHourFromNow, config.Config{})
 	require.NoError(t, err)
 
 	for _, queue := range queueList {
@@ -105,7 +109,7 @@ func TestNukeSqsQueue(t *testing.T) {
 	queueUrl := createTestQueue(t, session, queueName)
 	oneHourFromNow := time.Now().Add(1 * time.Hour)
 
-	urls, err := getAllSqsQueue(session, region, oneHourFromNow)
+	urls, err := getAllSqsQueue(session, region, oneHourFromNow, config.Config{})
 	require.NoError(t, err)
 	assert.Contains(t, awsgo.StringValueSlice(urls), awsgo.StringValue(queueUrl))
 
@@ -114,7 +118,7 @@ func TestNukeSqsQueue(t *testing.T) {
 
 	// SQS Queue deletion takes up to 60 seconds to be finished. See https://docs.aws.amazon.com/sdk-for-go/api/service/sqs/#SQS.DeleteQueue
 	for retry := 0; retry <= 6; retry++ {
-		urls, err = getAllSqsQueue(session, region, oneHourFromNow)
+		urls, err = getAllSqsQueue(session, region, oneHourFromNow, config.Config{})
 		if err == nil {
 			break
 		}
@@ -126,3 +130,28 @@ func TestNukeSqsQueue(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotContains(t, awsgo.StringValueSlice(urls), awsgo.StringValue(queueUrl))
 }
+
+func TestShouldIncludeSqsQueue(t *testing.T) {
+	now := time.Now()
+	queueUrl := awsgo.String("https://sqs.us-east-1.amazonaws.com/123456789012/cloud-nuke-test-queue")
+	excludeConfig := config.Config{
+		SQS: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{
+					{RE: *regexp.MustCompile("^cloud-nuke-test-.*")},
+				},
+			},
+		},
+	}
+
+	assert.True(t, shouldIncludeSqsQueue(queueUrl, now, now.Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSqsQueue(queueUrl, now, now.Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSqsQueue(queueUrl, now, now.Add(1*time.Hour), excludeConfig))
+	assert.False(t, shouldIncludeSqsQueue(nil, now, now.Add(1*time.Hour), config.Config{}))
+}
+
+func TestIsSqsQueueDoesNotExistErr(t *testing.T) {
+	assert.True(t, isSqsQueueDoesNotExistErr(awserr.New(sqs.ErrCodeQueueDoesNotExist, "The specified queue does not exist", nil)))
+	assert.False(t, isSqsQueueDoesNotExistErr(awserr.New(sqs.ErrCodeQueueDeletedRecently, "Queue deleted recently", nil)))
+	assert.False(t, isSqsQueueDoesNotExistErr(nil))
+}
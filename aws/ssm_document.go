@@ -0,0 +1,193 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// The owner of the documents AWS publishes in every account
+const awsSSMDocumentOwner = "Amazon"
+
+// Returns a formatted string of the names of the SSM documents owned by the account, leaving out the documents AWS and
+// other accounts share with it.
+func getAllSSMDocuments(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := ssm.New(session)
+
+	input := &ssm.ListDocumentsInput{
+		Filters: []*ssm.DocumentKeyValuesFilter{
+			{Key: aws.String(ssm.DocumentFilterKeyOwner), Values: aws.StringSlice([]string{"Self"})},
+		},
+	}
+
+	var documents []*ssm.DocumentIdentifier
+	err := svc.ListDocumentsPages(input, func(page *ssm.ListDocumentsOutput, lastPage bool) bool {
+		for _, document := range page.DocumentIdentifiers {
+			if shouldIncludeSSMDocument(document, excludeAfter, configObj) {
+				documents = append(documents, document)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, document := range documents {
+		exclude, err := hasSSMExcludeTag(svc, ssm.ResourceTypeForTaggingDocument, document.Name)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !exclude {
+			names = append(names, document.Name)
+		}
+	}
+	return names, nil
+}
+
+func shouldIncludeSSMDocument(document *ssm.DocumentIdentifier, excludeAfter time.Time, configObj config.Config) bool {
+	if document == nil || aws.StringValue(document.Owner) == awsSSMDocumentOwner {
+		return false
+	}
+
+	if document.CreatedDate != nil && excludeAfter.Before(*document.CreatedDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(document.Name),
+		configObj.SSMDocument.IncludeRule.NamesRegExp,
+		configObj.SSMDocument.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Returns a formatted string of State Manager association IDs. Associations are filtered on their name, falling back
+// to the name of the document they run when they don't have one.
+func getAllSSMAssociations(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := ssm.New(session)
+
+	var associations []*ssm.Association
+	err := svc.ListAssociationsPages(&ssm.ListAssociationsInput{}, func(page *ssm.ListAssociationsOutput, lastPage bool) bool {
+		associations = append(associations, page.Associations...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var ids []*string
+	for _, association := range associations {
+		// The creation date is only returned when describing the association
+		output, err := svc.DescribeAssociation(&ssm.DescribeAssociationInput{AssociationId: association.AssociationId})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !shouldIncludeSSMAssociation(output.AssociationDescription, excludeAfter, configObj) {
+			continue
+		}
+
+		exclude, err := hasSSMExcludeTag(svc, ssm.ResourceTypeForTaggingAssociation, association.AssociationId)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !exclude {
+			ids = append(ids, association.AssociationId)
+		}
+	}
+	return ids, nil
+}
+
+func shouldIncludeSSMAssociation(association *ssm.AssociationDescription, excludeAfter time.Time, configObj config.Config) bool {
+	if association == nil {
+		return false
+	}
+
+	if association.Date != nil && excludeAfter.Before(*association.Date) {
+		return false
+	}
+
+	name := aws.StringValue(association.AssociationName)
+	if name == "" {
+		name = aws.StringValue(association.Name)
+	}
+	return config.ShouldInclude(
+		name,
+		configObj.SSMAssociation.IncludeRule.NamesRegExp,
+		configObj.SSMAssociation.ExcludeRule.NamesRegExp,
+	)
+}
+
+func nukeSSMDocument(svc ssmiface.SSMAPI, name *string) error {
+	_, err := svc.DeleteDocument(&ssm.DeleteDocumentInput{Name: name})
+	return errors.WithStackTrace(err)
+}
+
+func nukeSSMAssociation(svc ssmiface.SSMAPI, id *string) error {
+	_, err := svc.DeleteAssociation(&ssm.DeleteAssociationInput{AssociationId: id})
+	return errors.WithStackTrace(err)
+}
+
+// nukeSSMResources deletes the given SSM documents or associations using deleteFn, recording the status of each of
+// them.
+func nukeSSMResources(session *session.Session, resourceType string, identifiers []*string, deleteFn func(svc ssmiface.SSMAPI, identifier *string) error) error {
+	svc := ssm.New(session)
+
+	if len(identifiers) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedIdentifiers []*string
+	var allErrs *multierror.Error
+
+	for _, identifier := range identifiers {
+		err := deleteFn(svc, identifier)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(identifier),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIdentifiers = append(deletedIdentifiers, identifier)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(identifier))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedIdentifiers), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// Deletes all SSM documents owned by the account
+func nukeAllSSMDocuments(session *session.Session, names []*string) error {
+	return nukeSSMResources(session, "SSM Document", names, nukeSSMDocument)
+}
+
+// Deletes all State Manager associations
+func nukeAllSSMAssociations(session *session.Session, ids []*string) error {
+	return nukeSSMResources(session, "SSM Association", ids, nukeSSMAssociation)
+}
@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldIncludeSSMDocument(t *testing.T) {
+	document := &ssm.DocumentIdentifier{
+		Name:        aws.String("cloud-nuke-test"),
+		Owner:       aws.String("123456789012"),
+		CreatedDate: aws.Time(time.Now()),
+	}
+	awsOwned := &ssm.DocumentIdentifier{
+		Name:        aws.String("AWS-RunShellScript"),
+		Owner:       aws.String(awsSSMDocumentOwner),
+		CreatedDate: aws.Time(time.Now()),
+	}
+
+	assert.True(t, shouldIncludeSSMDocument(document, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSSMDocument(document, time.Now().Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSSMDocument(awsOwned, time.Now().Add(1*time.Hour), config.Config{}))
+}
+
+func TestShouldIncludeSSMAssociationFallsBackToDocumentName(t *testing.T) {
+	excludeConfig := config.Config{
+		SSMAssociation: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^AWS-")}},
+			},
+		},
+	}
+	unnamed := &ssm.AssociationDescription{
+		Name: aws.String("AWS-GatherSoftwareInventory"),
+		Date: aws.Time(time.Now()),
+	}
+	named := &ssm.AssociationDescription{
+		AssociationName: aws.String("cloud-nuke-test"),
+		Name:            aws.String("AWS-GatherSoftwareInventory"),
+		Date:            aws.Time(time.Now()),
+	}
+
+	assert.False(t, shouldIncludeSSMAssociation(unnamed, time.Now().Add(1*time.Hour), excludeConfig))
+	assert.True(t, shouldIncludeSSMAssociation(named, time.Now().Add(1*time.Hour), excludeConfig))
+	assert.False(t, shouldIncludeSSMAssociation(named, time.Now().Add(-1*time.Hour), config.Config{}))
+}
@@ -0,0 +1,65 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// SSMAssociations - represents all State Manager associations
+type SSMAssociations struct {
+	AssociationIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (associations SSMAssociations) ResourceName() string {
+	return "ssm-association"
+}
+
+// ResourceIdentifiers - The IDs of the State Manager associations
+func (associations SSMAssociations) ResourceIdentifiers() []string {
+	return associations.AssociationIds
+}
+
+func (associations SSMAssociations) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (associations SSMAssociations) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSSMAssociations(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// SSMDocuments - represents all SSM documents owned by the account
+type SSMDocuments struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (documents SSMDocuments) ResourceName() string {
+	return "ssm-document"
+}
+
+// ResourceIdentifiers - The names of the SSM documents
+func (documents SSMDocuments) ResourceIdentifiers() []string {
+	return documents.Names
+}
+
+func (documents SSMDocuments) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (documents SSMDocuments) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSSMDocuments(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
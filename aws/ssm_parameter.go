@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// DeleteParameters accepts at most this many parameter names per call
+const ssmDeleteParametersLimit = 10
+
+// Returns a formatted string of SSM parameter names
+func getAllSSMParameters(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := ssm.New(session)
+
+	var parameters []*ssm.ParameterMetadata
+	err := svc.DescribeParametersPages(&ssm.DescribeParametersInput{MaxResults: aws.Int64(50)}, func(page *ssm.DescribeParametersOutput, lastPage bool) bool {
+		for _, parameter := range page.Parameters {
+			if shouldIncludeSSMParameter(parameter, excludeAfter, configObj) {
+				parameters = append(parameters, parameter)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, parameter := range parameters {
+		exclude, err := hasSSMExcludeTag(svc, ssm.ResourceTypeForTaggingParameter, parameter.Name)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !exclude {
+			names = append(names, parameter.Name)
+		}
+	}
+	return names, nil
+}
+
+func shouldIncludeSSMParameter(parameter *ssm.ParameterMetadata, excludeAfter time.Time, configObj config.Config) bool {
+	if parameter == nil {
+		return false
+	}
+
+	if parameter.LastModifiedDate != nil && excludeAfter.Before(*parameter.LastModifiedDate) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(parameter.Name),
+		configObj.SSMParameter.IncludeRule.NamesRegExp,
+		configObj.SSMParameter.ExcludeRule.NamesRegExp,
+	)
+}
+
+// hasSSMExcludeTag checks whether the exclude tag is set on the SSM resource of the given type
+func hasSSMExcludeTag(svc ssmiface.SSMAPI, resourceType string, resourceId *string) (bool, error) {
+	output, err := svc.ListTagsForResource(&ssm.ListTagsForResourceInput{
+		ResourceType: aws.String(resourceType),
+		ResourceId:   resourceId,
+	})
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+
+	for _, tag := range output.TagList {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Deletes all SSM parameters
+func nukeAllSSMParameters(session *session.Session, names []*string) error {
+	region := aws.StringValue(session.Config.Region)
+
+	svc := ssm.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No SSM Parameters to nuke in region %s", region)
+		return nil
+	}
+
+	// NOTE: we don't need to do pagination here, because the pagination is handled by the caller to this function,
+	// based on SSMParameters.MaxBatchSize, however we add a guard here to warn users when the batching fails.
+	if len(names) > ssmDeleteParametersLimit {
+		logging.Logger.Errorf("Nuking too many SSM Parameters at once (%d): halting as DeleteParameters can't take more", ssmDeleteParametersLimit)
+		return TooManySSMParametersErr{}
+	}
+
+	logging.Logger.Debugf("Deleting SSM Parameters in region %s", region)
+	output, err := svc.DeleteParameters(&ssm.DeleteParametersInput{Names: names})
+	if err != nil {
+		report.RecordBatch(report.BatchEntry{
+			Identifiers:  aws.StringValueSlice(names),
+			ResourceType: "SSM Parameter",
+			Error:        err,
+		})
+
+		logging.Logger.Debugf("[Failed] %s", err)
+		telemetry.TrackEvent(commonTelemetry.EventContext{
+			EventName: "Error Nuking SSM Parameter",
+		}, map[string]interface{}{
+			"region": region,
+		})
+		return errors.WithStackTrace(err)
+	}
+
+	// Record status of this resource
+	report.RecordBatch(report.BatchEntry{
+		Identifiers:  aws.StringValueSlice(output.DeletedParameters),
+		ResourceType: "SSM Parameter",
+	})
+
+	// Parameters are reported as invalid when they no longer exist, e.g. when they were deleted in the meantime
+	for _, name := range output.InvalidParameters {
+		logging.Logger.Debugf("SSM Parameter %s was not found in %s", aws.StringValue(name), region)
+	}
+
+	logging.Logger.Debugf("[OK] %d SSM Parameter(s) deleted in %s", len(output.DeletedParameters), region)
+	return nil
+}
+
+// Custom errors
+
+type TooManySSMParametersErr struct{}
+
+func (err TooManySSMParametersErr) Error() string {
+	return "Too many SSM Parameters requested at once."
+}
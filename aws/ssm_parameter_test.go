@@ -0,0 +1,56 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedSSMTags struct {
+	ssmiface.SSMAPI
+	Tags map[string][]*ssm.Tag
+}
+
+func (m *mockedSSMTags) ListTagsForResource(input *ssm.ListTagsForResourceInput) (*ssm.ListTagsForResourceOutput, error) {
+	return &ssm.ListTagsForResourceOutput{TagList: m.Tags[aws.StringValue(input.ResourceId)]}, nil
+}
+
+func TestShouldIncludeSSMParameter(t *testing.T) {
+	parameter := &ssm.ParameterMetadata{
+		Name:             aws.String("/cloud-nuke-test/password"),
+		LastModifiedDate: aws.Time(time.Now()),
+	}
+	excludeConfig := config.Config{
+		SSMParameter: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^/cloud-nuke-test/")}},
+			},
+		},
+	}
+
+	assert.True(t, shouldIncludeSSMParameter(parameter, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSSMParameter(parameter, time.Now().Add(-1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeSSMParameter(parameter, time.Now().Add(1*time.Hour), excludeConfig))
+}
+
+func TestHasSSMExcludeTag(t *testing.T) {
+	svc := &mockedSSMTags{Tags: map[string][]*ssm.Tag{
+		"excluded": {{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}},
+		"included": {{Key: aws.String("Name"), Value: aws.String("included")}},
+	}}
+
+	exclude, err := hasSSMExcludeTag(svc, ssm.ResourceTypeForTaggingParameter, aws.String("excluded"))
+	require.NoError(t, err)
+	assert.True(t, exclude)
+
+	exclude, err = hasSSMExcludeTag(svc, ssm.ResourceTypeForTaggingParameter, aws.String("included"))
+	require.NoError(t, err)
+	assert.False(t, exclude)
+}
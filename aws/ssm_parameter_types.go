@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// SSMParameters - represents all SSM Parameter Store parameters
+type SSMParameters struct {
+	Names []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (parameters SSMParameters) ResourceName() string {
+	return "ssm-parameter"
+}
+
+// ResourceIdentifiers - The names of the SSM parameters
+func (parameters SSMParameters) ResourceIdentifiers() []string {
+	return parameters.Names
+}
+
+func (parameters SSMParameters) MaxBatchSize() int {
+	// DeleteParameters accepts at most 10 parameters at once
+	return ssmDeleteParametersLimit
+}
+
+// Nuke - nuke 'em all!!!
+func (parameters SSMParameters) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllSSMParameters(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
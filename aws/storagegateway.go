@@ -0,0 +1,204 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/storagegateway/storagegatewayiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of Storage Gateway ARNs. Gateways don't expose a creation time, so the first seen tag is
+// used for the excludeAfter filter instead.
+func getAllStorageGateways(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := storagegateway.New(session)
+
+	var gateways []*storagegateway.GatewayInfo
+	err := svc.ListGatewaysPages(&storagegateway.ListGatewaysInput{}, func(page *storagegateway.ListGatewaysOutput, lastPage bool) bool {
+		for _, gateway := range page.Gateways {
+			if config.ShouldInclude(aws.StringValue(gateway.GatewayName), configObj.StorageGateway.IncludeRule.NamesRegExp, configObj.StorageGateway.ExcludeRule.NamesRegExp) {
+				gateways = append(gateways, gateway)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var arns []*string
+	for _, gateway := range gateways {
+		tags := map[string]string{}
+		err := svc.ListTagsForResourcePages(
+			&storagegateway.ListTagsForResourceInput{ResourceARN: gateway.GatewayARN},
+			func(page *storagegateway.ListTagsForResourceOutput, lastPage bool) bool {
+				for _, tag := range page.Tags {
+					tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+				}
+				return !lastPage
+			},
+		)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if tags[AwsResourceExclusionTagKey] == "true" {
+			continue
+		}
+
+		firstSeenTime, err := getOrSetFirstSeenStorageGatewayTag(svc, gateway.GatewayARN, tags)
+		if err != nil {
+			logging.Logger.Errorf("Unable to tag Storage Gateway %s: %s", aws.StringValue(gateway.GatewayARN), err)
+			return nil, errors.WithStackTrace(err)
+		}
+		if excludeAfter.After(firstSeenTime) {
+			arns = append(arns, gateway.GatewayARN)
+		}
+	}
+	return arns, nil
+}
+
+// getOrSetFirstSeenStorageGatewayTag returns the time cloud-nuke first saw the gateway, tagging it with the current
+// time the first time it is seen.
+func getOrSetFirstSeenStorageGatewayTag(svc storagegatewayiface.StorageGatewayAPI, gatewayArn *string, tags map[string]string) (time.Time, error) {
+	if value, ok := tags[firstSeenTagKey]; ok {
+		firstSeenTime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, errors.WithStackTrace(err)
+		}
+		return firstSeenTime, nil
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.AddTagsToResource(&storagegateway.AddTagsToResourceInput{
+		ResourceARN: gatewayArn,
+		Tags:        []*storagegateway.Tag{{Key: aws.String(firstSeenTagKey), Value: aws.String(now.Format(time.RFC3339))}},
+	})
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return now, nil
+}
+
+func listStorageGatewayFileShares(svc storagegatewayiface.StorageGatewayAPI, gatewayArn *string) ([]*storagegateway.FileShareInfo, error) {
+	var fileShares []*storagegateway.FileShareInfo
+	err := svc.ListFileSharesPages(&storagegateway.ListFileSharesInput{GatewayARN: gatewayArn}, func(page *storagegateway.ListFileSharesOutput, lastPage bool) bool {
+		fileShares = append(fileShares, page.FileShareInfoList...)
+		return !lastPage
+	})
+	return fileShares, errors.WithStackTrace(err)
+}
+
+// waitForStorageGatewayFileSharesToBeDeleted waits until the file shares of the gateway are deleted, which happens
+// asynchronously.
+func waitForStorageGatewayFileSharesToBeDeleted(svc storagegatewayiface.StorageGatewayAPI, gatewayArn *string) error {
+	for i := 0; i < 30; i++ {
+		fileShares, err := listStorageGatewayFileShares(svc, gatewayArn)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		if len(fileShares) == 0 {
+			return nil
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for %d file share(s) of Storage Gateway %s to be deleted", len(fileShares), aws.StringValue(gatewayArn))
+	}
+
+	return StorageGatewayFileSharesDeleteTimeoutError{gatewayArn: aws.StringValue(gatewayArn)}
+}
+
+// nukeStorageGateway deletes the file shares and volumes of the gateway, as deleting a gateway leaves them behind
+// along with their cache volumes and snapshots, and then the gateway itself.
+func nukeStorageGateway(svc storagegatewayiface.StorageGatewayAPI, gatewayArn *string) error {
+	fileShares, err := listStorageGatewayFileShares(svc, gatewayArn)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, fileShare := range fileShares {
+		// Force the deletion, as the file share would otherwise wait for its data to be uploaded to S3
+		_, err := svc.DeleteFileShare(&storagegateway.DeleteFileShareInput{
+			FileShareARN: fileShare.FileShareARN,
+			ForceDelete:  aws.Bool(true),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted file share %s of Storage Gateway %s", aws.StringValue(fileShare.FileShareARN), aws.StringValue(gatewayArn))
+	}
+	if len(fileShares) > 0 {
+		if err := waitForStorageGatewayFileSharesToBeDeleted(svc, gatewayArn); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	var volumeArns []*string
+	err = svc.ListVolumesPages(&storagegateway.ListVolumesInput{GatewayARN: gatewayArn}, func(page *storagegateway.ListVolumesOutput, lastPage bool) bool {
+		for _, volume := range page.VolumeInfos {
+			volumeArns = append(volumeArns, volume.VolumeARN)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	for _, volumeArn := range volumeArns {
+		if _, err := svc.DeleteVolume(&storagegateway.DeleteVolumeInput{VolumeARN: volumeArn}); err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted volume %s of Storage Gateway %s", aws.StringValue(volumeArn), aws.StringValue(gatewayArn))
+	}
+
+	_, err = svc.DeleteGateway(&storagegateway.DeleteGatewayInput{GatewayARN: gatewayArn})
+	return errors.WithStackTrace(err)
+}
+
+// Deletes all Storage Gateways, along with their file shares and volumes
+func nukeAllStorageGateways(session *session.Session, arns []*string) error {
+	svc := storagegateway.New(session)
+
+	if len(arns) == 0 {
+		logging.Logger.Debugf("No Storage Gateways to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Storage Gateways in region %s", *session.Config.Region)
+	var deletedArns []*string
+	var allErrs *multierror.Error
+
+	for _, arn := range arns {
+		err := nukeStorageGateway(svc, arn)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(arn),
+			ResourceType: "Storage Gateway",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Storage Gateway",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedArns = append(deletedArns, arn)
+			logging.Logger.Debugf("Deleted Storage Gateway: %s", aws.StringValue(arn))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Storage Gateway(s) deleted in %s", len(deletedArns), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/storagegateway/storagegatewayiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedStorageGateway struct {
+	storagegatewayiface.StorageGatewayAPI
+	FileShares []*storagegateway.FileShareInfo
+	Calls      []string
+}
+
+func (m *mockedStorageGateway) ListFileSharesPages(input *storagegateway.ListFileSharesInput, fn func(*storagegateway.ListFileSharesOutput, bool) bool) error {
+	fn(&storagegateway.ListFileSharesOutput{FileShareInfoList: m.FileShares}, true)
+	return nil
+}
+
+func (m *mockedStorageGateway) DeleteFileShare(input *storagegateway.DeleteFileShareInput) (*storagegateway.DeleteFileShareOutput, error) {
+	m.Calls = append(m.Calls, "delete-file-share/"+aws.StringValue(input.FileShareARN))
+	// The file share is gone by the time the gateway lists its file shares again
+	m.FileShares = nil
+	return &storagegateway.DeleteFileShareOutput{}, nil
+}
+
+func (m *mockedStorageGateway) ListVolumesPages(input *storagegateway.ListVolumesInput, fn func(*storagegateway.ListVolumesOutput, bool) bool) error {
+	fn(&storagegateway.ListVolumesOutput{VolumeInfos: []*storagegateway.VolumeInfo{{VolumeARN: aws.String("volume")}}}, true)
+	return nil
+}
+
+func (m *mockedStorageGateway) DeleteVolume(input *storagegateway.DeleteVolumeInput) (*storagegateway.DeleteVolumeOutput, error) {
+	m.Calls = append(m.Calls, "delete-volume/"+aws.StringValue(input.VolumeARN))
+	return &storagegateway.DeleteVolumeOutput{}, nil
+}
+
+func (m *mockedStorageGateway) DeleteGateway(input *storagegateway.DeleteGatewayInput) (*storagegateway.DeleteGatewayOutput, error) {
+	m.Calls = append(m.Calls, "delete-gateway/"+aws.StringValue(input.GatewayARN))
+	return &storagegateway.DeleteGatewayOutput{}, nil
+}
+
+func TestNukeStorageGatewayDeletesFileSharesAndVolumesFirst(t *testing.T) {
+	svc := &mockedStorageGateway{FileShares: []*storagegateway.FileShareInfo{{FileShareARN: aws.String("file-share")}}}
+	require.NoError(t, nukeStorageGateway(svc, aws.String("gateway")))
+	assert.Equal(t, []string{"delete-file-share/file-share", "delete-volume/volume", "delete-gateway/gateway"}, svc.Calls)
+}
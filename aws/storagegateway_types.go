@@ -0,0 +1,44 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// StorageGateways - represents all Storage Gateway gateways
+type StorageGateways struct {
+	GatewayArns []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (gateways StorageGateways) ResourceName() string {
+	return "storage-gateway"
+}
+
+// ResourceIdentifiers - The ARNs of the Storage Gateway gateways
+func (gateways StorageGateways) ResourceIdentifiers() []string {
+	return gateways.GatewayArns
+}
+
+func (gateways StorageGateways) MaxBatchSize() int {
+	// Deleting the file shares of a gateway takes a while, so we keep the batches small
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (gateways StorageGateways) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllStorageGateways(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type StorageGatewayFileSharesDeleteTimeoutError struct {
+	gatewayArn string
+}
+
+func (e StorageGatewayFileSharesDeleteTimeoutError) Error() string {
+	return "Timed out waiting for the file shares of Storage Gateway " + e.gatewayArn + " to be deleted"
+}
@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite/timestreamwriteiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of Timestream database names
+func getAllTimestreamDatabases(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := timestreamwrite.New(session)
+
+	var candidates []*timestreamwrite.Database
+	err := svc.ListDatabasesPages(&timestreamwrite.ListDatabasesInput{}, func(page *timestreamwrite.ListDatabasesOutput, lastPage bool) bool {
+		for _, database := range page.Databases {
+			if shouldIncludeTimestreamDatabase(database, excludeAfter, configObj) {
+				candidates = append(candidates, database)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var names []*string
+	for _, database := range candidates {
+		tags, err := svc.ListTagsForResource(&timestreamwrite.ListTagsForResourceInput{ResourceARN: database.Arn})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !hasTimestreamExcludeTag(tags.Tags) {
+			names = append(names, database.DatabaseName)
+		}
+	}
+	return names, nil
+}
+
+func shouldIncludeTimestreamDatabase(database *timestreamwrite.Database, excludeAfter time.Time, configObj config.Config) bool {
+	if database == nil {
+		return false
+	}
+
+	if database.CreationTime != nil && excludeAfter.Before(*database.CreationTime) {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(database.DatabaseName),
+		configObj.TimestreamDatabase.IncludeRule.NamesRegExp,
+		configObj.TimestreamDatabase.ExcludeRule.NamesRegExp,
+	)
+}
+
+func hasTimestreamExcludeTag(tags []*timestreamwrite.Tag) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == AwsResourceExclusionTagKey && aws.StringValue(tag.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+func listTimestreamTables(svc timestreamwriteiface.TimestreamWriteAPI, databaseName *string) ([]*timestreamwrite.Table, error) {
+	var tables []*timestreamwrite.Table
+	err := svc.ListTablesPages(&timestreamwrite.ListTablesInput{DatabaseName: databaseName}, func(page *timestreamwrite.ListTablesOutput, lastPage bool) bool {
+		tables = append(tables, page.Tables...)
+		return !lastPage
+	})
+	return tables, errors.WithStackTrace(err)
+}
+
+// nukeTimestreamDatabase deletes all the tables of the database, and once they are gone, the database itself, since
+// a database can't be deleted while it still has tables.
+func nukeTimestreamDatabase(svc timestreamwriteiface.TimestreamWriteAPI, databaseName *string) error {
+	tables, err := listTimestreamTables(svc, databaseName)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, table := range tables {
+		if aws.StringValue(table.TableStatus) == timestreamwrite.TableStatusDeleting {
+			continue
+		}
+
+		_, err := svc.DeleteTable(&timestreamwrite.DeleteTableInput{
+			DatabaseName: databaseName,
+			TableName:    table.TableName,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted Timestream table %s of database %s", aws.StringValue(table.TableName), aws.StringValue(databaseName))
+	}
+
+	if len(tables) > 0 {
+		if err := waitForTimestreamTablesToBeDeleted(svc, databaseName); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+
+	_, err = svc.DeleteDatabase(&timestreamwrite.DeleteDatabaseInput{DatabaseName: databaseName})
+	return errors.WithStackTrace(err)
+}
+
+func waitForTimestreamTablesToBeDeleted(svc timestreamwriteiface.TimestreamWriteAPI, databaseName *string) error {
+	for i := 0; i < 30; i++ {
+		tables, err := listTimestreamTables(svc, databaseName)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		if len(tables) == 0 {
+			return nil
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for %d table(s) of Timestream database %s to be deleted", len(tables), aws.StringValue(databaseName))
+	}
+
+	return TimestreamTablesDeleteTimeoutError{databaseName: aws.StringValue(databaseName)}
+}
+
+// Deletes all Timestream databases, along with their tables
+func nukeAllTimestreamDatabases(session *session.Session, names []*string) error {
+	svc := timestreamwrite.New(session)
+
+	if len(names) == 0 {
+		logging.Logger.Debugf("No Timestream databases to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Timestream databases in region %s", *session.Config.Region)
+	var deletedNames []*string
+	var allErrs *multierror.Error
+
+	for _, name := range names {
+		err := nukeTimestreamDatabase(svc, name)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(name),
+			ResourceType: "Timestream Database",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Timestream Database",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedNames = append(deletedNames, name)
+			logging.Logger.Debugf("Deleted Timestream database: %s", aws.StringValue(name))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Timestream database(s) deleted in %s", len(deletedNames), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite/timestreamwriteiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedTimestreamDatabase struct {
+	timestreamwriteiface.TimestreamWriteAPI
+	Tables []string
+	Calls  []string
+}
+
+func (m *mockedTimestreamDatabase) ListTablesPages(input *timestreamwrite.ListTablesInput, fn func(*timestreamwrite.ListTablesOutput, bool) bool) error {
+	var tables []*timestreamwrite.Table
+	for _, table := range m.Tables {
+		tables = append(tables, &timestreamwrite.Table{
+			TableName:   aws.String(table),
+			TableStatus: aws.String(timestreamwrite.TableStatusActive),
+		})
+	}
+	fn(&timestreamwrite.ListTablesOutput{Tables: tables}, true)
+	return nil
+}
+
+func (m *mockedTimestreamDatabase) DeleteTable(input *timestreamwrite.DeleteTableInput) (*timestreamwrite.DeleteTableOutput, error) {
+	m.Calls = append(m.Calls, "delete-table/"+aws.StringValue(input.TableName))
+	for i, table := range m.Tables {
+		if table == aws.StringValue(input.TableName) {
+			m.Tables = append(m.Tables[:i], m.Tables[i+1:]...)
+			break
+		}
+	}
+	return &timestreamwrite.DeleteTableOutput{}, nil
+}
+
+func (m *mockedTimestreamDatabase) DeleteDatabase(input *timestreamwrite.DeleteDatabaseInput) (*timestreamwrite.DeleteDatabaseOutput, error) {
+	m.Calls = append(m.Calls, "delete-database/"+aws.StringValue(input.DatabaseName))
+	return &timestreamwrite.DeleteDatabaseOutput{}, nil
+}
+
+func TestNukeTimestreamDatabaseDeletesTablesFirst(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedTimestreamDatabase{Tables: []string{"metrics", "events"}}
+	require.NoError(t, nukeTimestreamDatabase(mock, aws.String("database")))
+	assert.Equal(t, []string{"delete-table/metrics", "delete-table/events", "delete-database/database"}, mock.Calls)
+}
+
+func TestShouldIncludeTimestreamDatabase(t *testing.T) {
+	database := &timestreamwrite.Database{
+		DatabaseName: aws.String("cloud-nuke-test"),
+		CreationTime: aws.Time(time.Now()),
+	}
+
+	assert.True(t, shouldIncludeTimestreamDatabase(database, time.Now().Add(1*time.Hour), config.Config{}))
+	assert.False(t, shouldIncludeTimestreamDatabase(database, time.Now().Add(-1*time.Hour), config.Config{}))
+}
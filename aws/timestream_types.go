@@ -0,0 +1,44 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// TimestreamDatabases - represents all Timestream databases
+type TimestreamDatabases struct {
+	DatabaseNames []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (databases TimestreamDatabases) ResourceName() string {
+	return "timestream-database"
+}
+
+// ResourceIdentifiers - The names of the Timestream databases
+func (databases TimestreamDatabases) ResourceIdentifiers() []string {
+	return databases.DatabaseNames
+}
+
+func (databases TimestreamDatabases) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (databases TimestreamDatabases) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllTimestreamDatabases(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type TimestreamTablesDeleteTimeoutError struct {
+	databaseName string
+}
+
+func (e TimestreamTablesDeleteTimeoutError) Error() string {
+	return "Timed out waiting for the tables of Timestream database " + e.databaseName + " to be deleted"
+}
@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/transfer/transferiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of Transfer Family server IDs. Servers only have a name through their Name tag, so they are
+// filtered on it, falling back to their ID when they don't have one. Servers don't expose a creation time either, so
+// the first seen tag is used for the excludeAfter filter instead.
+func getAllTransferServers(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := transfer.New(session)
+
+	var servers []*transfer.ListedServer
+	err := svc.ListServersPages(&transfer.ListServersInput{}, func(page *transfer.ListServersOutput, lastPage bool) bool {
+		servers = append(servers, page.Servers...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var ids []*string
+	for _, server := range servers {
+		tags := map[string]string{}
+		err := svc.ListTagsForResourcePages(&transfer.ListTagsForResourceInput{Arn: server.Arn}, func(page *transfer.ListTagsForResourceOutput, lastPage bool) bool {
+			for _, tag := range page.Tags {
+				tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		if !shouldIncludeTransferServer(server, tags, configObj) {
+			continue
+		}
+
+		firstSeenTime, err := getOrSetFirstSeenTransferServerTag(svc, server.Arn, tags)
+		if err != nil {
+			logging.Logger.Errorf("Unable to tag Transfer Family server %s: %s", aws.StringValue(server.ServerId), err)
+			return nil, errors.WithStackTrace(err)
+		}
+		if excludeAfter.After(firstSeenTime) {
+			ids = append(ids, server.ServerId)
+		}
+	}
+	return ids, nil
+}
+
+func shouldIncludeTransferServer(server *transfer.ListedServer, tags map[string]string, configObj config.Config) bool {
+	if server == nil || tags[AwsResourceExclusionTagKey] == "true" {
+		return false
+	}
+
+	name, ok := tags["Name"]
+	if !ok {
+		name = aws.StringValue(server.ServerId)
+	}
+	return config.ShouldInclude(
+		name,
+		configObj.TransferServer.IncludeRule.NamesRegExp,
+		configObj.TransferServer.ExcludeRule.NamesRegExp,
+	)
+}
+
+// getOrSetFirstSeenTransferServerTag returns the time cloud-nuke first saw the server, tagging it with the current
+// time the first time it is seen.
+func getOrSetFirstSeenTransferServerTag(svc transferiface.TransferAPI, serverArn *string, tags map[string]string) (time.Time, error) {
+	if value, ok := tags[firstSeenTagKey]; ok {
+		firstSeenTime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, errors.WithStackTrace(err)
+		}
+		return firstSeenTime, nil
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.TagResource(&transfer.TagResourceInput{
+		Arn:  serverArn,
+		Tags: []*transfer.Tag{{Key: aws.String(firstSeenTagKey), Value: aws.String(now.Format(time.RFC3339))}},
+	})
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return now, nil
+}
+
+// nukeTransferServer deletes the users of the server and then the server itself
+func nukeTransferServer(svc transferiface.TransferAPI, serverId *string) error {
+	var userNames []*string
+	err := svc.ListUsersPages(&transfer.ListUsersInput{ServerId: serverId}, func(page *transfer.ListUsersOutput, lastPage bool) bool {
+		for _, user := range page.Users {
+			userNames = append(userNames, user.UserName)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	for _, userName := range userNames {
+		_, err := svc.DeleteUser(&transfer.DeleteUserInput{ServerId: serverId, UserName: userName})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Deleted user %s of Transfer Family server %s", aws.StringValue(userName), aws.StringValue(serverId))
+	}
+
+	_, err = svc.DeleteServer(&transfer.DeleteServerInput{ServerId: serverId})
+	return errors.WithStackTrace(err)
+}
+
+// Deletes all Transfer Family servers, along with their users
+func nukeAllTransferServers(session *session.Session, ids []*string) error {
+	svc := transfer.New(session)
+
+	if len(ids) == 0 {
+		logging.Logger.Debugf("No Transfer Family servers to nuke in region %s", *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all Transfer Family servers in region %s", *session.Config.Region)
+	var deletedIds []*string
+	var allErrs *multierror.Error
+
+	for _, id := range ids {
+		err := nukeTransferServer(svc, id)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(id),
+			ResourceType: "Transfer Family Server",
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Transfer Family Server",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIds = append(deletedIds, id)
+			logging.Logger.Debugf("Deleted Transfer Family server: %s", aws.StringValue(id))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d Transfer Family server(s) deleted in %s", len(deletedIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/transfer/transferiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldIncludeTransferServer(t *testing.T) {
+	server := &transfer.ListedServer{ServerId: aws.String("s-0123456789abcdef0")}
+	excludeConfig := config.Config{
+		TransferServer: config.ResourceType{
+			ExcludeRule: config.FilterRule{
+				NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^demo-")}},
+			},
+		},
+	}
+
+	assert.True(t, shouldIncludeTransferServer(server, map[string]string{}, excludeConfig))
+	assert.False(t, shouldIncludeTransferServer(server, map[string]string{"Name": "demo-sftp"}, excludeConfig))
+	assert.False(t, shouldIncludeTransferServer(server, map[string]string{AwsResourceExclusionTagKey: "true"}, config.Config{}))
+}
+
+type mockedTransferServer struct {
+	transferiface.TransferAPI
+	Calls []string
+}
+
+func (m *mockedTransferServer) ListUsersPages(input *transfer.ListUsersInput, fn func(*transfer.ListUsersOutput, bool) bool) error {
+	fn(&transfer.ListUsersOutput{Users: []*transfer.ListedUser{{UserName: aws.String("demo")}}}, true)
+	return nil
+}
+
+func (m *mockedTransferServer) DeleteUser(input *transfer.DeleteUserInput) (*transfer.DeleteUserOutput, error) {
+	m.Calls = append(m.Calls, "delete-user/"+aws.StringValue(input.UserName))
+	return &transfer.DeleteUserOutput{}, nil
+}
+
+func (m *mockedTransferServer) DeleteServer(input *transfer.DeleteServerInput) (*transfer.DeleteServerOutput, error) {
+	m.Calls = append(m.Calls, "delete-server/"+aws.StringValue(input.ServerId))
+	return &transfer.DeleteServerOutput{}, nil
+}
+
+func TestNukeTransferServerDeletesUsersFirst(t *testing.T) {
+	svc := &mockedTransferServer{}
+	require.NoError(t, nukeTransferServer(svc, aws.String("s-0123456789abcdef0")))
+	assert.Equal(t, []string{"delete-user/demo", "delete-server/s-0123456789abcdef0"}, svc.Calls)
+}
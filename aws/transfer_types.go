@@ -0,0 +1,36 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// TransferServers - represents all AWS Transfer Family servers
+type TransferServers struct {
+	ServerIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (servers TransferServers) ResourceName() string {
+	return "transfer-server"
+}
+
+// ResourceIdentifiers - The IDs of the Transfer Family servers
+func (servers TransferServers) ResourceIdentifiers() []string {
+	return servers.ServerIds
+}
+
+func (servers TransferServers) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (servers TransferServers) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllTransferServers(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
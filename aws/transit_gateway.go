@@ -10,9 +10,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
 	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
 )
 
 func sleepWithMessage(duration time.Duration, whySleepMessage string) {
@@ -49,6 +51,7 @@ func nukeAllTransitGatewayInstances(session *session.Session, ids []*string) err
 
 	logging.Logger.Debugf("Deleting all Transit Gateways in region %s", *session.Config.Region)
 	var deletedIds []*string
+	var allErrs *multierror.Error
 
 	for _, id := range ids {
 		params := &ec2.DeleteTransitGatewayInput{
@@ -72,6 +75,7 @@ func nukeAllTransitGatewayInstances(session *session.Session, ids []*string) err
 			}, map[string]interface{}{
 				"region": *session.Config.Region,
 			})
+			allErrs = multierror.Append(allErrs, err)
 		} else {
 			deletedIds = append(deletedIds, id)
 			logging.Logger.Debugf("Deleted Transit Gateway: %s", *id)
@@ -79,7 +83,7 @@ func nukeAllTransitGatewayInstances(session *session.Session, ids []*string) err
 	}
 
 	logging.Logger.Debugf("[OK] %d Transit Gateway(s) deleted in %s", len(deletedIds), *session.Config.Region)
-	return nil
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
 }
 
 // Returns a formatted string of TranstGatewayRouteTable IDs
@@ -124,6 +128,7 @@ func nukeAllTransitGatewayRouteTables(session *session.Session, ids []*string) e
 
 	logging.Logger.Debugf("Deleting all Transit Gateway Route Tables in region %s", *session.Config.Region)
 	var deletedIds []*string
+	var allErrs *multierror.Error
 
 	for _, id := range ids {
 		param := &ec2.DeleteTransitGatewayRouteTableInput{
@@ -131,8 +136,23 @@ func nukeAllTransitGatewayRouteTables(session *session.Session, ids []*string) e
 		}
 
 		_, err := svc.DeleteTransitGatewayRouteTable(param)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(id),
+			ResourceType: "Transit Gateway Route Table",
+			Error:        err,
+		}
+		report.Record(e)
+
 		if err != nil {
 			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Transit Gateway Route Table",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
 		} else {
 			deletedIds = append(deletedIds, id)
 			logging.Logger.Debugf("Deleted Transit Gateway Route Table: %s", *id)
@@ -140,68 +160,151 @@ func nukeAllTransitGatewayRouteTables(session *session.Session, ids []*string) e
 	}
 
 	logging.Logger.Debugf("[OK] %d Transit Gateway Route Table(s) deleted in %s", len(deletedIds), *session.Config.Region)
-	return nil
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
 }
 
-// Returns a formated string of TransitGatewayVpcAttachment IDs
-func getAllTransitGatewayVpcAttachments(session *session.Session, region string, excludeAfter time.Time) ([]*string, error) {
+// Returns a formatted string of TransitGatewayAttachment IDs. Only VPC, VPN and peering attachments are returned, as
+// the other attachment types are managed through other services or depend on one of these.
+func getAllTransitGatewayAttachments(session *session.Session, region string, excludeAfter time.Time) ([]*string, error) {
 	svc := ec2.New(session)
-	result, err := svc.DescribeTransitGatewayVpcAttachments(&ec2.DescribeTransitGatewayVpcAttachmentsInput{})
-	if err != nil {
-		return nil, errors.WithStackTrace(err)
+
+	param := &ec2.DescribeTransitGatewayAttachmentsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name: aws.String("resource-type"),
+				Values: aws.StringSlice([]string{
+					ec2.TransitGatewayAttachmentResourceTypeVpc,
+					ec2.TransitGatewayAttachmentResourceTypeVpn,
+					ec2.TransitGatewayAttachmentResourceTypePeering,
+				}),
+			},
+		},
 	}
 
 	var ids []*string
-	for _, tgwVpcAttachment := range result.TransitGatewayVpcAttachments {
-		if excludeAfter.After(*tgwVpcAttachment.CreationTime) && awsgo.StringValue(tgwVpcAttachment.State) != "deleted" && awsgo.StringValue(tgwVpcAttachment.State) != "deleting" {
-			ids = append(ids, tgwVpcAttachment.TransitGatewayAttachmentId)
+	err := svc.DescribeTransitGatewayAttachmentsPages(param, func(page *ec2.DescribeTransitGatewayAttachmentsOutput, lastPage bool) bool {
+		for _, tgwAttachment := range page.TransitGatewayAttachments {
+			if excludeAfter.After(*tgwAttachment.CreationTime) && awsgo.StringValue(tgwAttachment.State) != "deleted" && awsgo.StringValue(tgwAttachment.State) != "deleting" {
+				ids = append(ids, tgwAttachment.TransitGatewayAttachmentId)
+			}
 		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
 	}
 
 	return ids, nil
 }
 
-// Delete all TransitGatewayVpcAttachments
-func nukeAllTransitGatewayVpcAttachments(session *session.Session, ids []*string) error {
+// deleteTransitGatewayAttachment deletes the attachment using the API matching its type. VPN attachments are deleted
+// along with their VPN connection.
+func deleteTransitGatewayAttachment(svc ec2iface.EC2API, tgwAttachment *ec2.TransitGatewayAttachment) error {
+	var err error
+	switch awsgo.StringValue(tgwAttachment.ResourceType) {
+	case ec2.TransitGatewayAttachmentResourceTypeVpc:
+		_, err = svc.DeleteTransitGatewayVpcAttachment(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+			TransitGatewayAttachmentId: tgwAttachment.TransitGatewayAttachmentId,
+		})
+	case ec2.TransitGatewayAttachmentResourceTypeVpn:
+		_, err = svc.DeleteVpnConnection(&ec2.DeleteVpnConnectionInput{
+			VpnConnectionId: tgwAttachment.ResourceId,
+		})
+	case ec2.TransitGatewayAttachmentResourceTypePeering:
+		_, err = svc.DeleteTransitGatewayPeeringAttachment(&ec2.DeleteTransitGatewayPeeringAttachmentInput{
+			TransitGatewayAttachmentId: tgwAttachment.TransitGatewayAttachmentId,
+		})
+	default:
+		err = UnsupportedTransitGatewayAttachmentTypeError{
+			attachmentId: awsgo.StringValue(tgwAttachment.TransitGatewayAttachmentId),
+			resourceType: awsgo.StringValue(tgwAttachment.ResourceType),
+		}
+	}
+	return errors.WithStackTrace(err)
+}
+
+// waitForTransitGatewayAttachmentsToBeDeleted waits for the attachments to be detached, which takes a few minutes
+// and has to be done before the route tables and transit gateways can be deleted.
+func waitForTransitGatewayAttachmentsToBeDeleted(svc ec2iface.EC2API, ids []*string) error {
+	for i := 0; i < 60; i++ {
+		output, err := svc.DescribeTransitGatewayAttachments(&ec2.DescribeTransitGatewayAttachmentsInput{
+			TransitGatewayAttachmentIds: ids,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		remaining := 0
+		for _, tgwAttachment := range output.TransitGatewayAttachments {
+			if awsgo.StringValue(tgwAttachment.State) != ec2.TransitGatewayAttachmentStateDeleted {
+				remaining++
+			}
+		}
+		if remaining == 0 {
+			return nil
+		}
+
+		time.Sleep(10 * time.Second)
+		logging.Logger.Debugf("Waiting for %d Transit Gateway Attachment(s) to be deleted...", remaining)
+	}
+
+	return TransitGatewayAttachmentsDeleteTimeoutError{}
+}
+
+// Delete all TransitGatewayAttachments
+func nukeAllTransitGatewayAttachments(session *session.Session, ids []*string) error {
 	svc := ec2.New(session)
 
 	if len(ids) == 0 {
-		logging.Logger.Debugf("No Transit Gateway Vpc Attachments to nuke in region %s", *session.Config.Region)
+		logging.Logger.Debugf("No Transit Gateway Attachments to nuke in region %s", *session.Config.Region)
 		return nil
 	}
 
-	logging.Logger.Debugf("Deleting all Transit Gateway Vpc Attachments in region %s", *session.Config.Region)
-	var deletedIds []*string
+	logging.Logger.Debugf("Deleting all Transit Gateway Attachments in region %s", *session.Config.Region)
+	output, err := svc.DescribeTransitGatewayAttachments(&ec2.DescribeTransitGatewayAttachmentsInput{
+		TransitGatewayAttachmentIds: ids,
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
 
-	for _, id := range ids {
-		param := &ec2.DeleteTransitGatewayVpcAttachmentInput{
-			TransitGatewayAttachmentId: id,
-		}
+	var deletedIds []*string
+	var allErrs *multierror.Error
 
-		_, err := svc.DeleteTransitGatewayVpcAttachment(param)
+	for _, tgwAttachment := range output.TransitGatewayAttachments {
+		err := deleteTransitGatewayAttachment(svc, tgwAttachment)
 
 		// Record status of this resource
 		e := report.Entry{
-			Identifier:   aws.StringValue(id),
-			ResourceType: "Transit Gateway",
+			Identifier:   aws.StringValue(tgwAttachment.TransitGatewayAttachmentId),
+			ResourceType: "Transit Gateway Attachment",
 			Error:        err,
 		}
 		report.Record(e)
 
 		if err != nil {
 			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking Transit Gateway Attachment",
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
 		} else {
-			deletedIds = append(deletedIds, id)
-			logging.Logger.Debugf("Deleted Transit Gateway Vpc Attachment: %s", *id)
+			deletedIds = append(deletedIds, tgwAttachment.TransitGatewayAttachmentId)
+			logging.Logger.Debugf("Deleted Transit Gateway Attachment: %s", aws.StringValue(tgwAttachment.TransitGatewayAttachmentId))
 		}
 	}
 
-	sleepMessage := "TransitGateway Vpc Attachments takes some time to create, and since there is no waiter available, we sleep instead."
-	sleepFor := 180 * time.Second
-	sleepWithMessage(sleepFor, sleepMessage)
+	if len(deletedIds) > 0 {
+		if err := waitForTransitGatewayAttachmentsToBeDeleted(svc, deletedIds); err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			allErrs = multierror.Append(allErrs, err)
+		}
+	}
 
-	logging.Logger.Debugf(("[OK] %d Transit Gateway Vpc Attachment(s) deleted in %s"), len(deletedIds), *session.Config.Region)
-	return nil
+	logging.Logger.Debugf("[OK] %d Transit Gateway Attachment(s) deleted in %s", len(deletedIds), *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
 }
 
 func tgIsAvailableInRegion(session *session.Session, region string) (bool, error) {
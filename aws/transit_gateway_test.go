@@ -12,6 +12,7 @@ import (
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/gruntwork-io/go-commons/errors"
 )
@@ -263,14 +264,14 @@ func TestGetAllTransitGatewayVpcAttachment(t *testing.T) {
 	tgwName := "cloud-nuke-test-" + util.UniqueID()
 	tgwAttachment := createTestTransitGatewayVpcAttachment(t, session, tgwName)
 
-	defer nukeAllTransitGatewayVpcAttachments(session, []*string{tgwAttachment.TransitGatewayAttachmentId})
+	defer nukeAllTransitGatewayAttachments(session, []*string{tgwAttachment.TransitGatewayAttachmentId})
 	defer nukeAllTransitGatewayInstances(session, []*string{tgwAttachment.TransitGatewayId})
 
-	ids, err := getAllTransitGatewayVpcAttachments(session, region, time.Now().Add(1*time.Hour*-1))
+	ids, err := getAllTransitGatewayAttachments(session, region, time.Now().Add(1*time.Hour*-1))
 	require.NoError(t, err)
 	assert.NotContains(t, awsgo.StringValueSlice(ids), awsgo.StringValue(tgwAttachment.TransitGatewayAttachmentId))
 
-	ids, err = getAllTransitGatewayVpcAttachments(session, region, time.Now().Add(1*time.Hour))
+	ids, err = getAllTransitGatewayAttachments(session, region, time.Now().Add(1*time.Hour))
 	require.NoError(t, err)
 	assert.Contains(t, awsgo.StringValueSlice(ids), awsgo.StringValue(tgwAttachment.TransitGatewayAttachmentId))
 }
@@ -302,10 +303,69 @@ func TestNukeTransitGatewayVpcAttachment(t *testing.T) {
 	require.NoError(t, err)
 	defer nukeAllTransitGatewayInstances(session, []*string{tgwVpcAttachment.TransitGatewayId})
 
-	err = nukeAllTransitGatewayVpcAttachments(session, []*string{tgwVpcAttachment.TransitGatewayAttachmentId})
+	err = nukeAllTransitGatewayAttachments(session, []*string{tgwVpcAttachment.TransitGatewayAttachmentId})
 	require.NoError(t, err)
 
-	ids, err := getAllTransitGatewayVpcAttachments(session, region, time.Now().Add(1*time.Hour))
+	ids, err := getAllTransitGatewayAttachments(session, region, time.Now().Add(1*time.Hour))
 	require.NoError(t, err)
 	assert.NotContains(t, awsgo.StringValueSlice(ids), aws.StringValue(tgwVpcAttachment.TransitGatewayAttachmentId))
 }
+
+type mockedTransitGatewayAttachments struct {
+	ec2iface.EC2API
+	Deleted []string
+}
+
+func (m *mockedTransitGatewayAttachments) DeleteTransitGatewayVpcAttachment(input *ec2.DeleteTransitGatewayVpcAttachmentInput) (*ec2.DeleteTransitGatewayVpcAttachmentOutput, error) {
+	m.Deleted = append(m.Deleted, "vpc:"+aws.StringValue(input.TransitGatewayAttachmentId))
+	return &ec2.DeleteTransitGatewayVpcAttachmentOutput{}, nil
+}
+
+func (m *mockedTransitGatewayAttachments) DeleteVpnConnection(input *ec2.DeleteVpnConnectionInput) (*ec2.DeleteVpnConnectionOutput, error) {
+	m.Deleted = append(m.Deleted, "vpn:"+aws.StringValue(input.VpnConnectionId))
+	return &ec2.DeleteVpnConnectionOutput{}, nil
+}
+
+func (m *mockedTransitGatewayAttachments) DeleteTransitGatewayPeeringAttachment(input *ec2.DeleteTransitGatewayPeeringAttachmentInput) (*ec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+	m.Deleted = append(m.Deleted, "peering:"+aws.StringValue(input.TransitGatewayAttachmentId))
+	return &ec2.DeleteTransitGatewayPeeringAttachmentOutput{}, nil
+}
+
+func (m *mockedTransitGatewayAttachments) DescribeTransitGatewayAttachments(input *ec2.DescribeTransitGatewayAttachmentsInput) (*ec2.DescribeTransitGatewayAttachmentsOutput, error) {
+	var tgwAttachments []*ec2.TransitGatewayAttachment
+	for _, id := range input.TransitGatewayAttachmentIds {
+		tgwAttachments = append(tgwAttachments, &ec2.TransitGatewayAttachment{
+			TransitGatewayAttachmentId: id,
+			State:                      aws.String(ec2.TransitGatewayAttachmentStateDeleted),
+		})
+	}
+	return &ec2.DescribeTransitGatewayAttachmentsOutput{TransitGatewayAttachments: tgwAttachments}, nil
+}
+
+func TestDeleteTransitGatewayAttachmentByType(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedTransitGatewayAttachments{}
+	tgwAttachments := []*ec2.TransitGatewayAttachment{
+		{TransitGatewayAttachmentId: aws.String("tgw-attach-1"), ResourceType: aws.String(ec2.TransitGatewayAttachmentResourceTypeVpc), ResourceId: aws.String("vpc-1")},
+		{TransitGatewayAttachmentId: aws.String("tgw-attach-2"), ResourceType: aws.String(ec2.TransitGatewayAttachmentResourceTypeVpn), ResourceId: aws.String("vpn-1")},
+		{TransitGatewayAttachmentId: aws.String("tgw-attach-3"), ResourceType: aws.String(ec2.TransitGatewayAttachmentResourceTypePeering), ResourceId: aws.String("tgw-2")},
+	}
+	for _, tgwAttachment := range tgwAttachments {
+		require.NoError(t, deleteTransitGatewayAttachment(mock, tgwAttachment))
+	}
+	assert.Equal(t, []string{"vpc:tgw-attach-1", "vpn:vpn-1", "peering:tgw-attach-3"}, mock.Deleted)
+
+	err := deleteTransitGatewayAttachment(mock, &ec2.TransitGatewayAttachment{
+		TransitGatewayAttachmentId: aws.String("tgw-attach-4"),
+		ResourceType:               aws.String(ec2.TransitGatewayAttachmentResourceTypeDirectConnectGateway),
+	})
+	assert.Error(t, err)
+}
+
+func TestWaitForTransitGatewayAttachmentsToBeDeleted(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedTransitGatewayAttachments{}
+	require.NoError(t, waitForTransitGatewayAttachmentsToBeDeleted(mock, aws.StringSlice([]string{"tgw-attach-1", "tgw-attach-2"})))
+}
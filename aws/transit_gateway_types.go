@@ -6,7 +6,7 @@ import (
 	"github.com/gruntwork-io/go-commons/errors"
 )
 
-// TransitGatewaysVpcAttachment - represents all transit gateways vpc attachments
+// TransitGatewaysVpcAttachment - represents all transit gateways vpc, vpn and peering attachments
 type TransitGatewaysVpcAttachment struct {
 	Ids []string
 }
@@ -28,7 +28,7 @@ func (tgw TransitGatewaysVpcAttachment) ResourceIdentifiers() []string {
 
 // Nuke - nuke 'em all!!!
 func (tgw TransitGatewaysVpcAttachment) Nuke(session *session.Session, identifiers []string) error {
-	if err := nukeAllTransitGatewayVpcAttachments(session, awsgo.StringSlice(identifiers)); err != nil {
+	if err := nukeAllTransitGatewayAttachments(session, awsgo.StringSlice(identifiers)); err != nil {
 		return errors.WithStackTrace(err)
 	}
 
@@ -92,3 +92,18 @@ func (tgw TransitGateways) Nuke(session *session.Session, identifiers []string)
 
 	return nil
 }
+
+type TransitGatewayAttachmentsDeleteTimeoutError struct{}
+
+func (e TransitGatewayAttachmentsDeleteTimeoutError) Error() string {
+	return "Timed out waiting for Transit Gateway Attachments to be deleted"
+}
+
+type UnsupportedTransitGatewayAttachmentTypeError struct {
+	attachmentId string
+	resourceType string
+}
+
+func (e UnsupportedTransitGatewayAttachmentTypeError) Error() string {
+	return "Transit Gateway Attachment " + e.attachmentId + " of type " + e.resourceType + " can't be deleted by cloud-nuke"
+}
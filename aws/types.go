@@ -81,6 +81,12 @@ type AwsResources interface {
 	Nuke(session *session.Session, identifiers []string) error
 }
 
+// CLICommandRenderer is implemented by resources that can render the AWS CLI commands that would delete them, so that
+// a dry run can be exported for change review and executed by hand
+type CLICommandRenderer interface {
+	CLICommands(region string) []string
+}
+
 type AwsRegionResource struct {
 	Resources []AwsResources
 }
@@ -0,0 +1,389 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/gruntwork-io/go-commons/retry"
+	"github.com/hashicorp/go-multierror"
+)
+
+// CloudFront scoped WAFv2 resources are global, but can only be managed through us-east-1
+const wafv2CloudFrontRegion = "us-east-1"
+
+// wafv2ResourceSummary holds the fields shared by the summaries of web ACLs, rule groups and IP sets
+type wafv2ResourceSummary struct {
+	Name *string
+	ARN  *string
+}
+
+// wafv2ListPageFn lists a page of WAFv2 resources of the given scope, returning the marker of the next page, if any
+type wafv2ListPageFn func(svc wafv2iface.WAFV2API, scope string, nextMarker *string) ([]wafv2ResourceSummary, *string, error)
+
+// wafv2DeleteFn deletes the WAFv2 resource with the given ARN, whose scope, name and ID are parsed from the ARN
+type wafv2DeleteFn func(svc wafv2iface.WAFV2API, resourceArn *string, scope string, name string, id string) error
+
+// getWAFv2Scopes returns the scopes of the WAFv2 resources that are managed through the given region
+func getWAFv2Scopes(region string) []string {
+	if region == wafv2CloudFrontRegion {
+		return []string{wafv2.ScopeRegional, wafv2.ScopeCloudfront}
+	}
+	return []string{wafv2.ScopeRegional}
+}
+
+// getAllWAFv2Resources returns the ARNs of the WAFv2 resources listed by listPage. WAFv2 resources don't expose a
+// creation time, so the first seen tag is used for the excludeAfter filter instead.
+func getAllWAFv2Resources(session *session.Session, excludeAfter time.Time, resourceConfig config.ResourceType, listPage wafv2ListPageFn) ([]*string, error) {
+	svc := wafv2.New(session)
+
+	var arns []*string
+	for _, scope := range getWAFv2Scopes(aws.StringValue(session.Config.Region)) {
+		var nextMarker *string
+		for {
+			summaries, marker, err := listPage(svc, scope, nextMarker)
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
+			for _, summary := range summaries {
+				if !config.ShouldInclude(aws.StringValue(summary.Name), resourceConfig.IncludeRule.NamesRegExp, resourceConfig.ExcludeRule.NamesRegExp) {
+					continue
+				}
+
+				tags, err := getWAFv2Tags(svc, summary.ARN)
+				if err != nil {
+					logging.Logger.Errorf("Unable to retrieve tags for WAFv2 resource %s: %s", aws.StringValue(summary.ARN), err)
+					return nil, err
+				}
+				if tags[AwsResourceExclusionTagKey] == "true" {
+					continue
+				}
+
+				firstSeenTime, err := getOrSetFirstSeenWAFv2Tag(svc, summary.ARN, tags)
+				if err != nil {
+					logging.Logger.Errorf("Unable to tag WAFv2 resource %s: %s", aws.StringValue(summary.ARN), err)
+					return nil, err
+				}
+				if excludeAfter.After(firstSeenTime) {
+					arns = append(arns, summary.ARN)
+				}
+			}
+
+			// An empty marker is returned along with the last page
+			if aws.StringValue(marker) == "" {
+				break
+			}
+			nextMarker = marker
+		}
+	}
+	return arns, nil
+}
+
+func getWAFv2Tags(svc wafv2iface.WAFV2API, resourceArn *string) (map[string]string, error) {
+	tags := map[string]string{}
+	input := &wafv2.ListTagsForResourceInput{ResourceARN: resourceArn}
+	for {
+		output, err := svc.ListTagsForResource(input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if output.TagInfoForResource != nil {
+			for _, tag := range output.TagInfoForResource.TagList {
+				tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+		}
+
+		if aws.StringValue(output.NextMarker) == "" {
+			return tags, nil
+		}
+		input.NextMarker = output.NextMarker
+	}
+}
+
+// getOrSetFirstSeenWAFv2Tag returns the time cloud-nuke first saw the WAFv2 resource, tagging it with the current time
+// the first time it is seen.
+func getOrSetFirstSeenWAFv2Tag(svc wafv2iface.WAFV2API, resourceArn *string, tags map[string]string) (time.Time, error) {
+	if value, ok := tags[firstSeenTagKey]; ok {
+		firstSeenTime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, errors.WithStackTrace(err)
+		}
+		return firstSeenTime, nil
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.TagResource(&wafv2.TagResourceInput{
+		ResourceARN: resourceArn,
+		Tags:        []*wafv2.Tag{{Key: aws.String(firstSeenTagKey), Value: aws.String(now.Format(time.RFC3339))}},
+	})
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return now, nil
+}
+
+// parseWAFv2Arn returns the scope, name and ID of a WAFv2 resource from its ARN, which has the form
+// arn:aws:wafv2:<region>:<account>:<regional|global>/<webacl|rulegroup|ipset>/<name>/<id>
+func parseWAFv2Arn(resourceArn string) (string, string, string, error) {
+	parsedArn, err := arn.Parse(resourceArn)
+	if err != nil {
+		return "", "", "", errors.WithStackTrace(err)
+	}
+
+	parts := strings.Split(parsedArn.Resource, "/")
+	if len(parts) != 4 {
+		return "", "", "", errors.WithStackTrace(InvalidWAFv2ArnError{arn: resourceArn})
+	}
+
+	switch parts[0] {
+	case "regional":
+		return wafv2.ScopeRegional, parts[2], parts[3], nil
+	case "global":
+		return wafv2.ScopeCloudfront, parts[2], parts[3], nil
+	default:
+		return "", "", "", errors.WithStackTrace(InvalidWAFv2ArnError{arn: resourceArn})
+	}
+}
+
+// deleteWAFv2ResourceWithRetry calls deleteFn until it succeeds. Deletes fail when the lock token changed in the
+// meantime or while the resource is still referenced, e.g. by a web ACL or CloudFront distribution that is being
+// updated, so both cases are retried.
+func deleteWAFv2ResourceWithRetry(description string, deleteFn func() error) error {
+	return retry.DoWithRetry(
+		logging.Logger,
+		description,
+		6, 10*time.Second,
+		func() error {
+			err := deleteFn()
+			if err == nil {
+				return nil
+			}
+			if awsErr, ok := err.(awserr.Error); ok {
+				switch awsErr.Code() {
+				case wafv2.ErrCodeWAFOptimisticLockException, wafv2.ErrCodeWAFAssociatedItemException:
+					return err
+				}
+			}
+			return retry.FatalError{Underlying: err}
+		},
+	)
+}
+
+// disassociateRegionalWAFv2WebACL removes the web ACL from the load balancers, APIs and user pools it protects
+func disassociateRegionalWAFv2WebACL(svc wafv2iface.WAFV2API, webACLArn *string) error {
+	for _, resourceType := range wafv2.ResourceType_Values() {
+		output, err := svc.ListResourcesForWebACL(&wafv2.ListResourcesForWebACLInput{
+			WebACLArn:    webACLArn,
+			ResourceType: aws.String(resourceType),
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		for _, resourceArn := range output.ResourceArns {
+			if _, err := svc.DisassociateWebACL(&wafv2.DisassociateWebACLInput{ResourceArn: resourceArn}); err != nil {
+				return errors.WithStackTrace(err)
+			}
+			logging.Logger.Debugf("Disassociated WAFv2 web ACL %s from %s", aws.StringValue(webACLArn), aws.StringValue(resourceArn))
+		}
+	}
+	return nil
+}
+
+// disassociateCloudFrontWAFv2WebACL removes the web ACL from the CloudFront distributions it protects. CloudFront
+// associations are part of the distribution config, so they can't be removed through WAFv2.
+func disassociateCloudFrontWAFv2WebACL(svc cloudfrontiface.CloudFrontAPI, webACLArn *string) error {
+	var distributionIds []*string
+	input := &cloudfront.ListDistributionsByWebACLIdInput{WebACLId: webACLArn}
+	for {
+		output, err := svc.ListDistributionsByWebACLId(input)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		for _, distribution := range output.DistributionList.Items {
+			distributionIds = append(distributionIds, distribution.Id)
+		}
+
+		if !aws.BoolValue(output.DistributionList.IsTruncated) {
+			break
+		}
+		input.Marker = output.DistributionList.NextMarker
+	}
+
+	for _, distributionId := range distributionIds {
+		output, err := svc.GetDistributionConfig(&cloudfront.GetDistributionConfigInput{Id: distributionId})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		output.DistributionConfig.WebACLId = aws.String("")
+		_, err = svc.UpdateDistribution(&cloudfront.UpdateDistributionInput{
+			Id:                 distributionId,
+			IfMatch:            output.ETag,
+			DistributionConfig: output.DistributionConfig,
+		})
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		logging.Logger.Debugf("Disassociated WAFv2 web ACL %s from CloudFront distribution %s", aws.StringValue(webACLArn), aws.StringValue(distributionId))
+	}
+	return nil
+}
+
+// nukeWAFv2Resources deletes the given WAFv2 resources one by one using deleteFn, recording each of them in the report.
+func nukeWAFv2Resources(session *session.Session, resourceType string, arns []*string, deleteFn wafv2DeleteFn) error {
+	svc := wafv2.New(session)
+
+	if len(arns) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedArns []*string
+	var allErrs *multierror.Error
+
+	for _, resourceArn := range arns {
+		scope, name, id, err := parseWAFv2Arn(aws.StringValue(resourceArn))
+		if err == nil {
+			err = deleteFn(svc, resourceArn, scope, name, id)
+		}
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(resourceArn),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedArns = append(deletedArns, resourceArn)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(resourceArn))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedArns), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// Returns a formatted string of WAFv2 web ACL ARNs
+func getAllWAFv2WebACLs(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	return getAllWAFv2Resources(session, excludeAfter, configObj.WAFv2WebACL, func(svc wafv2iface.WAFV2API, scope string, nextMarker *string) ([]wafv2ResourceSummary, *string, error) {
+		output, err := svc.ListWebACLs(&wafv2.ListWebACLsInput{Scope: aws.String(scope), NextMarker: nextMarker})
+		if err != nil {
+			return nil, nil, err
+		}
+		var summaries []wafv2ResourceSummary
+		for _, webACL := range output.WebACLs {
+			summaries = append(summaries, wafv2ResourceSummary{Name: webACL.Name, ARN: webACL.ARN})
+		}
+		return summaries, output.NextMarker, nil
+	})
+}
+
+// Deletes all WAFv2 web ACLs, after disassociating them from the resources they protect
+func nukeAllWAFv2WebACLs(session *session.Session, arns []*string) error {
+	return nukeWAFv2Resources(session, "WAFv2 Web ACL", arns, func(svc wafv2iface.WAFV2API, webACLArn *string, scope string, name string, id string) error {
+		var err error
+		if scope == wafv2.ScopeCloudfront {
+			err = disassociateCloudFrontWAFv2WebACL(cloudfront.New(session), webACLArn)
+		} else {
+			err = disassociateRegionalWAFv2WebACL(svc, webACLArn)
+		}
+		if err != nil {
+			return err
+		}
+
+		return deleteWAFv2ResourceWithRetry(fmt.Sprintf("Delete WAFv2 web ACL %s", name), func() error {
+			output, err := svc.GetWebACL(&wafv2.GetWebACLInput{Scope: aws.String(scope), Name: aws.String(name), Id: aws.String(id)})
+			if err != nil {
+				return err
+			}
+			_, err = svc.DeleteWebACL(&wafv2.DeleteWebACLInput{Scope: aws.String(scope), Name: aws.String(name), Id: aws.String(id), LockToken: output.LockToken})
+			return err
+		})
+	})
+}
+
+// Returns a formatted string of WAFv2 rule group ARNs
+func getAllWAFv2RuleGroups(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	return getAllWAFv2Resources(session, excludeAfter, configObj.WAFv2RuleGroup, func(svc wafv2iface.WAFV2API, scope string, nextMarker *string) ([]wafv2ResourceSummary, *string, error) {
+		output, err := svc.ListRuleGroups(&wafv2.ListRuleGroupsInput{Scope: aws.String(scope), NextMarker: nextMarker})
+		if err != nil {
+			return nil, nil, err
+		}
+		var summaries []wafv2ResourceSummary
+		for _, ruleGroup := range output.RuleGroups {
+			summaries = append(summaries, wafv2ResourceSummary{Name: ruleGroup.Name, ARN: ruleGroup.ARN})
+		}
+		return summaries, output.NextMarker, nil
+	})
+}
+
+// Deletes all WAFv2 rule groups
+func nukeAllWAFv2RuleGroups(session *session.Session, arns []*string) error {
+	return nukeWAFv2Resources(session, "WAFv2 Rule Group", arns, func(svc wafv2iface.WAFV2API, ruleGroupArn *string, scope string, name string, id string) error {
+		return deleteWAFv2ResourceWithRetry(fmt.Sprintf("Delete WAFv2 rule group %s", name), func() error {
+			output, err := svc.GetRuleGroup(&wafv2.GetRuleGroupInput{Scope: aws.String(scope), Name: aws.String(name), Id: aws.String(id)})
+			if err != nil {
+				return err
+			}
+			_, err = svc.DeleteRuleGroup(&wafv2.DeleteRuleGroupInput{Scope: aws.String(scope), Name: aws.String(name), Id: aws.String(id), LockToken: output.LockToken})
+			return err
+		})
+	})
+}
+
+// Returns a formatted string of WAFv2 IP set ARNs
+func getAllWAFv2IPSets(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	return getAllWAFv2Resources(session, excludeAfter, configObj.WAFv2IPSet, func(svc wafv2iface.WAFV2API, scope string, nextMarker *string) ([]wafv2ResourceSummary, *string, error) {
+		output, err := svc.ListIPSets(&wafv2.ListIPSetsInput{Scope: aws.String(scope), NextMarker: nextMarker})
+		if err != nil {
+			return nil, nil, err
+		}
+		var summaries []wafv2ResourceSummary
+		for _, ipSet := range output.IPSets {
+			summaries = append(summaries, wafv2ResourceSummary{Name: ipSet.Name, ARN: ipSet.ARN})
+		}
+		return summaries, output.NextMarker, nil
+	})
+}
+
+// Deletes all WAFv2 IP sets
+func nukeAllWAFv2IPSets(session *session.Session, arns []*string) error {
+	return nukeWAFv2Resources(session, "WAFv2 IP Set", arns, func(svc wafv2iface.WAFV2API, ipSetArn *string, scope string, name string, id string) error {
+		return deleteWAFv2ResourceWithRetry(fmt.Sprintf("Delete WAFv2 IP set %s", name), func() error {
+			output, err := svc.GetIPSet(&wafv2.GetIPSetInput{Scope: aws.String(scope), Name: aws.String(name), Id: aws.String(id)})
+			if err != nil {
+				return err
+			}
+			_, err = svc.DeleteIPSet(&wafv2.DeleteIPSetInput{Scope: aws.String(scope), Name: aws.String(name), Id: aws.String(id), LockToken: output.LockToken})
+			return err
+		})
+	})
+}
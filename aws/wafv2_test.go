@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWAFv2Arn(t *testing.T) {
+	t.Parallel()
+
+	scope, name, id, err := parseWAFv2Arn("arn:aws:wafv2:eu-west-1:123456789012:regional/webacl/cloud-nuke-test/a1b2c3")
+	require.NoError(t, err)
+	assert.Equal(t, wafv2.ScopeRegional, scope)
+	assert.Equal(t, "cloud-nuke-test", name)
+	assert.Equal(t, "a1b2c3", id)
+
+	scope, _, _, err = parseWAFv2Arn("arn:aws:wafv2:us-east-1:123456789012:global/ipset/cloud-nuke-test/a1b2c3")
+	require.NoError(t, err)
+	assert.Equal(t, wafv2.ScopeCloudfront, scope)
+
+	_, _, _, err = parseWAFv2Arn("arn:aws:wafv2:us-east-1:123456789012:global/ipset")
+	assert.Error(t, err)
+}
+
+func TestGetWAFv2Scopes(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{wafv2.ScopeRegional, wafv2.ScopeCloudfront}, getWAFv2Scopes("us-east-1"))
+	assert.Equal(t, []string{wafv2.ScopeRegional}, getWAFv2Scopes("eu-west-1"))
+}
+
+type mockedWAFv2WebACLAssociations struct {
+	wafv2iface.WAFV2API
+	ResourceArns  map[string][]*string
+	Disassociated []string
+}
+
+func (m *mockedWAFv2WebACLAssociations) ListResourcesForWebACL(input *wafv2.ListResourcesForWebACLInput) (*wafv2.ListResourcesForWebACLOutput, error) {
+	return &wafv2.ListResourcesForWebACLOutput{ResourceArns: m.ResourceArns[aws.StringValue(input.ResourceType)]}, nil
+}
+
+func (m *mockedWAFv2WebACLAssociations) DisassociateWebACL(input *wafv2.DisassociateWebACLInput) (*wafv2.DisassociateWebACLOutput, error) {
+	m.Disassociated = append(m.Disassociated, aws.StringValue(input.ResourceArn))
+	return &wafv2.DisassociateWebACLOutput{}, nil
+}
+
+func TestDisassociateRegionalWAFv2WebACL(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedWAFv2WebACLAssociations{ResourceArns: map[string][]*string{
+		wafv2.ResourceTypeApplicationLoadBalancer: {aws.String("alb-arn")},
+		wafv2.ResourceTypeApiGateway:              {aws.String("api-stage-arn")},
+	}}
+	require.NoError(t, disassociateRegionalWAFv2WebACL(mock, aws.String("webacl-arn")))
+	assert.ElementsMatch(t, []string{"alb-arn", "api-stage-arn"}, mock.Disassociated)
+}
+
+type mockedCloudFrontWebACLDistributions struct {
+	cloudfrontiface.CloudFrontAPI
+	Updated map[string]string
+}
+
+func (m *mockedCloudFrontWebACLDistributions) ListDistributionsByWebACLId(input *cloudfront.ListDistributionsByWebACLIdInput) (*cloudfront.ListDistributionsByWebACLIdOutput, error) {
+	return &cloudfront.ListDistributionsByWebACLIdOutput{DistributionList: &cloudfront.DistributionList{
+		IsTruncated: aws.Bool(false),
+		Items:       []*cloudfront.DistributionSummary{{Id: aws.String("EDFDVBD6EXAMPLE")}},
+	}}, nil
+}
+
+func (m *mockedCloudFrontWebACLDistributions) GetDistributionConfig(input *cloudfront.GetDistributionConfigInput) (*cloudfront.GetDistributionConfigOutput, error) {
+	return &cloudfront.GetDistributionConfigOutput{
+		ETag:               aws.String("E2QWRUHEXAMPLE"),
+		DistributionConfig: &cloudfront.DistributionConfig{WebACLId: aws.String("webacl-arn")},
+	}, nil
+}
+
+func (m *mockedCloudFrontWebACLDistributions) UpdateDistribution(input *cloudfront.UpdateDistributionInput) (*cloudfront.UpdateDistributionOutput, error) {
+	m.Updated[aws.StringValue(input.Id)] = aws.StringValue(input.IfMatch) + ":" + aws.StringValue(input.DistributionConfig.WebACLId)
+	return &cloudfront.UpdateDistributionOutput{}, nil
+}
+
+func TestDisassociateCloudFrontWAFv2WebACL(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockedCloudFrontWebACLDistributions{Updated: map[string]string{}}
+	require.NoError(t, disassociateCloudFrontWAFv2WebACL(mock, aws.String("webacl-arn")))
+	assert.Equal(t, map[string]string{"EDFDVBD6EXAMPLE": "E2QWRUHEXAMPLE:"}, mock.Updated)
+}
@@ -0,0 +1,102 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// WAFv2WebACLs - represents all WAFv2 web ACLs
+type WAFv2WebACLs struct {
+	ARNs []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (webACLs WAFv2WebACLs) ResourceName() string {
+	return "wafv2-webacl"
+}
+
+// ResourceIdentifiers - The ARNs of the WAFv2 web ACLs
+func (webACLs WAFv2WebACLs) ResourceIdentifiers() []string {
+	return webACLs.ARNs
+}
+
+func (webACLs WAFv2WebACLs) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (webACLs WAFv2WebACLs) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllWAFv2WebACLs(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// WAFv2RuleGroups - represents all WAFv2 rule groups
+type WAFv2RuleGroups struct {
+	ARNs []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (ruleGroups WAFv2RuleGroups) ResourceName() string {
+	return "wafv2-rulegroup"
+}
+
+// ResourceIdentifiers - The ARNs of the WAFv2 rule groups
+func (ruleGroups WAFv2RuleGroups) ResourceIdentifiers() []string {
+	return ruleGroups.ARNs
+}
+
+func (ruleGroups WAFv2RuleGroups) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (ruleGroups WAFv2RuleGroups) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllWAFv2RuleGroups(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// WAFv2IPSets - represents all WAFv2 IP sets
+type WAFv2IPSets struct {
+	ARNs []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (ipSets WAFv2IPSets) ResourceName() string {
+	return "wafv2-ipset"
+}
+
+// ResourceIdentifiers - The ARNs of the WAFv2 IP sets
+func (ipSets WAFv2IPSets) ResourceIdentifiers() []string {
+	return ipSets.ARNs
+}
+
+func (ipSets WAFv2IPSets) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (ipSets WAFv2IPSets) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllWAFv2IPSets(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type InvalidWAFv2ArnError struct {
+	arn string
+}
+
+func (e InvalidWAFv2ArnError) Error() string {
+	return "Unable to parse the scope, name and ID of WAFv2 resource " + e.arn
+}
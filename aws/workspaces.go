@@ -0,0 +1,332 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/workspaces/workspacesiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Returns a formatted string of WorkSpace IDs
+func getAllWorkspaces(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := workspaces.New(session)
+
+	directories, err := listWorkspaceDirectories(svc)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	directoryNames := map[string]string{}
+	for _, directory := range directories {
+		directoryNames[aws.StringValue(directory.DirectoryId)] = aws.StringValue(directory.DirectoryName)
+	}
+
+	allWorkspaces, err := listWorkspaces(svc, nil)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var ids []*string
+	for _, workspace := range allWorkspaces {
+		include, err := shouldNukeWorkspace(svc, workspace, directoryNames[aws.StringValue(workspace.DirectoryId)], excludeAfter, configObj)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if include {
+			ids = append(ids, workspace.WorkspaceId)
+		}
+	}
+	return ids, nil
+}
+
+// shouldNukeWorkspace filters the WorkSpace on its user and the name of its directory, its tags and the time it was
+// first seen, as WorkSpaces have no creation time.
+func shouldNukeWorkspace(svc workspacesiface.WorkSpacesAPI, workspace *workspaces.Workspace, directoryName string, excludeAfter time.Time, configObj config.Config) (bool, error) {
+	if !shouldIncludeWorkspace(workspace, directoryName, configObj) {
+		return false, nil
+	}
+
+	tags, err := getWorkspacesTags(svc, workspace.WorkspaceId)
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+	if tags[AwsResourceExclusionTagKey] == "true" {
+		return false, nil
+	}
+
+	firstSeenTime, err := getOrSetFirstSeenWorkspacesTag(svc, workspace.WorkspaceId, tags)
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+	return excludeAfter.After(firstSeenTime), nil
+}
+
+func shouldIncludeWorkspace(workspace *workspaces.Workspace, directoryName string, configObj config.Config) bool {
+	if workspace == nil {
+		return false
+	}
+
+	state := aws.StringValue(workspace.State)
+	if state == workspaces.WorkspaceStateTerminating || state == workspaces.WorkspaceStateTerminated {
+		return false
+	}
+
+	// The rules of the directory apply to its WorkSpaces too, so that protecting a directory protects its WorkSpaces
+	return config.ShouldInclude(
+		aws.StringValue(workspace.UserName),
+		configObj.Workspace.IncludeRule.NamesRegExp,
+		configObj.Workspace.ExcludeRule.NamesRegExp,
+	) && config.ShouldInclude(
+		directoryName,
+		configObj.WorkspaceDirectory.IncludeRule.NamesRegExp,
+		configObj.WorkspaceDirectory.ExcludeRule.NamesRegExp,
+	)
+}
+
+// Returns a formatted string of the IDs of WorkSpaces directories that will be empty once the WorkSpaces in them are
+// nuked
+func getAllWorkspaceDirectories(session *session.Session, excludeAfter time.Time, configObj config.Config) ([]*string, error) {
+	svc := workspaces.New(session)
+
+	directories, err := listWorkspaceDirectories(svc)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var ids []*string
+	for _, directory := range directories {
+		if !shouldIncludeWorkspaceDirectory(directory, configObj) {
+			continue
+		}
+
+		tags, err := getWorkspacesTags(svc, directory.DirectoryId)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if tags[AwsResourceExclusionTagKey] == "true" {
+			continue
+		}
+
+		firstSeenTime, err := getOrSetFirstSeenWorkspacesTag(svc, directory.DirectoryId, tags)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if !excludeAfter.After(firstSeenTime) {
+			continue
+		}
+
+		empty, err := isWorkspaceDirectoryEmptyOnceNuked(svc, directory, excludeAfter, configObj)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if empty {
+			ids = append(ids, directory.DirectoryId)
+		}
+	}
+	return ids, nil
+}
+
+func shouldIncludeWorkspaceDirectory(directory *workspaces.WorkspaceDirectory, configObj config.Config) bool {
+	if directory == nil {
+		return false
+	}
+
+	state := aws.StringValue(directory.State)
+	if state == workspaces.WorkspaceDirectoryStateDeregistering || state == workspaces.WorkspaceDirectoryStateDeregistered {
+		return false
+	}
+
+	return config.ShouldInclude(
+		aws.StringValue(directory.DirectoryName),
+		configObj.WorkspaceDirectory.IncludeRule.NamesRegExp,
+		configObj.WorkspaceDirectory.ExcludeRule.NamesRegExp,
+	)
+}
+
+// isWorkspaceDirectoryEmptyOnceNuked returns whether all the WorkSpaces in the directory are either already being
+// terminated or will be terminated by cloud-nuke.
+func isWorkspaceDirectoryEmptyOnceNuked(svc workspacesiface.WorkSpacesAPI, directory *workspaces.WorkspaceDirectory, excludeAfter time.Time, configObj config.Config) (bool, error) {
+	directoryWorkspaces, err := listWorkspaces(svc, directory.DirectoryId)
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+
+	for _, workspace := range directoryWorkspaces {
+		state := aws.StringValue(workspace.State)
+		if state == workspaces.WorkspaceStateTerminating || state == workspaces.WorkspaceStateTerminated {
+			continue
+		}
+
+		include, err := shouldNukeWorkspace(svc, workspace, aws.StringValue(directory.DirectoryName), excludeAfter, configObj)
+		if err != nil {
+			return false, errors.WithStackTrace(err)
+		}
+		if !include {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func listWorkspaceDirectories(svc workspacesiface.WorkSpacesAPI) ([]*workspaces.WorkspaceDirectory, error) {
+	var directories []*workspaces.WorkspaceDirectory
+	err := svc.DescribeWorkspaceDirectoriesPages(&workspaces.DescribeWorkspaceDirectoriesInput{}, func(page *workspaces.DescribeWorkspaceDirectoriesOutput, lastPage bool) bool {
+		directories = append(directories, page.Directories...)
+		return !lastPage
+	})
+	return directories, errors.WithStackTrace(err)
+}
+
+// listWorkspaces returns the WorkSpaces in the given directory, or all WorkSpaces if no directory is given
+func listWorkspaces(svc workspacesiface.WorkSpacesAPI, directoryId *string) ([]*workspaces.Workspace, error) {
+	var allWorkspaces []*workspaces.Workspace
+	err := svc.DescribeWorkspacesPages(&workspaces.DescribeWorkspacesInput{DirectoryId: directoryId}, func(page *workspaces.DescribeWorkspacesOutput, lastPage bool) bool {
+		allWorkspaces = append(allWorkspaces, page.Workspaces...)
+		return !lastPage
+	})
+	return allWorkspaces, errors.WithStackTrace(err)
+}
+
+func getWorkspacesTags(svc workspacesiface.WorkSpacesAPI, resourceId *string) (map[string]string, error) {
+	output, err := svc.DescribeTags(&workspaces.DescribeTagsInput{ResourceId: resourceId})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	tags := map[string]string{}
+	for _, tag := range output.TagList {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tags, nil
+}
+
+func getOrSetFirstSeenWorkspacesTag(svc workspacesiface.WorkSpacesAPI, resourceId *string, tags map[string]string) (time.Time, error) {
+	if value, ok := tags[firstSeenTagKey]; ok {
+		firstSeenTime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, errors.WithStackTrace(err)
+		}
+		return firstSeenTime, nil
+	}
+
+	now := time.Now().UTC()
+	_, err := svc.CreateTags(&workspaces.CreateTagsInput{
+		ResourceId: resourceId,
+		Tags:       []*workspaces.Tag{{Key: aws.String(firstSeenTagKey), Value: aws.String(now.Format(time.RFC3339))}},
+	})
+	if err != nil {
+		return time.Time{}, errors.WithStackTrace(err)
+	}
+	return now, nil
+}
+
+func terminateWorkspace(svc workspacesiface.WorkSpacesAPI, workspaceId *string) error {
+	output, err := svc.TerminateWorkspaces(&workspaces.TerminateWorkspacesInput{
+		TerminateWorkspaceRequests: []*workspaces.TerminateRequest{{WorkspaceId: workspaceId}},
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	// Terminating WorkSpaces is a batch operation, which reports failures per WorkSpace instead of returning an error
+	for _, failure := range output.FailedRequests {
+		return errors.WithStackTrace(WorkspaceTerminateError{
+			workspaceId: aws.StringValue(workspaceId),
+			code:        aws.StringValue(failure.ErrorCode),
+			message:     aws.StringValue(failure.ErrorMessage),
+		})
+	}
+	return nil
+}
+
+// deregisterWorkspaceDirectory waits for the WorkSpaces in the directory to be terminated, as only empty directories
+// can be deregistered. The underlying Directory Service directory is left alone.
+func deregisterWorkspaceDirectory(svc workspacesiface.WorkSpacesAPI, directoryId *string) error {
+	empty := false
+	for i := 0; i < 60 && !empty; i++ {
+		directoryWorkspaces, err := listWorkspaces(svc, directoryId)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		empty = true
+		for _, workspace := range directoryWorkspaces {
+			if aws.StringValue(workspace.State) != workspaces.WorkspaceStateTerminated {
+				empty = false
+			}
+		}
+
+		if !empty {
+			time.Sleep(10 * time.Second)
+			logging.Logger.Debugf("Waiting for the WorkSpaces in directory %s to be terminated", aws.StringValue(directoryId))
+		}
+	}
+	if !empty {
+		return WorkspaceDirectoryNotEmptyError{directoryId: aws.StringValue(directoryId)}
+	}
+
+	_, err := svc.DeregisterWorkspaceDirectory(&workspaces.DeregisterWorkspaceDirectoryInput{DirectoryId: directoryId})
+	return errors.WithStackTrace(err)
+}
+
+// nukeWorkspacesResources deletes the given WorkSpaces or WorkSpaces directories using deleteFn, recording the status
+// of each of them.
+func nukeWorkspacesResources(session *session.Session, resourceType string, identifiers []*string, deleteFn func(svc workspacesiface.WorkSpacesAPI, identifier *string) error) error {
+	svc := workspaces.New(session)
+
+	if len(identifiers) == 0 {
+		logging.Logger.Debugf("No %ss to nuke in region %s", resourceType, *session.Config.Region)
+		return nil
+	}
+
+	logging.Logger.Debugf("Deleting all %ss in region %s", resourceType, *session.Config.Region)
+	var deletedIdentifiers []*string
+	var allErrs *multierror.Error
+
+	for _, identifier := range identifiers {
+		err := deleteFn(svc, identifier)
+
+		// Record status of this resource
+		e := report.Entry{
+			Identifier:   aws.StringValue(identifier),
+			ResourceType: resourceType,
+			Error:        err,
+		}
+		report.Record(e)
+
+		if err != nil {
+			logging.Logger.Debugf("[Failed] %s", err)
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: "Error Nuking " + resourceType,
+			}, map[string]interface{}{
+				"region": *session.Config.Region,
+			})
+			allErrs = multierror.Append(allErrs, err)
+		} else {
+			deletedIdentifiers = append(deletedIdentifiers, identifier)
+			logging.Logger.Debugf("Deleted %s: %s", resourceType, aws.StringValue(identifier))
+		}
+	}
+
+	logging.Logger.Debugf("[OK] %d %s(s) deleted in %s", len(deletedIdentifiers), resourceType, *session.Config.Region)
+	return errors.WithStackTrace(allErrs.ErrorOrNil())
+}
+
+// Terminates all WorkSpaces
+func nukeAllWorkspaces(session *session.Session, ids []*string) error {
+	return nukeWorkspacesResources(session, "WorkSpace", ids, terminateWorkspace)
+}
+
+// Deregisters all WorkSpaces directories once their WorkSpaces are terminated
+func nukeAllWorkspaceDirectories(session *session.Session, ids []*string) error {
+	return nukeWorkspacesResources(session, "WorkSpaces Directory", ids, deregisterWorkspaceDirectory)
+}
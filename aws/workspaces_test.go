@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/workspaces/workspacesiface"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedWorkspaces struct {
+	workspacesiface.WorkSpacesAPI
+	Workspaces []*workspaces.Workspace
+	Tags       map[string][]*workspaces.Tag
+	Calls      []string
+}
+
+func (m *mockedWorkspaces) DescribeWorkspacesPages(input *workspaces.DescribeWorkspacesInput, fn func(*workspaces.DescribeWorkspacesOutput, bool) bool) error {
+	fn(&workspaces.DescribeWorkspacesOutput{Workspaces: m.Workspaces}, true)
+	return nil
+}
+
+func (m *mockedWorkspaces) DescribeTags(input *workspaces.DescribeTagsInput) (*workspaces.DescribeTagsOutput, error) {
+	return &workspaces.DescribeTagsOutput{TagList: m.Tags[aws.StringValue(input.ResourceId)]}, nil
+}
+
+func (m *mockedWorkspaces) CreateTags(input *workspaces.CreateTagsInput) (*workspaces.CreateTagsOutput, error) {
+	m.Calls = append(m.Calls, "CreateTags:"+aws.StringValue(input.ResourceId))
+	return &workspaces.CreateTagsOutput{}, nil
+}
+
+func TestIsWorkspaceDirectoryEmptyOnceNuked(t *testing.T) {
+	directory := &workspaces.WorkspaceDirectory{DirectoryId: aws.String("d-1234567890"), DirectoryName: aws.String("corp.example.com")}
+	firstSeen := []*workspaces.Tag{{Key: aws.String(firstSeenTagKey), Value: aws.String(time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339))}}
+	excluded := append([]*workspaces.Tag{{Key: aws.String(AwsResourceExclusionTagKey), Value: aws.String("true")}}, firstSeen...)
+
+	mock := &mockedWorkspaces{
+		Workspaces: []*workspaces.Workspace{
+			{WorkspaceId: aws.String("ws-1"), UserName: aws.String("alice"), State: aws.String(workspaces.WorkspaceStateAvailable)},
+			{WorkspaceId: aws.String("ws-2"), UserName: aws.String("bob"), State: aws.String(workspaces.WorkspaceStateTerminating)},
+		},
+		Tags: map[string][]*workspaces.Tag{"ws-1": firstSeen},
+	}
+	empty, err := isWorkspaceDirectoryEmptyOnceNuked(mock, directory, time.Now(), config.Config{})
+	require.NoError(t, err)
+	assert.True(t, empty)
+
+	mock.Tags["ws-1"] = excluded
+	empty, err = isWorkspaceDirectoryEmptyOnceNuked(mock, directory, time.Now(), config.Config{})
+	require.NoError(t, err)
+	assert.False(t, empty)
+}
+
+func TestShouldIncludeWorkspace(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		WorkspaceId: aws.String("ws-1"),
+		UserName:    aws.String("alice"),
+		State:       aws.String(workspaces.WorkspaceStateAvailable),
+	}
+	terminated := &workspaces.Workspace{
+		WorkspaceId: aws.String("ws-2"),
+		UserName:    aws.String("alice"),
+		State:       aws.String(workspaces.WorkspaceStateTerminated),
+	}
+
+	excludeUserConfig := config.Config{
+		Workspace: config.ResourceType{
+			ExcludeRule: config.FilterRule{NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^alice$")}}},
+		},
+	}
+	includeDirectoryConfig := config.Config{
+		WorkspaceDirectory: config.ResourceType{
+			IncludeRule: config.FilterRule{NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^test\\.")}}},
+		},
+	}
+
+	assert.True(t, shouldIncludeWorkspace(workspace, "corp.example.com", config.Config{}))
+	assert.False(t, shouldIncludeWorkspace(terminated, "corp.example.com", config.Config{}))
+	assert.False(t, shouldIncludeWorkspace(workspace, "corp.example.com", excludeUserConfig))
+	assert.False(t, shouldIncludeWorkspace(workspace, "corp.example.com", includeDirectoryConfig))
+	assert.True(t, shouldIncludeWorkspace(workspace, "test.example.com", includeDirectoryConfig))
+}
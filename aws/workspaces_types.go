@@ -0,0 +1,83 @@
+package aws
+
+import (
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// Workspaces - represents all WorkSpaces
+type Workspaces struct {
+	WorkspaceIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (workspaces Workspaces) ResourceName() string {
+	return "workspace"
+}
+
+// ResourceIdentifiers - The IDs of the WorkSpaces
+func (workspaces Workspaces) ResourceIdentifiers() []string {
+	return workspaces.WorkspaceIds
+}
+
+func (workspaces Workspaces) MaxBatchSize() int {
+	// Tentative batch size to ensure AWS doesn't throttle
+	return 49
+}
+
+// Nuke - nuke 'em all!!!
+func (workspaces Workspaces) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllWorkspaces(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// WorkspaceDirectories - represents all directories registered with WorkSpaces
+type WorkspaceDirectories struct {
+	DirectoryIds []string
+}
+
+// ResourceName - the simple name of the aws resource
+func (directories WorkspaceDirectories) ResourceName() string {
+	return "workspace-directory"
+}
+
+// ResourceIdentifiers - The IDs of the WorkSpaces directories
+func (directories WorkspaceDirectories) ResourceIdentifiers() []string {
+	return directories.DirectoryIds
+}
+
+func (directories WorkspaceDirectories) MaxBatchSize() int {
+	// Deregistering a directory waits for its WorkSpaces to be terminated, so we keep the batches small
+	return 10
+}
+
+// Nuke - nuke 'em all!!!
+func (directories WorkspaceDirectories) Nuke(session *session.Session, identifiers []string) error {
+	if err := nukeAllWorkspaceDirectories(session, awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+type WorkspaceTerminateError struct {
+	workspaceId string
+	code        string
+	message     string
+}
+
+func (e WorkspaceTerminateError) Error() string {
+	return "Failed to terminate WorkSpace " + e.workspaceId + ": " + e.code + " " + e.message
+}
+
+type WorkspaceDirectoryNotEmptyError struct {
+	directoryId string
+}
+
+func (e WorkspaceDirectoryNotEmptyError) Error() string {
+	return "Timed out waiting for the WorkSpaces in directory " + e.directoryId + " to be terminated"
+}
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/gruntwork-io/cloud-nuke/aws"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/progressbar"
 	"github.com/gruntwork-io/cloud-nuke/ui"
 	"github.com/gruntwork-io/go-commons/errors"
 	"github.com/pterm/pterm"
@@ -67,6 +69,14 @@ func CreateCli(version string, mixPanelClientId string) *cli.App {
 					Name:  "dry-run",
 					Usage: "Dry run without taking any action.",
 				},
+				&cli.BoolFlag{
+					Name:  "export-cli-commands",
+					Usage: "Print the AWS CLI commands that would delete the targeted resources. Can only be used with --dry-run.",
+				},
+				&cli.BoolFlag{
+					Name:  "show-eta",
+					Usage: "Show an estimate of the time remaining while nuking. Enabled by default when attached to a terminal.",
+				},
 				&cli.BoolFlag{
 					Name:  "force",
 					Usage: "Skip nuke confirmation prompt. WARNING: this will automatically delete all targeted resources without any confirmation. It will not modify resource selections made via the --resource-type flag or an optional config file.",
@@ -190,6 +200,14 @@ func awsNuke(c *cli.Context) error {
 		return errors.WithStackTrace(parseErr)
 	}
 
+	if c.Bool("export-cli-commands") && !c.Bool("dry-run") {
+		return ExportCLICommandsWithoutDryRunError{}
+	}
+
+	if c.Bool("show-eta") || progressbar.IsTerminal(os.Stdout) {
+		progressbar.EnableETA()
+	}
+
 	configObj := config.Config{}
 	configFilePath := c.String("config")
 
@@ -333,6 +351,12 @@ func awsNuke(c *cli.Context) error {
 			EventName: "Skipping nuke, dryrun set",
 		}, map[string]interface{}{})
 		logging.Logger.Infoln("Not taking any action as dry-run set to true.")
+
+		if c.Bool("export-cli-commands") {
+			for _, command := range aws.ExtractCLICommands(account) {
+				fmt.Println(command)
+			}
+		}
 		return nil
 	}
 
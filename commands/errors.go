@@ -12,3 +12,9 @@ type InvalidFlagError struct {
 func (e InvalidFlagError) Error() string {
 	return fmt.Sprintf("Invalid value %s for flag %s", e.Value, e.Name)
 }
+
+type ExportCLICommandsWithoutDryRunError struct{}
+
+func (e ExportCLICommandsWithoutDryRunError) Error() string {
+	return "The --export-cli-commands flag can only be used together with --dry-run"
+}
@@ -1,56 +1,159 @@
 package config
 
 import (
+	"fmt"
 	"io/ioutil"
+	"net"
 	"path/filepath"
 	"regexp"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
 
 // Config - the config object we pass around
 type Config struct {
-	S3                    ResourceType `yaml:"s3"`
-	IAMUsers              ResourceType `yaml:"IAMUsers"`
-	IAMGroups             ResourceType `yaml:"IAMGroups"`
-	IAMPolicies           ResourceType `yaml:"IAMPolicies"`
-	IAMServiceLinkedRoles ResourceType `yaml:"IAMServiceLinkedRoles"`
-	IAMRoles              ResourceType `yaml:"IAMRoles"`
-	SecretsManagerSecrets ResourceType `yaml:"SecretsManager"`
-	NatGateway            ResourceType `yaml:"NatGateway"`
-	AccessAnalyzer        ResourceType `yaml:"AccessAnalyzer"`
-	CloudWatchDashboard   ResourceType `yaml:"CloudWatchDashboard"`
-	OpenSearchDomain      ResourceType `yaml:"OpenSearchDomain"`
-	DynamoDB              ResourceType `yaml:"DynamoDB"`
-	EBSVolume             ResourceType `yaml:"EBSVolume"`
-	LambdaFunction        ResourceType `yaml:"LambdaFunction"`
-	ELBv2                 ResourceType `yaml:"ELBv2"`
-	ECSService            ResourceType `yaml:"ECSService"`
-	ECSCluster            ResourceType `yaml:"ECSCluster"`
-	Elasticache           ResourceType `yaml:"Elasticache"`
-	VPC                   ResourceType `yaml:"VPC"`
-	OIDCProvider          ResourceType `yaml:"OIDCProvider"`
-	AutoScalingGroup      ResourceType `yaml:"AutoScalingGroup"`
-	LaunchConfiguration   ResourceType `yaml:"LaunchConfiguration"`
-	ElasticIP             ResourceType `yaml:"ElasticIP"`
-	EC2                   ResourceType `yaml:"EC2"`
-	EC2KeyPairs           ResourceType `yaml:"EC2KeyPairs"`
-	EC2DedicatedHosts     ResourceType `yaml:"EC2DedicatedHosts"`
-	CloudWatchLogGroup    ResourceType `yaml:"CloudWatchLogGroup"`
-	KMSCustomerKeys       ResourceType `yaml:"KMSCustomerKeys"`
-	EKSCluster            ResourceType `yaml:"EKSCluster"`
-	SageMakerNotebook     ResourceType `yaml:"SageMakerNotebook"`
-	KinesisStream         ResourceType `yaml:"KinesisStream"`
-	APIGateway            ResourceType `yaml:"APIGateway"`
-	APIGatewayV2          ResourceType `yaml:"APIGatewayV2"`
-	ElasticFileSystem     ResourceType `yaml:"ElasticFileSystem"`
-	CloudtrailTrail       ResourceType `yaml:"CloudtrailTrail"`
-	ECRRepository         ResourceType `yaml:"ECRRepository"`
-	DBInstances           ResourceType `yaml:"DBInstances"`
-	LaunchTemplate        ResourceType `yaml:"LaunchTemplate"`
-	ConfigServiceRule     ResourceType `yaml:"ConfigServiceRule"`
-	ConfigServiceRecorder ResourceType `yaml:"ConfigServiceRecorder"`
-	CloudWatchAlarm       ResourceType `yaml:"CloudWatchAlarm"`
+	S3                                ResourceType                      `yaml:"s3"`
+	IAMUsers                          ResourceType                      `yaml:"IAMUsers"`
+	IAMGroups                         ResourceType                      `yaml:"IAMGroups"`
+	IAMPolicies                       ResourceType                      `yaml:"IAMPolicies"`
+	IAMServiceLinkedRoles             ResourceType                      `yaml:"IAMServiceLinkedRoles"`
+	IAMRoles                          IAMRolesResourceType              `yaml:"IAMRoles"`
+	SecretsManagerSecrets             SecretsManagerSecretsResourceType `yaml:"SecretsManager"`
+	NatGateway                        ResourceType                      `yaml:"NatGateway"`
+	AccessAnalyzer                    ResourceType                      `yaml:"AccessAnalyzer"`
+	CloudWatchDashboard               ResourceType                      `yaml:"CloudWatchDashboard"`
+	OpenSearchDomain                  ResourceType                      `yaml:"OpenSearchDomain"`
+	DynamoDB                          DynamoDBResourceType              `yaml:"DynamoDB"`
+	EBSVolume                         EBSVolumeResourceType             `yaml:"EBSVolume"`
+	LambdaFunction                    ResourceType                      `yaml:"LambdaFunction"`
+	ELBv2                             ResourceType                      `yaml:"ELBv2"`
+	ECSService                        ResourceType                      `yaml:"ECSService"`
+	ECSCluster                        ResourceType                      `yaml:"ECSCluster"`
+	Elasticache                       ResourceType                      `yaml:"Elasticache"`
+	VPC                               ResourceType                      `yaml:"VPC"`
+	OIDCProvider                      ResourceType                      `yaml:"OIDCProvider"`
+	AutoScalingGroup                  ResourceType                      `yaml:"AutoScalingGroup"`
+	LaunchConfiguration               ResourceType                      `yaml:"LaunchConfiguration"`
+	ElasticIP                         ResourceType                      `yaml:"ElasticIP"`
+	EC2                               ResourceType                      `yaml:"EC2"`
+	EC2KeyPairs                       ResourceType                      `yaml:"EC2KeyPairs"`
+	EC2DedicatedHosts                 ResourceType                      `yaml:"EC2DedicatedHosts"`
+	CloudWatchLogGroup                ResourceType                      `yaml:"CloudWatchLogGroup"`
+	KMSCustomerKeys                   KMSCustomerKeysResourceType       `yaml:"KMSCustomerKeys"`
+	EKSCluster                        ResourceType                      `yaml:"EKSCluster"`
+	SageMakerNotebook                 ResourceType                      `yaml:"SageMakerNotebook"`
+	KinesisStream                     ResourceType                      `yaml:"KinesisStream"`
+	APIGateway                        ResourceType                      `yaml:"APIGateway"`
+	APIGatewayV2                      ResourceType                      `yaml:"APIGatewayV2"`
+	ElasticFileSystem                 ResourceType                      `yaml:"ElasticFileSystem"`
+	CloudtrailTrail                   CloudtrailTrailResourceType       `yaml:"CloudtrailTrail"`
+	ECRRepository                     ResourceType                      `yaml:"ECRRepository"`
+	DBInstances                       ResourceType                      `yaml:"DBInstances"`
+	LaunchTemplate                    ResourceType                      `yaml:"LaunchTemplate"`
+	ConfigServiceRule                 ResourceType                      `yaml:"ConfigServiceRule"`
+	ConfigServiceRecorder             ResourceType                      `yaml:"ConfigServiceRecorder"`
+	CloudWatchAlarm                   ResourceType                      `yaml:"CloudWatchAlarm"`
+	EBSSnapshot                       ResourceType                      `yaml:"EBSSnapshot"`
+	AMI                               ResourceType                      `yaml:"AMI"`
+	VPCEndpoint                       ResourceType                      `yaml:"VPCEndpoint"`
+	InternetGateway                   ResourceType                      `yaml:"InternetGateway"`
+	SecurityGroup                     ResourceType                      `yaml:"SecurityGroup"`
+	NetworkInterface                  NetworkResourceType               `yaml:"NetworkInterface"`
+	Route53HostedZone                 ResourceType                      `yaml:"Route53HostedZone"`
+	DynamoDBBackup                    ResourceType                      `yaml:"DynamoDBBackup"`
+	SQS                               ResourceType                      `yaml:"SQS"`
+	SNS                               ResourceType                      `yaml:"SNS"`
+	LambdaLayer                       ResourceType                      `yaml:"LambdaLayer"`
+	ECRPublicRepository               ResourceType                      `yaml:"ECRPublicRepository"`
+	ECSTaskDefinition                 ECSTaskDefinitionResourceType     `yaml:"ECSTaskDefinition"`
+	SfnStateMachine                   ResourceType                      `yaml:"SfnStateMachine"`
+	KinesisFirehose                   ResourceType                      `yaml:"KinesisFirehose"`
+	MSKCluster                        ResourceType                      `yaml:"MSKCluster"`
+	Redshift                          ResourceType                      `yaml:"Redshift"`
+	RedshiftSnapshot                  ResourceType                      `yaml:"RedshiftSnapshot"`
+	RedshiftServerless                ResourceType                      `yaml:"RedshiftServerless"`
+	GlueJob                           ResourceType                      `yaml:"GlueJob"`
+	GlueCrawler                       ResourceType                      `yaml:"GlueCrawler"`
+	GlueDatabase                      ResourceType                      `yaml:"GlueDatabase"`
+	GlueDevEndpoint                   ResourceType                      `yaml:"GlueDevEndpoint"`
+	AthenaWorkgroup                   AthenaWorkgroupResourceType       `yaml:"AthenaWorkgroup"`
+	SageMakerEndpoint                 ResourceType                      `yaml:"SageMakerEndpoint"`
+	SageMakerModel                    ResourceType                      `yaml:"SageMakerModel"`
+	SageMakerStudioDomain             ResourceType                      `yaml:"SageMakerStudioDomain"`
+	FSx                               ResourceType                      `yaml:"FSx"`
+	ElasticBeanstalk                  ResourceType                      `yaml:"ElasticBeanstalk"`
+	CloudFormationStack               CloudFormationStackResourceType   `yaml:"CloudFormationStack"`
+	AppSync                           ResourceType                      `yaml:"AppSync"`
+	CognitoUserPool                   ResourceType                      `yaml:"CognitoUserPool"`
+	CognitoIdentityPool               ResourceType                      `yaml:"CognitoIdentityPool"`
+	ACM                               ResourceType                      `yaml:"ACM"`
+	WAFv2WebACL                       ResourceType                      `yaml:"WAFv2WebACL"`
+	WAFv2RuleGroup                    ResourceType                      `yaml:"WAFv2RuleGroup"`
+	WAFv2IPSet                        ResourceType                      `yaml:"WAFv2IPSet"`
+	GlobalAccelerator                 ResourceType                      `yaml:"GlobalAccelerator"`
+	LightsailInstance                 ResourceType                      `yaml:"LightsailInstance"`
+	LightsailDatabase                 ResourceType                      `yaml:"LightsailDatabase"`
+	LightsailStaticIp                 ResourceType                      `yaml:"LightsailStaticIp"`
+	LightsailLoadBalancer             ResourceType                      `yaml:"LightsailLoadBalancer"`
+	BatchJobQueue                     ResourceType                      `yaml:"BatchJobQueue"`
+	BatchComputeEnvironment           ResourceType                      `yaml:"BatchComputeEnvironment"`
+	EMRCluster                        ResourceType                      `yaml:"EMRCluster"`
+	OpenSearchServerlessCollection    ResourceType                      `yaml:"OpenSearchServerlessCollection"`
+	NeptuneCluster                    ResourceType                      `yaml:"NeptuneCluster"`
+	DocDBCluster                      ResourceType                      `yaml:"DocDBCluster"`
+	TimestreamDatabase                ResourceType                      `yaml:"TimestreamDatabase"`
+	QLDBLedger                        ResourceType                      `yaml:"QLDBLedger"`
+	MQBroker                          ResourceType                      `yaml:"MQBroker"`
+	CodeBuildProject                  CodeBuildProjectResourceType      `yaml:"CodeBuildProject"`
+	CodePipelinePipeline              ResourceType                      `yaml:"CodePipelinePipeline"`
+	CodeCommitRepository              ResourceType                      `yaml:"CodeCommitRepository"`
+	CodeDeployApplication             ResourceType                      `yaml:"CodeDeployApplication"`
+	EventBridgeRule                   ResourceType                      `yaml:"EventBridgeRule"`
+	EventBridgeBus                    ResourceType                      `yaml:"EventBridgeBus"`
+	EventBridgeSchedule               ResourceType                      `yaml:"EventBridgeSchedule"`
+	EventBridgeScheduleGroup          ResourceType                      `yaml:"EventBridgeScheduleGroup"`
+	SSMParameter                      ResourceType                      `yaml:"SSMParameter"`
+	SSMAssociation                    ResourceType                      `yaml:"SSMAssociation"`
+	SSMDocument                       ResourceType                      `yaml:"SSMDocument"`
+	IAMInstanceProfiles               ResourceType                      `yaml:"IAMInstanceProfiles"`
+	SAMLProvider                      ResourceType                      `yaml:"SAMLProvider"`
+	BackupPlan                        ResourceType                      `yaml:"BackupPlan"`
+	BackupVault                       ResourceType                      `yaml:"BackupVault"`
+	StorageGateway                    ResourceType                      `yaml:"StorageGateway"`
+	DataSyncTask                      ResourceType                      `yaml:"DataSyncTask"`
+	DataSyncLocation                  ResourceType                      `yaml:"DataSyncLocation"`
+	DataSyncAgent                     ResourceType                      `yaml:"DataSyncAgent"`
+	TransferServer                    ResourceType                      `yaml:"TransferServer"`
+	AppRunnerService                  ResourceType                      `yaml:"AppRunnerService"`
+	AppRunnerAutoScalingConfiguration ResourceType                      `yaml:"AppRunnerAutoScalingConfiguration"`
+	AppRunnerVpcConnector             ResourceType                      `yaml:"AppRunnerVpcConnector"`
+	AmplifyApp                        ResourceType                      `yaml:"AmplifyApp"`
+	IoTCertificate                    ResourceType                      `yaml:"IoTCertificate"`
+	IoTThing                          ResourceType                      `yaml:"IoTThing"`
+	IoTThingGroup                     ResourceType                      `yaml:"IoTThingGroup"`
+	IoTPolicy                         ResourceType                      `yaml:"IoTPolicy"`
+	Workspace                         ResourceType                      `yaml:"Workspace"`
+	WorkspaceDirectory                ResourceType                      `yaml:"WorkspaceDirectory"`
+	AppStreamFleet                    ResourceType                      `yaml:"AppStreamFleet"`
+	AppStreamStack                    ResourceType                      `yaml:"AppStreamStack"`
+	AppStreamImageBuilder             ResourceType                      `yaml:"AppStreamImageBuilder"`
+	ServiceCatalogProvisionedProduct  ResourceType                      `yaml:"ServiceCatalogProvisionedProduct"`
+	ServiceCatalogProduct             ResourceType                      `yaml:"ServiceCatalogProduct"`
+	ServiceCatalogPortfolio           ResourceType                      `yaml:"ServiceCatalogPortfolio"`
+	ConfigServiceConformancePack      ResourceType                      `yaml:"ConfigServiceConformancePack"`
+	ConfigServiceDeliveryChannel      ResourceType                      `yaml:"ConfigServiceDeliveryChannel"`
+	MacieClassificationJob            ResourceType                      `yaml:"MacieClassificationJob"`
+	RdsSnapshot                       ResourceType                      `yaml:"RdsSnapshot"`
+	RdsClusterSnapshot                ResourceType                      `yaml:"RdsClusterSnapshot"`
+	RdsProxy                          ResourceType                      `yaml:"RdsProxy"`
+	RdsParameterGroup                 ResourceType                      `yaml:"RdsParameterGroup"`
+	RdsOptionGroup                    ResourceType                      `yaml:"RdsOptionGroup"`
+	RdsSubnetGroup                    ResourceType                      `yaml:"RdsSubnetGroup"`
+	ElasticacheServerless             ResourceType                      `yaml:"ElasticacheServerless"`
+	ElasticacheUserGroup              ResourceType                      `yaml:"ElasticacheUserGroup"`
+	ElasticacheUser                   ResourceType                      `yaml:"ElasticacheUser"`
+	EC2PlacementGroup                 ResourceType                      `yaml:"EC2PlacementGroup"`
 }
 
 type ResourceType struct {
@@ -58,14 +161,111 @@ type ResourceType struct {
 	ExcludeRule FilterRule `yaml:"exclude"`
 }
 
+// NetworkResourceType - the config of resources that can also be scoped to the network they live in
+type NetworkResourceType struct {
+	IncludeRule NetworkFilterRule `yaml:"include"`
+	ExcludeRule NetworkFilterRule `yaml:"exclude"`
+}
+
+// EBSVolumeResourceType - the config of EBS volumes, which support options of their own on top of the filters
+type EBSVolumeResourceType struct {
+	NetworkResourceType `yaml:",inline"`
+	// InheritStackExclusion opts in to also excluding volumes whose parent CloudFormation stack carries the cloud-nuke
+	// exclusion tag, at the cost of extra DescribeStacks calls
+	InheritStackExclusion bool `yaml:"inherit_stack_exclusion"`
+	// ProtectRecentActivity opts in to skipping volumes that show activity within the given window (e.g. 30m),
+	// regardless of how old they are
+	ProtectRecentActivity time.Duration `yaml:"protect_recent_activity"`
+}
+
+// AthenaWorkgroupResourceType - the config of Athena workgroups
+type AthenaWorkgroupResourceType struct {
+	ResourceType `yaml:",inline"`
+	// CleanQueryResults opts in to also deleting the query results a workgroup has written to S3
+	CleanQueryResults bool `yaml:"clean_query_results"`
+}
+
+// CloudFormationStackResourceType - the config of CloudFormation stacks
+type CloudFormationStackResourceType struct {
+	ResourceType `yaml:",inline"`
+	// DisableTerminationProtection opts in to lifting termination protection from stacks so they can be deleted,
+	// instead of skipping them
+	DisableTerminationProtection bool `yaml:"disable_termination_protection"`
+	// RetainFailedResources opts in to deleting stacks that repeatedly fail to delete while retaining the resources
+	// that block them
+	RetainFailedResources bool `yaml:"retain_failed_resources"`
+}
+
+// DynamoDBResourceType - the config of DynamoDB tables
+type DynamoDBResourceType struct {
+	ResourceType `yaml:",inline"`
+	// DisableDeletionProtection opts in to lifting deletion protection from tables so they can be deleted, instead of
+	// reporting them
+	DisableDeletionProtection bool `yaml:"disable_deletion_protection"`
+}
+
+// ECSTaskDefinitionResourceType - the config of ECS task definitions
+type ECSTaskDefinitionResourceType struct {
+	ResourceType `yaml:",inline"`
+	// DeleteRevisions opts in to deleting revisions once they are deregistered, along with the revisions that were
+	// already inactive
+	DeleteRevisions bool `yaml:"delete_revisions"`
+}
+
+// SecretsManagerSecretsResourceType - the config of Secrets Manager secrets
+type SecretsManagerSecretsResourceType struct {
+	ResourceType `yaml:",inline"`
+	// RecoveryWindowInDays schedules secrets for deletion after the given number of days instead of deleting them
+	// immediately without the possibility of recovery
+	RecoveryWindowInDays int64 `yaml:"recovery_window_in_days"`
+}
+
+// KMSCustomerKeysResourceType - the config of KMS customer managed keys
+type KMSCustomerKeysResourceType struct {
+	ResourceType `yaml:",inline"`
+	// RecoveryWindowInDays sets the waiting period, in days, after which keys scheduled for deletion are deleted
+	RecoveryWindowInDays int64 `yaml:"recovery_window_in_days"`
+}
+
+// CodeBuildProjectResourceType - the config of CodeBuild projects
+type CodeBuildProjectResourceType struct {
+	ResourceType `yaml:",inline"`
+	// DeleteReportGroups opts in to also deleting the report groups a project has created, along with their reports
+	DeleteReportGroups bool `yaml:"delete_report_groups"`
+}
+
+// IAMRolesResourceType - the config of IAM roles
+type IAMRolesResourceType struct {
+	ResourceType `yaml:",inline"`
+	// ProtectedNames lists the exact names of roles that must never be deleted, whatever the other rules say
+	ProtectedNames []string `yaml:"protected_names"`
+}
+
+// CloudtrailTrailResourceType - the config of CloudTrail trails
+type CloudtrailTrailResourceType struct {
+	ResourceType `yaml:",inline"`
+	// ProtectMultiRegionTrails opts in to keeping the trails that apply to all regions or to the whole organization
+	ProtectMultiRegionTrails bool `yaml:"protect_multi_region_trails"`
+}
+
 type FilterRule struct {
 	NamesRegExp []Expression `yaml:"names_regex"`
 }
 
+// NetworkFilterRule - a filter rule that can also match the IP of a resource against CIDR blocks
+type NetworkFilterRule struct {
+	FilterRule `yaml:",inline"`
+	CIDRs      []CIDR `yaml:"cidrs"`
+}
+
 type Expression struct {
 	RE regexp.Regexp
 }
 
+type CIDR struct {
+	Net net.IPNet
+}
+
 // UnmarshalText - Internally used by yaml.Unmarshal to unmarshall an Expression field
 func (expression *Expression) UnmarshalText(data []byte) error {
 	var pattern string
@@ -84,6 +284,24 @@ func (expression *Expression) UnmarshalText(data []byte) error {
 	return nil
 }
 
+// UnmarshalText - Internally used by yaml.Unmarshal to unmarshall a CIDR field
+func (cidr *CIDR) UnmarshalText(data []byte) error {
+	var block string
+
+	if err := yaml.Unmarshal(data, &block); err != nil {
+		return err
+	}
+
+	_, ipNet, err := net.ParseCIDR(block)
+	if err != nil {
+		return err
+	}
+
+	cidr.Net = *ipNet
+
+	return nil
+}
+
 // GetConfig - Unmarshall the config file and parse it into a config object.
 func GetConfig(filePath string) (*Config, error) {
 	var configObj Config
@@ -103,9 +321,37 @@ func GetConfig(filePath string) (*Config, error) {
 		return nil, err
 	}
 
+	if err := validateRecoveryWindow("SecretsManager", configObj.SecretsManagerSecrets.RecoveryWindowInDays); err != nil {
+		return nil, err
+	}
+	if err := validateRecoveryWindow("KMSCustomerKeys", configObj.KMSCustomerKeys.RecoveryWindowInDays); err != nil {
+		return nil, err
+	}
+
 	return &configObj, nil
 }
 
+// Both Secrets Manager and KMS only accept waiting periods between 7 and 30 days
+const (
+	minRecoveryWindowInDays = 7
+	maxRecoveryWindowInDays = 30
+)
+
+// validateRecoveryWindow checks that a recovery window, when set, is one the APIs accept, so that a bad value is
+// reported when the config loads rather than once for every resource being deleted
+func validateRecoveryWindow(resourceType string, days int64) error {
+	if days == 0 {
+		return nil
+	}
+	if days < minRecoveryWindowInDays || days > maxRecoveryWindowInDays {
+		return fmt.Errorf(
+			"%s: recovery_window_in_days must be between %d and %d, got %d",
+			resourceType, minRecoveryWindowInDays, maxRecoveryWindowInDays, days,
+		)
+	}
+	return nil
+}
+
 func matches(name string, regexps []Expression) bool {
 	for _, re := range regexps {
 		if re.RE.MatchString(name) {
@@ -131,3 +377,34 @@ func ShouldInclude(name string, includeREs []Expression, excludeREs []Expression
 		return matches(name, includeREs)
 	}
 }
+
+func containsIP(ip net.IP, cidrs []CIDR) bool {
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range cidrs {
+		if cidr.Net.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ShouldIncludeIP - Checks if a resource's IP address should be included according to the CIDR inclusion and exclusion
+// rules. A nil IP (e.g. a resource that could not be placed in a network) never falls within any CIDR, so it is only
+// included when there are no inclusion rules.
+func ShouldIncludeIP(ip net.IP, includeCIDRs []CIDR, excludeCIDRs []CIDR) bool {
+	if len(includeCIDRs) == 0 && len(excludeCIDRs) == 0 {
+		// If no rules are defined, should always include
+		return true
+	} else if containsIP(ip, excludeCIDRs) {
+		// If the IP falls within an excluded CIDR, should not include
+		return false
+	} else if len(includeCIDRs) == 0 {
+		// Given the IP is not in an excluded CIDR, should include if there is no 'include' list
+		return true
+	} else {
+		// Given there is a 'include' list, should include only if the IP falls within one of its CIDRs
+		return containsIP(ip, includeCIDRs)
+	}
+}
@@ -1,58 +1,18 @@
 package config
 
 import (
+	"net"
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func emptyConfig() *Config {
-	return &Config{
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-		ResourceType{FilterRule{}, FilterRule{}},
-	}
+	return &Config{}
 }
 
 func TestConfig_Garbage(t *testing.T) {
@@ -931,6 +891,202 @@ func TestConfigElasticFileSystem_FilterNames(t *testing.T) {
 
 // end ElasticFileSystem tests
 
+// EBS CIDR tests
+
+func TestConfigEBS_IncludeCIDRs(t *testing.T) {
+	configFilePath := "./mocks/ebs_include_cidrs.yaml"
+	configObj, err := GetConfig(configFilePath)
+
+	require.NoError(t, err)
+
+	if reflect.DeepEqual(configObj, emptyConfig()) {
+		assert.Fail(t, "Config should not be empty, %+v\n", configObj)
+	}
+
+	require.Len(t, configObj.EBSVolume.IncludeRule.CIDRs, 1)
+	require.Len(t, configObj.EBSVolume.ExcludeRule.CIDRs, 1)
+	assert.Equal(t, "10.0.0.0/16", configObj.EBSVolume.IncludeRule.CIDRs[0].Net.String())
+	assert.Equal(t, "10.0.128.0/24", configObj.EBSVolume.ExcludeRule.CIDRs[0].Net.String())
+
+	return
+}
+
+func TestConfigEBS_MalformedCIDRs(t *testing.T) {
+	configFilePath := "./mocks/ebs_malformed_cidrs.yaml"
+	_, err := GetConfig(configFilePath)
+
+	require.Error(t, err, "Received expected error")
+	return
+}
+
+func TestConfigNetworkInterface_IncludeCIDRsWithNames(t *testing.T) {
+	configFilePath := "./mocks/network_interface_include_cidrs_with_names.yaml"
+	configObj, err := GetConfig(configFilePath)
+
+	require.NoError(t, err)
+
+	require.Len(t, configObj.NetworkInterface.IncludeRule.NamesRegExp, 1)
+	require.Len(t, configObj.NetworkInterface.IncludeRule.CIDRs, 1)
+	assert.Equal(t, "^cloud-nuke-.*", configObj.NetworkInterface.IncludeRule.NamesRegExp[0].RE.String())
+	assert.Equal(t, "10.0.0.0/16", configObj.NetworkInterface.IncludeRule.CIDRs[0].Net.String())
+
+	return
+}
+
+// end EBS CIDR tests
+
+func TestConfigEBS_InheritStackExclusion(t *testing.T) {
+	configFilePath := "./mocks/ebs_inherit_stack_exclusion.yaml"
+	configObj, err := GetConfig(configFilePath)
+
+	require.NoError(t, err)
+
+	if reflect.DeepEqual(configObj, emptyConfig()) {
+		assert.Fail(t, "Config should not be empty, %+v\n", configObj)
+	}
+
+	assert.True(t, configObj.EBSVolume.InheritStackExclusion)
+
+	return
+}
+
+func TestConfigEBS_ProtectRecentActivity(t *testing.T) {
+	configFilePath := "./mocks/ebs_protect_recent_activity.yaml"
+	configObj, err := GetConfig(configFilePath)
+
+	require.NoError(t, err)
+
+	if reflect.DeepEqual(configObj, emptyConfig()) {
+		assert.Fail(t, "Config should not be empty, %+v\n", configObj)
+	}
+
+	assert.Equal(t, 90*time.Minute, configObj.EBSVolume.ProtectRecentActivity)
+
+	return
+}
+
+func TestConfigAthenaWorkgroup_CleanQueryResults(t *testing.T) {
+	configFilePath := "./mocks/athena_workgroup_clean_query_results.yaml"
+	configObj, err := GetConfig(configFilePath)
+
+	require.NoError(t, err)
+
+	if reflect.DeepEqual(configObj, emptyConfig()) {
+		assert.Fail(t, "Config should not be empty, %+v\n", configObj)
+	}
+
+	assert.True(t, configObj.AthenaWorkgroup.CleanQueryResults)
+
+	return
+}
+
+func TestConfigCloudFormationStack_DeletionOverrides(t *testing.T) {
+	configFilePath := "./mocks/cloudformation_stack_deletion_overrides.yaml"
+	configObj, err := GetConfig(configFilePath)
+
+	require.NoError(t, err)
+
+	if reflect.DeepEqual(configObj, emptyConfig()) {
+		assert.Fail(t, "Config should not be empty, %+v\n", configObj)
+	}
+
+	assert.True(t, configObj.CloudFormationStack.DisableTerminationProtection)
+	assert.True(t, configObj.CloudFormationStack.RetainFailedResources)
+
+	return
+}
+
+func TestConfigDynamoDB_DisableDeletionProtection(t *testing.T) {
+	configFilePath := "./mocks/dynamodb_disable_deletion_protection.yaml"
+	configObj, err := GetConfig(configFilePath)
+
+	require.NoError(t, err)
+
+	if reflect.DeepEqual(configObj, emptyConfig()) {
+		assert.Fail(t, "Config should not be empty, %+v\n", configObj)
+	}
+
+	assert.True(t, configObj.DynamoDB.DisableDeletionProtection)
+
+	return
+}
+
+func TestConfigECSTaskDefinition_DeleteRevisions(t *testing.T) {
+	configFilePath := "./mocks/ecs_task_definition_delete_revisions.yaml"
+	configObj, err := GetConfig(configFilePath)
+
+	require.NoError(t, err)
+
+	if reflect.DeepEqual(configObj, emptyConfig()) {
+		assert.Fail(t, "Config should not be empty, %+v\n", configObj)
+	}
+
+	assert.True(t, configObj.ECSTaskDefinition.DeleteRevisions)
+
+	return
+}
+
+func TestConfigSecretsManager_RecoveryWindow(t *testing.T) {
+	configFilePath := "./mocks/secrets_manager_recovery_window.yaml"
+	configObj, err := GetConfig(configFilePath)
+
+	require.NoError(t, err)
+
+	if reflect.DeepEqual(configObj, emptyConfig()) {
+		assert.Fail(t, "Config should not be empty, %+v\n", configObj)
+	}
+
+	assert.Equal(t, int64(7), configObj.SecretsManagerSecrets.RecoveryWindowInDays)
+
+	return
+}
+
+func TestConfigSecretsManager_RecoveryWindowTooShort(t *testing.T) {
+	configFilePath := "./mocks/secrets_manager_recovery_window_too_short.yaml"
+	_, err := GetConfig(configFilePath)
+
+	require.Error(t, err, "Received expected error")
+	return
+}
+
+func TestConfigKMSCustomerKeys_RecoveryWindowTooLong(t *testing.T) {
+	configFilePath := "./mocks/kms_customer_keys_recovery_window_too_long.yaml"
+	_, err := GetConfig(configFilePath)
+
+	require.Error(t, err, "Received expected error")
+	return
+}
+
+func TestConfigCodeBuild_DeleteReportGroups(t *testing.T) {
+	configFilePath := "./mocks/codebuild_delete_report_groups.yaml"
+	configObj, err := GetConfig(configFilePath)
+
+	require.NoError(t, err)
+
+	if reflect.DeepEqual(configObj, emptyConfig()) {
+		assert.Fail(t, "Config should not be empty, %+v\n", configObj)
+	}
+
+	assert.True(t, configObj.CodeBuildProject.DeleteReportGroups)
+
+	return
+}
+
+func TestConfigIAMRoles_ProtectedNames(t *testing.T) {
+	configFilePath := "./mocks/iam_role_protected_names.yaml"
+	configObj, err := GetConfig(configFilePath)
+
+	require.NoError(t, err)
+
+	if reflect.DeepEqual(configObj, emptyConfig()) {
+		assert.Fail(t, "Config should not be empty, %+v\n", configObj)
+	}
+
+	assert.Equal(t, []string{"ci-deployer", "break-glass"}, configObj.IAMRoles.ProtectedNames)
+
+	return
+}
+
 func TestShouldInclude_AllowWhenEmpty(t *testing.T) {
 	var includeREs []Expression
 	var excludeREs []Expression
@@ -981,3 +1137,77 @@ func TestShouldInclude_WhenMatchesIncludeAndExclude(t *testing.T) {
 	assert.False(t, ShouldInclude("terraform-tf-state", includeREs, excludeREs),
 		"Should not include when doesn't matches 'include' list")
 }
+
+func mustParseCIDR(t *testing.T, block string) CIDR {
+	_, ipNet, err := net.ParseCIDR(block)
+	require.NoError(t, err)
+	return CIDR{Net: *ipNet}
+}
+
+func TestShouldIncludeIP_AllowWhenEmpty(t *testing.T) {
+	var includeCIDRs []CIDR
+	var excludeCIDRs []CIDR
+
+	assert.True(t, ShouldIncludeIP(net.ParseIP("10.0.0.1"), includeCIDRs, excludeCIDRs),
+		"Should include when both lists are empty")
+	assert.True(t, ShouldIncludeIP(nil, includeCIDRs, excludeCIDRs),
+		"Should include an unresolved IP when both lists are empty")
+}
+
+func TestShouldIncludeIP_ExcludeWhenWithin(t *testing.T) {
+	var includeCIDRs []CIDR
+	excludeCIDRs := []CIDR{mustParseCIDR(t, "10.0.0.0/16")}
+
+	assert.False(t, ShouldIncludeIP(net.ParseIP("10.0.3.4"), includeCIDRs, excludeCIDRs),
+		"Should not include when within the 'exclude' list")
+	assert.True(t, ShouldIncludeIP(net.ParseIP("172.16.0.4"), includeCIDRs, excludeCIDRs),
+		"Should include when not within the 'exclude' list")
+	assert.True(t, ShouldIncludeIP(nil, includeCIDRs, excludeCIDRs),
+		"Should include an unresolved IP when there is no 'include' list")
+}
+
+func TestShouldIncludeIP_IncludeWhenWithin(t *testing.T) {
+	includeCIDRs := []CIDR{mustParseCIDR(t, "10.0.0.0/16")}
+	excludeCIDRs := []CIDR{mustParseCIDR(t, "10.0.128.0/24")}
+
+	assert.True(t, ShouldIncludeIP(net.ParseIP("10.0.3.4"), includeCIDRs, excludeCIDRs),
+		"Should include when within the 'include' list but not the 'exclude' list")
+	assert.False(t, ShouldIncludeIP(net.ParseIP("10.0.128.9"), includeCIDRs, excludeCIDRs),
+		"Should not include when within the 'exclude' list")
+	assert.False(t, ShouldIncludeIP(net.ParseIP("192.168.1.1"), includeCIDRs, excludeCIDRs),
+		"Should not include when not within the 'include' list")
+	assert.False(t, ShouldIncludeIP(nil, includeCIDRs, excludeCIDRs),
+		"Should not include an unresolved IP when there is an 'include' list")
+}
+
+func TestConfigCloudtrailTrail_ProtectMultiRegionTrails(t *testing.T) {
+	configFilePath := "./mocks/cloudtrail_protect_multi_region_trails.yaml"
+	configObj, err := GetConfig(configFilePath)
+
+	require.NoError(t, err)
+
+	if reflect.DeepEqual(configObj, emptyConfig()) {
+		assert.Fail(t, "Config should not be empty, %+v\n", configObj)
+	}
+
+	assert.True(t, configObj.CloudtrailTrail.ProtectMultiRegionTrails)
+
+	return
+}
+
+func TestConfigAthenaWorkgroup_OptionsWithFilters(t *testing.T) {
+	configFilePath := "./mocks/athena_workgroup_options_with_filters.yaml"
+	configObj, err := GetConfig(configFilePath)
+
+	require.NoError(t, err)
+
+	// Options of a resource type sit next to its filters
+	expected := emptyConfig()
+	expected.AthenaWorkgroup.IncludeRule.NamesRegExp = []Expression{{RE: *regexp.MustCompile("^cloud-nuke-.*")}}
+	expected.AthenaWorkgroup.CleanQueryResults = true
+	if !reflect.DeepEqual(configObj, expected) {
+		assert.Fail(t, "Config should match the expected config", "%+v\n", configObj)
+	}
+
+	return
+}
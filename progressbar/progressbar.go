@@ -1,13 +1,25 @@
 package progressbar
 
 import (
+	"fmt"
+	"os"
 	"time"
 
 	"github.com/pterm/pterm"
 )
 
+// How often the ETA shown in the progressbar title is refreshed
+const etaUpdateInterval = 5 * time.Second
+
 var p *pterm.ProgressbarPrinter
 
+var (
+	etaEnabled    bool
+	startedAt     time.Time
+	lastETAUpdate time.Time
+	completed     int
+)
+
 func init() {
 	p = &pterm.DefaultProgressbar
 	p.RemoveWhenDone = true
@@ -18,10 +30,58 @@ func GetProgressbar() *pterm.ProgressbarPrinter {
 	return p
 }
 
+// WithTotal sets the number of items the progressbar tracks, and starts measuring the rate at which they complete
 func WithTotal(i int) {
 	p = p.WithTotal(i)
+	startedAt = time.Now()
+	lastETAUpdate = startedAt
+	completed = 0
 }
 
 func UpdateTitle(t string) {
 	p = p.UpdateTitle(t)
 }
+
+// EnableETA turns on the periodic ETA shown in the progressbar title
+func EnableETA() {
+	etaEnabled = true
+}
+
+// IsTerminal returns true if the given file is attached to a TTY
+func IsTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// Increment advances the progressbar by one item and, when the ETA is enabled, periodically refreshes the ETA in its
+// title based on the rate observed so far
+func Increment() {
+	p.Increment()
+	completed++
+
+	if !etaEnabled {
+		return
+	}
+
+	now := time.Now()
+	if now.Sub(lastETAUpdate) < etaUpdateInterval {
+		return
+	}
+	lastETAUpdate = now
+
+	remaining := EstimateRemaining(completed, p.Total, now.Sub(startedAt))
+	UpdateTitle(fmt.Sprintf("Nuked %d/%d resources, ETA %s", completed, p.Total, remaining.Round(time.Second)))
+}
+
+// EstimateRemaining extrapolates how long the remaining items will take from the rate at which the completed ones
+// finished
+func EstimateRemaining(completed int, total int, elapsed time.Duration) time.Duration {
+	if completed <= 0 || total <= completed {
+		return 0
+	}
+	perItem := elapsed / time.Duration(completed)
+	return perItem * time.Duration(total-completed)
+}
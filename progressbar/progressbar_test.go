@@ -0,0 +1,21 @@
+package progressbar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateRemaining(t *testing.T) {
+	assert.Equal(t, 30*time.Second, EstimateRemaining(10, 40, 10*time.Second))
+	assert.Equal(t, 2*time.Minute, EstimateRemaining(1, 3, time.Minute))
+}
+
+func TestEstimateRemainingEdgeCases(t *testing.T) {
+	// Nothing completed yet, so there is no rate to extrapolate from
+	assert.Equal(t, time.Duration(0), EstimateRemaining(0, 40, 10*time.Second))
+	// Done, or more items recorded than targeted
+	assert.Equal(t, time.Duration(0), EstimateRemaining(40, 40, 10*time.Second))
+	assert.Equal(t, time.Duration(0), EstimateRemaining(41, 40, 10*time.Second))
+}
@@ -33,8 +33,7 @@ func Record(e Entry) {
 	m.Lock()
 	records[e.Identifier] = e
 	// Increment the progressbar so the user feels measurable progress on long-running nuke jobs
-	p := progressbar.GetProgressbar()
-	p.Increment()
+	progressbar.Increment()
 }
 
 // RecordBatch accepts a BatchEntry that contains a slice of identifiers, loops through them and converts each identifier to